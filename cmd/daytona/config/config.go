@@ -20,12 +20,18 @@ type Profile struct {
 	Id   string    `json:"id"`
 	Name string    `json:"name"`
 	Api  ServerApi `json:"api"`
+	// Set for profiles added from a workspace sharing link. The API key only grants access to this workspace
+	SharedWorkspaceId string `json:"sharedWorkspaceId,omitempty"`
 }
 
 type Config struct {
 	ActiveProfileId string    `json:"activeProfile"`
 	DefaultIdeId    string    `json:"defaultIde"`
 	Profiles        []Profile `json:"profiles"`
+	// IDE used for projects of the language detected by the agent, e.g. go -> goland
+	LanguageIdeIds map[string]string `json:"languageIdes,omitempty"`
+	// IDE used for a single project, keyed by "<WORKSPACE_ID>/<PROJECT_NAME>". Takes precedence over the language IDE
+	ProjectIdeIds map[string]string `json:"projectIdes,omitempty"`
 }
 
 type Ide struct {
@@ -85,6 +91,23 @@ func (c *Config) GetActiveProfile() (Profile, error) {
 	return Profile{}, errors.New("active profile not found")
 }
 
+// GetProjectIdeId returns the IDE configured for the project, falling back to the IDE of its language and then to the default IDE
+func (c *Config) GetProjectIdeId(workspaceId, projectName, language string) string {
+	if ideId, ok := c.ProjectIdeIds[GetProjectIdeKey(workspaceId, projectName)]; ok {
+		return ideId
+	}
+
+	if ideId, ok := c.LanguageIdeIds[language]; ok && language != "" {
+		return ideId
+	}
+
+	return c.DefaultIdeId
+}
+
+func GetProjectIdeKey(workspaceId, projectName string) string {
+	return fmt.Sprintf("%s/%s", workspaceId, projectName)
+}
+
 func (c *Config) Save() error {
 	configFilePath, err := getConfigPath()
 	if err != nil {
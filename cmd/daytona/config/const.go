@@ -52,6 +52,7 @@ func GetSupportedGitProviders() []GitProvider {
 		{"codeberg", "Codeberg"},
 		{"gitea", "Gitea"},
 		{"gitness", "Gitness"},
+		{"gerrit", "Gerrit"},
 		{"azure-devops", "Azure DevOps"},
 	}
 }
@@ -76,6 +77,8 @@ func GetDocsLinkFromGitProvider(providerId string) string {
 		return "https://docs.gitea.com/1.21/development/api-usage#generating-and-listing-api-tokens"
 	case "gitness":
 		return "https://docs.gitness.com/administration/user-management#generate-user-token"
+	case "gerrit":
+		return "https://gerrit-review.googlesource.com/Documentation/user-upload.html#http"
 	case "azure-devops":
 		return "https://learn.microsoft.com/en-us/azure/devops/organizations/accounts/use-personal-access-tokens-to-authenticate?view=azure-devops&tabs=Windows#create-a-pat"
 	default:
@@ -103,6 +106,8 @@ func GetScopesFromGitProvider(providerId string) string {
 		return "read:organization,write:repository,read:user"
 	case "gitness":
 		return "/"
+	case "gerrit":
+		return "Read access to the projects (HTTP password)"
 	case "azure-devops":
 		return "Code (Status, Read & Write); User Profile (Read); Project and Team (Read)"
 	default:
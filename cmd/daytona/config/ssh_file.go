@@ -114,12 +114,25 @@ func generateSshConfigEntry(profileId, workspaceId, projectName, knownHostsPath
 	tab := "\t"
 	projectHostname := GetProjectHostname(profileId, workspaceId, projectName)
 
+	keyPath, err := GetSshKeyPath()
+	if err != nil {
+		return "", err
+	}
+
+	certificatePath, err := GetSshCertificatePath(projectHostname)
+	if err != nil {
+		return "", err
+	}
+
+	// The key and certificate are only used if the server issues SSH certificates
 	config := fmt.Sprintf("Host %s\n"+
 		tab+"User daytona\n"+
 		tab+"StrictHostKeyChecking no\n"+
 		tab+"UserKnownHostsFile %s\n"+
 		tab+"ProxyCommand %s ssh-proxy %s %s %s\n"+
-		tab+"ForwardAgent yes\n\n", projectHostname, knownHostsPath, daytonaPath, profileId, workspaceId, projectName)
+		tab+"IdentityFile \"%s\"\n"+
+		tab+"CertificateFile \"%s\"\n"+
+		tab+"ForwardAgent yes\n\n", projectHostname, knownHostsPath, daytonaPath, profileId, workspaceId, projectName, keyPath, certificatePath)
 
 	return config, nil
 }
@@ -205,6 +218,25 @@ func GetProjectHostname(profileId, workspaceId, projectName string) string {
 	return fmt.Sprintf("%s-%s-%s", profileId, workspaceId, projectName)
 }
 
+// GetSshKeyPath returns the path of the private key the server issues SSH certificates for
+func GetSshKeyPath() (string, error) {
+	configDir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, "ssh", "id_ed25519"), nil
+}
+
+func GetSshCertificatePath(projectHostname string) (string, error) {
+	configDir, err := GetConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(configDir, "ssh", fmt.Sprintf("%s-cert.pub", projectHostname)), nil
+}
+
 func init() {
 	if runtime.GOOS == "windows" {
 		sshHomeDir = os.Getenv("USERPROFILE")
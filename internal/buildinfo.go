@@ -5,4 +5,7 @@ package internal
 
 var (
 	Version = "v0.0.0-dev"
+	// Base64 encoded Ed25519 key the release checksums are signed with. Set with -ldflags by release builds, see .goreleaser.yaml.
+	// Builds without the key can not self-update
+	ReleasePublicKey = ""
 )
@@ -4,6 +4,7 @@
 package tailscale
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
 	"strings"
@@ -28,6 +29,15 @@ func GetConnection(profile *config.Profile) (*tsnet.Server, error) {
 		return nil, err
 	}
 
+	if profile.SharedWorkspaceId != "" {
+		networkKey, res, err := apiClient.ShareAPI.GenerateSharedNetworkKey(context.Background()).Execute()
+		if err != nil {
+			return nil, apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		return getTsnetConnection(configDir, networkKey.GetKey(), networkKey.GetControlUrl())
+	}
+
 	serverConfig, res, err := apiClient.ServerAPI.GetConfigExecute(apiclient.ApiGetConfigRequest{})
 	if err != nil {
 		return nil, apiclient_util.HandleErrorResponse(res, err)
@@ -38,8 +48,6 @@ func GetConnection(profile *config.Profile) (*tsnet.Server, error) {
 		return nil, apiclient_util.HandleErrorResponse(res, err)
 	}
 
-	cliId := uuid.New().String()
-
 	var controlURL string
 	if strings.Contains(profile.Api.Url, "localhost") || strings.Contains(profile.Api.Url, "0.0.0.0") || strings.Contains(profile.Api.Url, "127.0.0.1") {
 		controlURL = fmt.Sprintf("http://localhost:%d", *serverConfig.HeadscalePort)
@@ -47,8 +55,14 @@ func GetConnection(profile *config.Profile) (*tsnet.Server, error) {
 		controlURL = util.GetFrpcHeadscaleUrl(*serverConfig.Frps.Protocol, *serverConfig.Id, *serverConfig.Frps.Domain)
 	}
 
+	return getTsnetConnection(configDir, *networkKey.Key, controlURL)
+}
+
+func getTsnetConnection(configDir, authKey, controlURL string) (*tsnet.Server, error) {
+	cliId := uuid.New().String()
+
 	return tailscale.GetConnection(&tailscale.TsnetConnConfig{
-		AuthKey:    *networkKey.Key,
+		AuthKey:    authKey,
 		ControlURL: controlURL,
 		Dir:        filepath.Join(configDir, "tailscale", cliId),
 		Logf:       func(format string, args ...any) {},
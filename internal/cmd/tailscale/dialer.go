@@ -0,0 +1,86 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tailscale
+
+import (
+	"context"
+	"net"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+)
+
+type Dialer interface {
+	Dial(ctx context.Context, network, address string) (net.Conn, error)
+}
+
+// Projects in the tailnet network mode are reached directly through the tailnet the host is connected to
+type tailnetDialer struct {
+	net.Dialer
+}
+
+func (d *tailnetDialer) Dial(ctx context.Context, network, address string) (net.Conn, error) {
+	return d.DialContext(ctx, network, address)
+}
+
+// Returns a dialer for the workspace projects based on the network mode of the workspace target
+func GetDialer(profile *config.Profile, workspaceId string) (Dialer, error) {
+	networkMode, err := getNetworkMode(profile, workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	if networkMode == apiclient.NetworkModeTailnet {
+		return &tailnetDialer{}, nil
+	}
+
+	return GetConnection(profile)
+}
+
+func getNetworkMode(profile *config.Profile, workspaceId string) (apiclient.NetworkMode, error) {
+	apiClient, err := apiclient_util.GetApiClient(profile)
+	if err != nil {
+		return "", err
+	}
+
+	ctx := context.Background()
+
+	// Share keys can not access the targets and the server config
+	if profile.SharedWorkspaceId != "" {
+		sharedWorkspace, res, err := apiClient.ShareAPI.GetSharedWorkspace(ctx).Execute()
+		if err != nil {
+			return "", apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		return sharedWorkspace.GetNetworkMode(), nil
+	}
+
+	ws, res, err := apiClient.WorkspaceAPI.GetWorkspace(ctx, workspaceId).Execute()
+	if err != nil {
+		return "", apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	targets, res, err := apiClient.TargetAPI.ListTargets(ctx).Execute()
+	if err != nil {
+		return "", apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	for _, t := range targets.Items {
+		if t.GetName() == ws.GetTarget() && t.GetNetworkMode() != "" {
+			return t.GetNetworkMode(), nil
+		}
+	}
+
+	serverConfig, res, err := apiClient.ServerAPI.GetConfigExecute(apiclient.ApiGetConfigRequest{})
+	if err != nil {
+		return "", apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	if serverConfig.GetNetworkMode() != "" {
+		return serverConfig.GetNetworkMode(), nil
+	}
+
+	return apiclient.NetworkModeDaytona, nil
+}
@@ -10,9 +10,7 @@ import (
 	"net"
 
 	"github.com/daytonaio/daytona/pkg/ports"
-	"github.com/daytonaio/daytona/pkg/tailscale"
 	"github.com/daytonaio/daytona/pkg/workspace"
-	"tailscale.com/tsnet"
 )
 
 func ForwardPort(workspaceId, projectName string, targetPort uint16) (*uint16, chan error) {
@@ -27,7 +25,7 @@ func ForwardPort(workspaceId, projectName string, targetPort uint16) (*uint16, c
 		}
 	}
 
-	tsConn, err := tailscale.GetConnection(nil)
+	dialer, err := GetDialer(nil, workspaceId)
 	if err != nil {
 		errChan <- err
 		return nil, errChan
@@ -49,15 +47,15 @@ func ForwardPort(workspaceId, projectName string, targetPort uint16) (*uint16, c
 
 			targetUrl := fmt.Sprintf("%s:%d", workspace.GetProjectHostname(workspaceId, projectName), targetPort)
 
-			go handlePortConnection(conn, tsConn, targetUrl, errChan)
+			go handlePortConnection(conn, dialer, targetUrl, errChan)
 		}
 	}()
 
 	return &hostPort, errChan
 }
 
-func handlePortConnection(conn net.Conn, tsConn *tsnet.Server, targetUrl string, errChan chan error) {
-	dialConn, err := tsConn.Dial(context.Background(), "tcp", targetUrl)
+func handlePortConnection(conn net.Conn, dialer Dialer, targetUrl string, errChan chan error) {
+	dialConn, err := dialer.Dial(context.Background(), "tcp", targetUrl)
 	if err != nil {
 		errChan <- err
 		return
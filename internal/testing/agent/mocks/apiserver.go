@@ -11,12 +11,14 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/profiledata"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/gin-gonic/gin"
 )
 
-func NewMockRestServer(t *testing.T, workspace *workspace.Workspace) *httptest.Server {
+func NewMockRestServer(t *testing.T, workspace *workspace.Workspace, profileData *profiledata.ProfileData) *httptest.Server {
 	router := gin.Default()
 	serverController := router.Group("/server")
 	{
@@ -50,6 +52,20 @@ func NewMockRestServer(t *testing.T, workspace *workspace.Workspace) *httptest.S
 			// This simulates a non-configured git provider
 			ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get git provider for url"))
 		})
+		gitproviderController.GET("/credential/:url", func(ctx *gin.Context) {
+			ctx.JSON(http.StatusOK, gitprovider.GitCredential{
+				Username:  "daytona",
+				Password:  "token",
+				ExpiresAt: "2024-01-01T00:00:00Z",
+			})
+		})
+	}
+
+	profileDataController := router.Group("/profile")
+	{
+		profileDataController.GET("/", func(ctx *gin.Context) {
+			ctx.JSON(http.StatusOK, profileData)
+		})
 	}
 
 	server := httptest.NewServer(router)
@@ -13,6 +13,7 @@ import (
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/image"
 	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/system"
 	"github.com/docker/docker/client"
 	v1 "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/stretchr/testify/mock"
@@ -42,6 +43,21 @@ func (m *MockApiClient) NetworkRemove(ctx context.Context, networkID string) err
 	return args.Error(0)
 }
 
+func (m *MockApiClient) NetworkInspect(ctx context.Context, networkID string, options types.NetworkInspectOptions) (types.NetworkResource, error) {
+	args := m.Called(ctx, networkID, options)
+	return args.Get(0).(types.NetworkResource), args.Error(1)
+}
+
+func (m *MockApiClient) NetworkConnect(ctx context.Context, networkID, container string, config *network.EndpointSettings) error {
+	args := m.Called(ctx, networkID, container, config)
+	return args.Error(0)
+}
+
+func (m *MockApiClient) NetworkDisconnect(ctx context.Context, networkID, container string, force bool) error {
+	args := m.Called(ctx, networkID, container, force)
+	return args.Error(0)
+}
+
 func (m *MockApiClient) ImageList(ctx context.Context, options image.ListOptions) ([]image.Summary, error) {
 	args := m.Called(ctx, options)
 	return args.Get(0).([]image.Summary), args.Error(1)
@@ -111,3 +127,13 @@ func (m *MockApiClient) VolumeRemove(ctx context.Context, volume string, force b
 	args := m.Called(ctx, volume, force)
 	return args.Error(0)
 }
+
+func (m *MockApiClient) ServerVersion(ctx context.Context) (types.Version, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(types.Version), args.Error(1)
+}
+
+func (m *MockApiClient) Info(ctx context.Context) (system.Info, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(system.Info), args.Error(1)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/daytonaio/daytona/pkg/objectstorage"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/docker/docker/api/types"
 	"github.com/stretchr/testify/mock"
@@ -78,3 +79,13 @@ func (c *MockClient) GetContainerLogs(containerName string, logWriter io.Writer)
 	args := c.Called(containerName, logWriter)
 	return args.Error(0)
 }
+
+func (c *MockClient) ArchiveProject(opts *docker.CreateProjectOptions, storage objectstorage.S3Config, archiveKey string) error {
+	args := c.Called(opts, storage, archiveKey)
+	return args.Error(0)
+}
+
+func (c *MockClient) UnarchiveProject(opts *docker.CreateProjectOptions, storage objectstorage.S3Config, archiveKey string) error {
+	args := c.Called(opts, storage, archiveKey)
+	return args.Error(0)
+}
@@ -7,6 +7,7 @@ package mocks
 
 import (
 	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/profiledata"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
 	"github.com/stretchr/testify/mock"
@@ -26,8 +27,8 @@ func (m *MockGitService) RepositoryExists(project *workspace.Project) (bool, err
 	return args.Bool(0), args.Error(1)
 }
 
-func (m *MockGitService) SetGitConfig(userData *gitprovider.GitUser) error {
-	args := m.Called(userData)
+func (m *MockGitService) SetGitConfig(userData *gitprovider.GitUser, identity *profiledata.GitIdentity) error {
+	args := m.Called(userData, identity)
 	return args.Error(0)
 }
 
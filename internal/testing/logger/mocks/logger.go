@@ -6,6 +6,7 @@
 package mocks
 
 import (
+	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -18,6 +19,11 @@ func (m *mockLogger) Write(p []byte) (n int, err error) {
 	return args.Int(0), args.Error(1)
 }
 
+func (m *mockLogger) WriteProgress(progress logs.LogProgress, msg string) error {
+	args := m.Called(progress, msg)
+	return args.Error(0)
+}
+
 func (m *mockLogger) Close() error {
 	args := m.Called()
 	return args.Error(0)
@@ -0,0 +1,51 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package targets
+
+import (
+	"github.com/daytonaio/daytona/pkg/provider"
+)
+
+type InMemoryTargetGroupStore struct {
+	groups map[string]*provider.TargetGroup
+}
+
+func NewInMemoryTargetGroupStore() provider.TargetGroupStore {
+	return &InMemoryTargetGroupStore{
+		groups: make(map[string]*provider.TargetGroup),
+	}
+}
+
+func (s *InMemoryTargetGroupStore) List() ([]*provider.TargetGroup, error) {
+	groups := []*provider.TargetGroup{}
+	for _, g := range s.groups {
+		groups = append(groups, g)
+	}
+
+	return groups, nil
+}
+
+func (s *InMemoryTargetGroupStore) Find(groupName string) (*provider.TargetGroup, error) {
+	group, ok := s.groups[groupName]
+	if !ok {
+		return nil, provider.ErrTargetGroupNotFound
+	}
+
+	return group, nil
+}
+
+func (s *InMemoryTargetGroupStore) Save(group *provider.TargetGroup) error {
+	s.groups[group.Name] = group
+	return nil
+}
+
+func (s *InMemoryTargetGroupStore) Delete(group *provider.TargetGroup) error {
+	if _, ok := s.groups[group.Name]; !ok {
+		return provider.ErrTargetGroupNotFound
+	}
+	delete(s.groups, group.Name)
+	return nil
+}
@@ -0,0 +1,52 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitproviders
+
+import (
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+)
+
+type InMemoryGitProviderConfigStore struct {
+	gitProviders map[string]*gitprovider.GitProviderConfig
+}
+
+func NewInMemoryGitProviderConfigStore() gitprovider.ConfigStore {
+	return &InMemoryGitProviderConfigStore{
+		gitProviders: make(map[string]*gitprovider.GitProviderConfig),
+	}
+}
+
+func (s *InMemoryGitProviderConfigStore) List() ([]*gitprovider.GitProviderConfig, error) {
+	gitProviders := []*gitprovider.GitProviderConfig{}
+	for _, gitProvider := range s.gitProviders {
+		gitProviders = append(gitProviders, gitProvider)
+	}
+
+	return gitProviders, nil
+}
+
+func (s *InMemoryGitProviderConfigStore) Find(id string) (*gitprovider.GitProviderConfig, error) {
+	gitProvider, ok := s.gitProviders[id]
+	if !ok {
+		return nil, gitprovider.ErrGitProviderNotFound
+	}
+
+	return gitProvider, nil
+}
+
+func (s *InMemoryGitProviderConfigStore) Save(gitProvider *gitprovider.GitProviderConfig) error {
+	s.gitProviders[gitProvider.Id] = gitProvider
+	return nil
+}
+
+func (s *InMemoryGitProviderConfigStore) Delete(gitProvider *gitprovider.GitProviderConfig) error {
+	_, ok := s.gitProviders[gitProvider.Id]
+	if !ok {
+		return gitprovider.ErrGitProviderNotFound
+	}
+	delete(s.gitProviders, gitProvider.Id)
+	return nil
+}
@@ -10,29 +10,30 @@ import (
 )
 
 type InMemoryProfileDataStore struct {
-	profileData *profiledata.ProfileData
+	profileData map[string]*profiledata.ProfileData
 }
 
 func NewInMemoryProfileDataStore() profiledata.Store {
 	return &InMemoryProfileDataStore{
-		profileData: nil,
+		profileData: make(map[string]*profiledata.ProfileData),
 	}
 }
 
-func (s *InMemoryProfileDataStore) Get() (*profiledata.ProfileData, error) {
-	if s.profileData == nil {
+func (s *InMemoryProfileDataStore) Get(userId string) (*profiledata.ProfileData, error) {
+	profileData, ok := s.profileData[userId]
+	if !ok {
 		return nil, profiledata.ErrProfileDataNotFound
 	}
 
-	return s.profileData, nil
+	return profileData, nil
 }
 
-func (s *InMemoryProfileDataStore) Save(profileData *profiledata.ProfileData) error {
-	s.profileData = profileData
+func (s *InMemoryProfileDataStore) Save(userId string, profileData *profiledata.ProfileData) error {
+	s.profileData[userId] = profileData
 	return nil
 }
 
-func (s *InMemoryProfileDataStore) Delete() error {
-	s.profileData = nil
+func (s *InMemoryProfileDataStore) Delete(userId string) error {
+	delete(s.profileData, userId)
 	return nil
 }
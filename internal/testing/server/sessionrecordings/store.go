@@ -0,0 +1,57 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sessionrecordings
+
+import (
+	"sort"
+
+	"github.com/daytonaio/daytona/pkg/sessionrecording"
+)
+
+type InMemorySessionRecordingStore struct {
+	recordings map[string]*sessionrecording.SessionRecording
+}
+
+func NewInMemorySessionRecordingStore() sessionrecording.Store {
+	return &InMemorySessionRecordingStore{
+		recordings: make(map[string]*sessionrecording.SessionRecording),
+	}
+}
+
+func (s *InMemorySessionRecordingStore) List() ([]*sessionrecording.SessionRecording, error) {
+	recordings := []*sessionrecording.SessionRecording{}
+	for _, r := range s.recordings {
+		recordings = append(recordings, r)
+	}
+
+	sort.Slice(recordings, func(i, j int) bool {
+		return recordings[i].StartedAt < recordings[j].StartedAt
+	})
+
+	return recordings, nil
+}
+
+func (s *InMemorySessionRecordingStore) Find(id string) (*sessionrecording.SessionRecording, error) {
+	recording, ok := s.recordings[id]
+	if !ok {
+		return nil, sessionrecording.ErrSessionRecordingNotFound
+	}
+
+	return recording, nil
+}
+
+func (s *InMemorySessionRecordingStore) Save(recording *sessionrecording.SessionRecording) error {
+	s.recordings[recording.Id] = recording
+	return nil
+}
+
+func (s *InMemorySessionRecordingStore) Delete(recording *sessionrecording.SessionRecording) error {
+	if _, ok := s.recordings[recording.Id]; !ok {
+		return sessionrecording.ErrSessionRecordingNotFound
+	}
+	delete(s.recordings, recording.Id)
+	return nil
+}
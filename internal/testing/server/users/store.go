@@ -0,0 +1,53 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package users
+
+import (
+	"github.com/daytonaio/daytona/pkg/user"
+)
+
+type InMemoryUserStore struct {
+	users map[string]*user.User
+}
+
+func NewInMemoryUserStore() user.Store {
+	return &InMemoryUserStore{
+		users: make(map[string]*user.User),
+	}
+}
+
+func (s *InMemoryUserStore) List() ([]*user.User, error) {
+	users := []*user.User{}
+	for _, u := range s.users {
+		users = append(users, u)
+	}
+
+	return users, nil
+}
+
+func (s *InMemoryUserStore) Find(id string) (*user.User, error) {
+	u, ok := s.users[id]
+	if !ok {
+		return nil, user.ErrUserNotFound
+	}
+
+	return u, nil
+}
+
+func (s *InMemoryUserStore) FindByName(name string) (*user.User, error) {
+	for _, u := range s.users {
+		if u.Name == name {
+			return u, nil
+		}
+	}
+
+	return nil, user.ErrUserNotFound
+}
+
+func (s *InMemoryUserStore) Save(u *user.User) error {
+	s.users[u.Id] = u
+	return nil
+}
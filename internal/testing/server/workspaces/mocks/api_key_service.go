@@ -6,6 +6,8 @@
 package mocks
 
 import (
+	"time"
+
 	"github.com/daytonaio/daytona/pkg/apikey"
 	"github.com/stretchr/testify/mock"
 )
@@ -23,6 +25,26 @@ func (s *mockApiKeyService) Generate(keyType apikey.ApiKeyType, name string) (st
 	return args.String(0), args.Error(1)
 }
 
+func (s *mockApiKeyService) GenerateShareKey(workspaceId string, expiresAt time.Time) (*apikey.ApiKey, string, error) {
+	args := s.Called(workspaceId, expiresAt)
+	return args.Get(0).(*apikey.ApiKey), args.String(1), args.Error(2)
+}
+
+func (s *mockApiKeyService) GenerateUserKey(userId, name string) (string, error) {
+	args := s.Called(userId, name)
+	return args.String(0), args.Error(1)
+}
+
+func (s *mockApiKeyService) GetApiKey(apiKey string) (*apikey.ApiKey, error) {
+	args := s.Called(apiKey)
+	return args.Get(0).(*apikey.ApiKey), args.Error(1)
+}
+
+func (s *mockApiKeyService) GetSharedWorkspaceId(apiKey string) (string, bool) {
+	args := s.Called(apiKey)
+	return args.String(0), args.Bool(1)
+}
+
 func (s *mockApiKeyService) IsProjectApiKey(apiKey string) bool {
 	args := s.Called(apiKey)
 	return args.Bool(0)
@@ -43,7 +65,17 @@ func (s *mockApiKeyService) ListClientKeys() ([]*apikey.ApiKey, error) {
 	return args.Get(0).([]*apikey.ApiKey), args.Error(1)
 }
 
+func (s *mockApiKeyService) ListShareKeys(workspaceId string) ([]*apikey.ApiKey, error) {
+	args := s.Called(workspaceId)
+	return args.Get(0).([]*apikey.ApiKey), args.Error(1)
+}
+
 func (s *mockApiKeyService) Revoke(name string) error {
 	args := s.Called(name)
 	return args.Error(0)
 }
+
+func (s *mockApiKeyService) RevokeClientKey(userId, name string) error {
+	args := s.Called(userId, name)
+	return args.Error(0)
+}
@@ -24,18 +24,23 @@ type MockBuilderPlugin struct {
 
 type MockBuilderFactory struct {
 	mock.Mock
+	// Returned by CheckExistingBuild. No prebuilt image exists if not set
+	ExistingBuildResult *builder.BuildResult
+	// Results saved by the builders of the factory
+	SavedBuildResults []builder.BuildResult
 }
 
 func (f *MockBuilderFactory) Create(p workspace.Project, gpc *gitprovider.GitProviderConfig) (builder.IBuilder, error) {
-	return &mockBuilder{}, nil
+	return &mockBuilder{factory: f}, nil
 }
 
 func (f *MockBuilderFactory) CheckExistingBuild(p workspace.Project) (*builder.BuildResult, error) {
-	return MockBuildResults, nil
+	return f.ExistingBuildResult, nil
 }
 
 type mockBuilder struct {
 	mock.Mock
+	factory *MockBuilderFactory
 }
 
 func (b *mockBuilder) Build() (*builder.BuildResult, error) {
@@ -46,11 +51,11 @@ func (b *mockBuilder) CleanUp() error {
 	return nil
 }
 
-func (b *mockBuilder) Publish() error {
+func (b *mockBuilder) Publish(r *builder.BuildResult) error {
 	return nil
 }
 
 func (p *mockBuilder) SaveBuildResults(r builder.BuildResult) error {
-	args := p.Called(r)
-	return args.Error(0)
+	p.factory.SavedBuildResults = append(p.factory.SavedBuildResults, r)
+	return nil
 }
@@ -7,6 +7,8 @@ package mocks
 
 import (
 	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/server/gitproviders"
+	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -18,6 +20,10 @@ func NewMockGitProviderService() *mockGitProviderService {
 	return &mockGitProviderService{}
 }
 
+func (m *mockGitProviderService) ForOwner(ownerId string) gitproviders.IGitProviderService {
+	return m
+}
+
 func (m *mockGitProviderService) GetConfig(id string) (*gitprovider.GitProviderConfig, error) {
 	args := m.Called(id)
 	return args.Get(0).(*gitprovider.GitProviderConfig), args.Error(1)
@@ -28,6 +34,21 @@ func (m *mockGitProviderService) GetConfigForUrl(url string) (*gitprovider.GitPr
 	return args.Get(0).(*gitprovider.GitProviderConfig), args.Error(1)
 }
 
+func (m *mockGitProviderService) ResolveConfig(repoUrl string, gitProviderConfigId string) (*gitprovider.GitProviderConfig, error) {
+	args := m.Called(repoUrl, gitProviderConfigId)
+	return args.Get(0).(*gitprovider.GitProviderConfig), args.Error(1)
+}
+
+func (m *mockGitProviderService) GetGitCredential(repoUrl string, gitProviderConfigId string) (*gitprovider.GitCredential, error) {
+	args := m.Called(repoUrl, gitProviderConfigId)
+	return args.Get(0).(*gitprovider.GitCredential), args.Error(1)
+}
+
+func (m *mockGitProviderService) SetDefaultConfig(gitProviderConfigId string) error {
+	args := m.Called(gitProviderConfigId)
+	return args.Error(0)
+}
+
 func (m *mockGitProviderService) GetGitProvider(id string) (gitprovider.GitProvider, error) {
 	args := m.Called(id)
 	return args.Get(0).(gitprovider.GitProvider), args.Error(1)
@@ -53,6 +74,11 @@ func (m *mockGitProviderService) GetRepoBranches(gitProviderId string, namespace
 	return args.Get(0).([]*gitprovider.GitBranch), args.Error(1)
 }
 
+func (m *mockGitProviderService) GetRepoCommits(gitProviderId string, namespaceId string, repositoryId string, branch string, page int, perPage int) ([]*gitprovider.GitCommit, error) {
+	args := m.Called(gitProviderId, namespaceId, repositoryId, branch, page, perPage)
+	return args.Get(0).([]*gitprovider.GitCommit), args.Error(1)
+}
+
 func (m *mockGitProviderService) GetRepoPRs(gitProviderId string, namespaceId string, repositoryId string) ([]*gitprovider.GitPullRequest, error) {
 	args := m.Called(gitProviderId, namespaceId, repositoryId)
 	return args.Get(0).([]*gitprovider.GitPullRequest), args.Error(1)
@@ -63,6 +89,11 @@ func (m *mockGitProviderService) GetRepositories(gitProviderId string, namespace
 	return args.Get(0).([]*gitprovider.GitRepository), args.Error(1)
 }
 
+func (m *mockGitProviderService) GetRepositoryConfig(repoUrl string) (*workspace.RepositoryConfig, error) {
+	args := m.Called(repoUrl)
+	return args.Get(0).(*workspace.RepositoryConfig), args.Error(1)
+}
+
 func (m *mockGitProviderService) ListConfigs() ([]*gitprovider.GitProviderConfig, error) {
 	args := m.Called()
 	return args.Get(0).([]*gitprovider.GitProviderConfig), args.Error(1)
@@ -82,3 +113,8 @@ func (m *mockGitProviderService) GetLastCommitSha(repo *gitprovider.GitRepositor
 	args := m.Called(repo)
 	return args.String(0), args.Error(1)
 }
+
+func (m *mockGitProviderService) GetCacheStatus(gitProviderId string) *gitprovider.GitProviderCacheStatus {
+	args := m.Called(gitProviderId)
+	return args.Get(0).(*gitprovider.GitProviderCacheStatus)
+}
@@ -0,0 +1,28 @@
+//go:build testing
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	"github.com/daytonaio/daytona/pkg/server/headscale/acl"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockNetworkAccess struct {
+	mock.Mock
+}
+
+func NewMockNetworkAccess() *mockNetworkAccess {
+	return &mockNetworkAccess{}
+}
+
+func (m *mockNetworkAccess) SetAccessGrants(grants acl.Grants) {
+	m.Called(grants)
+}
+
+func (m *mockNetworkAccess) DeleteTaggedNodes(tag string) error {
+	args := m.Called(tag)
+	return args.Error(0)
+}
@@ -8,6 +8,7 @@ package mocks
 import (
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/objectstorage"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/stretchr/testify/mock"
@@ -21,22 +22,22 @@ func NewMockProvisioner() *mockProvisioner {
 	return &mockProvisioner{}
 }
 
-func (p *mockProvisioner) CreateProject(project *workspace.Project, target *provider.ProviderTarget, cr *containerregistry.ContainerRegistry, gc *gitprovider.GitProviderConfig) error {
+func (p *mockProvisioner) CreateProject(project *workspace.Project, target *provider.ProviderTarget, cr *containerregistry.ContainerRegistry, gc *gitprovider.GitProviderConfig, progress provider.ProgressReporter) error {
 	args := p.Called(project, target, cr, gc)
 	return args.Error(0)
 }
 
-func (p *mockProvisioner) CreateWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error {
+func (p *mockProvisioner) CreateWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget, progress provider.ProgressReporter) error {
 	args := p.Called(workspace, target)
 	return args.Error(0)
 }
 
-func (p *mockProvisioner) DestroyProject(project *workspace.Project, target *provider.ProviderTarget) error {
+func (p *mockProvisioner) DestroyProject(project *workspace.Project, target *provider.ProviderTarget, progress provider.ProgressReporter) error {
 	args := p.Called(project, target)
 	return args.Error(0)
 }
 
-func (p *mockProvisioner) DestroyWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error {
+func (p *mockProvisioner) DestroyWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget, progress provider.ProgressReporter) error {
 	args := p.Called(workspace, target)
 	return args.Error(0)
 }
@@ -46,6 +47,11 @@ func (p *mockProvisioner) GetWorkspaceInfo(w *workspace.Workspace, target *provi
 	return args.Get(0).(*workspace.WorkspaceInfo), args.Error(1)
 }
 
+func (p *mockProvisioner) GetWorkspaceCostEstimate(w *workspace.Workspace, target *provider.ProviderTarget) (*workspace.CostEstimate, error) {
+	args := p.Called(w, target)
+	return args.Get(0).(*workspace.CostEstimate), args.Error(1)
+}
+
 func (p *mockProvisioner) StartProject(project *workspace.Project, target *provider.ProviderTarget) error {
 	args := p.Called(project, target)
 	return args.Error(0)
@@ -65,3 +71,13 @@ func (p *mockProvisioner) StopWorkspace(workspace *workspace.Workspace, target *
 	args := p.Called(workspace, target)
 	return args.Error(0)
 }
+
+func (p *mockProvisioner) ArchiveProject(project *workspace.Project, target *provider.ProviderTarget, storage *objectstorage.S3Config) error {
+	args := p.Called(project, target, storage)
+	return args.Error(0)
+}
+
+func (p *mockProvisioner) UnarchiveProject(project *workspace.Project, target *provider.ProviderTarget, cr *containerregistry.ContainerRegistry, gc *gitprovider.GitProviderConfig, storage *objectstorage.S3Config) error {
+	args := p.Called(project, target, cr, gc, storage)
+	return args.Error(0)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/internal"
 	"github.com/daytonaio/daytona/pkg/api"
+	"github.com/daytonaio/daytona/pkg/api/middlewares"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/server"
 )
@@ -50,10 +51,16 @@ func GetApiClient(profile *config.Profile) (*apiclient.APIClient, error) {
 		return nil, err
 	}
 
-	_, err = http.Head(healthUrl)
+	res, err := http.Head(healthUrl)
 	if err != nil {
 		return nil, ErrHealthCheckFailed(healthUrl)
 	}
+	res.Body.Close()
+
+	err = checkServerVersion(res.Header.Get(middlewares.SERVER_VERSION_HEADER))
+	if err != nil {
+		return nil, err
+	}
 
 	clientConfig := apiclient.NewConfiguration()
 	clientConfig.Servers = apiclient.ServerConfigurations{
@@ -65,12 +72,14 @@ func GetApiClient(profile *config.Profile) (*apiclient.APIClient, error) {
 	clientConfig.AddDefaultHeader("Authorization", fmt.Sprintf("Bearer %s", apiKey))
 	clientConfig.AddDefaultHeader(CLIENT_VERSION_HEADER, internal.Version)
 
-	apiClient = apiclient.NewAPIClient(clientConfig)
+	client := apiclient.NewAPIClient(clientConfig)
 
-	apiClient.GetConfig().HTTPClient = &http.Client{
+	client.GetConfig().HTTPClient = &http.Client{
 		Transport: http.DefaultTransport,
 	}
 
+	apiClient = client
+
 	return apiClient, nil
 }
 
@@ -123,7 +132,7 @@ func GetProviderList() ([]apiclient.Provider, error) {
 		return nil, HandleErrorResponse(res, err)
 	}
 
-	return providersList, nil
+	return providersList.Items, nil
 }
 
 func GetTargetList() ([]apiclient.ProviderTarget, error) {
@@ -137,7 +146,7 @@ func GetTargetList() ([]apiclient.ProviderTarget, error) {
 		return nil, HandleErrorResponse(resp, err)
 	}
 
-	return targets, nil
+	return targets.Items, nil
 }
 
 func GetWorkspace(workspaceNameOrId string) (*apiclient.WorkspaceDTO, error) {
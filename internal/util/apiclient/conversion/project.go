@@ -45,6 +45,7 @@ func ToProject(projectDTO *apiclient.Project) *workspace.Project {
 		PostStartCommands:  projectDTO.PostStartCommands,
 		Repository:         repository,
 		State:              projectState,
+		WorktreeMode:       projectDTO.GetWorktreeMode(),
 	}
 
 	if projectDTO.Repository.PrNumber != nil {
@@ -75,6 +76,7 @@ func ToGitStatus(gitStatusDTO *apiclient.GitStatus) *workspace.GitStatus {
 
 	return &workspace.GitStatus{
 		CurrentBranch: *gitStatusDTO.CurrentBranch,
+		HeadSha:       gitStatusDTO.GetHeadSha(),
 		Files:         files,
 	}
 }
@@ -99,6 +101,27 @@ func ToGitStatusDTO(gitStatus *workspace.GitStatus) *apiclient.GitStatus {
 
 	return &apiclient.GitStatus{
 		CurrentBranch: &gitStatus.CurrentBranch,
+		HeadSha:       &gitStatus.HeadSha,
 		FileStatus:    fileStatusDTO,
 	}
 }
+
+func ToProjectResourcesDTO(resources *workspace.ProjectResources) *apiclient.ProjectResources {
+	if resources == nil {
+		return nil
+	}
+
+	cpuUsage := float32(resources.CpuUsage)
+	memoryUsage := int32(resources.MemoryUsageMB)
+	memoryLimit := int32(resources.MemoryLimitMB)
+	diskUsage := int32(resources.DiskUsageMB)
+	diskTotal := int32(resources.DiskTotalMB)
+
+	return &apiclient.ProjectResources{
+		CpuUsage:      &cpuUsage,
+		MemoryUsageMB: &memoryUsage,
+		MemoryLimitMB: &memoryLimit,
+		DiskUsageMB:   &diskUsage,
+		DiskTotalMB:   &diskTotal,
+	}
+}
@@ -6,6 +6,8 @@ package apiclient
 import (
 	"fmt"
 	"strings"
+
+	"github.com/daytonaio/daytona/pkg/server"
 )
 
 func ErrHealthCheckFailed(healthUrl string) error {
@@ -15,3 +17,11 @@ func ErrHealthCheckFailed(healthUrl string) error {
 func IsHealthCheckFailed(err error) bool {
 	return strings.HasPrefix(err.Error(), "failed to check server health at:")
 }
+
+func ErrIncompatibleServerVersion(clientVersion, serverVersion string) error {
+	return fmt.Errorf("CLI version %s is not compatible with Daytona Server version %s. Update the CLI or the server to the same version, or use --skip-version-check to continue anyway", clientVersion, serverVersion)
+}
+
+func ErrCapabilityNotSupported(capability server.Capability, serverVersion string) error {
+	return fmt.Errorf("the Daytona Server (version %s) does not support %s. Update the server to use this feature", serverVersion, capability)
+}
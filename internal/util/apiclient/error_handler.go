@@ -9,16 +9,41 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
 
 	"github.com/daytonaio/daytona/internal"
+	"github.com/daytonaio/daytona/pkg/api/controllers"
 	"github.com/daytonaio/daytona/pkg/api/middlewares"
 	log "github.com/sirupsen/logrus"
 )
 
-type ApiErrorResponse struct {
+// Error response of servers that do not return structured errors yet
+type legacyErrorResponse struct {
 	Error string `json:"error"`
 }
 
+// ApiError is returned by HandleErrorResponse for structured error responses of the Daytona Server
+type ApiError struct {
+	StatusCode int
+	Code       controllers.ErrorCode
+	Message    string
+	Details    []string
+}
+
+func (e *ApiError) Error() string {
+	if len(e.Details) == 0 {
+		return e.Message
+	}
+
+	return fmt.Sprintf("%s (%s)", e.Message, strings.Join(e.Details, "; "))
+}
+
+// IsErrorCode returns true if err is an API error with the given code
+func IsErrorCode(err error, code controllers.ErrorCode) bool {
+	var apiErr *ApiError
+	return errors.As(err, &apiErr) && apiErr.Code == code
+}
+
 func HandleErrorResponse(res *http.Response, requestErr error) error {
 	if res == nil {
 		return requestErr
@@ -31,18 +56,36 @@ func HandleErrorResponse(res *http.Response, requestErr error) error {
 		return err
 	}
 
-	var errResponse ApiErrorResponse
+	var errResponse controllers.ErrorResponse
 
 	err = json.Unmarshal(body, &errResponse)
 	if err != nil {
 		return errors.New(string(body))
 	}
 
-	if !IsHealthCheckFailed(errors.New(errResponse.Error)) {
+	if errResponse.Message == "" {
+		var legacyResponse legacyErrorResponse
+		err = json.Unmarshal(body, &legacyResponse)
+		if err != nil || legacyResponse.Error == "" {
+			return errors.New(string(body))
+		}
+
+		errResponse = controllers.ErrorResponse{
+			Code:    controllers.GetErrorCode(res.StatusCode),
+			Message: legacyResponse.Error,
+		}
+	}
+
+	if !IsHealthCheckFailed(errors.New(errResponse.Message)) {
 		checkVersionsMismatch(res)
 	}
 
-	return errors.New(errResponse.Error)
+	return &ApiError{
+		StatusCode: res.StatusCode,
+		Code:       errResponse.Code,
+		Message:    errResponse.Message,
+		Details:    errResponse.Details,
+	}
 }
 
 func checkVersionsMismatch(res *http.Response) {
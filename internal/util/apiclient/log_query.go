@@ -0,0 +1,53 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apiclient
+
+import (
+	"net/url"
+
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/server"
+)
+
+// LogQuery is the query of the log websocket endpoints
+type LogQuery struct {
+	Follow bool
+	// Regular expression the log messages must match
+	Grep string
+	// Minimum severity of structured log entries (e.g. warn)
+	Level string
+	// RFC3339 timestamps or durations before now (e.g. 1h)
+	Since string
+	Until string
+}
+
+// Encode validates the filters before they are sent to the server. Servers that do not support filtering
+// would return the whole log instead
+func (q LogQuery) Encode() (string, error) {
+	values := url.Values{}
+
+	if q.Follow {
+		values.Set("follow", "true")
+	}
+
+	filter, err := logs.ParseLogFilter(q.Grep, q.Level, q.Since, q.Until)
+	if err != nil {
+		return "", err
+	}
+
+	if filter != nil {
+		err = RequireCapability(server.CapabilityLogFilters)
+		if err != nil {
+			return "", err
+		}
+
+		for key, value := range map[string]string{"grep": q.Grep, "level": q.Level, "since": q.Since, "until": q.Until} {
+			if value != "" {
+				values.Set(key, value)
+			}
+		}
+	}
+
+	return values.Encode(), nil
+}
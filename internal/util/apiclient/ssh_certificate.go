@@ -0,0 +1,139 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apiclient
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/server"
+	"golang.org/x/crypto/ssh"
+)
+
+// EnsureSshCertificate requests a new SSH certificate for the project if the server issues them and the current
+// certificate is past half of its validity. It must be called before connecting to the project over SSH
+func EnsureSshCertificate(profile config.Profile, workspaceId, projectName string) error {
+	apiClient, err := GetApiClient(&profile)
+	if err != nil {
+		return err
+	}
+
+	version, err := getServerVersion(apiClient)
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(version.Capabilities, string(server.CapabilitySshCertificates)) {
+		return nil
+	}
+
+	keyPath, err := config.GetSshKeyPath()
+	if err != nil {
+		return err
+	}
+
+	certificatePath, err := config.GetSshCertificatePath(config.GetProjectHostname(profile.Id, workspaceId, projectName))
+	if err != nil {
+		return err
+	}
+
+	signer, err := loadOrCreateSshKey(keyPath)
+	if err != nil {
+		return err
+	}
+
+	if isSshCertificateValid(certificatePath, signer.PublicKey()) {
+		return nil
+	}
+
+	publicKey := string(ssh.MarshalAuthorizedKey(signer.PublicKey()))
+
+	request := apiclient.IssueSshCertificate{
+		PublicKey: publicKey,
+	}
+
+	var cert *apiclient.SshCertificate
+	var res *http.Response
+
+	// Share keys can only request certificates for the projects of the shared workspace
+	if profile.SharedWorkspaceId != "" {
+		cert, res, err = apiClient.ShareAPI.IssueSharedSshCertificate(context.Background(), projectName).Request(request).Execute()
+	} else {
+		cert, res, err = apiClient.WorkspaceAPI.IssueSshCertificate(context.Background(), workspaceId, projectName).Request(request).Execute()
+	}
+	if err != nil {
+		// Certificates are not required by the project if the server does not issue them
+		if res != nil && res.StatusCode == http.StatusPreconditionFailed {
+			return nil
+		}
+		return HandleErrorResponse(res, err)
+	}
+
+	return os.WriteFile(certificatePath, []byte(cert.Certificate+"\n"), 0600)
+}
+
+func loadOrCreateSshKey(keyPath string) (ssh.Signer, error) {
+	content, err := os.ReadFile(keyPath)
+	if err == nil {
+		return ssh.ParsePrivateKey(content)
+	}
+
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	pemBlock, err := ssh.MarshalPrivateKey(privateKey, "daytona")
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(filepath.Dir(keyPath), 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.WriteFile(keyPath, pem.EncodeToMemory(pemBlock), 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	return ssh.NewSignerFromKey(privateKey)
+}
+
+// Certificates are renewed once half of their validity has passed so that long-running IDE sessions can reconnect
+func isSshCertificateValid(certificatePath string, publicKey ssh.PublicKey) bool {
+	content, err := os.ReadFile(certificatePath)
+	if err != nil {
+		return false
+	}
+
+	key, _, _, _, err := ssh.ParseAuthorizedKey(content)
+	if err != nil {
+		return false
+	}
+
+	cert, ok := key.(*ssh.Certificate)
+	if !ok || string(cert.Key.Marshal()) != string(publicKey.Marshal()) {
+		return false
+	}
+
+	validAfter := time.Unix(int64(cert.ValidAfter), 0)
+	validBefore := time.Unix(int64(cert.ValidBefore), 0)
+
+	return time.Now().Before(validAfter.Add(validBefore.Sub(validAfter) / 2))
+}
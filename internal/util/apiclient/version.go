@@ -0,0 +1,97 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package apiclient
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/daytonaio/daytona/internal"
+	"github.com/daytonaio/daytona/pkg/api/middlewares"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/server"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/mod/semver"
+)
+
+// Set by the --skip-version-check flag to use a Daytona Server with an incompatible version
+var SkipVersionCheck = false
+
+// Looked up on first use and kept for the lifetime of the process
+var serverVersion *apiclient.ServerVersion
+
+// The server reports its version in the headers of every response, so the version of the health check response
+// is checked instead of making a separate request
+func checkServerVersion(version string) error {
+	if isCompatibleVersion(internal.Version, version) {
+		return nil
+	}
+
+	if !SkipVersionCheck {
+		return ErrIncompatibleServerVersion(internal.Version, version)
+	}
+
+	log.Warnf("CLI version %s is not compatible with Daytona Server version %s. Some commands may fail.", internal.Version, version)
+	return nil
+}
+
+// Returns the version and the capabilities of the server. The server is only asked once per process
+func getServerVersion(client *apiclient.APIClient) (*apiclient.ServerVersion, error) {
+	if serverVersion != nil {
+		return serverVersion, nil
+	}
+
+	version, res, err := client.ServerAPI.GetServerVersion(context.Background()).Execute()
+	if err != nil {
+		if res == nil || res.StatusCode != http.StatusNotFound {
+			return nil, HandleErrorResponse(res, err)
+		}
+
+		// Servers that predate the version endpoint only report their version in the response headers
+		version = &apiclient.ServerVersion{
+			Version:      res.Header.Get(middlewares.SERVER_VERSION_HEADER),
+			Capabilities: []string{},
+		}
+	}
+
+	serverVersion = version
+
+	return serverVersion, nil
+}
+
+// Versions are compatible if they share the major version, or the minor version before v1.
+// Development builds are compatible with any version
+func isCompatibleVersion(clientVersion, serverVersion string) bool {
+	for _, version := range []string{clientVersion, serverVersion} {
+		if !semver.IsValid(version) || semver.Prerelease(version) == "-dev" {
+			return true
+		}
+	}
+
+	if semver.Major(clientVersion) != "v0" {
+		return semver.Major(clientVersion) == semver.Major(serverVersion)
+	}
+
+	return semver.MajorMinor(clientVersion) == semver.MajorMinor(serverVersion)
+}
+
+// RequireCapability returns an error if the Daytona Server does not advertise the capability
+func RequireCapability(capability server.Capability) error {
+	client, err := GetApiClient(nil)
+	if err != nil {
+		return err
+	}
+
+	version, err := getServerVersion(client)
+	if err != nil {
+		return err
+	}
+
+	if slices.Contains(version.Capabilities, string(capability)) {
+		return nil
+	}
+
+	return ErrCapabilityNotSupported(capability, version.Version)
+}
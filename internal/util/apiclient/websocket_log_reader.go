@@ -91,3 +91,25 @@ func readJSONLog(ws *websocket.Conn, stopLogs *bool, index int) {
 		}
 	}
 }
+
+func ReadBuildLogs(activeProfile config.Profile, buildId string, projectName string, stopLogs *bool) {
+	query := "follow=true"
+
+	logs_view.CalculateLongestPrefixLength([]string{projectName})
+
+	for {
+		ws, _, err := GetWebsocketConn(fmt.Sprintf("/log/build/%s", buildId), &activeProfile, &query)
+		// We want to retry getting the logs if it fails
+		if err != nil {
+			if *stopLogs {
+				return
+			}
+			time.Sleep(250 * time.Millisecond)
+			continue
+		}
+
+		readJSONLog(ws, stopLogs, 0)
+		ws.Close()
+		break
+	}
+}
@@ -4,7 +4,9 @@
 package util
 
 import (
+	"encoding/base64"
 	"fmt"
+	"hash/fnv"
 )
 
 func GetFrpcApiDomain(serverId, frpsDomain string) string {
@@ -30,3 +32,15 @@ func GetFrpcRegistryDomain(serverId, frpsDomain string) string {
 func GetFrpcRegistryUrl(protocol, serverId, frpsDomain string) string {
 	return fmt.Sprintf("%s://%s", protocol, GetFrpcRegistryDomain(serverId, frpsDomain))
 }
+
+// GetFrpcPortForwardSubDomain returns the stable subdomain a public project port is exposed on
+func GetFrpcPortForwardSubDomain(serverId, workspaceId, projectName string, port uint16) string {
+	h := fnv.New64()
+	h.Write([]byte(fmt.Sprintf("%s-%s-%s", workspaceId, projectName, serverId)))
+
+	return fmt.Sprintf("%d-%s", port, base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprint(h.Sum64()))))
+}
+
+func GetFrpcPortForwardUrl(protocol, serverId, frpsDomain, workspaceId, projectName string, port uint16) string {
+	return fmt.Sprintf("%s://%s.%s", protocol, GetFrpcPortForwardSubDomain(serverId, workspaceId, projectName, port), frpsDomain)
+}
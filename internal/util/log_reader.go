@@ -13,7 +13,12 @@ import (
 	"github.com/daytonaio/daytona/pkg/logs"
 )
 
-func ReadLog(ctx context.Context, logReader io.Reader, follow bool, c chan []byte, errChan chan error) {
+func ReadLog(ctx context.Context, logReader io.Reader, follow bool, filter *logs.LogFilter, c chan []byte, errChan chan error) {
+	if filter != nil {
+		readFilteredLog(ctx, logReader, follow, filter, c, errChan)
+		return
+	}
+
 	reader := bufio.NewReader(logReader)
 
 	for {
@@ -37,7 +42,40 @@ func ReadLog(ctx context.Context, logReader io.Reader, follow bool, c chan []byt
 	}
 }
 
-func ReadJSONLog(ctx context.Context, logReader io.Reader, follow bool, c chan interface{}, errChan chan error) {
+// Plain text logs are matched line by line so only complete lines are sent
+func readFilteredLog(ctx context.Context, logReader io.Reader, follow bool, filter *logs.LogFilter, c chan []byte, errChan chan error) {
+	reader := bufio.NewReader(logReader)
+	var line []byte
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+			chunk, err := reader.ReadBytes('\n')
+			line = append(line, chunk...)
+			if err != nil {
+				if err != io.EOF {
+					errChan <- err
+				} else if !follow {
+					if len(line) > 0 && filter.MatchLine(string(line)) {
+						c <- line
+					}
+					errChan <- io.EOF
+					return
+				}
+				continue
+			}
+
+			if filter.MatchLine(string(line)) {
+				c <- line
+			}
+			line = nil
+		}
+	}
+}
+
+func ReadJSONLog(ctx context.Context, logReader io.Reader, follow bool, filter *logs.LogFilter, c chan interface{}, errChan chan error) {
 	var buffer bytes.Buffer
 	reader := bufio.NewReader(logReader)
 	delimiter := []byte(logs.LogDelimiter)
@@ -71,7 +109,9 @@ func ReadJSONLog(ctx context.Context, logReader io.Reader, follow bool, c chan i
 					return
 				}
 
-				c <- logEntry
+				if filter == nil || filter.Match(logEntry) {
+					c <- logEntry
+				}
 				buffer.Reset()
 				buffer.Write(data[index+len(delimiter):]) // write remaining data to buffer
 			}
@@ -20,6 +20,7 @@ import (
 	agent_config "github.com/daytonaio/daytona/pkg/agent/config"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/profiledata"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
 	log "github.com/sirupsen/logrus"
@@ -61,7 +62,7 @@ func (a *Agent) startProjectMode() error {
 	}
 
 	// Ignoring error because we don't want to fail if the git provider is not found
-	gitProvider, _ := a.getGitProvider(project.Repository.Url)
+	gitProvider, _ := a.getGitProvider(project.Repository.Url, project.GitProviderConfigId)
 
 	var auth *http.BasicAuth
 	if gitProvider != nil {
@@ -102,6 +103,11 @@ func (a *Agent) startProjectMode() error {
 		}
 	}
 
+	a.language, err = workspace.DetectLanguage(a.Config.ProjectDir)
+	if err != nil {
+		log.Error(fmt.Sprintf("failed to detect the project language: %s", err))
+	}
+
 	var gitUser *gitprovider.GitUser
 	if gitProvider != nil {
 		user, err := a.getGitUser(*gitProvider.Id)
@@ -116,7 +122,24 @@ func (a *Agent) startProjectMode() error {
 		}
 	}
 
-	err = a.Git.SetGitConfig(gitUser)
+	gitIdentity, err := a.getGitIdentity()
+	if err != nil {
+		log.Error(fmt.Sprintf("failed to get git identity: %s", err))
+	}
+
+	if a.GitCredentialSocketPath != "" {
+		err = a.startGitCredentialServer(project)
+		if err != nil {
+			log.Error(fmt.Sprintf("failed to start the git credential server: %s", err))
+		} else {
+			err = a.installGitCredentialHelper()
+			if err != nil {
+				log.Error(fmt.Sprintf("failed to install the git credential helper: %s", err))
+			}
+		}
+	}
+
+	err = a.Git.SetGitConfig(gitUser, gitIdentity)
 	if err != nil {
 		log.Error(fmt.Sprintf("failed to set git config: %s", err))
 	}
@@ -165,7 +188,7 @@ func (a *Agent) getProject() (*workspace.Project, error) {
 	return nil, errors.New("project not found")
 }
 
-func (a *Agent) getGitProvider(repoUrl string) (*apiclient.GitProvider, error) {
+func (a *Agent) getGitProvider(repoUrl string, gitProviderConfigId string) (*apiclient.GitProvider, error) {
 	ctx := context.Background()
 
 	apiClient, err := apiclient_util.GetAgentApiClient(a.Config.Server.ApiUrl, a.Config.Server.ApiKey)
@@ -174,7 +197,12 @@ func (a *Agent) getGitProvider(repoUrl string) (*apiclient.GitProvider, error) {
 	}
 
 	encodedUrl := url.QueryEscape(repoUrl)
-	gitProvider, res, err := apiClient.GitProviderAPI.GetGitProviderForUrl(ctx, encodedUrl).Execute()
+	request := apiClient.GitProviderAPI.GetGitProviderForUrl(ctx, encodedUrl)
+	if gitProviderConfigId != "" {
+		request = request.GitProviderConfigId(gitProviderConfigId)
+	}
+
+	gitProvider, res, err := request.Execute()
 	if err != nil {
 		return nil, apiclient_util.HandleErrorResponse(res, err)
 	}
@@ -186,6 +214,30 @@ func (a *Agent) getGitProvider(repoUrl string) (*apiclient.GitProvider, error) {
 	return nil, nil
 }
 
+func (a *Agent) getGitIdentity() (*profiledata.GitIdentity, error) {
+	apiClient, err := apiclient_util.GetAgentApiClient(a.Config.Server.ApiUrl, a.Config.Server.ApiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// The project API key acts on behalf of the workspace owner, so only the profile data of the owner is returned
+	profileData, res, err := apiClient.ProfileAPI.GetProfileData(context.Background()).Execute()
+	if err != nil {
+		return nil, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	if profileData.GitIdentity == nil {
+		return nil, nil
+	}
+
+	return &profiledata.GitIdentity{
+		Name:          profileData.GitIdentity.GetName(),
+		Email:         profileData.GitIdentity.GetEmail(),
+		SigningKey:    profileData.GitIdentity.GetSigningKey(),
+		SigningFormat: profiledata.GitSigningFormat(profileData.GitIdentity.GetSigningFormat()),
+	}, nil
+}
+
 func (a *Agent) getGitUser(gitProviderId string) (*apiclient.GitUser, error) {
 	apiClient, err := apiclient_util.GetAgentApiClient(a.Config.Server.ApiUrl, a.Config.Server.ApiKey)
 	if err != nil {
@@ -313,10 +365,18 @@ func (a *Agent) updateProjectState() error {
 		return err
 	}
 
+	welcome, err := workspace.ReadWelcomeFile(a.Config.ProjectDir)
+	if err != nil {
+		log.Error(fmt.Sprintf("failed to read the project welcome file: %s", err))
+	}
+
 	uptime := a.uptime()
 	res, err := apiClient.WorkspaceAPI.SetProjectState(context.Background(), a.Config.WorkspaceId, a.Config.ProjectName).SetState(apiclient.SetProjectState{
 		Uptime:    &uptime,
 		GitStatus: conversion.ToGitStatusDTO(gitStatus),
+		Resources: conversion.ToProjectResourcesDTO(a.getProjectResources()),
+		Welcome:   &welcome,
+		Language:  &a.language,
 	}).Execute()
 	if err != nil {
 		return apiclient_util.HandleErrorResponse(res, err)
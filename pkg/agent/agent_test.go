@@ -5,6 +5,11 @@ package agent_test
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"testing"
@@ -18,6 +23,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/agent"
 	"github.com/daytonaio/daytona/pkg/agent/config"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/profiledata"
 	"github.com/daytonaio/daytona/pkg/workspace"
 )
 
@@ -48,6 +54,15 @@ var workspace1 = &workspace.Workspace{
 	},
 }
 
+var profileData1 = &profiledata.ProfileData{
+	GitIdentity: &profiledata.GitIdentity{
+		Name:          "Test User",
+		Email:         "test@example.com",
+		SigningKey:    "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAITest test@example.com",
+		SigningFormat: profiledata.GitSigningFormatSsh,
+	},
+}
+
 var gitStatus1 = &workspace.GitStatus{
 	CurrentBranch: "main",
 	Files: []*workspace.FileStatus{{
@@ -71,14 +86,14 @@ func TestAgent(t *testing.T) {
 	buf := bytes.Buffer{}
 	log.SetOutput(&buf)
 
-	apiServer := mocks.NewMockRestServer(t, workspace1)
+	apiServer := mocks.NewMockRestServer(t, workspace1, profileData1)
 	defer apiServer.Close()
 
 	mockConfig.Server.ApiUrl = apiServer.URL
 
 	mockGitService := mock_git.NewMockGitService()
 	mockGitService.On("RepositoryExists", project1).Return(true, nil)
-	mockGitService.On("SetGitConfig", mock.Anything).Return(nil)
+	mockGitService.On("SetGitConfig", mock.Anything, profileData1.GitIdentity).Return(nil)
 	mockGitService.On("GetGitStatus").Return(gitStatus1, nil)
 
 	mockSshServer := mocks.NewMockSshServer()
@@ -87,14 +102,17 @@ func TestAgent(t *testing.T) {
 	mockConfig.ProjectDir = t.TempDir()
 
 	postCreateLockFilePath := filepath.Join(t.TempDir(), ".daytona_post_create.lock")
+	gitCredentialDir := t.TempDir()
 
 	// Create a new Agent instance
 	a := &agent.Agent{
-		Config:                 mockConfig,
-		Git:                    mockGitService,
-		Ssh:                    mockSshServer,
-		Tailscale:              mockTailscaleServer,
-		PostCreateLockFilePath: postCreateLockFilePath,
+		Config:                  mockConfig,
+		Git:                     mockGitService,
+		Ssh:                     mockSshServer,
+		Tailscale:               mockTailscaleServer,
+		PostCreateLockFilePath:  postCreateLockFilePath,
+		GitCredentialSocketPath: filepath.Join(gitCredentialDir, "git-credential.sock"),
+		GitCredentialHelperPath: filepath.Join(gitCredentialDir, "git-credential-daytona"),
 	}
 
 	t.Run("Start agent", func(t *testing.T) {
@@ -108,6 +126,28 @@ func TestAgent(t *testing.T) {
 		require.FileExists(t, mockConfig.ProjectDir+"/test.txt")
 	})
 
+	t.Run("Serve git credentials", func(t *testing.T) {
+		require.FileExists(t, a.GitCredentialHelperPath)
+
+		client := &http.Client{
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					return (&net.Dialer{}).DialContext(ctx, "unix", a.GitCredentialSocketPath)
+				},
+			},
+		}
+
+		res, err := client.Get("http://agent/credential?url=" + url.QueryEscape("https://github.com/daytonaio/daytona"))
+		require.Nil(t, err)
+		defer res.Body.Close()
+		require.Equal(t, http.StatusOK, res.StatusCode)
+
+		var credential gitprovider.GitCredential
+		require.Nil(t, json.NewDecoder(res.Body).Decode(&credential))
+		require.Equal(t, "daytona", credential.Username)
+		require.Equal(t, "token", credential.Password)
+	})
+
 	t.Run("Post create commands not ran", func(t *testing.T) {
 		projectDir := t.TempDir()
 		postCreateLockFilePath := filepath.Join(t.TempDir(), ".daytona_post_create.lock")
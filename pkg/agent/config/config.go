@@ -25,8 +25,12 @@ type Config struct {
 	ProjectName string  `envconfig:"DAYTONA_WS_PROJECT_NAME"`
 	WorkspaceId string  `envconfig:"DAYTONA_WS_ID" validate:"required"`
 	LogFilePath *string `envconfig:"DAYTONA_AGENT_LOG_FILE_PATH"`
-	Server      DaytonaServerConfig
-	Mode        Mode
+	// If set, SSH connections must authenticate with a certificate issued by the server CA
+	SshCaPublicKey string `envconfig:"DAYTONA_SSH_CA_PUBLIC_KEY"`
+	// If set, terminal sessions of SSH connections are recorded and uploaded to the server
+	RecordSshSessions bool `envconfig:"DAYTONA_RECORD_SSH_SESSIONS"`
+	Server            DaytonaServerConfig
+	Mode              Mode
 }
 
 type Mode string
@@ -0,0 +1,135 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	log "github.com/sirupsen/logrus"
+)
+
+// Serves git credentials to the git credential helper over a unix socket that is only accessible to the project user.
+// The credentials are requested from the server on demand so no git provider tokens are stored in the project.
+func (a *Agent) startGitCredentialServer(project *workspace.Project) error {
+	err := os.MkdirAll(filepath.Dir(a.GitCredentialSocketPath), 0700)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(a.GitCredentialSocketPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	listener, err := net.Listen("unix", a.GitCredentialSocketPath)
+	if err != nil {
+		return err
+	}
+
+	err = os.Chmod(a.GitCredentialSocketPath, 0600)
+	if err != nil {
+		listener.Close()
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/credential", func(w http.ResponseWriter, r *http.Request) {
+		a.handleGitCredentialRequest(w, r, project)
+	})
+
+	go func() {
+		err := http.Serve(listener, mux)
+		if err != nil {
+			log.Error(fmt.Sprintf("git credential server stopped: %s", err))
+		}
+	}()
+
+	return nil
+}
+
+func (a *Agent) handleGitCredentialRequest(w http.ResponseWriter, r *http.Request, project *workspace.Project) {
+	repoUrl := r.URL.Query().Get("url")
+	if repoUrl == "" {
+		http.Error(w, "url is required", http.StatusBadRequest)
+		return
+	}
+
+	apiClient, err := apiclient_util.GetAgentApiClient(a.Config.Server.ApiUrl, a.Config.Server.ApiKey)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	request := apiClient.GitProviderAPI.GetGitCredential(context.Background(), url.QueryEscape(repoUrl))
+	// The config selected for the project is only used for its own repository
+	if project.GitProviderConfigId != "" && project.Repository != nil && isSameRepository(repoUrl, project.Repository.Url) {
+		request = request.GitProviderConfigId(project.GitProviderConfigId)
+	}
+
+	credential, res, err := request.Execute()
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if res != nil && res.StatusCode == http.StatusNotFound {
+			statusCode = http.StatusNotFound
+		}
+		http.Error(w, apiclient_util.HandleErrorResponse(res, err).Error(), statusCode)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(credential)
+	if err != nil {
+		log.Error(fmt.Sprintf("failed to write git credential response: %s", err))
+	}
+}
+
+// Writes the git-credential-daytona helper which forwards the requests of git to the credential server
+func (a *Agent) installGitCredentialHelper() error {
+	if a.GitCredentialHelperPath == "" {
+		return errors.New("git credential helper path is not set")
+	}
+
+	daytonaPath, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(a.GitCredentialHelperPath), 0755)
+	if err != nil {
+		return err
+	}
+
+	script := fmt.Sprintf("#!/bin/sh\nexec '%s' git-credential --socket '%s' \"$@\"\n", daytonaPath, a.GitCredentialSocketPath)
+
+	return os.WriteFile(a.GitCredentialHelperPath, []byte(script), 0755)
+}
+
+func isSameRepository(url1, url2 string) bool {
+	return normalizeRepositoryUrl(url1) == normalizeRepositoryUrl(url2)
+}
+
+func normalizeRepositoryUrl(repoUrl string) string {
+	if i := strings.Index(repoUrl, "://"); i != -1 {
+		repoUrl = repoUrl[i+3:]
+	}
+	if i := strings.Index(repoUrl, "@"); i != -1 {
+		repoUrl = repoUrl[i+1:]
+	}
+
+	repoUrl = strings.Replace(repoUrl, ":", "/", 1)
+	repoUrl = strings.TrimSuffix(strings.TrimSuffix(repoUrl, "/"), ".git")
+
+	return strings.ToLower(strings.TrimPrefix(repoUrl, "www."))
+}
@@ -0,0 +1,28 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+)
+
+// UploadSessionRecording stores the recording of a project SSH session on the server
+func (a *Agent) UploadSessionRecording(cast []byte) error {
+	apiClient, err := apiclient_util.GetAgentApiClient(a.Config.Server.ApiUrl, a.Config.Server.ApiKey)
+	if err != nil {
+		return err
+	}
+
+	_, res, err := apiClient.SessionRecordingAPI.UploadSessionRecording(context.Background(), a.Config.WorkspaceId, a.Config.ProjectName).Recording(apiclient.UploadSessionRecordingRequest{
+		Cast: string(cast),
+	}).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	return nil
+}
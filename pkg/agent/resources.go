@@ -0,0 +1,155 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+const (
+	cgroupCpuStatPath       = "/sys/fs/cgroup/cpu.stat"
+	cgroupMemoryCurrentPath = "/sys/fs/cgroup/memory.current"
+	cgroupMemoryMaxPath     = "/sys/fs/cgroup/memory.max"
+	memInfoPath             = "/proc/meminfo"
+)
+
+type cpuSample struct {
+	usage     time.Duration
+	timestamp time.Time
+}
+
+// Returns the resource usage of the project container.
+// The CPU usage is calculated from the difference to the previous sample so the first call reports 0.
+func (a *Agent) getProjectResources() *workspace.ProjectResources {
+	resources := &workspace.ProjectResources{}
+
+	usage, err := readCgroupCpuUsage()
+	if err == nil {
+		sample := &cpuSample{usage: usage, timestamp: time.Now()}
+		if a.lastCpuSample != nil {
+			elapsed := sample.timestamp.Sub(a.lastCpuSample.timestamp)
+			if elapsed > 0 {
+				resources.CpuUsage = float64(sample.usage-a.lastCpuSample.usage) / float64(elapsed) * 100
+			}
+		}
+		a.lastCpuSample = sample
+	}
+
+	resources.MemoryUsageMB, resources.MemoryLimitMB = readMemoryUsage()
+
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(a.Config.ProjectDir, &stat); err == nil {
+		total := stat.Blocks * uint64(stat.Bsize)
+		free := stat.Bfree * uint64(stat.Bsize)
+		resources.DiskTotalMB = toMB(total)
+		resources.DiskUsageMB = toMB(total - free)
+	}
+
+	return resources
+}
+
+func readCgroupCpuUsage() (time.Duration, error) {
+	file, err := os.Open(cgroupCpuStatPath)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && fields[0] == "usage_usec" {
+			usec, err := strconv.ParseUint(fields[1], 10, 64)
+			if err != nil {
+				return 0, err
+			}
+			return time.Duration(usec) * time.Microsecond, nil
+		}
+	}
+
+	return 0, os.ErrNotExist
+}
+
+// Returns the used and available memory, preferring the container cgroup limits over the host memory
+func readMemoryUsage() (uint64, uint64) {
+	var used, limit uint64
+
+	current, err := readUintFile(cgroupMemoryCurrentPath)
+	if err == nil {
+		used = current
+	}
+
+	max, err := readUintFile(cgroupMemoryMaxPath)
+	if err == nil {
+		limit = max
+	}
+
+	if used == 0 || limit == 0 {
+		memTotal, memAvailable, err := readMemInfo()
+		if err == nil {
+			if used == 0 {
+				used = memTotal - memAvailable
+			}
+			if limit == 0 {
+				limit = memTotal
+			}
+		}
+	}
+
+	return toMB(used), toMB(limit)
+}
+
+func readMemInfo() (uint64, uint64, error) {
+	file, err := os.Open(memInfoPath)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer file.Close()
+
+	var memTotal, memAvailable uint64
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		// Values are reported in kB
+		switch fields[0] {
+		case "MemTotal:":
+			memTotal = value * 1024
+		case "MemAvailable:":
+			memAvailable = value * 1024
+		}
+	}
+
+	return memTotal, memAvailable, nil
+}
+
+// Reads a file containing a single number. Returns an error for "max" (no limit)
+func readUintFile(path string) (uint64, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseUint(strings.TrimSpace(string(content)), 10, 64)
+}
+
+func toMB(bytes uint64) uint64 {
+	return bytes / 1024 / 1024
+}
@@ -0,0 +1,408 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ssh
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// scpCommand is a parsed remote invocation of the legacy scp protocol ("scp -t" or "scp -f").
+// It is used when the project image does not provide an scp binary.
+type scpCommand struct {
+	// Receive files from the client (-t) instead of sending them (-f)
+	Sink          bool
+	Recursive     bool
+	PreserveTimes bool
+	// Set by the client when the target must be a directory (-d)
+	TargetIsDir bool
+	Paths       []string
+}
+
+const (
+	scpOk      = 0
+	scpWarning = 1
+	scpError   = 2
+)
+
+func parseScpCommand(args []string) (*scpCommand, bool) {
+	if len(args) < 2 || filepath.Base(args[0]) != "scp" {
+		return nil, false
+	}
+
+	cmd := &scpCommand{}
+	modeSet := false
+	flagsEnded := false
+
+	for _, arg := range args[1:] {
+		if flagsEnded || !strings.HasPrefix(arg, "-") || len(cmd.Paths) > 0 {
+			cmd.Paths = append(cmd.Paths, arg)
+			continue
+		}
+
+		if arg == "--" {
+			flagsEnded = true
+			continue
+		}
+
+		for _, flag := range arg[1:] {
+			switch flag {
+			case 't':
+				cmd.Sink = true
+				modeSet = true
+			case 'f':
+				modeSet = true
+			case 'r':
+				cmd.Recursive = true
+			case 'p':
+				cmd.PreserveTimes = true
+			case 'd':
+				cmd.TargetIsDir = true
+			case 'v':
+			default:
+				return nil, false
+			}
+		}
+	}
+
+	if !modeSet || len(cmd.Paths) == 0 {
+		return nil, false
+	}
+
+	return cmd, true
+}
+
+// Runs the scp command over rw. Relative paths are resolved against workDir.
+func runScp(cmd *scpCommand, rw io.ReadWriter, workDir string) error {
+	paths := []string{}
+	for _, p := range cmd.Paths {
+		if !filepath.IsAbs(p) {
+			p = filepath.Join(workDir, p)
+		}
+		paths = append(paths, p)
+	}
+
+	reader := bufio.NewReader(rw)
+
+	if cmd.Sink {
+		if len(paths) != 1 {
+			return sendScpError(rw, errors.New("scp: ambiguous target"))
+		}
+		return runScpSink(cmd, reader, rw, paths[0])
+	}
+
+	return runScpSource(cmd, reader, rw, paths)
+}
+
+func runScpSink(cmd *scpCommand, reader *bufio.Reader, w io.Writer, target string) error {
+	targetInfo, err := os.Stat(target)
+	targetIsDir := err == nil && targetInfo.IsDir()
+	if cmd.TargetIsDir && !targetIsDir {
+		return sendScpError(w, fmt.Errorf("scp: %s: Not a directory", target))
+	}
+
+	err = sendScpAck(w)
+	if err != nil {
+		return err
+	}
+
+	dirs := []string{}
+	var times *[2]time.Time
+
+	for {
+		line, err := reader.ReadString('\n')
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" {
+			continue
+		}
+
+		// Destination of the next file or directory
+		dest := target
+		if len(dirs) > 0 {
+			dest = dirs[len(dirs)-1]
+		}
+
+		switch line[0] {
+		case 'T':
+			times, err = parseScpTimes(line[1:])
+			if err != nil {
+				return sendScpError(w, err)
+			}
+		case 'C', 'D':
+			mode, size, name, err := parseScpEntry(line[1:])
+			if err != nil {
+				return sendScpError(w, err)
+			}
+
+			path := dest
+			if len(dirs) > 0 || targetIsDir {
+				path = filepath.Join(dest, name)
+			}
+
+			if line[0] == 'D' {
+				if !cmd.Recursive {
+					return sendScpError(w, errors.New("scp: received directory without -r"))
+				}
+
+				err = os.MkdirAll(path, mode)
+				if err != nil {
+					return sendScpError(w, err)
+				}
+				dirs = append(dirs, path)
+
+				err = sendScpAck(w)
+				if err != nil {
+					return err
+				}
+			} else {
+				err = receiveScpFile(reader, w, path, mode, size)
+				if err != nil {
+					return err
+				}
+			}
+
+			if times != nil {
+				_ = os.Chtimes(path, times[1], times[0])
+				times = nil
+			}
+			continue
+		case 'E':
+			if len(dirs) == 0 {
+				return sendScpError(w, errors.New("scp: unexpected end of directory"))
+			}
+			dirs = dirs[:len(dirs)-1]
+		case scpWarning, scpError:
+			// The client reported an error reading its local file
+			if line[0] == scpError {
+				return errors.New(line[1:])
+			}
+			continue
+		default:
+			return sendScpError(w, fmt.Errorf("scp: unexpected message: %q", line))
+		}
+
+		err = sendScpAck(w)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func receiveScpFile(reader *bufio.Reader, w io.Writer, path string, mode os.FileMode, size int64) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return sendScpError(w, err)
+	}
+	defer file.Close()
+
+	err = sendScpAck(w)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.CopyN(file, reader, size)
+	if err != nil {
+		return err
+	}
+
+	err = readScpAck(reader)
+	if err != nil {
+		return err
+	}
+
+	return sendScpAck(w)
+}
+
+func runScpSource(cmd *scpCommand, reader *bufio.Reader, w io.Writer, paths []string) error {
+	err := readScpAck(reader)
+	if err != nil {
+		return err
+	}
+
+	for _, path := range paths {
+		err = sendScpPath(cmd, reader, w, path)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func sendScpPath(cmd *scpCommand, reader *bufio.Reader, w io.Writer, path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return sendScpWarning(w, err)
+	}
+
+	if cmd.PreserveTimes {
+		_, err = fmt.Fprintf(w, "T%d 0 %d 0\n", info.ModTime().Unix(), info.ModTime().Unix())
+		if err != nil {
+			return err
+		}
+		err = readScpAck(reader)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !info.IsDir() {
+		return sendScpFile(reader, w, path, info)
+	}
+
+	if !cmd.Recursive {
+		return sendScpWarning(w, fmt.Errorf("scp: %s: not a regular file", path))
+	}
+
+	_, err = fmt.Fprintf(w, "D%04o 0 %s\n", info.Mode().Perm(), info.Name())
+	if err != nil {
+		return err
+	}
+	err = readScpAck(reader)
+	if err != nil {
+		return err
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return sendScpWarning(w, err)
+	}
+
+	for _, entry := range entries {
+		err = sendScpPath(cmd, reader, w, filepath.Join(path, entry.Name()))
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprint(w, "E\n")
+	if err != nil {
+		return err
+	}
+
+	return readScpAck(reader)
+}
+
+func sendScpFile(reader *bufio.Reader, w io.Writer, path string, info os.FileInfo) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return sendScpWarning(w, err)
+	}
+	defer file.Close()
+
+	_, err = fmt.Fprintf(w, "C%04o %d %s\n", info.Mode().Perm(), info.Size(), info.Name())
+	if err != nil {
+		return err
+	}
+	err = readScpAck(reader)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.CopyN(w, file, info.Size())
+	if err != nil {
+		return err
+	}
+
+	err = sendScpAck(w)
+	if err != nil {
+		return err
+	}
+
+	return readScpAck(reader)
+}
+
+// Parses "<mode> <size> <name>"
+func parseScpEntry(entry string) (os.FileMode, int64, string, error) {
+	parts := strings.SplitN(entry, " ", 3)
+	if len(parts) != 3 {
+		return 0, 0, "", fmt.Errorf("scp: invalid entry: %q", entry)
+	}
+
+	mode, err := strconv.ParseUint(parts[0], 8, 32)
+	if err != nil {
+		return 0, 0, "", fmt.Errorf("scp: invalid mode: %q", parts[0])
+	}
+
+	size, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || size < 0 {
+		return 0, 0, "", fmt.Errorf("scp: invalid size: %q", parts[1])
+	}
+
+	name := parts[2]
+	if name == "" || name == "." || name == ".." || strings.Contains(name, "/") {
+		return 0, 0, "", fmt.Errorf("scp: invalid name: %q", name)
+	}
+
+	return os.FileMode(mode), size, name, nil
+}
+
+// Parses "<mtime> 0 <atime> 0" and returns the access and modification times
+func parseScpTimes(entry string) (*[2]time.Time, error) {
+	parts := strings.Fields(entry)
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("scp: invalid times: %q", entry)
+	}
+
+	mtime, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("scp: invalid mtime: %q", parts[0])
+	}
+
+	atime, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("scp: invalid atime: %q", parts[2])
+	}
+
+	return &[2]time.Time{time.Unix(atime, 0), time.Unix(mtime, 0)}, nil
+}
+
+func sendScpAck(w io.Writer) error {
+	_, err := w.Write([]byte{scpOk})
+	return err
+}
+
+// Reports a non fatal error to the client which continues with the next file
+func sendScpWarning(w io.Writer, err error) error {
+	_, writeErr := fmt.Fprintf(w, "%c%s\n", scpWarning, err.Error())
+	return writeErr
+}
+
+// Reports a fatal error to the client and returns it
+func sendScpError(w io.Writer, err error) error {
+	_, _ = fmt.Fprintf(w, "%c%s\n", scpError, err.Error())
+	return err
+}
+
+func readScpAck(reader *bufio.Reader) error {
+	code, err := reader.ReadByte()
+	if err != nil {
+		return err
+	}
+
+	if code == scpOk {
+		return nil
+	}
+
+	message, err := reader.ReadString('\n')
+	if err != nil {
+		return err
+	}
+
+	return errors.New(strings.TrimSuffix(message, "\n"))
+}
@@ -0,0 +1,77 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ssh
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Reads the client messages from in and records the server messages in out
+type scpConn struct {
+	in  io.Reader
+	out bytes.Buffer
+}
+
+func (c *scpConn) Read(p []byte) (int, error) {
+	return c.in.Read(p)
+}
+
+func (c *scpConn) Write(p []byte) (int, error) {
+	return c.out.Write(p)
+}
+
+func TestParseScpCommand(t *testing.T) {
+	cmd, ok := parseScpCommand([]string{"scp", "-v", "-r", "-d", "-t", "--", "dir"})
+	require.True(t, ok)
+	require.Equal(t, &scpCommand{Sink: true, Recursive: true, TargetIsDir: true, Paths: []string{"dir"}}, cmd)
+
+	cmd, ok = parseScpCommand([]string{"scp", "-pf", "a.txt", "b.txt"})
+	require.True(t, ok)
+	require.Equal(t, &scpCommand{PreserveTimes: true, Paths: []string{"a.txt", "b.txt"}}, cmd)
+
+	_, ok = parseScpCommand([]string{"scp", "a.txt", "host:b.txt"})
+	require.False(t, ok)
+
+	_, ok = parseScpCommand([]string{"ls", "-t", "dir"})
+	require.False(t, ok)
+}
+
+func TestScpSink(t *testing.T) {
+	workDir := t.TempDir()
+
+	input := "D0755 0 src\n" +
+		"C0644 5 hello.txt\nhello\x00" +
+		"E\n"
+	conn := &scpConn{in: bytes.NewBufferString(input)}
+
+	err := runScp(&scpCommand{Sink: true, Recursive: true, Paths: []string{"dst"}}, conn, workDir)
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(filepath.Join(workDir, "dst", "hello.txt"))
+	require.NoError(t, err)
+	require.Equal(t, "hello", string(content))
+
+	// One ack for the start, the directory, the file header, the file content and the end of the directory
+	require.Equal(t, []byte{0, 0, 0, 0, 0}, conn.out.Bytes())
+}
+
+func TestScpSource(t *testing.T) {
+	workDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(workDir, "hello.txt"), []byte("hello"), 0644)
+	require.NoError(t, err)
+
+	// Acks for the start, the file header and the file content
+	conn := &scpConn{in: bytes.NewReader([]byte{0, 0, 0})}
+
+	err = runScp(&scpCommand{Paths: []string{"hello.txt"}}, conn, workDir)
+	require.NoError(t, err)
+
+	require.Equal(t, "C0644 5 hello.txt\nhello\x00", conn.out.String())
+}
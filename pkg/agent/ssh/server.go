@@ -14,6 +14,10 @@ import (
 
 	"github.com/creack/pty"
 	"github.com/daytonaio/daytona/pkg/agent/ssh/config"
+	"github.com/daytonaio/daytona/pkg/sessionrecording"
+	"github.com/daytonaio/daytona/pkg/sshca"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/gliderlabs/ssh"
 	"github.com/pkg/sftp"
 	"golang.org/x/sys/unix"
@@ -24,9 +28,24 @@ import (
 type Server struct {
 	ProjectDir        string
 	DefaultProjectDir string
+	// Authority whose certificates are required to connect. Connections are not authenticated if not set
+	CaPublicKey string
+	// Principal the certificates must be issued for
+	Principal string
+	// Uploads the asciicast recording of a finished terminal session. Sessions are not recorded if not set
+	UploadRecording func(cast []byte) error
 }
 
 func (s *Server) Start() error {
+	var certificateChecker *sshca.CertificateChecker
+	if s.CaPublicKey != "" {
+		var err error
+		certificateChecker, err = sshca.NewCertificateChecker(s.CaPublicKey)
+		if err != nil {
+			return err
+		}
+	}
+
 	forwardedTCPHandler := &ssh.ForwardedTCPHandler{}
 	unixForwardHandler := newForwardedUnixHandler()
 
@@ -76,6 +95,17 @@ func (s *Server) Start() error {
 		},
 	}
 
+	if certificateChecker != nil {
+		sshServer.PublicKeyHandler = func(ctx ssh.Context, key ssh.PublicKey) bool {
+			err := certificateChecker.Check(s.Principal, key)
+			if err != nil {
+				log.Debugf("Rejected SSH key of %s: %s", ctx.RemoteAddr(), err)
+				return false
+			}
+			return true
+		}
+	}
+
 	log.Printf("Starting ssh server on port %d...\n", config.SSH_PORT)
 	return sshServer.ListenAndServe()
 }
@@ -84,11 +114,7 @@ func (s *Server) handlePty(session ssh.Session, ptyReq ssh.Pty, winCh <-chan ssh
 	shell := s.getShell()
 	cmd := exec.Command(shell)
 
-	cmd.Dir = s.ProjectDir
-
-	if _, err := os.Stat(s.ProjectDir); os.IsNotExist(err) {
-		cmd.Dir = s.DefaultProjectDir
-	}
+	cmd.Dir = s.getWorkingDir()
 
 	if ssh.AgentRequested(session) {
 		l, err := ssh.NewAgentListener()
@@ -110,19 +136,57 @@ func (s *Server) handlePty(session ssh.Session, ptyReq ssh.Pty, winCh <-chan ssh
 		return
 	}
 
+	var output io.Writer = session
+	var recorder *sessionrecording.Recorder
+	if s.UploadRecording != nil {
+		// Only the output is recorded so that passwords typed without echo are not stored
+		recorder = sessionrecording.NewRecorder(ptyReq.Window.Width, ptyReq.Window.Height, map[string]string{
+			"TERM":  ptyReq.Term,
+			"SHELL": shell,
+		})
+		output = io.MultiWriter(session, recorder)
+		defer s.uploadRecording(recorder)
+	}
+
+	s.renderWelcome(output, ptyReq.Window.Width)
+
+	if recorder != nil {
+		fmt.Fprint(output, "This session is recorded\r\n\r\n")
+	}
+
 	go func() {
 		for win := range winCh {
 			syscall.Syscall(syscall.SYS_IOCTL, f.Fd(), uintptr(syscall.TIOCSWINSZ),
 				uintptr(unsafe.Pointer(&struct{ h, w, x, y uint16 }{uint16(win.Height), uint16(win.Width), 0, 0})))
+			if recorder != nil {
+				recorder.Resize(win.Width, win.Height)
+			}
 		}
 	}()
 	go func() {
 		io.Copy(f, session) // stdin
 	}()
-	io.Copy(session, f) // stdout
+	io.Copy(output, f) // stdout
+}
+
+// The upload does not block closing the session
+func (s *Server) uploadRecording(recorder *sessionrecording.Recorder) {
+	go func() {
+		err := s.UploadRecording(recorder.Cast())
+		if err != nil {
+			log.Errorf("Failed to upload the session recording: %v", err)
+		}
+	}()
 }
 
 func (s *Server) handleNonPty(session ssh.Session) {
+	if scpCmd, ok := parseScpCommand(session.Command()); ok {
+		if _, err := exec.LookPath("scp"); err != nil {
+			s.handleScp(session, scpCmd)
+			return
+		}
+	}
+
 	args := []string{}
 	if len(session.Command()) > 0 {
 		args = append([]string{"-c"}, session.RawCommand())
@@ -143,10 +207,7 @@ func (s *Server) handleNonPty(session ssh.Session) {
 		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", "SSH_AUTH_SOCK", l.Addr().String()))
 	}
 
-	cmd.Dir = s.ProjectDir
-	if _, err := os.Stat(s.ProjectDir); os.IsNotExist(err) {
-		cmd.Dir = s.DefaultProjectDir
-	}
+	cmd.Dir = s.getWorkingDir()
 
 	cmd.Stdout = session
 	cmd.Stderr = session.Stderr()
@@ -264,10 +325,54 @@ func (s *Server) getShell() string {
 	return "sh"
 }
 
+// Returns the project directory, or the default project directory if the project is not cloned yet
+func (s *Server) getWorkingDir() string {
+	if _, err := os.Stat(s.ProjectDir); os.IsNotExist(err) {
+		return s.DefaultProjectDir
+	}
+
+	return s.ProjectDir
+}
+
+// Shows the project welcome file on login
+func (s *Server) renderWelcome(w io.Writer, width int) {
+	welcome, err := workspace.ReadWelcomeFile(s.getWorkingDir())
+	if err != nil {
+		log.Errorf("Failed to read the welcome file: %v", err)
+		return
+	}
+	if strings.TrimSpace(welcome) == "" {
+		return
+	}
+
+	output, err := views.RenderMarkdown(welcome, width)
+	if err != nil {
+		log.Errorf("Failed to render the welcome file: %v", err)
+		output = welcome
+	}
+
+	// The client terminal is in raw mode
+	fmt.Fprint(w, strings.ReplaceAll(output, "\n", "\r\n")+"\r\n\r\n")
+}
+
+// The legacy scp protocol is served by the agent if the project image does not include scp
+func (s *Server) handleScp(session ssh.Session, scpCmd *scpCommand) {
+	err := runScp(scpCmd, session, s.getWorkingDir())
+	if err != nil {
+		log.Errorf("scp failed: %v", err)
+		session.Exit(1)
+		return
+	}
+
+	session.Exit(0)
+}
+
 func (s *Server) sftpHandler(session ssh.Session) {
 	debugStream := io.Discard
 	serverOptions := []sftp.ServerOption{
 		sftp.WithDebug(debugStream),
+		// Relative paths are resolved against the project directory like in shell sessions
+		sftp.WithServerWorkingDirectory(s.getWorkingDir()),
 	}
 	server, err := sftp.NewServer(
 		session,
@@ -281,5 +386,9 @@ func (s *Server) sftpHandler(session ssh.Session) {
 		server.Close()
 	} else if err != nil {
 		log.Errorf("sftp server completed with error: %s\n", err)
+		session.Exit(1)
+		return
 	}
+
+	session.Exit(0)
 }
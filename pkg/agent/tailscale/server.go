@@ -4,6 +4,7 @@
 package tailscale
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net"
@@ -23,16 +24,25 @@ import (
 type Server struct {
 	Hostname string
 	Server   config.DaytonaServerConfig
+	// If set, the network key is requested for the project so the network mode of its target is respected
+	WorkspaceId string
+	ProjectName string
 }
 
 func (s *Server) Start() error {
 	errChan := make(chan error)
 
-	tsnetServer, err := s.connect()
+	tsnetServer, networkKey, err := s.connect()
 	if err != nil {
 		return fmt.Errorf("failed to connect to server: %v", err)
 	}
 
+	// The Daytona server is not part of external tailnets so the connection health is left to the tailnet
+	if networkKey.ControlUrl != nil && *networkKey.ControlUrl != "" {
+		log.Infof("Connected to tailnet %s", *networkKey.ControlUrl)
+		return <-errChan
+	}
+
 	go func(tsnetServer *tsnet.Server) {
 		for {
 			time.Sleep(5 * time.Second)
@@ -47,7 +57,7 @@ func (s *Server) Start() error {
 					log.Errorf("Failed to close tsnet server: %v", err)
 				}
 
-				tsnetServer, err = s.connect()
+				tsnetServer, _, err = s.connect()
 				if err != nil {
 					log.Errorf("Failed to reconnect: %v", err)
 				} else {
@@ -62,13 +72,18 @@ func (s *Server) Start() error {
 	return <-errChan
 }
 
-func (s *Server) getNetworkKey() (string, error) {
+func (s *Server) getNetworkKey() (*apiclient.NetworkKey, error) {
 	apiClient, err := apiclient_util.GetAgentApiClient(s.Server.ApiUrl, s.Server.ApiKey)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	networkKey, _, err := apiClient.ServerAPI.GenerateNetworkKeyExecute(apiclient.ApiGenerateNetworkKeyRequest{})
+	var networkKey *apiclient.NetworkKey
+	if s.ProjectName != "" {
+		networkKey, _, err = apiClient.WorkspaceAPI.GenerateProjectNetworkKey(context.Background(), s.WorkspaceId, s.ProjectName).Execute()
+	} else {
+		networkKey, _, err = apiClient.ServerAPI.GenerateNetworkKeyExecute(apiclient.ApiGenerateNetworkKeyRequest{})
+	}
 	// Retry indefinitely. Used to reconnect to the Daytona Server
 	if err != nil {
 		log.Tracef("Failed to get network key: %v", err)
@@ -76,10 +91,10 @@ func (s *Server) getNetworkKey() (string, error) {
 		return s.getNetworkKey()
 	}
 
-	return *networkKey.Key, nil
+	return networkKey, nil
 }
 
-func (s *Server) getTsnetServer() (*tsnet.Server, error) {
+func (s *Server) getTsnetServer() (*tsnet.Server, *apiclient.NetworkKey, error) {
 	tsnetServer := &tsnet.Server{
 		Hostname:   s.Hostname,
 		ControlURL: s.Server.Url,
@@ -88,10 +103,13 @@ func (s *Server) getTsnetServer() (*tsnet.Server, error) {
 
 	networkKey, err := s.getNetworkKey()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get network key: %v", err)
+		return nil, nil, fmt.Errorf("failed to get network key: %v", err)
 	}
 
-	tsnetServer.AuthKey = networkKey
+	tsnetServer.AuthKey = *networkKey.Key
+	if networkKey.ControlUrl != nil && *networkKey.ControlUrl != "" {
+		tsnetServer.ControlURL = *networkKey.ControlUrl
+	}
 
 	tsnetServer.RegisterFallbackTCPHandler(func(src, dest netip.AddrPort) (handler func(net.Conn), intercept bool) {
 		destPort := dest.Port()
@@ -126,18 +144,18 @@ func (s *Server) getTsnetServer() (*tsnet.Server, error) {
 		}, true
 	})
 
-	return tsnetServer, nil
+	return tsnetServer, networkKey, nil
 }
 
-func (s *Server) connect() (*tsnet.Server, error) {
-	tsnetServer, err := s.getTsnetServer()
+func (s *Server) connect() (*tsnet.Server, *apiclient.NetworkKey, error) {
+	tsnetServer, networkKey, err := s.getTsnetServer()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	ln, err := tsnetServer.Listen("tcp", ":80")
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	go func() {
@@ -150,5 +168,5 @@ func (s *Server) connect() (*tsnet.Server, error) {
 		}
 	}()
 
-	return tsnetServer, nil
+	return tsnetServer, networkKey, nil
 }
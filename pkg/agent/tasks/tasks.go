@@ -0,0 +1,200 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/tailscale/hujson"
+)
+
+type TaskSource string
+
+const (
+	// Declared under customizations.daytona.tasks in the devcontainer configuration
+	TaskSourceDevcontainer TaskSource = "devcontainer"
+	TaskSourceMake         TaskSource = "make"
+	TaskSourceNpm          TaskSource = "npm"
+)
+
+type Task struct {
+	Name    string     `json:"name"`
+	Command string     `json:"command"`
+	Source  TaskSource `json:"source"`
+}
+
+var ErrTaskNotFound = errors.New("task not found")
+
+var devcontainerConfigPaths = []string{
+	filepath.Join(".devcontainer", "devcontainer.json"),
+	".devcontainer.json",
+}
+
+var makefileNames = []string{"GNUmakefile", "makefile", "Makefile"}
+
+// Matches rule targets and skips variable assignments, special and pattern targets
+var makeTargetRegex = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9_./-]*)\s*:([^=]|$)`)
+
+// Discover returns the tasks declared in the project directory. Devcontainer tasks come first, followed by
+// Makefile targets and package.json scripts. A name already taken by a previous source is prefixed with the source name
+func Discover(projectDir string) ([]Task, error) {
+	tasks := []Task{}
+	names := map[string]bool{}
+
+	for _, discover := range []func(string) ([]Task, error){discoverDevcontainerTasks, discoverMakeTargets, discoverNpmScripts} {
+		sourceTasks, err := discover(projectDir)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, task := range sourceTasks {
+			if names[task.Name] {
+				task.Name = fmt.Sprintf("%s:%s", task.Source, task.Name)
+			}
+			names[task.Name] = true
+			tasks = append(tasks, task)
+		}
+	}
+
+	return tasks, nil
+}
+
+func Find(tasks []Task, name string) (*Task, error) {
+	for _, task := range tasks {
+		if task.Name == name {
+			return &task, nil
+		}
+	}
+
+	return nil, ErrTaskNotFound
+}
+
+func discoverDevcontainerTasks(projectDir string) ([]Task, error) {
+	for _, configPath := range devcontainerConfigPaths {
+		content, err := os.ReadFile(filepath.Join(projectDir, configPath))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		standardized, err := hujson.Standardize(content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", configPath, err)
+		}
+
+		var config struct {
+			Customizations struct {
+				Daytona struct {
+					Tasks map[string]string `json:"tasks"`
+				} `json:"daytona"`
+			} `json:"customizations"`
+		}
+
+		err = json.Unmarshal(standardized, &config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the tasks in %s: %w", configPath, err)
+		}
+
+		tasks := []Task{}
+		for _, name := range sortedKeys(config.Customizations.Daytona.Tasks) {
+			tasks = append(tasks, Task{
+				Name:    name,
+				Command: config.Customizations.Daytona.Tasks[name],
+				Source:  TaskSourceDevcontainer,
+			})
+		}
+
+		return tasks, nil
+	}
+
+	return nil, nil
+}
+
+func discoverMakeTargets(projectDir string) ([]Task, error) {
+	for _, makefileName := range makefileNames {
+		file, err := os.Open(filepath.Join(projectDir, makefileName))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		defer file.Close()
+
+		tasks := []Task{}
+		targets := map[string]bool{}
+
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			match := makeTargetRegex.FindStringSubmatch(scanner.Text())
+			if match == nil || targets[match[1]] {
+				continue
+			}
+			targets[match[1]] = true
+
+			tasks = append(tasks, Task{
+				Name:    match[1],
+				Command: fmt.Sprintf("make %s", match[1]),
+				Source:  TaskSourceMake,
+			})
+		}
+
+		return tasks, scanner.Err()
+	}
+
+	return nil, nil
+}
+
+func discoverNpmScripts(projectDir string) ([]Task, error) {
+	content, err := os.ReadFile(filepath.Join(projectDir, "package.json"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var packageJson struct {
+		Scripts map[string]string `json:"scripts"`
+	}
+
+	err = json.Unmarshal(content, &packageJson)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse package.json: %w", err)
+	}
+
+	tasks := []Task{}
+	for _, name := range sortedKeys(packageJson.Scripts) {
+		tasks = append(tasks, Task{
+			Name:    name,
+			Command: fmt.Sprintf("npm run %s", shellQuote(name)),
+			Source:  TaskSourceNpm,
+		})
+	}
+
+	return tasks, nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := []string{}
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
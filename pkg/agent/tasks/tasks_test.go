@@ -0,0 +1,78 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/agent/tasks"
+	"github.com/stretchr/testify/require"
+)
+
+const devcontainerConfig = `{
+	// Tasks can be run with daytona run
+	"image": "mcr.microsoft.com/devcontainers/go",
+	"customizations": {
+		"daytona": {
+			"tasks": {
+				"test": "go test ./...",
+			},
+		},
+	},
+}`
+
+const makefile = `GOFLAGS := -v
+.PHONY: build test
+
+build: deps
+	go build ./...
+
+test:
+	go test ./...
+
+%.o: %.c
+	cc -c $<
+`
+
+const packageJson = `{
+	"scripts": {
+		"dev": "vite",
+		"lint": "eslint ."
+	}
+}`
+
+func TestDiscover(t *testing.T) {
+	projectDir := t.TempDir()
+
+	require.Nil(t, os.MkdirAll(filepath.Join(projectDir, ".devcontainer"), 0755))
+	require.Nil(t, os.WriteFile(filepath.Join(projectDir, ".devcontainer", "devcontainer.json"), []byte(devcontainerConfig), 0644))
+	require.Nil(t, os.WriteFile(filepath.Join(projectDir, "Makefile"), []byte(makefile), 0644))
+	require.Nil(t, os.WriteFile(filepath.Join(projectDir, "package.json"), []byte(packageJson), 0644))
+
+	discovered, err := tasks.Discover(projectDir)
+	require.Nil(t, err)
+
+	require.Equal(t, []tasks.Task{
+		{Name: "test", Command: "go test ./...", Source: tasks.TaskSourceDevcontainer},
+		{Name: "build", Command: "make build", Source: tasks.TaskSourceMake},
+		{Name: "make:test", Command: "make test", Source: tasks.TaskSourceMake},
+		{Name: "dev", Command: "npm run 'dev'", Source: tasks.TaskSourceNpm},
+		{Name: "lint", Command: "npm run 'lint'", Source: tasks.TaskSourceNpm},
+	}, discovered)
+
+	task, err := tasks.Find(discovered, "make:test")
+	require.Nil(t, err)
+	require.Equal(t, "make test", task.Command)
+
+	_, err = tasks.Find(discovered, "deploy")
+	require.Equal(t, tasks.ErrTaskNotFound, err)
+}
+
+func TestDiscoverEmptyProject(t *testing.T) {
+	discovered, err := tasks.Discover(t.TempDir())
+	require.Nil(t, err)
+	require.Empty(t, discovered)
+}
@@ -26,5 +26,11 @@ type Agent struct {
 	Tailscale              TailscaleServer
 	LogWriter              io.Writer
 	PostCreateLockFilePath string
-	startTime              time.Time
+	// Git credentials are served to projects over this unix socket if set
+	GitCredentialSocketPath string
+	GitCredentialHelperPath string
+	startTime               time.Time
+	lastCpuSample           *cpuSample
+	// Detected once after the repository is cloned
+	language string
 }
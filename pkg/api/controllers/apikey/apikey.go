@@ -7,6 +7,9 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/daytonaio/daytona/pkg/api/controllers"
+	"github.com/daytonaio/daytona/pkg/api/middlewares"
+	"github.com/daytonaio/daytona/pkg/apikey"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
 )
@@ -17,20 +20,36 @@ import (
 //	@Summary		List API keys
 //	@Description	List API keys
 //	@Produce		json
-//	@Success		200	{array}	ApiKey
+//	@Param			page	query		int	false	"Page number, starting at 1"
+//	@Param			perPage	query		int	false	"Number of items per page. All items are returned if not set"
+//	@Success		200		{object}	controllers.PaginatedList[ApiKey]
 //	@Router			/apikey [get]
 //
 //	@id				ListClientApiKeys
 func ListClientApiKeys(ctx *gin.Context) {
 	server := server.GetInstance(nil)
 
-	response, err := server.ApiKeyService.ListClientKeys()
+	keys, err := server.ApiKeyService.ListClientKeys()
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get client API keys: %s", err.Error()))
 		return
 	}
 
-	ctx.JSON(200, response)
+	// Users only see their own keys
+	response := []*apikey.ApiKey{}
+	for _, key := range keys {
+		if middlewares.CanAccess(ctx, key.UserId) {
+			response = append(response, key)
+		}
+	}
+
+	list, err := controllers.Paginate(ctx, response)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	ctx.JSON(200, list)
 }
 
 // RevokeApiKey		godoc
@@ -48,9 +67,14 @@ func RevokeApiKey(ctx *gin.Context) {
 
 	server := server.GetInstance(nil)
 
-	err := server.ApiKeyService.Revoke(apiKeyName)
+	// Users can only revoke their own keys
+	err := server.ApiKeyService.RevokeClientKey(middlewares.GetOwnerId(ctx), apiKeyName)
 	if err != nil {
-		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to revoke api key: %s", err.Error()))
+		statusCode := http.StatusInternalServerError
+		if apikey.IsApiKeyNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to revoke api key: %s", err.Error()))
 		return
 	}
 
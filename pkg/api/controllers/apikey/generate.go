@@ -4,11 +4,14 @@
 package apikey
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
+	"github.com/daytonaio/daytona/pkg/api/middlewares"
 	"github.com/daytonaio/daytona/pkg/apikey"
 	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/apikeys"
 	"github.com/gin-gonic/gin"
 )
 
@@ -28,9 +31,21 @@ func GenerateApiKey(ctx *gin.Context) {
 
 	server := server.GetInstance(nil)
 
-	response, err := server.ApiKeyService.Generate(apikey.ApiKeyTypeClient, apiKeyName)
+	var response string
+	var err error
+
+	// Keys generated by a user authenticate as the same user
+	if u := middlewares.GetAuthenticatedUser(ctx); u != nil {
+		response, err = server.ApiKeyService.GenerateUserKey(u.Id, apiKeyName)
+	} else {
+		response, err = server.ApiKeyService.Generate(apikey.ApiKeyTypeClient, apiKeyName)
+	}
 	if err != nil {
-		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get API keys: %s", err.Error()))
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, apikeys.ErrApiKeyAlreadyExists) {
+			statusCode = http.StatusConflict
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to get API keys: %s", err.Error()))
 		return
 	}
 
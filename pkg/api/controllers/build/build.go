@@ -0,0 +1,115 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers"
+	"github.com/daytonaio/daytona/pkg/api/middlewares"
+	"github.com/daytonaio/daytona/pkg/builder"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/builds"
+	"github.com/daytonaio/daytona/pkg/server/builds/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// CreateBuild 			godoc
+//
+//	@Tags			build
+//	@Summary		Create a build
+//	@Description	Create a one-off build of a repository
+//	@Param			build	body	CreateBuildRequest	true	"Create build"
+//	@Produce		json
+//	@Success		200	{object}	Build
+//	@Router			/build [post]
+//
+//	@id				CreateBuild
+func CreateBuild(ctx *gin.Context) {
+	var createBuildReq dto.CreateBuildRequest
+	err := ctx.BindJSON(&createBuildReq)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %s", err.Error()))
+		return
+	}
+
+	createBuildReq.OwnerId = middlewares.GetOwnerId(ctx)
+
+	server := server.GetInstance(nil)
+
+	b, err := server.BuildService.Create(createBuildReq)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to create build: %s", err.Error()))
+		return
+	}
+
+	ctx.JSON(200, b)
+}
+
+// GetBuild 			godoc
+//
+//	@Tags			build
+//	@Summary		Get build
+//	@Description	Get build
+//	@Produce		json
+//	@Param			buildId	path		string	true	"Build ID"
+//	@Success		200		{object}	Build
+//	@Router			/build/{buildId} [get]
+//
+//	@id				GetBuild
+func GetBuild(ctx *gin.Context) {
+	buildId := ctx.Param("buildId")
+
+	server := server.GetInstance(nil)
+
+	b, err := server.BuildService.Find(buildId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if builds.IsBuildNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to get build: %s", err.Error()))
+		return
+	}
+
+	ctx.JSON(200, b)
+}
+
+// ListBuilds 			godoc
+//
+//	@Tags			build
+//	@Summary		List builds
+//	@Description	List builds
+//	@Produce		json
+//	@Param			page	query		int	false	"Page number, starting at 1"
+//	@Param			perPage	query		int	false	"Number of items per page. All items are returned if not set"
+//	@Success		200		{object}	controllers.PaginatedList[Build]
+//	@Router			/build [get]
+//
+//	@id				ListBuilds
+func ListBuilds(ctx *gin.Context) {
+	server := server.GetInstance(nil)
+
+	allBuilds, err := server.BuildService.List()
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list builds: %s", err.Error()))
+		return
+	}
+
+	buildList := []*builder.Build{}
+	for _, b := range allBuilds {
+		if middlewares.CanAccess(ctx, b.OwnerId) {
+			buildList = append(buildList, b)
+		}
+	}
+
+	list, err := controllers.Paginate(ctx, buildList)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	ctx.JSON(200, list)
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 
+	"github.com/daytonaio/daytona/pkg/api/controllers"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
 )
@@ -51,7 +52,9 @@ func GetContainerRegistry(ctx *gin.Context) {
 //	@Summary		List container registries
 //	@Description	List container registries
 //	@Produce		json
-//	@Success		200	{array}	ContainerRegistry
+//	@Param			page	query		int	false	"Page number, starting at 1"
+//	@Param			perPage	query		int	false	"Number of items per page. All items are returned if not set"
+//	@Success		200		{object}	controllers.PaginatedList[ContainerRegistry]
 //	@Router			/container-registry [get]
 //
 //	@id				ListContainerRegistries
@@ -68,5 +71,11 @@ func ListContainerRegistries(ctx *gin.Context) {
 		cr.Password = ""
 	}
 
-	ctx.JSON(200, crs)
+	list, err := controllers.Paginate(ctx, crs)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	ctx.JSON(200, list)
 }
@@ -0,0 +1,78 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ErrorCode string // @name ErrorCode
+
+const (
+	ErrorCodeBadRequest      ErrorCode = "bad_request"
+	ErrorCodeUnauthorized    ErrorCode = "unauthorized"
+	ErrorCodeForbidden       ErrorCode = "forbidden"
+	ErrorCodeNotFound        ErrorCode = "not_found"
+	ErrorCodeConflict        ErrorCode = "conflict"
+	ErrorCodeTooManyRequests ErrorCode = "too_many_requests"
+	ErrorCodeInternal        ErrorCode = "internal_error"
+	// The git provider token must be authorized for the SSO of the organization
+	ErrorCodeSsoAuthorizationRequired ErrorCode = "sso_authorization_required"
+)
+
+type ErrorResponse struct {
+	Code    ErrorCode `json:"code" validate:"required"`
+	Message string    `json:"message" validate:"required"`
+	// Additional errors that occurred while handling the request
+	Details []string `json:"details,omitempty"`
+} // @name ErrorResponse
+
+// NewErrorResponse builds the response for the errors attached to the request.
+// The code can be set explicitly by passing an ErrorCode as the error meta, e.g. ctx.Error(err).SetMeta(ErrorCodeConflict).
+func NewErrorResponse(statusCode int, errs []*gin.Error) ErrorResponse {
+	response := ErrorResponse{
+		Code: GetErrorCode(statusCode),
+	}
+
+	if len(errs) == 0 {
+		response.Message = http.StatusText(statusCode)
+		return response
+	}
+
+	response.Message = errs[0].Err.Error()
+	if code, ok := errs[0].Meta.(ErrorCode); ok {
+		response.Code = code
+	}
+
+	for _, err := range errs[1:] {
+		response.Details = append(response.Details, err.Err.Error())
+	}
+
+	return response
+}
+
+func GetErrorCode(statusCode int) ErrorCode {
+	switch statusCode {
+	case http.StatusBadRequest:
+		return ErrorCodeBadRequest
+	case http.StatusUnauthorized:
+		return ErrorCodeUnauthorized
+	case http.StatusForbidden:
+		return ErrorCodeForbidden
+	case http.StatusNotFound:
+		return ErrorCodeNotFound
+	case http.StatusConflict:
+		return ErrorCodeConflict
+	case http.StatusTooManyRequests:
+		return ErrorCodeTooManyRequests
+	}
+
+	if statusCode >= 400 && statusCode < 500 {
+		return ErrorCodeBadRequest
+	}
+
+	return ErrorCodeInternal
+}
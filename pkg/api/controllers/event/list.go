@@ -0,0 +1,40 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package event
+
+import (
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/events"
+	"github.com/gin-gonic/gin"
+)
+
+// ListEvents godoc
+//
+//	@Tags			event
+//	@Summary		List events
+//	@Description	List the most recent server events
+//	@Produce		json
+//	@Param			page	query		int	false	"Page number, starting at 1"
+//	@Param			perPage	query		int	false	"Number of items per page. All items are returned if not set"
+//	@Success		200		{object}	controllers.PaginatedList[events.Event]
+//	@Router			/event [get]
+//
+//	@id				ListEvents
+func ListEvents(ctx *gin.Context) {
+	server := server.GetInstance(nil)
+
+	eventList := []events.Event{}
+	eventList = append(eventList, server.EventService.List()...)
+
+	list, err := controllers.Paginate(ctx, eventList)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	ctx.JSON(200, list)
+}
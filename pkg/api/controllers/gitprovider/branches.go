@@ -8,8 +8,8 @@ import (
 	"net/http"
 	"net/url"
 
+	"github.com/daytonaio/daytona/pkg/api/controllers"
 	_ "github.com/daytonaio/daytona/pkg/gitprovider"
-	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
 )
 
@@ -18,11 +18,13 @@ import (
 //	@Tags			gitProvider
 //	@Summary		Get Git repository branches
 //	@Description	Get Git repository branches
-//	@Param			gitProviderId	path	string	true	"Git provider"
-//	@Param			namespaceId		path	string	true	"Namespace"
-//	@Param			repositoryId	path	string	true	"Repository"
+//	@Param			gitProviderId	path		string	true	"Git provider"
+//	@Param			namespaceId		path		string	true	"Namespace"
+//	@Param			repositoryId	path		string	true	"Repository"
+//	@Param			page			query		int		false	"Page number, starting at 1"
+//	@Param			perPage			query		int		false	"Number of items per page. All items are returned if not set"
 //	@Produce		json
-//	@Success		200	{array}	GitBranch
+//	@Success		200				{object}	controllers.PaginatedList[gitprovider.GitBranch]
 //	@Router			/gitprovider/{gitProviderId}/{namespaceId}/{repositoryId}/branches [get]
 //
 //	@id				GetRepoBranches
@@ -43,13 +45,19 @@ func GetRepoBranches(ctx *gin.Context) {
 		return
 	}
 
-	server := server.GetInstance(nil)
+	gitProviderService := getGitProviderService(ctx)
 
-	response, err := server.GitProviderService.GetRepoBranches(gitProviderId, namespaceId, repositoryId)
+	response, err := gitProviderService.GetRepoBranches(gitProviderId, namespaceId, repositoryId)
 	if err != nil {
-		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get repo branches: %s", err.Error()))
+		abortWithGitProviderError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to get repo branches: %w", err))
 		return
 	}
 
-	ctx.JSON(200, response)
+	list, err := controllers.Paginate(ctx, response)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	ctx.JSON(200, list)
 }
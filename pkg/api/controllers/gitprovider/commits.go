@@ -0,0 +1,73 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitprovider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/gin-gonic/gin"
+)
+
+const defaultCommitsPerPage = 30
+
+// GetRepoCommits 			godoc
+//
+//	@Tags			gitProvider
+//	@Summary		Get Git repository commits
+//	@Description	Get a page of the Git repository commit history, newest first. Git providers do not report the number of commits,
+//	@Description	so the page only reports whether more commits may follow
+//	@Param			gitProviderId	path		string	true	"Git provider"
+//	@Param			namespaceId		path		string	true	"Namespace"
+//	@Param			repositoryId	path		string	true	"Repository"
+//	@Param			branch			query		string	false	"Branch. Defaults to the default branch of the repository"
+//	@Param			page			query		int		false	"Page number, starting at 1"
+//	@Param			perPage			query		int		false	"Number of commits per page (max 100)"
+//	@Produce		json
+//	@Success		200				{object}	controllers.Page[gitprovider.GitCommit]
+//	@Router			/gitprovider/{gitProviderId}/{namespaceId}/{repositoryId}/commits [get]
+//
+//	@id				GetRepoCommits
+func GetRepoCommits(ctx *gin.Context) {
+	gitProviderId := ctx.Param("gitProviderId")
+	namespaceArg := ctx.Param("namespaceId")
+	repositoryArg := ctx.Param("repositoryId")
+
+	namespaceId, err := url.QueryUnescape(namespaceArg)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to parse namespace: %s", err.Error()))
+		return
+	}
+
+	repositoryId, err := url.QueryUnescape(repositoryArg)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to parse repository: %s", err.Error()))
+		return
+	}
+
+	page, perPage, err := controllers.GetPageParams(ctx, defaultCommitsPerPage)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	gitProviderService := getGitProviderService(ctx)
+
+	response, err := gitProviderService.GetRepoCommits(gitProviderId, namespaceId, repositoryId, ctx.Query("branch"), page, perPage)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, gitprovider.ErrCommitListingNotSupported) {
+			statusCode = http.StatusNotImplemented
+		}
+		abortWithGitProviderError(ctx, statusCode, fmt.Errorf("failed to get repo commits: %w", err))
+		return
+	}
+
+	// The commits are already paginated by the Git provider
+	ctx.JSON(200, controllers.NewPage(response, page, perPage))
+}
@@ -8,7 +8,6 @@ import (
 	"net/http"
 	"net/url"
 
-	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
 )
 
@@ -32,9 +31,9 @@ func GetGitContext(ctx *gin.Context) {
 		return
 	}
 
-	server := server.GetInstance(nil)
+	gitProviderService := getGitProviderService(ctx)
 
-	gitProvider, err := server.GitProviderService.GetGitProviderForUrl(decodedURLParam)
+	gitProvider, err := gitProviderService.GetGitProviderForUrl(decodedURLParam)
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get git provider for url: %s", err.Error()))
 		return
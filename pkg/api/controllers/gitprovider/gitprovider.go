@@ -4,13 +4,17 @@
 package gitprovider
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
 	"net/url"
 
+	"github.com/daytonaio/daytona/pkg/api/controllers"
+	"github.com/daytonaio/daytona/pkg/api/middlewares"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/gitproviders"
 	"github.com/gin-gonic/gin"
 )
 
@@ -20,16 +24,18 @@ import (
 //	@Summary		List Git providers
 //	@Description	List Git providers
 //	@Produce		json
-//	@Success		200	{array}	gitprovider.GitProviderConfig
+//	@Param			page	query		int	false	"Page number, starting at 1"
+//	@Param			perPage	query		int	false	"Number of items per page. All items are returned if not set"
+//	@Success		200		{object}	controllers.PaginatedList[gitprovider.GitProviderConfig]
 //	@Router			/gitprovider [get]
 //
 //	@id				ListGitProviders
 func ListGitProviders(ctx *gin.Context) {
 	var response []*gitprovider.GitProviderConfig
 
-	server := server.GetInstance(nil)
+	gitProviderService := getGitProviderService(ctx)
 
-	response, err := server.GitProviderService.ListConfigs()
+	response, err := gitProviderService.ListConfigs()
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list git providers: %s", err.Error()))
 		return
@@ -37,9 +43,19 @@ func ListGitProviders(ctx *gin.Context) {
 
 	for _, provider := range response {
 		provider.Token = ""
+		if provider.GitHubApp != nil {
+			provider.GitHubApp.PrivateKey = ""
+		}
+		provider.CacheStatus = gitProviderService.GetCacheStatus(provider.Id)
 	}
 
-	ctx.JSON(200, response)
+	list, err := controllers.Paginate(ctx, response)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	ctx.JSON(200, list)
 }
 
 // GetGitProviderForUrl 			godoc
@@ -48,8 +64,9 @@ func ListGitProviders(ctx *gin.Context) {
 //	@Summary		Get Git provider
 //	@Description	Get Git provider
 //	@Produce		json
-//	@Param			url	path		string	true	"Url"
-//	@Success		200	{object}	gitprovider.GitProviderConfig
+//	@Param			url					path		string	true	"Url"
+//	@Param			gitProviderConfigId	query		string	false	"Git provider config selected for the project"
+//	@Success		200					{object}	gitprovider.GitProviderConfig
 //	@Router			/gitprovider/for-url/{url} [get]
 //
 //	@id				GetGitProviderForUrl
@@ -62,17 +79,56 @@ func GetGitProviderForUrl(ctx *gin.Context) {
 		return
 	}
 
-	server := server.GetInstance(nil)
+	gitProviderService := getGitProviderService(ctx)
 
-	gitProvider, err := server.GitProviderService.GetConfigForUrl(decodedUrl)
+	gitProvider, err := gitProviderService.ResolveConfig(decodedUrl, ctx.Query("gitProviderConfigId"))
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get git provider for url: %s", err.Error()))
 		return
 	}
 
+	// The installation token is returned instead of the app credentials
+	gitProvider.GitHubApp = nil
+
 	ctx.JSON(200, gitProvider)
 }
 
+// GetGitCredential 			godoc
+//
+//	@Tags			gitProvider
+//	@Summary		Get Git credential
+//	@Description	Get the Git credential for the repository url. GitHub App installation tokens and GitLab project tokens expire on their own
+//	@Produce		json
+//	@Param			url					path		string	true	"Url"
+//	@Param			gitProviderConfigId	query		string	false	"Git provider config selected for the project"
+//	@Success		200					{object}	gitprovider.GitCredential
+//	@Router			/gitprovider/credential/{url} [get]
+//
+//	@id				GetGitCredential
+func GetGitCredential(ctx *gin.Context) {
+	urlParam := ctx.Param("url")
+
+	decodedUrl, err := url.QueryUnescape(urlParam)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to decode query param: %s", err.Error()))
+		return
+	}
+
+	gitProviderService := getGitProviderService(ctx)
+
+	credential, err := gitProviderService.GetGitCredential(decodedUrl, ctx.Query("gitProviderConfigId"))
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if gitprovider.IsGitProviderNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to get git credential for url: %s", err.Error()))
+		return
+	}
+
+	ctx.JSON(200, credential)
+}
+
 // SetGitProvider 			godoc
 //
 //	@Tags			gitProvider
@@ -93,9 +149,12 @@ func SetGitProvider(ctx *gin.Context) {
 		return
 	}
 
-	server := server.GetInstance(nil)
+	// Configs keep their owner when they are updated
+	gitProviderData.OwnerId = middlewares.GetOwnerId(ctx)
+
+	gitProviderService := getGitProviderService(ctx)
 
-	err = server.GitProviderService.SetGitProviderConfig(&gitProviderData)
+	err = gitProviderService.SetGitProviderConfig(&gitProviderData)
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to set git provider: %s", err.Error()))
 		return
@@ -104,6 +163,31 @@ func SetGitProvider(ctx *gin.Context) {
 	ctx.JSON(200, nil)
 }
 
+// SetDefaultGitProvider 			godoc
+//
+//	@Tags			gitProvider
+//	@Summary		Set default Git provider
+//	@Description	Use the Git provider config for repositories of its host when no config is selected for the project or matched by alias
+//	@Param			gitProviderId	path	string	true	"Git provider"
+//	@Produce		json
+//	@Success		200
+//	@Router			/gitprovider/{gitProviderId}/set-default [post]
+//
+//	@id				SetDefaultGitProvider
+func SetDefaultGitProvider(ctx *gin.Context) {
+	gitProviderId := ctx.Param("gitProviderId")
+
+	gitProviderService := getGitProviderService(ctx)
+
+	err := gitProviderService.SetDefaultConfig(gitProviderId)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to set default git provider: %s", err.Error()))
+		return
+	}
+
+	ctx.JSON(200, nil)
+}
+
 // RemoveGitProvider 			godoc
 //
 //	@Tags			gitProvider
@@ -118,9 +202,9 @@ func SetGitProvider(ctx *gin.Context) {
 func RemoveGitProvider(ctx *gin.Context) {
 	gitProviderId := ctx.Param("gitProviderId")
 
-	server := server.GetInstance(nil)
+	gitProviderService := getGitProviderService(ctx)
 
-	err := server.GitProviderService.RemoveGitProvider(gitProviderId)
+	err := gitProviderService.RemoveGitProvider(gitProviderId)
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to remove git provider: %s", err.Error()))
 		return
@@ -128,3 +212,26 @@ func RemoveGitProvider(ctx *gin.Context) {
 
 	ctx.JSON(200, nil)
 }
+
+// Errors of organizations that require SSO authorization of the token are returned with their own error code so that
+// clients can guide the user through the authorization
+func abortWithGitProviderError(ctx *gin.Context, statusCode int, err error) {
+	var ssoErr *gitprovider.SsoAuthorizationRequiredError
+	if errors.As(err, &ssoErr) {
+		ctx.AbortWithError(http.StatusForbidden, ssoErr).SetMeta(controllers.ErrorCodeSsoAuthorizationRequired)
+		return
+	}
+
+	ctx.AbortWithError(statusCode, err)
+}
+
+// Admins manage the configs of all users, other users only see and use their own configs
+func getGitProviderService(ctx *gin.Context) gitproviders.IGitProviderService {
+	server := server.GetInstance(nil)
+
+	if middlewares.IsAdmin(ctx) {
+		return server.GitProviderService
+	}
+
+	return server.GitProviderService.ForOwner(middlewares.GetOwnerId(ctx))
+}
@@ -7,7 +7,8 @@ import (
 	"fmt"
 	"net/http"
 
-	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/api/controllers"
+	_ "github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/gin-gonic/gin"
 )
 
@@ -16,22 +17,30 @@ import (
 //	@Tags			gitProvider
 //	@Summary		Get Git namespaces
 //	@Description	Get Git namespaces
-//	@Param			gitProviderId	path	string	true	"Git provider"
+//	@Param			gitProviderId	path		string	true	"Git provider"
+//	@Param			page			query		int		false	"Page number, starting at 1"
+//	@Param			perPage			query		int		false	"Number of items per page. All items are returned if not set"
 //	@Produce		json
-//	@Success		200	{array}	GitNamespace
+//	@Success		200				{object}	controllers.PaginatedList[gitprovider.GitNamespace]
 //	@Router			/gitprovider/{gitProviderId}/namespaces [get]
 //
 //	@id				GetNamespaces
 func GetNamespaces(ctx *gin.Context) {
 	gitProviderId := ctx.Param("gitProviderId")
 
-	server := server.GetInstance(nil)
+	gitProviderService := getGitProviderService(ctx)
 
-	response, err := server.GitProviderService.GetNamespaces(gitProviderId)
+	response, err := gitProviderService.GetNamespaces(gitProviderId)
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get namespaces: %s", err.Error()))
 		return
 	}
 
-	ctx.JSON(200, response)
+	list, err := controllers.Paginate(ctx, response)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	ctx.JSON(200, list)
 }
@@ -8,7 +8,8 @@ import (
 	"net/http"
 	"net/url"
 
-	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/api/controllers"
+	_ "github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/gin-gonic/gin"
 )
 
@@ -17,11 +18,13 @@ import (
 //	@Tags			gitProvider
 //	@Summary		Get Git repository PRs
 //	@Description	Get Git repository PRs
-//	@Param			gitProviderId	path	string	true	"Git provider"
-//	@Param			namespaceId		path	string	true	"Namespace"
-//	@Param			repositoryId	path	string	true	"Repository"
+//	@Param			gitProviderId	path		string	true	"Git provider"
+//	@Param			namespaceId		path		string	true	"Namespace"
+//	@Param			repositoryId	path		string	true	"Repository"
+//	@Param			page			query		int		false	"Page number, starting at 1"
+//	@Param			perPage			query		int		false	"Number of items per page. All items are returned if not set"
 //	@Produce		json
-//	@Success		200	{array}	GitPullRequest
+//	@Success		200				{object}	controllers.PaginatedList[gitprovider.GitPullRequest]
 //	@Router			/gitprovider/{gitProviderId}/{namespaceId}/{repositoryId}/pull-requests [get]
 //
 //	@id				GetRepoPRs
@@ -42,13 +45,19 @@ func GetRepoPRs(ctx *gin.Context) {
 		return
 	}
 
-	server := server.GetInstance(nil)
+	gitProviderService := getGitProviderService(ctx)
 
-	response, err := server.GitProviderService.GetRepoPRs(gitProviderId, namespaceId, repositoryId)
+	response, err := gitProviderService.GetRepoPRs(gitProviderId, namespaceId, repositoryId)
 	if err != nil {
-		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get repository pull requests: %s", err.Error()))
+		abortWithGitProviderError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to get repository pull requests: %w", err))
 		return
 	}
 
-	ctx.JSON(200, response)
+	list, err := controllers.Paginate(ctx, response)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	ctx.JSON(200, list)
 }
@@ -7,7 +7,8 @@ import (
 	"fmt"
 	"net/http"
 
-	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/api/controllers"
+	_ "github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/gin-gonic/gin"
 )
 
@@ -16,10 +17,12 @@ import (
 //	@Tags			gitProvider
 //	@Summary		Get Git repositories
 //	@Description	Get Git repositories
-//	@Param			gitProviderId	path	string	true	"Git provider"
-//	@Param			namespaceId		path	string	true	"Namespace"
+//	@Param			gitProviderId	path		string	true	"Git provider"
+//	@Param			namespaceId		path		string	true	"Namespace"
+//	@Param			page			query		int		false	"Page number, starting at 1"
+//	@Param			perPage			query		int		false	"Number of items per page. All items are returned if not set"
 //	@Produce		json
-//	@Success		200	{array}	GitRepository
+//	@Success		200				{object}	controllers.PaginatedList[gitprovider.GitRepository]
 //	@Router			/gitprovider/{gitProviderId}/{namespaceId}/repositories [get]
 //
 //	@id				GetRepositories
@@ -27,13 +30,19 @@ func GetRepositories(ctx *gin.Context) {
 	gitProviderId := ctx.Param("gitProviderId")
 	namespaceId := ctx.Param("namespaceId")
 
-	server := server.GetInstance(nil)
+	gitProviderService := getGitProviderService(ctx)
 
-	response, err := server.GitProviderService.GetRepositories(gitProviderId, namespaceId)
+	response, err := gitProviderService.GetRepositories(gitProviderId, namespaceId)
 	if err != nil {
-		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get repositories for url: %s", err.Error()))
+		abortWithGitProviderError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to get repositories for url: %w", err))
 		return
 	}
 
-	ctx.JSON(200, response)
+	list, err := controllers.Paginate(ctx, response)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	ctx.JSON(200, list)
 }
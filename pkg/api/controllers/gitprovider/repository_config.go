@@ -0,0 +1,54 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitprovider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/gin-gonic/gin"
+)
+
+// GetRepositoryConfig 			godoc
+//
+//	@Tags			gitProvider
+//	@Summary		Get repository config
+//	@Description	Get the default workspace settings declared in the .daytona/config.yaml file of a repository
+//	@Produce		json
+//	@Param			gitUrl	path		string	true	"Git URL"
+//	@Success		200		{object}	RepositoryConfig
+//	@Router			/gitprovider/repository-config/{gitUrl} [get]
+//
+//	@id				GetRepositoryConfig
+func GetRepositoryConfig(ctx *gin.Context) {
+	gitUrl := ctx.Param("gitUrl")
+
+	decodedURLParam, err := url.QueryUnescape(gitUrl)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to decode query param: %s", err.Error()))
+		return
+	}
+
+	gitProviderService := getGitProviderService(ctx)
+
+	config, err := gitProviderService.GetRepositoryConfig(decodedURLParam)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, gitprovider.ErrRepoFileNotFound) {
+			statusCode = http.StatusNotFound
+		} else if errors.Is(err, gitprovider.ErrFileReadingNotSupported) {
+			statusCode = http.StatusNotImplemented
+		} else if errors.Is(err, workspace.ErrInvalidRepositoryConfig) {
+			statusCode = http.StatusUnprocessableEntity
+		}
+		abortWithGitProviderError(ctx, statusCode, fmt.Errorf("failed to get repository config: %w", err))
+		return
+	}
+
+	ctx.JSON(200, config)
+}
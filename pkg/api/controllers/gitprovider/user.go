@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"net/http"
 
-	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
 )
 
@@ -25,9 +24,9 @@ import (
 func GetGitUser(ctx *gin.Context) {
 	gitProviderId := ctx.Param("gitProviderId")
 
-	server := server.GetInstance(nil)
+	gitProviderService := getGitProviderService(ctx)
 
-	response, err := server.GitProviderService.GetGitUser(gitProviderId)
+	response, err := gitProviderService.GetGitUser(gitProviderId)
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get git user: %s", err.Error()))
 		return
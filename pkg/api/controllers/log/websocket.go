@@ -9,6 +9,7 @@ import (
 	"net/http"
 
 	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
@@ -31,10 +32,22 @@ func writeToWs(ws *websocket.Conn, c chan []byte, errChan chan error) {
 	}
 }
 
+// Log entries are filtered on the server with the grep (regular expression), level (minimum severity),
+// since and until (RFC3339 timestamps or durations before now) query parameters
+func getLogFilter(ginCtx *gin.Context) (*logs.LogFilter, error) {
+	return logs.ParseLogFilter(ginCtx.Query("grep"), ginCtx.Query("level"), ginCtx.Query("since"), ginCtx.Query("until"))
+}
+
 func readLog(ginCtx *gin.Context, logReader io.Reader) {
 	followQuery := ginCtx.Query("follow")
 	follow := followQuery == "true"
 
+	filter, err := getLogFilter(ginCtx)
+	if err != nil {
+		ginCtx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
 	ws, err := upgrader.Upgrade(ginCtx.Writer, ginCtx.Request, nil)
 	if err != nil {
 		log.Error(err)
@@ -47,7 +60,7 @@ func readLog(ginCtx *gin.Context, logReader io.Reader) {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	defer cancel()
-	go util.ReadLog(ctx, logReader, follow, msgChannel, errChannel)
+	go util.ReadLog(ctx, logReader, follow, filter, msgChannel, errChannel)
 	go writeToWs(ws, msgChannel, errChannel)
 
 	go func() {
@@ -98,6 +111,12 @@ func readJSONLog(ginCtx *gin.Context, logReader io.Reader) {
 	followQuery := ginCtx.Query("follow")
 	follow := followQuery == "true"
 
+	filter, err := getLogFilter(ginCtx)
+	if err != nil {
+		ginCtx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
 	ws, err := upgrader.Upgrade(ginCtx.Writer, ginCtx.Request, nil)
 	if err != nil {
 		log.Error(err)
@@ -110,7 +129,7 @@ func readJSONLog(ginCtx *gin.Context, logReader io.Reader) {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	defer cancel()
-	go util.ReadJSONLog(ctx, logReader, follow, msgChannel, errChannel)
+	go util.ReadJSONLog(ctx, logReader, follow, filter, msgChannel, errChannel)
 	go writeJSONToWs(ws, msgChannel, errChannel)
 
 	go func() {
@@ -186,3 +205,17 @@ func ReadProjectLog(ginCtx *gin.Context) {
 
 	readJSONLog(ginCtx, projectLogReader)
 }
+
+func ReadBuildLog(ginCtx *gin.Context) {
+	buildId := ginCtx.Param("buildId")
+
+	server := server.GetInstance(nil)
+
+	buildLogReader, err := server.BuildService.GetBuildLogReader(buildId)
+	if err != nil {
+		ginCtx.AbortWithError(http.StatusInternalServerError, err)
+		return
+	}
+
+	readJSONLog(ginCtx, buildLogReader)
+}
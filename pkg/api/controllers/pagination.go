@@ -0,0 +1,115 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Maximum number of items per page of lists that are paginated by an external API
+const MaxPerPage = 100
+
+var (
+	ErrInvalidPagination = errors.New("page and perPage must be positive integers")
+	ErrPerPageTooLarge   = fmt.Errorf("perPage must be at most %d", MaxPerPage)
+)
+
+type PaginatedList[T any] struct {
+	Items   []T `json:"items" validate:"required"`
+	Total   int `json:"total" validate:"required"`
+	Page    int `json:"page" validate:"required"`
+	PerPage int `json:"perPage" validate:"required"`
+} // @name PaginatedList
+
+// Page is a page of a list whose total is not known, e.g. a list paginated by an external API
+type Page[T any] struct {
+	Items   []T `json:"items" validate:"required"`
+	Page    int `json:"page" validate:"required"`
+	PerPage int `json:"perPage" validate:"required"`
+	// Set if the page is full, so the next page may have items
+	HasMore bool `json:"hasMore" validate:"required"`
+} // @name Page
+
+// NewPage returns the page of items returned by an external API for the page and perPage params
+func NewPage[T any](items []T, page, perPage int) *Page[T] {
+	return &Page[T]{
+		Items:   append([]T{}, items...),
+		Page:    page,
+		PerPage: perPage,
+		HasMore: len(items) >= perPage,
+	}
+}
+
+// Paginate returns the page of items requested with the page and perPage query params.
+// All items are returned on a single page if perPage is not set.
+func Paginate[T any](ctx *gin.Context, items []T) (*PaginatedList[T], error) {
+	page, err := getPositiveQueryInt(ctx, "page", 1)
+	if err != nil {
+		return nil, err
+	}
+
+	perPage, err := getPositiveQueryInt(ctx, "perPage", len(items))
+	if err != nil {
+		return nil, err
+	}
+
+	list := &PaginatedList[T]{
+		Items:   []T{},
+		Total:   len(items),
+		Page:    page,
+		PerPage: perPage,
+	}
+
+	start := (page - 1) * perPage
+	if start >= len(items) {
+		return list, nil
+	}
+
+	end := start + perPage
+	if end > len(items) {
+		end = len(items)
+	}
+
+	list.Items = append(list.Items, items[start:end]...)
+
+	return list, nil
+}
+
+// GetPageParams returns the page and perPage query params for lists that are paginated by an external API.
+// perPage is limited to MaxPerPage
+func GetPageParams(ctx *gin.Context, defaultPerPage int) (int, int, error) {
+	page, err := getPositiveQueryInt(ctx, "page", 1)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	perPage, err := getPositiveQueryInt(ctx, "perPage", defaultPerPage)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if perPage > MaxPerPage {
+		return 0, 0, ErrPerPageTooLarge
+	}
+
+	return page, perPage, nil
+}
+
+func getPositiveQueryInt(ctx *gin.Context, key string, defaultValue int) (int, error) {
+	value := ctx.Query(key)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	result, err := strconv.Atoi(value)
+	if err != nil || result < 1 {
+		return 0, ErrInvalidPagination
+	}
+
+	return result, nil
+}
@@ -0,0 +1,106 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestContext(query string) *gin.Context {
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx.Request = httptest.NewRequest("GET", "/?"+query, nil)
+	return ctx
+}
+
+func TestPaginate(t *testing.T) {
+	items := []string{"a", "b", "c", "d", "e"}
+
+	list, err := Paginate(newTestContext(""), items)
+	require.NoError(t, err)
+	require.Equal(t, items, list.Items)
+	require.Equal(t, 5, list.Total)
+	require.Equal(t, 1, list.Page)
+	require.Equal(t, 5, list.PerPage)
+
+	list, err = Paginate(newTestContext("page=2&perPage=2"), items)
+	require.NoError(t, err)
+	require.Equal(t, []string{"c", "d"}, list.Items)
+	require.Equal(t, 5, list.Total)
+
+	list, err = Paginate(newTestContext("page=3&perPage=2"), items)
+	require.NoError(t, err)
+	require.Equal(t, []string{"e"}, list.Items)
+
+	list, err = Paginate(newTestContext("page=4&perPage=2"), items)
+	require.NoError(t, err)
+	require.Empty(t, list.Items)
+	require.NotNil(t, list.Items)
+
+	_, err = Paginate(newTestContext("perPage=0"), items)
+	require.ErrorIs(t, err, ErrInvalidPagination)
+
+	_, err = Paginate(newTestContext("page=abc"), items)
+	require.ErrorIs(t, err, ErrInvalidPagination)
+}
+
+func TestGetPageParams(t *testing.T) {
+	page, perPage, err := GetPageParams(newTestContext(""), 30)
+	require.NoError(t, err)
+	require.Equal(t, 1, page)
+	require.Equal(t, 30, perPage)
+
+	page, perPage, err = GetPageParams(newTestContext("page=3&perPage=100"), 30)
+	require.NoError(t, err)
+	require.Equal(t, 3, page)
+	require.Equal(t, 100, perPage)
+
+	_, _, err = GetPageParams(newTestContext("perPage=101"), 30)
+	require.ErrorIs(t, err, ErrPerPageTooLarge)
+
+	_, _, err = GetPageParams(newTestContext("page=0"), 30)
+	require.ErrorIs(t, err, ErrInvalidPagination)
+
+	_, _, err = GetPageParams(newTestContext("perPage=-1"), 30)
+	require.ErrorIs(t, err, ErrInvalidPagination)
+}
+
+func TestNewPage(t *testing.T) {
+	page := NewPage([]string{"a", "b"}, 2, 2)
+	require.Equal(t, &Page[string]{Items: []string{"a", "b"}, Page: 2, PerPage: 2, HasMore: true}, page)
+
+	page = NewPage([]string{"a"}, 3, 2)
+	require.Equal(t, []string{"a"}, page.Items)
+	require.False(t, page.HasMore)
+
+	// Empty pages are serialized as an empty list
+	page = NewPage[string](nil, 4, 2)
+	require.NotNil(t, page.Items)
+	require.False(t, page.HasMore)
+
+	content, err := json.Marshal(page)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"items": [], "page": 4, "perPage": 2, "hasMore": false}`, string(content))
+}
+
+func TestNewErrorResponse(t *testing.T) {
+	ctx := newTestContext("")
+	_ = ctx.Error(ErrInvalidPagination)
+	_ = ctx.Error(ErrInvalidPagination)
+
+	response := NewErrorResponse(400, ctx.Errors)
+	require.Equal(t, ErrorCodeBadRequest, response.Code)
+	require.Equal(t, ErrInvalidPagination.Error(), response.Message)
+	require.Len(t, response.Details, 1)
+
+	ctx = newTestContext("")
+	ctx.Error(ErrInvalidPagination).SetMeta(ErrorCodeConflict)
+
+	response = NewErrorResponse(400, ctx.Errors)
+	require.Equal(t, ErrorCodeConflict, response.Code)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/daytonaio/daytona/pkg/api/middlewares"
 	"github.com/daytonaio/daytona/pkg/profiledata"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
@@ -16,7 +17,7 @@ import (
 //
 //	@Tags			profile
 //	@Summary		Get profile data
-//	@Description	Get profile data
+//	@Description	Get the profile data of the user. Workspace and project API keys get the profile data of the workspace owner
 //	@Accept			json
 //	@Success		200 {object} profiledata.ProfileData
 //	@Router			/profile [get]
@@ -24,7 +25,7 @@ import (
 //	@id				GetProfileData
 func GetProfileData(ctx *gin.Context) {
 	server := server.GetInstance(nil)
-	profileData, err := server.ProfileDataService.Get()
+	profileData, err := server.ProfileDataService.Get(middlewares.GetOwnerId(ctx))
 	if err != nil {
 		if profiledata.IsProfileDataNotFound(err) {
 			ctx.JSON(200, &profiledata.ProfileData{})
@@ -41,7 +42,7 @@ func GetProfileData(ctx *gin.Context) {
 //
 //	@Tags			profile
 //	@Summary		Set profile data
-//	@Description	Set profile data
+//	@Description	Set the profile data of the user
 //	@Accept			json
 //	@Param			profileData	body	profiledata.ProfileData	true	"Profile data"
 //	@Success		201
@@ -57,7 +58,7 @@ func SetProfileData(ctx *gin.Context) {
 	}
 
 	server := server.GetInstance(nil)
-	err = server.ProfileDataService.Save(&req)
+	err = server.ProfileDataService.Save(middlewares.GetOwnerId(ctx), &req)
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to save profile data: %s", err.Error()))
 		return
@@ -70,14 +71,14 @@ func SetProfileData(ctx *gin.Context) {
 //
 //	@Tags			profile
 //	@Summary		Delete profile data
-//	@Description	Delete profile data
+//	@Description	Delete the profile data of the user
 //	@Success		204
 //	@Router			/profile [delete]
 //
 //	@id				DeleteProfileData
 func DeleteProfileData(ctx *gin.Context) {
 	server := server.GetInstance(nil)
-	err := server.ProfileDataService.Delete()
+	err := server.ProfileDataService.Delete(middlewares.GetOwnerId(ctx))
 	if err != nil {
 		if profiledata.IsProfileDataNotFound(err) {
 			ctx.Status(204)
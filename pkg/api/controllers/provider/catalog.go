@@ -0,0 +1,79 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers/provider/dto"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/mod/semver"
+)
+
+// GetProviderCatalog godoc
+//
+//	@Tags			provider
+//	@Summary		Get provider catalog
+//	@Description	Get the providers available in the registry and whether the installed ones are outdated
+//	@Produce		json
+//	@Success		200	{array}	ProviderCatalogEntry
+//	@Router			/provider/catalog [get]
+//
+//	@id				GetProviderCatalog
+func GetProviderCatalog(ctx *gin.Context) {
+	server := server.GetInstance(nil)
+
+	manifest, err := server.ProviderManager.GetProvidersManifest()
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get providers manifest: %s", err.Error()))
+		return
+	}
+
+	installedVersions := map[string]string{}
+	for _, provider := range server.ProviderManager.GetProviders() {
+		info, err := provider.GetInfo()
+		if err != nil {
+			ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get provider: %s", err.Error()))
+			return
+		}
+
+		installedVersions[info.Name] = info.Version
+	}
+
+	result := []dto.ProviderCatalogEntry{}
+	for providerName, providerManifest := range *manifest {
+		latestVersion, _ := providerManifest.FindLatestVersion()
+
+		versions := []string{}
+		for version := range providerManifest.Versions {
+			if version == "latest" {
+				continue
+			}
+			versions = append(versions, version)
+		}
+		semver.Sort(versions)
+
+		entry := dto.ProviderCatalogEntry{
+			Name:          providerName,
+			LatestVersion: latestVersion,
+			Versions:      versions,
+		}
+
+		if installedVersion, ok := installedVersions[providerName]; ok {
+			entry.InstalledVersion = &installedVersion
+			entry.Outdated = manifest.HasUpdateAvailable(providerName, installedVersion)
+		}
+
+		result = append(result, entry)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	ctx.JSON(200, result)
+}
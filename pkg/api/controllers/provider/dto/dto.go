@@ -10,9 +10,27 @@ import (
 type Provider struct {
 	Name    string `json:"name"`
 	Version string `json:"version"`
+	// Set if the provider was installed from a file instead of the registry
+	InstalledFromFile bool `json:"installedFromFile"`
 } //	@name	Provider
 
 type InstallProviderRequest struct {
 	Name         string                        `json:"name"`
 	DownloadUrls map[os.OperatingSystem]string `json:"downloadUrls"`
+	Checksums    map[os.OperatingSystem]string `json:"checksums,omitempty"`
 } //	@name	InstallProviderRequest
+
+type InstallProviderFromFileRequest struct {
+	// Path of a provider tarball or binary on the server host
+	Path string `json:"path" validate:"required"`
+	// Path of the manifest describing a provider binary. Tarballs contain their manifest
+	ManifestPath string `json:"manifestPath,omitempty"`
+} //	@name	InstallProviderFromFileRequest
+
+type ProviderCatalogEntry struct {
+	Name             string   `json:"name" validate:"required"`
+	LatestVersion    string   `json:"latestVersion" validate:"required"`
+	Versions         []string `json:"versions" validate:"required"`
+	InstalledVersion *string  `json:"installedVersion,omitempty"`
+	Outdated         bool     `json:"outdated" validate:"required"`
+} //	@name	ProviderCatalogEntry
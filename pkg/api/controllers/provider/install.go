@@ -4,10 +4,12 @@
 package provider
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/daytonaio/daytona/pkg/api/controllers/provider/dto"
+	"github.com/daytonaio/daytona/pkg/provider/manager"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
 )
@@ -40,7 +42,7 @@ func InstallProvider(ctx *gin.Context) {
 		}
 	}
 
-	downloadPath, err := server.ProviderManager.DownloadProvider(req.DownloadUrls, req.Name, true)
+	downloadPath, err := server.ProviderManager.DownloadProvider(req.DownloadUrls, req.Checksums, req.Name, true)
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to download provider: %s", err.Error()))
 		return
@@ -54,3 +56,49 @@ func InstallProvider(ctx *gin.Context) {
 
 	ctx.Status(200)
 }
+
+// InstallProviderFromFile godoc
+//
+//	@Tags			provider
+//	@Summary		Install a provider from a file
+//	@Description	Install a provider from a tarball or binary on the server host, e.g. on servers without internet access
+//	@Accept			json
+//	@Param			provider	body	InstallProviderFromFileRequest	true	"Provider file to install"
+//	@Success		200
+//	@Router			/provider/install/file [post]
+//
+//	@id				InstallProviderFromFile
+func InstallProviderFromFile(ctx *gin.Context) {
+	var req dto.InstallProviderFromFileRequest
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %s", err.Error()))
+		return
+	}
+
+	manifest, err := manager.ReadOfflineManifest(req.Path, req.ManifestPath)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to read provider manifest: %s", err.Error()))
+		return
+	}
+
+	// The current provider is only replaced once the file is verified
+	server := server.GetInstance(nil)
+	pluginPath, err := server.ProviderManager.InstallProviderFromFile(req.Path, *manifest)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, manager.ErrInvalidProviderFile) {
+			statusCode = http.StatusBadRequest
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to install provider: %s", err.Error()))
+		return
+	}
+
+	err = server.ProviderManager.RegisterProvider(pluginPath)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to register provider: %s", err.Error()))
+		return
+	}
+
+	ctx.Status(200)
+}
@@ -7,9 +7,12 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/daytonaio/daytona/pkg/api/controllers"
 	"github.com/daytonaio/daytona/pkg/api/controllers/provider/dto"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
+
+	log "github.com/sirupsen/logrus"
 )
 
 // ListProviders godoc
@@ -18,7 +21,9 @@ import (
 //	@Summary		List providers
 //	@Description	List providers
 //	@Produce		json
-//	@Success		200	{array}	dto.Provider
+//	@Param			page	query		int	false	"Page number, starting at 1"
+//	@Param			perPage	query		int	false	"Number of items per page. All items are returned if not set"
+//	@Success		200		{object}	controllers.PaginatedList[dto.Provider]
 //	@Router			/provider [get]
 //
 //	@id				ListProviders
@@ -34,11 +39,23 @@ func ListProviders(ctx *gin.Context) {
 			return
 		}
 
+		offlineInstall, err := server.ProviderManager.GetOfflineInstall(info.Name)
+		if err != nil {
+			log.Errorf("Failed to read the offline install of provider %s: %s", info.Name, err)
+		}
+
 		result = append(result, dto.Provider{
-			Name:    info.Name,
-			Version: info.Version,
+			Name:              info.Name,
+			Version:           info.Version,
+			InstalledFromFile: offlineInstall != nil,
 		})
 	}
 
-	ctx.JSON(200, result)
+	list, err := controllers.Paginate(ctx, result)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	ctx.JSON(200, list)
 }
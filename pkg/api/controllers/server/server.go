@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/api/middlewares"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
 )
@@ -15,7 +17,7 @@ import (
 //
 //	@Tags			server
 //	@Summary		Get the server configuration
-//	@Description	Get the server configuration
+//	@Description	Get the server configuration. Users other than admins get the configuration without credentials
 //	@Produce		json
 //	@Success		200	{object}	ServerConfig
 //	@Router			/server/config [get]
@@ -28,6 +30,10 @@ func GetConfig(ctx *gin.Context) {
 		return
 	}
 
+	if !middlewares.IsAdmin(ctx) {
+		config = config.Redacted()
+	}
+
 	ctx.JSON(200, config)
 }
 
@@ -64,7 +70,7 @@ func SetConfig(ctx *gin.Context) {
 //
 //	@Tags			server
 //	@Summary		Generate a new authentication key
-//	@Description	Generate a new authentication key
+//	@Description	Generate a new authentication key. Keys of users other than admins only grant access to their own workspaces
 //	@Produce		json
 //	@Success		200	{object}	NetworkKey
 //	@Router			/server/network-key [post]
@@ -73,7 +79,7 @@ func SetConfig(ctx *gin.Context) {
 func GenerateNetworkKey(ctx *gin.Context) {
 	s := server.GetInstance(nil)
 
-	authKey, err := s.TailscaleServer.CreateAuthKey()
+	authKey, err := s.GetClientNetworkKey(middlewares.GetRequestApiKey(ctx), middlewares.GetAuthenticatedUser(ctx))
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to generate network key: %s", err.Error()))
 		return
@@ -81,3 +87,35 @@ func GenerateNetworkKey(ctx *gin.Context) {
 
 	ctx.JSON(200, &server.NetworkKey{Key: authKey})
 }
+
+// GenerateSharedNetworkKey 		godoc
+//
+//	@Tags			share
+//	@Summary		Generate a network key for a shared workspace
+//	@Description	Generate a network key for a shared workspace. The key includes the control server URL
+//	@Produce		json
+//	@Success		200	{object}	NetworkKey
+//	@Router			/share/network-key [post]
+//
+//	@id				GenerateSharedNetworkKey
+func GenerateSharedNetworkKey(ctx *gin.Context) {
+	s := server.GetInstance(nil)
+
+	c, err := server.GetConfig()
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get config: %s", err.Error()))
+		return
+	}
+
+	// Share holders only get access to the projects of the shared workspace
+	authKey, err := s.GetShareNetworkKey(ctx.GetString(middlewares.ShareNameKey))
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to generate network key: %s", err.Error()))
+		return
+	}
+
+	ctx.JSON(200, &server.NetworkKey{
+		Key:        authKey,
+		ControlUrl: util.GetFrpcHeadscaleUrl(c.Frps.Protocol, c.Id, c.Frps.Domain),
+	})
+}
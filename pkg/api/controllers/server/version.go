@@ -0,0 +1,25 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// GetServerVersion 			godoc
+//
+//	@Tags			server
+//	@Summary		Get the server version and capabilities
+//	@Description	Get the server version and capabilities. Does not require authentication
+//	@Produce		json
+//	@Success		200	{object}	ServerVersion
+//	@Router			/server/version [get]
+//
+//	@id				GetServerVersion
+func GetServerVersion(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, server.GetVersionInfo())
+}
@@ -0,0 +1,42 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sessionrecording
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/sessionrecording"
+	"github.com/gin-gonic/gin"
+)
+
+// GetSessionRecordingCast godoc
+//
+//	@Tags			session-recording
+//	@Summary		Get a session recording
+//	@Description	Get the recorded terminal session in the asciicast v2 format
+//	@Produce		plain
+//	@Param			recordingId	path		string	true	"Session recording ID"
+//	@Success		200			{string}	cast
+//	@Router			/session-recording/{recordingId}/cast [get]
+//
+//	@id				GetSessionRecordingCast
+func GetSessionRecordingCast(ctx *gin.Context) {
+	recordingId := ctx.Param("recordingId")
+
+	server := server.GetInstance(nil)
+
+	cast, err := server.SessionRecordingService.GetCast(recordingId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if sessionrecording.IsSessionRecordingNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to get session recording: %s", err.Error()))
+		return
+	}
+
+	ctx.String(200, string(cast))
+}
@@ -0,0 +1,9 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+type UploadSessionRecordingRequest struct {
+	// Recording in the asciicast v2 format
+	Cast string `json:"cast" validate:"required"`
+} //	@name	UploadSessionRecordingRequest
@@ -0,0 +1,46 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sessionrecording
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// ListSessionRecordings godoc
+//
+//	@Tags			session-recording
+//	@Summary		List session recordings
+//	@Description	List the recorded terminal sessions, including those of removed workspaces
+//	@Produce		json
+//	@Param			workspaceId	query		string	false	"Only list the recordings of the workspace"
+//	@Param			page		query		int		false	"Page number, starting at 1"
+//	@Param			perPage		query		int		false	"Number of items per page. All items are returned if not set"
+//	@Success		200			{object}	controllers.PaginatedList[SessionRecording]
+//	@Router			/session-recording [get]
+//
+//	@id				ListSessionRecordings
+func ListSessionRecordings(ctx *gin.Context) {
+	workspaceId := ctx.Query("workspaceId")
+
+	server := server.GetInstance(nil)
+
+	recordings, err := server.SessionRecordingService.List(workspaceId)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list session recordings: %s", err.Error()))
+		return
+	}
+
+	list, err := controllers.Paginate(ctx, recordings)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	ctx.JSON(200, list)
+}
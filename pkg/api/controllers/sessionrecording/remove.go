@@ -0,0 +1,41 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sessionrecording
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/sessionrecording"
+	"github.com/gin-gonic/gin"
+)
+
+// RemoveSessionRecording godoc
+//
+//	@Tags			session-recording
+//	@Summary		Remove a session recording
+//	@Description	Remove a session recording
+//	@Param			recordingId	path	string	true	"Session recording ID"
+//	@Success		204
+//	@Router			/session-recording/{recordingId} [delete]
+//
+//	@id				RemoveSessionRecording
+func RemoveSessionRecording(ctx *gin.Context) {
+	recordingId := ctx.Param("recordingId")
+
+	server := server.GetInstance(nil)
+
+	err := server.SessionRecordingService.Delete(recordingId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if sessionrecording.IsSessionRecordingNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to remove session recording: %s", err.Error()))
+		return
+	}
+
+	ctx.Status(204)
+}
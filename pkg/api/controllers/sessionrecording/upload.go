@@ -0,0 +1,58 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sessionrecording
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers/sessionrecording/dto"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/sessionrecordings"
+	"github.com/daytonaio/daytona/pkg/sessionrecording"
+	"github.com/gin-gonic/gin"
+)
+
+// UploadSessionRecording godoc
+//
+//	@Tags			session-recording
+//	@Summary		Upload a session recording
+//	@Description	Upload the recording of a terminal session. Used by the project agents
+//	@Accept			json
+//	@Produce		json
+//	@Param			workspaceId	path		string							true	"Workspace ID"
+//	@Param			projectId	path		string							true	"Project ID"
+//	@Param			recording	body		UploadSessionRecordingRequest	true	"Session recording"
+//	@Success		201			{object}	SessionRecording
+//	@Router			/workspace/{workspaceId}/{projectId}/session-recording [post]
+//
+//	@id				UploadSessionRecording
+func UploadSessionRecording(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	var req dto.UploadSessionRecordingRequest
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %s", err.Error()))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	recording, err := server.SessionRecordingService.Save(workspaceId, projectId, []byte(req.Cast))
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, sessionrecording.ErrInvalidCast) {
+			statusCode = http.StatusBadRequest
+		} else if errors.Is(err, sessionrecordings.ErrCastTooLarge) {
+			statusCode = http.StatusRequestEntityTooLarge
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to save session recording: %s", err.Error()))
+		return
+	}
+
+	ctx.JSON(201, recording)
+}
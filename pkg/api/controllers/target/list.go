@@ -7,6 +7,9 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/daytonaio/daytona/pkg/api/controllers"
+	"github.com/daytonaio/daytona/pkg/api/middlewares"
+	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
 )
@@ -17,18 +20,34 @@ import (
 //	@Summary		List targets
 //	@Description	List targets
 //	@Produce		json
-//	@Success		200	{array}	ProviderTarget
+//	@Param			page	query		int	false	"Page number, starting at 1"
+//	@Param			perPage	query		int	false	"Number of items per page. All items are returned if not set"
+//	@Success		200		{object}	controllers.PaginatedList[ProviderTarget]
 //	@Router			/target [get]
 //
 //	@id				ListTargets
 func ListTargets(ctx *gin.Context) {
 	server := server.GetInstance(nil)
 
-	targets, err := server.ProviderTargetService.List()
+	allTargets, err := server.ProviderTargetService.List()
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list targets: %s", err.Error()))
 		return
 	}
 
-	ctx.JSON(200, targets)
+	// Users see the shared targets and their own
+	targets := []*provider.ProviderTarget{}
+	for _, target := range allTargets {
+		if target.OwnerId == "" || middlewares.CanAccess(ctx, target.OwnerId) {
+			targets = append(targets, target)
+		}
+	}
+
+	list, err := controllers.Paginate(ctx, targets)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	ctx.JSON(200, list)
 }
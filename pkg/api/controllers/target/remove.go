@@ -4,9 +4,11 @@
 package target
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
+	"github.com/daytonaio/daytona/pkg/api/middlewares"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
 )
@@ -32,6 +34,12 @@ func RemoveTarget(ctx *gin.Context) {
 		return
 	}
 
+	// Shared targets are managed by admins
+	if !middlewares.CanAccess(ctx, target.OwnerId) {
+		ctx.AbortWithError(http.StatusForbidden, errors.New("the target is owned by another user"))
+		return
+	}
+
 	err = server.ProviderTargetService.Delete(target)
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to remove target: %s", err.Error()))
@@ -4,9 +4,11 @@
 package target
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
+	"github.com/daytonaio/daytona/pkg/api/middlewares"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/gin-gonic/gin"
@@ -34,10 +36,16 @@ func SetTarget(ctx *gin.Context) {
 
 	target, err := server.ProviderTargetService.Find(req.Name)
 	if err == nil {
+		// Shared targets are managed by admins
+		if !middlewares.CanAccess(ctx, target.OwnerId) {
+			ctx.AbortWithError(http.StatusForbidden, errors.New("the target is owned by another user"))
+			return
+		}
 		target.Options = req.Options
 		target.ProviderInfo = req.ProviderInfo
 	} else {
 		target = &req
+		target.OwnerId = middlewares.GetOwnerId(ctx)
 	}
 
 	err = server.ProviderTargetService.Save(target)
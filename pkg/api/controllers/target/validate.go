@@ -0,0 +1,49 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package target
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// ValidateTarget godoc
+//
+//	@Tags			target
+//	@Summary		Validate a target
+//	@Description	Validate the target options with the target provider
+//	@Produce		json
+//	@Param			target	body	ProviderTarget	true	"Target to validate"
+//	@Success		200		{array}	TargetOptionValidationError
+//	@Router			/target/validate [post]
+//
+//	@id				ValidateTarget
+func ValidateTarget(ctx *gin.Context) {
+	var req provider.ProviderTarget
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %s", err.Error()))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	p, err := server.ProviderManager.GetProvider(req.ProviderInfo.Name)
+	if err != nil {
+		ctx.AbortWithError(http.StatusNotFound, fmt.Errorf("provider not found: %s", err.Error()))
+		return
+	}
+
+	validationErrors, err := (*p).ValidateTargetOptions(req.Options)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to validate target options: %s", err.Error()))
+		return
+	}
+
+	ctx.JSON(200, validationErrors)
+}
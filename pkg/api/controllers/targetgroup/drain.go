@@ -0,0 +1,75 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package targetgroup
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers/targetgroup/dto"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/targetgroups"
+	"github.com/gin-gonic/gin"
+)
+
+// DrainTargetGroup godoc
+//
+//	@Tags			target-group
+//	@Summary		Drain target group members
+//	@Description	Stop placing new workspaces on the target group members. Existing workspaces are kept
+//	@Param			group	path		string					true	"Target group name"
+//	@Param			request	body		DrainTargetGroupRequest	false	"Members to drain"
+//	@Success		200		{object}	TargetGroup
+//	@Router			/target-group/{group}/drain [post]
+//
+//	@id				DrainTargetGroup
+func DrainTargetGroup(ctx *gin.Context) {
+	setDrained(ctx, true)
+}
+
+// UndrainTargetGroup godoc
+//
+//	@Tags			target-group
+//	@Summary		Undrain target group members
+//	@Description	Resume placing new workspaces on the target group members
+//	@Param			group	path		string					true	"Target group name"
+//	@Param			request	body		DrainTargetGroupRequest	false	"Members to undrain"
+//	@Success		200		{object}	TargetGroup
+//	@Router			/target-group/{group}/undrain [post]
+//
+//	@id				UndrainTargetGroup
+func UndrainTargetGroup(ctx *gin.Context) {
+	setDrained(ctx, false)
+}
+
+func setDrained(ctx *gin.Context, drained bool) {
+	groupName := ctx.Param("group")
+
+	var req dto.DrainTargetGroupRequest
+	if ctx.Request.ContentLength > 0 {
+		err := ctx.BindJSON(&req)
+		if err != nil {
+			ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %s", err.Error()))
+			return
+		}
+	}
+
+	server := server.GetInstance(nil)
+
+	group, err := server.TargetGroupService.SetDrained(groupName, req.Targets, drained)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if provider.IsTargetGroupNotFound(err) {
+			statusCode = http.StatusNotFound
+		} else if errors.Is(err, targetgroups.ErrTargetNotInGroup) {
+			statusCode = http.StatusBadRequest
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to update target group: %s", err.Error()))
+		return
+	}
+
+	ctx.JSON(200, group)
+}
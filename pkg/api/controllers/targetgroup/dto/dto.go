@@ -0,0 +1,9 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+type DrainTargetGroupRequest struct {
+	// Members to drain or undrain. All members are updated if not set
+	Targets []string `json:"targets,omitempty"`
+} //	@name	DrainTargetGroupRequest
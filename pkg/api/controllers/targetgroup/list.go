@@ -0,0 +1,43 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package targetgroup
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// ListTargetGroups godoc
+//
+//	@Tags			target-group
+//	@Summary		List target groups
+//	@Description	List target groups with the number of workspaces on each member
+//	@Produce		json
+//	@Param			page	query		int	false	"Page number, starting at 1"
+//	@Param			perPage	query		int	false	"Number of items per page. All items are returned if not set"
+//	@Success		200		{object}	controllers.PaginatedList[TargetGroupDTO]
+//	@Router			/target-group [get]
+//
+//	@id				ListTargetGroups
+func ListTargetGroups(ctx *gin.Context) {
+	server := server.GetInstance(nil)
+
+	groups, err := server.TargetGroupService.List()
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list target groups: %s", err.Error()))
+		return
+	}
+
+	list, err := controllers.Paginate(ctx, groups)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	ctx.JSON(200, list)
+}
@@ -0,0 +1,41 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package targetgroup
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// RemoveTargetGroup godoc
+//
+//	@Tags			target-group
+//	@Summary		Remove a target group
+//	@Description	Remove a target group. The member targets and their workspaces are kept
+//	@Param			group	path	string	true	"Target group name"
+//	@Success		204
+//	@Router			/target-group/{group} [delete]
+//
+//	@id				RemoveTargetGroup
+func RemoveTargetGroup(ctx *gin.Context) {
+	groupName := ctx.Param("group")
+
+	server := server.GetInstance(nil)
+
+	err := server.TargetGroupService.Delete(groupName)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if provider.IsTargetGroupNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to remove target group: %s", err.Error()))
+		return
+	}
+
+	ctx.Status(204)
+}
@@ -0,0 +1,42 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package targetgroup
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// SetTargetGroup godoc
+//
+//	@Tags			target-group
+//	@Summary		Set a target group
+//	@Description	Create or replace a target group. Members that are kept stay drained
+//	@Param			targetGroup	body	TargetGroup	true	"Target group to set"
+//	@Success		201
+//	@Router			/target-group [put]
+//
+//	@id				SetTargetGroup
+func SetTargetGroup(ctx *gin.Context) {
+	var req provider.TargetGroup
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %s", err.Error()))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	err = server.TargetGroupService.Save(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("failed to set target group: %s", err.Error()))
+		return
+	}
+
+	ctx.Status(201)
+}
@@ -0,0 +1,18 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import "github.com/daytonaio/daytona/pkg/user"
+
+type CreateUserRequest struct {
+	Name string `json:"name" validate:"required"`
+	// Defaults to member if not set
+	Role *user.Role `json:"role,omitempty"`
+} //	@name	CreateUserRequest
+
+type CreateUserResponse struct {
+	User user.User `json:"user" validate:"required"`
+	// First API key of the user. It is only returned once
+	ApiKey string `json:"apiKey" validate:"required"`
+} //	@name	CreateUserResponse
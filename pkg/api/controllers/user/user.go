@@ -0,0 +1,137 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package user
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers"
+	"github.com/daytonaio/daytona/pkg/api/controllers/user/dto"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/users"
+	"github.com/daytonaio/daytona/pkg/user"
+	"github.com/gin-gonic/gin"
+)
+
+// ListUsers godoc
+//
+//	@Tags			user
+//	@Summary		List users
+//	@Description	List the users of the server
+//	@Produce		json
+//	@Param			page	query		int	false	"Page number, starting at 1"
+//	@Param			perPage	query		int	false	"Number of items per page. All items are returned if not set"
+//	@Success		200		{object}	controllers.PaginatedList[User]
+//	@Router			/user [get]
+//
+//	@id				ListUsers
+func ListUsers(ctx *gin.Context) {
+	server := server.GetInstance(nil)
+
+	userList, err := server.UserService.List()
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list users: %s", err.Error()))
+		return
+	}
+
+	list, err := controllers.Paginate(ctx, userList)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	ctx.JSON(200, list)
+}
+
+// CreateUser godoc
+//
+//	@Tags			user
+//	@Summary		Create a user
+//	@Description	Create a user and generate its first API key
+//	@Param			user	body	CreateUserRequest	true	"User to create"
+//	@Produce		json
+//	@Success		201	{object}	CreateUserResponse
+//	@Router			/user [post]
+//
+//	@id				CreateUser
+func CreateUser(ctx *gin.Context) {
+	var req dto.CreateUserRequest
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %s", err.Error()))
+		return
+	}
+
+	var role user.Role
+	if req.Role != nil {
+		role = *req.Role
+	}
+
+	server := server.GetInstance(nil)
+
+	u, apiKey, err := server.UserService.Create(req.Name, role)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, users.ErrInvalidUserName) || errors.Is(err, users.ErrInvalidUserRole) {
+			statusCode = http.StatusBadRequest
+		} else if errors.Is(err, users.ErrUserAlreadyExists) {
+			statusCode = http.StatusConflict
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to create user: %s", err.Error()))
+		return
+	}
+
+	ctx.JSON(201, dto.CreateUserResponse{
+		User:   *u,
+		ApiKey: apiKey,
+	})
+}
+
+// DisableUser godoc
+//
+//	@Tags			user
+//	@Summary		Disable a user
+//	@Description	Reject the API keys of the user. The workspaces and other resources of the user are kept
+//	@Param			userName	path		string	true	"User name"
+//	@Success		200			{object}	User
+//	@Router			/user/{userName}/disable [post]
+//
+//	@id				DisableUser
+func DisableUser(ctx *gin.Context) {
+	setDisabled(ctx, true)
+}
+
+// EnableUser godoc
+//
+//	@Tags			user
+//	@Summary		Enable a user
+//	@Description	Accept the API keys of a disabled user again
+//	@Param			userName	path		string	true	"User name"
+//	@Success		200			{object}	User
+//	@Router			/user/{userName}/enable [post]
+//
+//	@id				EnableUser
+func EnableUser(ctx *gin.Context) {
+	setDisabled(ctx, false)
+}
+
+func setDisabled(ctx *gin.Context, disabled bool) {
+	userName := ctx.Param("userName")
+
+	server := server.GetInstance(nil)
+
+	u, err := server.UserService.SetDisabled(userName, disabled)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if user.IsUserNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to update user: %s", err.Error()))
+		return
+	}
+
+	ctx.JSON(200, u)
+}
@@ -0,0 +1,72 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/gin-gonic/gin"
+)
+
+// ArchiveWorkspace 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Archive workspace
+//	@Description	Export the workspace projects to the archive storage and remove them from the target
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/archive [post]
+//
+//	@id				ArchiveWorkspace
+func ArchiveWorkspace(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	server := server.GetInstance(nil)
+
+	err := server.WorkspaceService.ArchiveWorkspace(workspaceId)
+	if err != nil {
+		ctx.AbortWithError(getArchiveErrorStatusCode(err), fmt.Errorf("failed to archive workspace %s: %s", workspaceId, err.Error()))
+		return
+	}
+
+	ctx.Status(200)
+}
+
+// UnarchiveWorkspace 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Unarchive workspace
+//	@Description	Restore the archived workspace projects to the target
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/unarchive [post]
+//
+//	@id				UnarchiveWorkspace
+func UnarchiveWorkspace(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	server := server.GetInstance(nil)
+
+	err := server.WorkspaceService.UnarchiveWorkspace(workspaceId)
+	if err != nil {
+		ctx.AbortWithError(getArchiveErrorStatusCode(err), fmt.Errorf("failed to unarchive workspace %s: %s", workspaceId, err.Error()))
+		return
+	}
+
+	ctx.Status(200)
+}
+
+func getArchiveErrorStatusCode(err error) int {
+	if workspaces.IsWorkspaceNotFound(err) {
+		return http.StatusNotFound
+	} else if workspaces.IsWorkspaceArchived(err) || workspaces.IsWorkspaceNotArchived(err) {
+		return http.StatusConflict
+	} else if workspaces.IsArchiveNotConfigured(err) {
+		return http.StatusPreconditionFailed
+	}
+	return http.StatusInternalServerError
+}
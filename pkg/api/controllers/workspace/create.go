@@ -4,9 +4,11 @@
 package workspace
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
+	"github.com/daytonaio/daytona/pkg/api/middlewares"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
 	"github.com/gin-gonic/gin"
@@ -33,6 +35,17 @@ func CreateWorkspace(ctx *gin.Context) {
 
 	server := server.GetInstance(nil)
 
+	createWorkspaceReq.OwnerId = middlewares.GetOwnerId(ctx)
+
+	// Users can create workspaces on the shared targets and their own. Target group members are picked by admins
+	if createWorkspaceReq.Target != "" && (createWorkspaceReq.TargetGroup == nil || *createWorkspaceReq.TargetGroup == "") {
+		target, err := server.ProviderTargetService.Find(createWorkspaceReq.Target)
+		if err == nil && target.OwnerId != "" && !middlewares.CanAccess(ctx, target.OwnerId) {
+			ctx.AbortWithError(http.StatusForbidden, errors.New("the target is owned by another user"))
+			return
+		}
+	}
+
 	w, err := server.WorkspaceService.CreateWorkspace(createWorkspaceReq)
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to create workspace: %s", err.Error()))
@@ -6,6 +6,24 @@ package dto
 import "github.com/daytonaio/daytona/pkg/workspace"
 
 type SetProjectState struct {
-	Uptime    uint64              `json:"uptime"`
-	GitStatus workspace.GitStatus `json:"gitStatus"`
+	Uptime    uint64                      `json:"uptime"`
+	GitStatus workspace.GitStatus         `json:"gitStatus"`
+	Resources *workspace.ProjectResources `json:"resources,omitempty"`
+	Welcome   string                      `json:"welcome,omitempty"`
+	Language  string                      `json:"language,omitempty"`
 } // @name SetProjectState
+
+type ExtendWorkspace struct {
+	// Duration (e.g. 24h) by which the workspace expiry is extended
+	Duration string `json:"duration" validate:"required"`
+} // @name ExtendWorkspace
+
+type ShareWorkspace struct {
+	// Duration (e.g. 2h) after which the sharing link expires
+	Duration string `json:"duration" validate:"required"`
+} // @name ShareWorkspace
+
+type IssueSshCertificate struct {
+	// Public key in the OpenSSH authorized_keys format
+	PublicKey string `json:"publicKey" validate:"required"`
+} // @name IssueSshCertificate
@@ -0,0 +1,53 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers/workspace/dto"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// ExtendWorkspace 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Extend workspace expiry
+//	@Description	Extend workspace expiry
+//	@Param			workspaceId	path	string			true	"Workspace ID or Name"
+//	@Param			extend		body	ExtendWorkspace	true	"Extend workspace"
+//	@Produce		json
+//	@Success		200	{object}	Workspace
+//	@Router			/workspace/{workspaceId}/extend [post]
+//
+//	@id				ExtendWorkspace
+func ExtendWorkspace(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	var extendWorkspaceDTO dto.ExtendWorkspace
+	err := ctx.BindJSON(&extendWorkspaceDTO)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %s", err.Error()))
+		return
+	}
+
+	duration, err := time.ParseDuration(extendWorkspaceDTO.Duration)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid duration: %s", err.Error()))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	w, err := server.WorkspaceService.ExtendWorkspace(workspaceId, duration)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to extend workspace %s: %s", workspaceId, err.Error()))
+		return
+	}
+
+	ctx.JSON(200, w)
+}
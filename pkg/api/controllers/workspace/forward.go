@@ -0,0 +1,131 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/gin-gonic/gin"
+)
+
+// AddPortForward 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Add port forward
+//	@Description	Persist a port forward of the project. The port is forwarded by the server whenever the project is running
+//	@Param			workspaceId	path	string				true	"Workspace ID or Name"
+//	@Param			projectId	path	string				true	"Project ID"
+//	@Param			portForward	body	ProjectPortForward	true	"Port forward"
+//	@Produce		json
+//	@Success		200	{object}	PortForward
+//	@Router			/workspace/{workspaceId}/{projectId}/forward [post]
+//
+//	@id				AddPortForward
+func AddPortForward(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	var portForward workspace.ProjectPortForward
+	err := ctx.BindJSON(&portForward)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %s", err.Error()))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	result, err := server.WorkspaceService.AddPortForward(workspaceId, projectId, portForward)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) || workspaces.IsProjectNotFound(err) {
+			statusCode = http.StatusNotFound
+		} else if workspaces.IsInvalidPort(err) {
+			statusCode = http.StatusBadRequest
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to add port forward: %s", err.Error()))
+		return
+	}
+
+	ctx.JSON(200, result)
+}
+
+// ListPortForwards 			godoc
+//
+//	@Tags			workspace
+//	@Summary		List port forwards
+//	@Description	List the persisted port forwards of the workspace projects
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Produce		json
+//	@Param			page	query		int	false	"Page number, starting at 1"
+//	@Param			perPage	query		int	false	"Number of items per page. All items are returned if not set"
+//	@Success		200		{object}	controllers.PaginatedList[PortForward]
+//	@Router			/workspace/{workspaceId}/forward [get]
+//
+//	@id				ListPortForwards
+func ListPortForwards(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	server := server.GetInstance(nil)
+
+	portForwards, err := server.WorkspaceService.ListPortForwards(workspaceId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to list port forwards: %s", err.Error()))
+		return
+	}
+
+	list, err := controllers.Paginate(ctx, portForwards)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	ctx.JSON(200, list)
+}
+
+// RemovePortForward 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Remove port forward
+//	@Description	Stop and remove a port forward of the project
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Param			projectId	path	string	true	"Project ID"
+//	@Param			port		path	int		true	"Project port"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/{projectId}/forward/{port} [delete]
+//
+//	@id				RemovePortForward
+func RemovePortForward(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	port, err := strconv.ParseUint(ctx.Param("port"), 10, 16)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid port: %s", err.Error()))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	err = server.WorkspaceService.RemovePortForward(workspaceId, projectId, uint16(port))
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) || workspaces.IsProjectNotFound(err) || workspaces.IsPortForwardNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to remove port forward: %s", err.Error()))
+		return
+	}
+
+	ctx.Status(200)
+}
@@ -0,0 +1,38 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// GenerateProjectNetworkKey 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Generate a project network key
+//	@Description	Generate the key used by the project to join the network of its network mode
+//	@Produce		json
+//	@Param			workspaceId	path		string	true	"Workspace ID or Name"
+//	@Param			projectId	path		string	true	"Project ID"
+//	@Success		200			{object}	NetworkKey
+//	@Router			/workspace/{workspaceId}/{projectId}/network-key [post]
+//
+//	@id				GenerateProjectNetworkKey
+func GenerateProjectNetworkKey(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	server := server.GetInstance(nil)
+
+	networkKey, err := server.GetProjectNetworkKey(workspaceId)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to generate network key: %s", err.Error()))
+		return
+	}
+
+	ctx.JSON(200, networkKey)
+}
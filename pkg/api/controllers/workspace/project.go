@@ -43,6 +43,9 @@ func SetProjectState(ctx *gin.Context) {
 		Uptime:    setProjectStateDTO.Uptime,
 		UpdatedAt: time.Now().Format(time.RFC1123),
 		GitStatus: &setProjectStateDTO.GitStatus,
+		Resources: setProjectStateDTO.Resources,
+		Welcome:   setProjectStateDTO.Welcome,
+		Language:  setProjectStateDTO.Language,
 	})
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to stop workspace %s: %s", workspaceId, err.Error()))
@@ -0,0 +1,52 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// ResetProject 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Reset project
+//	@Description	Re-run the clone and build steps of a project
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Param			projectId	path	string	true	"Project ID"
+//	@Param			hard		query	bool	false	"Wipe the project data before recreating it"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/{projectId}/reset [post]
+//
+//	@id				ResetProject
+func ResetProject(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+	hardQuery := ctx.Query("hard")
+	var err error
+	hard := false
+
+	if hardQuery != "" {
+		hard, err = strconv.ParseBool(hardQuery)
+		if err != nil {
+			ctx.AbortWithError(http.StatusBadRequest, errors.New("invalid value for hard flag"))
+			return
+		}
+	}
+
+	server := server.GetInstance(nil)
+
+	err = server.WorkspaceService.ResetProject(workspaceId, projectId, hard)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to reset project %s: %s", projectId, err.Error()))
+		return
+	}
+
+	ctx.Status(200)
+}
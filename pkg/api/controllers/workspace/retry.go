@@ -0,0 +1,44 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/gin-gonic/gin"
+)
+
+// RetryWorkspaceCreation 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Retry workspace creation
+//	@Description	Resume an interrupted workspace creation from the step that failed
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Produce		json
+//	@Success		200	{object}	Workspace
+//	@Router			/workspace/{workspaceId}/retry-creation [post]
+//
+//	@id				RetryWorkspaceCreation
+func RetryWorkspaceCreation(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	server := server.GetInstance(nil)
+
+	w, err := server.WorkspaceService.RetryWorkspaceCreation(workspaceId)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceNotFound(err) {
+			statusCode = http.StatusNotFound
+		} else if workspaces.IsWorkspaceAlreadyCreated(err) || workspaces.IsWorkspaceBusy(err) {
+			statusCode = http.StatusConflict
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to retry the creation of workspace %s: %s", workspaceId, err.Error()))
+		return
+	}
+
+	ctx.JSON(200, w)
+}
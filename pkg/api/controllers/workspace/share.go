@@ -0,0 +1,207 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers"
+	"github.com/daytonaio/daytona/pkg/api/controllers/workspace/dto"
+	"github.com/daytonaio/daytona/pkg/api/middlewares"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/sshca"
+	"github.com/gin-gonic/gin"
+)
+
+// ShareWorkspace 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Share workspace
+//	@Description	Create an expiring link granting access to the workspace
+//	@Param			workspaceId	path	string			true	"Workspace ID or Name"
+//	@Param			share		body	ShareWorkspace	true	"Share workspace"
+//	@Produce		json
+//	@Success		200	{object}	WorkspaceShare
+//	@Router			/workspace/{workspaceId}/share [post]
+//
+//	@id				ShareWorkspace
+func ShareWorkspace(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	var shareWorkspaceDTO dto.ShareWorkspace
+	err := ctx.BindJSON(&shareWorkspaceDTO)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %s", err.Error()))
+		return
+	}
+
+	duration, err := time.ParseDuration(shareWorkspaceDTO.Duration)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid duration: %s", err.Error()))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	share, err := server.WorkspaceService.ShareWorkspace(workspaceId, duration)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsSshCertificatesRequired(err) {
+			statusCode = http.StatusPreconditionFailed
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to share workspace %s: %s", workspaceId, err.Error()))
+		return
+	}
+
+	ctx.JSON(200, share)
+}
+
+// ListWorkspaceShares 			godoc
+//
+//	@Tags			workspace
+//	@Summary		List workspace shares
+//	@Description	List the active sharing links of the workspace
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Produce		json
+//	@Param			page	query		int	false	"Page number, starting at 1"
+//	@Param			perPage	query		int	false	"Number of items per page. All items are returned if not set"
+//	@Success		200		{object}	controllers.PaginatedList[WorkspaceShare]
+//	@Router			/workspace/{workspaceId}/share [get]
+//
+//	@id				ListWorkspaceShares
+func ListWorkspaceShares(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+
+	server := server.GetInstance(nil)
+
+	shares, err := server.WorkspaceService.ListWorkspaceShares(workspaceId)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list workspace shares: %s", err.Error()))
+		return
+	}
+
+	list, err := controllers.Paginate(ctx, shares)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	ctx.JSON(200, list)
+}
+
+// RevokeWorkspaceShare 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Revoke workspace share
+//	@Description	Revoke a sharing link of the workspace
+//	@Param			workspaceId	path	string	true	"Workspace ID or Name"
+//	@Param			shareName	path	string	true	"Share name"
+//	@Success		200
+//	@Router			/workspace/{workspaceId}/share/{shareName} [delete]
+//
+//	@id				RevokeWorkspaceShare
+func RevokeWorkspaceShare(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	shareName := ctx.Param("shareName")
+
+	server := server.GetInstance(nil)
+
+	err := server.WorkspaceService.RevokeWorkspaceShare(workspaceId, shareName)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsWorkspaceShareNotFound(err) {
+			statusCode = http.StatusNotFound
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to revoke workspace share: %s", err.Error()))
+		return
+	}
+
+	ctx.Status(200)
+}
+
+// GetSharedWorkspace 			godoc
+//
+//	@Tags			share
+//	@Summary		Get shared workspace
+//	@Description	Get the workspace the share key grants access to
+//	@Produce		json
+//	@Success		200	{object}	SharedWorkspace
+//	@Router			/share/workspace [get]
+//
+//	@id				GetSharedWorkspace
+func GetSharedWorkspace(ctx *gin.Context) {
+	workspaceId := ctx.GetString(middlewares.SharedWorkspaceIdKey)
+
+	s := server.GetInstance(nil)
+
+	w, err := s.WorkspaceService.GetSharedWorkspace(workspaceId)
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get shared workspace: %s", err.Error()))
+		return
+	}
+
+	// The target network mode takes precedence over the server network mode
+	if w.NetworkMode == "" {
+		c, err := server.GetConfig()
+		if err != nil {
+			ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get config: %s", err.Error()))
+			return
+		}
+
+		w.NetworkMode = c.NetworkMode
+		if w.NetworkMode == "" {
+			w.NetworkMode = provider.NetworkModeDaytona
+		}
+	}
+
+	ctx.JSON(200, w)
+}
+
+// IssueSharedSshCertificate 			godoc
+//
+//	@Tags			share
+//	@Summary		Issue SSH certificate for a shared project
+//	@Description	Issue an SSH user certificate accepted by the project agent. The certificate expires with the share at the latest
+//	@Param			projectId	path	string				true	"Project ID"
+//	@Param			request		body	IssueSshCertificate	true	"Public key to sign"
+//	@Produce		json
+//	@Success		200	{object}	SshCertificate
+//	@Router			/share/project/{projectId}/ssh-certificate [post]
+//
+//	@id				IssueSharedSshCertificate
+func IssueSharedSshCertificate(ctx *gin.Context) {
+	workspaceId := ctx.GetString(middlewares.SharedWorkspaceIdKey)
+	shareName := ctx.GetString(middlewares.ShareNameKey)
+	projectId := ctx.Param("projectId")
+
+	var req dto.IssueSshCertificate
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %s", err.Error()))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	cert, err := server.WorkspaceService.IssueSharedSshCertificate(workspaceId, shareName, projectId, req.PublicKey)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsSshCertificatesDisabled(err) {
+			statusCode = http.StatusPreconditionFailed
+		} else if workspaces.IsWorkspaceShareNotFound(err) || workspaces.IsProjectNotFound(err) {
+			statusCode = http.StatusNotFound
+		} else if errors.Is(err, sshca.ErrInvalidPublicKey) {
+			statusCode = http.StatusBadRequest
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to issue SSH certificate: %s", err.Error()))
+		return
+	}
+
+	ctx.JSON(200, cert)
+}
@@ -0,0 +1,59 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers/workspace/dto"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/sshca"
+	"github.com/gin-gonic/gin"
+)
+
+// IssueSshCertificate 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Issue SSH certificate
+//	@Description	Issue a short-lived SSH user certificate accepted by the project agent
+//	@Param			workspaceId	path	string				true	"Workspace ID or Name"
+//	@Param			projectId	path	string				true	"Project ID"
+//	@Param			request		body	IssueSshCertificate	true	"Public key to sign"
+//	@Produce		json
+//	@Success		200	{object}	SshCertificate
+//	@Router			/workspace/{workspaceId}/{projectId}/ssh-certificate [post]
+//
+//	@id				IssueSshCertificate
+func IssueSshCertificate(ctx *gin.Context) {
+	workspaceId := ctx.Param("workspaceId")
+	projectId := ctx.Param("projectId")
+
+	var req dto.IssueSshCertificate
+	err := ctx.BindJSON(&req)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, fmt.Errorf("invalid request body: %s", err.Error()))
+		return
+	}
+
+	server := server.GetInstance(nil)
+
+	cert, err := server.WorkspaceService.IssueSshCertificate(workspaceId, projectId, req.PublicKey)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if workspaces.IsSshCertificatesDisabled(err) {
+			statusCode = http.StatusPreconditionFailed
+		} else if workspaces.IsWorkspaceNotFound(err) || workspaces.IsProjectNotFound(err) {
+			statusCode = http.StatusNotFound
+		} else if errors.Is(err, sshca.ErrInvalidPublicKey) {
+			statusCode = http.StatusBadRequest
+		}
+		ctx.AbortWithError(statusCode, fmt.Errorf("failed to issue SSH certificate: %s", err.Error()))
+		return
+	}
+
+	ctx.JSON(200, cert)
+}
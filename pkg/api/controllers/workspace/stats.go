@@ -0,0 +1,78 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/api/controllers"
+	"github.com/daytonaio/daytona/pkg/api/middlewares"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/gin-gonic/gin"
+)
+
+// ListProjectStats 			godoc
+//
+//	@Tags			workspace
+//	@Summary		List resource usage of running projects
+//	@Description	List resource usage of running projects across all workspaces
+//	@Produce		json
+//	@Param			page	query		int	false	"Page number, starting at 1"
+//	@Param			perPage	query		int	false	"Number of items per page. All items are returned if not set"
+//	@Success		200		{object}	controllers.PaginatedList[ProjectStats]
+//	@Router			/stats/projects [get]
+//
+//	@id				ListProjectStats
+func ListProjectStats(ctx *gin.Context) {
+	server := server.GetInstance(nil)
+
+	allStats, err := server.WorkspaceService.ListProjectStats()
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list project stats: %s", err.Error()))
+		return
+	}
+
+	stats := []dto.ProjectStats{}
+	for _, s := range allStats {
+		ownerId, err := server.WorkspaceService.GetWorkspaceOwner(s.WorkspaceId)
+		if err == nil && middlewares.CanAccess(ctx, ownerId) {
+			stats = append(stats, s)
+		}
+	}
+
+	list, err := controllers.Paginate(ctx, stats)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	ctx.JSON(200, list)
+}
+
+// GetPrebuildStats 			godoc
+//
+//	@Tags			workspace
+//	@Summary		Get prebuild stats
+//	@Description	Get the share of projects in existing workspaces that were created from a prebuilt image
+//	@Produce		json
+//	@Success		200	{object}	PrebuildStats
+//	@Router			/stats/prebuilds [get]
+//
+//	@id				GetPrebuildStats
+func GetPrebuildStats(ctx *gin.Context) {
+	server := server.GetInstance(nil)
+
+	// Users only see the stats of their own workspaces
+	stats, err := server.WorkspaceService.GetPrebuildStats(func(ownerId string) bool {
+		return middlewares.CanAccess(ctx, ownerId)
+	})
+	if err != nil {
+		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to get prebuild stats: %s", err.Error()))
+		return
+	}
+
+	ctx.JSON(200, stats)
+}
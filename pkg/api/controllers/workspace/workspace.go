@@ -9,7 +9,10 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/daytonaio/daytona/pkg/api/controllers"
+	"github.com/daytonaio/daytona/pkg/api/middlewares"
 	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
 	"github.com/gin-gonic/gin"
 )
 
@@ -44,7 +47,9 @@ func GetWorkspace(ctx *gin.Context) {
 //	@Summary		List workspaces
 //	@Description	List workspaces
 //	@Produce		json
-//	@Success		200	{array}	WorkspaceDTO
+//	@Param			page	query		int	false	"Page number, starting at 1"
+//	@Param			perPage	query		int	false	"Number of items per page. All items are returned if not set"
+//	@Success		200		{object}	controllers.PaginatedList[WorkspaceDTO]
 //	@Router			/workspace [get]
 //	@Param			verbose	query	bool	false	"Verbose"
 //
@@ -64,13 +69,26 @@ func ListWorkspaces(ctx *gin.Context) {
 
 	server := server.GetInstance(nil)
 
-	workspaceList, err := server.WorkspaceService.ListWorkspaces(verbose)
+	allWorkspaces, err := server.WorkspaceService.ListWorkspaces(verbose)
 	if err != nil {
 		ctx.AbortWithError(http.StatusInternalServerError, fmt.Errorf("failed to list workspaces: %s", err.Error()))
 		return
 	}
 
-	ctx.JSON(200, workspaceList)
+	workspaceList := []dto.WorkspaceDTO{}
+	for _, w := range allWorkspaces {
+		if middlewares.CanAccess(ctx, w.OwnerId) {
+			workspaceList = append(workspaceList, w)
+		}
+	}
+
+	list, err := controllers.Paginate(ctx, workspaceList)
+	if err != nil {
+		ctx.AbortWithError(http.StatusBadRequest, err)
+		return
+	}
+
+	ctx.JSON(200, list)
 }
 
 // RemoveWorkspace 			godoc
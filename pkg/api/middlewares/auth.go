@@ -7,10 +7,15 @@ import (
 	"errors"
 	"strings"
 
+	"github.com/daytonaio/daytona/pkg/apikey"
 	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/user"
 	"github.com/gin-gonic/gin"
 )
 
+// Context key of the stored record of the API key of the request
+const ApiKeyKey = "apiKey"
+
 func AuthMiddleware() gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		bearerToken := ctx.GetHeader("Authorization")
@@ -29,12 +34,73 @@ func AuthMiddleware() gin.HandlerFunc {
 
 		if !server.ApiKeyService.IsValidApiKey(token) {
 			ctx.AbortWithError(401, errors.New("unauthorized"))
+			return
+		}
+
+		key, err := server.ApiKeyService.GetApiKey(token)
+		if err != nil {
+			ctx.AbortWithError(401, errors.New("unauthorized"))
+			return
+		}
+
+		u, err := getApiKeyUser(server, key)
+		if err != nil {
+			ctx.AbortWithError(401, errors.New("unauthorized"))
+			return
 		}
 
+		if u != nil {
+			if u.Disabled {
+				ctx.AbortWithError(403, errors.New("user is disabled"))
+				return
+			}
+			ctx.Set(AuthenticatedUserKey, u)
+		}
+
+		ctx.Set(ApiKeyKey, key)
+
 		ctx.Next()
 	}
 }
 
+// Returns the stored record of the API key of the request. Returns nil outside of the protected routes
+func GetRequestApiKey(ctx *gin.Context) *apikey.ApiKey {
+	value, ok := ctx.Get(ApiKeyKey)
+	if !ok {
+		return nil
+	}
+
+	key, ok := value.(*apikey.ApiKey)
+	if !ok {
+		return nil
+	}
+
+	return key
+}
+
+// Returns the user the key belongs to. Workspace and project keys act on behalf of the workspace owner.
+// Returns nil if the key or the workspace has no owner
+func getApiKeyUser(server *server.Server, key *apikey.ApiKey) (*user.User, error) {
+	var err error
+	userId := key.UserId
+
+	switch key.Type {
+	case apikey.ApiKeyTypeWorkspace, apikey.ApiKeyTypeProject:
+		// Workspace keys are named after the workspace and project keys after the workspace and the project
+		workspaceId, _, _ := strings.Cut(key.Name, "/")
+		userId, err = server.WorkspaceService.GetWorkspaceOwner(workspaceId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if userId == "" {
+		return nil, nil
+	}
+
+	return server.UserService.Find(userId)
+}
+
 func ExtractToken(bearerToken string) string {
 	if !strings.HasPrefix(bearerToken, "Bearer ") {
 		return ""
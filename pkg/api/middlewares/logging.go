@@ -6,6 +6,7 @@ package middlewares
 import (
 	"time"
 
+	"github.com/daytonaio/daytona/pkg/api/controllers"
 	"github.com/gin-gonic/gin"
 
 	log "github.com/sirupsen/logrus"
@@ -29,7 +30,7 @@ func LoggingMiddleware() gin.HandlerFunc {
 				"latency": latencyTime,
 				"error":   ctx.Errors.String(),
 			}).Error("API ERROR")
-			ctx.JSON(statusCode, gin.H{"error": ctx.Errors[0].Err.Error()})
+			ctx.JSON(statusCode, controllers.NewErrorResponse(statusCode, ctx.Errors))
 		} else {
 			log.WithFields(log.Fields{
 				"method":  reqMethod,
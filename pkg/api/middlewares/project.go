@@ -28,6 +28,7 @@ func ProjectAuthMiddleware() gin.HandlerFunc {
 
 		if !server.ApiKeyService.IsProjectApiKey(token) && !server.ApiKeyService.IsWorkspaceApiKey(token) {
 			ctx.AbortWithError(401, errors.New("unauthorized"))
+			return
 		}
 
 		ctx.Next()
@@ -0,0 +1,124 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package middlewares
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/apikeys"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/time/rate"
+)
+
+// Log endpoints stream over long lived websocket connections and are not rate limited
+var rateLimitExemptPrefixes = []string{"/log/"}
+
+// Interval at which the limiters of idle API keys are removed
+const rateLimiterSweepInterval = time.Minute
+
+// RateLimitMiddleware limits the request rate of each API key with a token bucket.
+// Requests over the limit are rejected with 429 Too Many Requests and a Retry-After header.
+func RateLimitMiddleware(requestsPerSecond float64, burst int) gin.HandlerFunc {
+	limiter := newRateLimiter(requestsPerSecond, burst, time.Now)
+
+	return func(ctx *gin.Context) {
+		for _, prefix := range rateLimitExemptPrefixes {
+			if strings.HasPrefix(ctx.FullPath(), prefix) {
+				ctx.Next()
+				return
+			}
+		}
+
+		token := ExtractToken(ctx.GetHeader("Authorization"))
+		if token == "" {
+			ctx.Next()
+			return
+		}
+
+		delay := limiter.reserve(token)
+		if delay > 0 {
+			ctx.Header("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+			ctx.AbortWithError(http.StatusTooManyRequests, errors.New("rate limit exceeded"))
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+type keyLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// rateLimiter holds a token bucket per API key. The keys are stored hashed
+type rateLimiter struct {
+	limit rate.Limit
+	burst int
+	// Time after which the bucket of an idle key is full again, so removing its limiter does not change the limit
+	idleTimeout time.Duration
+	limiters    map[string]*keyLimiter
+	lastSweep   time.Time
+	now         func() time.Time
+	mutex       sync.Mutex
+}
+
+func newRateLimiter(requestsPerSecond float64, burst int, now func() time.Time) *rateLimiter {
+	if burst < 1 {
+		burst = int(math.Max(1, math.Ceil(requestsPerSecond)))
+	}
+
+	return &rateLimiter{
+		limit:       rate.Limit(requestsPerSecond),
+		burst:       burst,
+		idleTimeout: time.Duration(math.Ceil(float64(burst) / requestsPerSecond * float64(time.Second))),
+		limiters:    map[string]*keyLimiter{},
+		lastSweep:   now(),
+		now:         now,
+	}
+}
+
+// Returns the time to wait before the next request of the token is allowed. The request is counted if there is no wait
+func (l *rateLimiter) reserve(token string) time.Duration {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	now := l.now()
+	if now.Sub(l.lastSweep) >= rateLimiterSweepInterval {
+		l.sweep(now)
+	}
+
+	keyHash := apikeys.HashKey(token)
+
+	limiter, ok := l.limiters[keyHash]
+	if !ok {
+		limiter = &keyLimiter{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.limiters[keyHash] = limiter
+	}
+	limiter.lastSeen = now
+
+	reservation := limiter.limiter.ReserveN(now, 1)
+	delay := reservation.DelayFrom(now)
+	if delay > 0 {
+		reservation.CancelAt(now)
+	}
+
+	return delay
+}
+
+func (l *rateLimiter) sweep(now time.Time) {
+	for keyHash, limiter := range l.limiters {
+		if now.Sub(limiter.lastSeen) > l.idleTimeout {
+			delete(l.limiters, keyHash)
+		}
+	}
+
+	l.lastSweep = now
+}
@@ -0,0 +1,107 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package middlewares_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/api/middlewares"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func newRateLimitedRouter(requestsPerSecond float64, burst int) *gin.Engine {
+	router := gin.New()
+	router.Use(middlewares.RateLimitMiddleware(requestsPerSecond, burst))
+
+	ok := func(ctx *gin.Context) {
+		ctx.Status(http.StatusOK)
+	}
+	router.GET("/workspace", ok)
+	router.GET("/log/workspace/:workspaceId", ok)
+
+	return router
+}
+
+func request(router *gin.Engine, path, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, req)
+
+	return recorder
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	t.Run("rejects requests over the burst", func(t *testing.T) {
+		router := newRateLimitedRouter(0.1, 2)
+
+		require.Equal(t, http.StatusOK, request(router, "/workspace", "token").Code)
+		require.Equal(t, http.StatusOK, request(router, "/workspace", "token").Code)
+
+		recorder := request(router, "/workspace", "token")
+		require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+		// One request is allowed every 10 seconds
+		require.Equal(t, "10", recorder.Header().Get("Retry-After"))
+	})
+
+	t.Run("limits each token separately", func(t *testing.T) {
+		router := newRateLimitedRouter(0.1, 1)
+
+		require.Equal(t, http.StatusOK, request(router, "/workspace", "token-1").Code)
+		require.Equal(t, http.StatusTooManyRequests, request(router, "/workspace", "token-1").Code)
+
+		require.Equal(t, http.StatusOK, request(router, "/workspace", "token-2").Code)
+		require.Equal(t, http.StatusTooManyRequests, request(router, "/workspace", "token-2").Code)
+	})
+
+	t.Run("refills the bucket over time", func(t *testing.T) {
+		router := newRateLimitedRouter(20, 1)
+
+		require.Equal(t, http.StatusOK, request(router, "/workspace", "token").Code)
+
+		recorder := request(router, "/workspace", "token")
+		require.Equal(t, http.StatusTooManyRequests, recorder.Code)
+		// Delays below a second are rounded up
+		require.Equal(t, "1", recorder.Header().Get("Retry-After"))
+
+		time.Sleep(100 * time.Millisecond)
+
+		require.Equal(t, http.StatusOK, request(router, "/workspace", "token").Code)
+	})
+
+	t.Run("does not limit log streams", func(t *testing.T) {
+		router := newRateLimitedRouter(0.1, 1)
+
+		for i := 0; i < 5; i++ {
+			require.Equal(t, http.StatusOK, request(router, "/log/workspace/workspace-1", "token").Code)
+		}
+
+		// Log streams do not use up the bucket of the token
+		require.Equal(t, http.StatusOK, request(router, "/workspace", "token").Code)
+	})
+
+	t.Run("does not limit requests without a token", func(t *testing.T) {
+		router := newRateLimitedRouter(0.1, 1)
+
+		for i := 0; i < 5; i++ {
+			require.Equal(t, http.StatusOK, request(router, "/workspace", "").Code)
+		}
+	})
+
+	t.Run("defaults the burst to the rate", func(t *testing.T) {
+		router := newRateLimitedRouter(2.5, 0)
+
+		for i := 0; i < 3; i++ {
+			require.Equal(t, http.StatusOK, request(router, "/workspace", "token").Code)
+		}
+		require.Equal(t, http.StatusTooManyRequests, request(router, "/workspace", "token").Code)
+	})
+}
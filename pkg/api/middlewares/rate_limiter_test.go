@@ -0,0 +1,46 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package middlewares
+
+import (
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/apikeys"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiterEvictsIdleKeys(t *testing.T) {
+	now := time.Now()
+	// One request every 10 seconds with a burst of 3, so idle buckets are full after 30 seconds
+	limiter := newRateLimiter(0.1, 3, func() time.Time { return now })
+
+	require.Zero(t, limiter.reserve("token-1"))
+	require.Zero(t, limiter.reserve("token-2"))
+
+	// Only the hashes of the keys are stored
+	require.Len(t, limiter.limiters, 2)
+	require.Contains(t, limiter.limiters, apikeys.HashKey("token-1"))
+	require.NotContains(t, limiter.limiters, "token-1")
+
+	now = now.Add(50 * time.Second)
+	require.Zero(t, limiter.reserve("token-1"))
+
+	// token-2 has been idle longer than the time to refill its bucket
+	now = now.Add(15 * time.Second)
+	require.Zero(t, limiter.reserve("token-3"))
+	require.Len(t, limiter.limiters, 2)
+	require.Contains(t, limiter.limiters, apikeys.HashKey("token-1"))
+	require.NotContains(t, limiter.limiters, apikeys.HashKey("token-2"))
+
+	require.Zero(t, limiter.reserve("token-3"))
+	require.Zero(t, limiter.reserve("token-3"))
+	require.Equal(t, 10*time.Second, limiter.reserve("token-3"))
+
+	// Limited keys are removed once their bucket is full again
+	now = now.Add(rateLimiterSweepInterval)
+	require.Zero(t, limiter.reserve("token-1"))
+	require.Len(t, limiter.limiters, 1)
+	require.NotContains(t, limiter.limiters, apikeys.HashKey("token-3"))
+}
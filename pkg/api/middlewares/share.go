@@ -0,0 +1,52 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package middlewares
+
+import (
+	"errors"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/gin-gonic/gin"
+)
+
+// Context key of the workspace the share key grants access to
+const SharedWorkspaceIdKey = "sharedWorkspaceId"
+
+// Context key of the name of the share the key belongs to
+const ShareNameKey = "shareName"
+
+func ShareAuthMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		bearerToken := ctx.GetHeader("Authorization")
+		if bearerToken == "" {
+			ctx.AbortWithError(401, errors.New("unauthorized"))
+			return
+		}
+
+		token := ExtractToken(bearerToken)
+		if token == "" {
+			ctx.AbortWithError(401, errors.New("unauthorized"))
+			return
+		}
+
+		server := server.GetInstance(nil)
+
+		workspaceId, ok := server.ApiKeyService.GetSharedWorkspaceId(token)
+		if !ok {
+			ctx.AbortWithError(401, errors.New("unauthorized"))
+			return
+		}
+
+		key, err := server.ApiKeyService.GetApiKey(token)
+		if err != nil {
+			ctx.AbortWithError(401, errors.New("unauthorized"))
+			return
+		}
+
+		ctx.Set(SharedWorkspaceIdKey, workspaceId)
+		ctx.Set(ShareNameKey, key.Name)
+
+		ctx.Next()
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package middlewares
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/builds"
+	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/user"
+	"github.com/gin-gonic/gin"
+)
+
+// Context key of the user the API key of the request belongs to
+const AuthenticatedUserKey = "authenticatedUser"
+
+// Returns the user the API key of the request belongs to.
+// Returns nil for keys created before users were introduced, which have full access to the server
+func GetAuthenticatedUser(ctx *gin.Context) *user.User {
+	value, ok := ctx.Get(AuthenticatedUserKey)
+	if !ok {
+		return nil
+	}
+
+	u, ok := value.(*user.User)
+	if !ok {
+		return nil
+	}
+
+	return u
+}
+
+// Returns true for requests without a user. Their keys were created before users were introduced, e.g. the key
+// generated on the first server start or the keys of workspaces created before, and keep full access so that
+// existing setups keep working after upgrading. Only AuthMiddleware sets the user, so routes that do not use it
+// must not rely on IsAdmin
+func IsAdmin(ctx *gin.Context) bool {
+	u := GetAuthenticatedUser(ctx)
+	return u == nil || u.IsAdmin()
+}
+
+// Returns the owner ID of the resources created by the request. Empty for keys without a user
+func GetOwnerId(ctx *gin.Context) string {
+	u := GetAuthenticatedUser(ctx)
+	if u == nil {
+		return ""
+	}
+
+	return u.Id
+}
+
+// Admins can access all resources, other users only the resources they own
+func CanAccess(ctx *gin.Context, ownerId string) bool {
+	return IsAdmin(ctx) || ownerId == GetOwnerId(ctx)
+}
+
+func AdminMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if !IsAdmin(ctx) {
+			ctx.AbortWithError(http.StatusForbidden, errors.New("the route is only available to admins"))
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+// Rejects requests to the workspace in the workspaceId path parameter if it is owned by another user
+func WorkspaceOwnerMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		workspaceId := ctx.Param("workspaceId")
+		if workspaceId == "" || IsAdmin(ctx) {
+			ctx.Next()
+			return
+		}
+
+		server := server.GetInstance(nil)
+
+		ownerId, err := server.WorkspaceService.GetWorkspaceOwner(workspaceId)
+		if err != nil {
+			// The handler reports missing workspaces
+			if errors.Is(err, workspaces.ErrWorkspaceNotFound) {
+				ctx.Next()
+				return
+			}
+			ctx.AbortWithError(http.StatusInternalServerError, err)
+			return
+		}
+
+		if !CanAccess(ctx, ownerId) {
+			ctx.AbortWithError(http.StatusForbidden, errors.New("the workspace is owned by another user"))
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+// Rejects requests to the build in the buildId path parameter if it was created by another user
+func BuildOwnerMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		buildId := ctx.Param("buildId")
+		if buildId == "" || IsAdmin(ctx) {
+			ctx.Next()
+			return
+		}
+
+		server := server.GetInstance(nil)
+
+		ownerId, err := server.BuildService.GetBuildOwner(buildId)
+		if err != nil {
+			// The handler reports missing builds
+			if builds.IsBuildNotFound(err) {
+				ctx.Next()
+				return
+			}
+			ctx.AbortWithError(http.StatusInternalServerError, err)
+			return
+		}
+
+		if !CanAccess(ctx, ownerId) {
+			ctx.AbortWithError(http.StatusForbidden, errors.New("the build is owned by another user"))
+			return
+		}
+
+		ctx.Next()
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package middlewares_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/api/middlewares"
+	"github.com/daytonaio/daytona/pkg/user"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func newContext(u *user.User) *gin.Context {
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	if u != nil {
+		ctx.Set(middlewares.AuthenticatedUserKey, u)
+	}
+
+	return ctx
+}
+
+func TestIsAdmin(t *testing.T) {
+	// Keys created before users were introduced have no user and keep full access
+	require.True(t, middlewares.IsAdmin(newContext(nil)))
+
+	require.True(t, middlewares.IsAdmin(newContext(&user.User{Id: "1", Role: user.RoleAdmin})))
+	require.False(t, middlewares.IsAdmin(newContext(&user.User{Id: "2", Role: user.RoleMember})))
+}
+
+func TestCanAccess(t *testing.T) {
+	require.True(t, middlewares.CanAccess(newContext(nil), "2"))
+	require.True(t, middlewares.CanAccess(newContext(&user.User{Id: "1", Role: user.RoleAdmin}), "2"))
+
+	member := newContext(&user.User{Id: "2", Role: user.RoleMember})
+	require.True(t, middlewares.CanAccess(member, "2"))
+	require.False(t, middlewares.CanAccess(member, "1"))
+	require.False(t, middlewares.CanAccess(member, ""))
+}
+
+func TestAdminMiddleware(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		user   *user.User
+		status int
+	}{
+		{name: "without user", status: http.StatusOK},
+		{name: "admin", user: &user.User{Id: "1", Role: user.RoleAdmin}, status: http.StatusOK},
+		{name: "member", user: &user.User{Id: "2", Role: user.RoleMember}, status: http.StatusForbidden},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			router := gin.New()
+			router.GET("/", func(ctx *gin.Context) {
+				if tc.user != nil {
+					ctx.Set(middlewares.AuthenticatedUserKey, tc.user)
+				}
+			}, middlewares.AdminMiddleware(), func(ctx *gin.Context) {
+				ctx.Status(http.StatusOK)
+			})
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			require.Equal(t, tc.status, recorder.Code)
+		})
+	}
+}
@@ -32,13 +32,18 @@ import (
 
 	"github.com/daytonaio/daytona/pkg/api/controllers/apikey"
 	"github.com/daytonaio/daytona/pkg/api/controllers/binary"
+	"github.com/daytonaio/daytona/pkg/api/controllers/build"
 	"github.com/daytonaio/daytona/pkg/api/controllers/containerregistry"
+	"github.com/daytonaio/daytona/pkg/api/controllers/event"
 	"github.com/daytonaio/daytona/pkg/api/controllers/gitprovider"
 	log_controller "github.com/daytonaio/daytona/pkg/api/controllers/log"
 	"github.com/daytonaio/daytona/pkg/api/controllers/profiledata"
 	"github.com/daytonaio/daytona/pkg/api/controllers/provider"
 	"github.com/daytonaio/daytona/pkg/api/controllers/server"
+	"github.com/daytonaio/daytona/pkg/api/controllers/sessionrecording"
 	"github.com/daytonaio/daytona/pkg/api/controllers/target"
+	"github.com/daytonaio/daytona/pkg/api/controllers/targetgroup"
+	"github.com/daytonaio/daytona/pkg/api/controllers/user"
 	"github.com/daytonaio/daytona/pkg/api/controllers/workspace"
 
 	"github.com/gin-gonic/gin"
@@ -51,20 +56,29 @@ import (
 
 type ApiServerConfig struct {
 	ApiPort int
+	// Requests per second allowed for each API key. Rate limiting is disabled if 0
+	RateLimit      float64
+	RateLimitBurst int
 }
 
 const HEALTH_CHECK_ROUTE = "/health"
 
+const VERSION_ROUTE = "/server/version"
+
 func NewApiServer(config ApiServerConfig) *ApiServer {
 	return &ApiServer{
-		apiPort: config.ApiPort,
+		apiPort:        config.ApiPort,
+		rateLimit:      config.RateLimit,
+		rateLimitBurst: config.RateLimitBurst,
 	}
 }
 
 type ApiServer struct {
-	apiPort    int
-	httpServer *http.Server
-	router     *gin.Engine
+	apiPort        int
+	rateLimit      float64
+	rateLimitBurst int
+	httpServer     *http.Server
+	router         *gin.Engine
 }
 
 func (a *ApiServer) Start() error {
@@ -94,14 +108,18 @@ func (a *ApiServer) Start() error {
 	public.GET(HEALTH_CHECK_ROUTE, func(c *gin.Context) {
 		c.JSON(http.StatusOK, gin.H{"status": "ok"})
 	})
+	public.GET(VERSION_ROUTE, server.GetServerVersion)
 
 	protected := a.router.Group("/")
 	protected.Use(middlewares.AuthMiddleware())
+	if a.rateLimit > 0 {
+		protected.Use(middlewares.RateLimitMiddleware(a.rateLimit, a.rateLimitBurst))
+	}
 
 	serverController := protected.Group("/server")
 	{
 		serverController.GET("/config", server.GetConfig)
-		serverController.POST("/config", server.SetConfig)
+		serverController.POST("/config", middlewares.AdminMiddleware(), server.SetConfig)
 		serverController.POST("/network-key", server.GenerateNetworkKey)
 	}
 
@@ -112,26 +130,56 @@ func (a *ApiServer) Start() error {
 	}
 
 	workspaceController := protected.Group("/workspace")
+	workspaceController.Use(middlewares.WorkspaceOwnerMiddleware())
 	{
 		workspaceController.GET("/:workspaceId", workspace.GetWorkspace)
 		workspaceController.GET("/", workspace.ListWorkspaces)
 		workspaceController.POST("/", workspace.CreateWorkspace)
 		workspaceController.POST("/:workspaceId/start", workspace.StartWorkspace)
 		workspaceController.POST("/:workspaceId/stop", workspace.StopWorkspace)
+		workspaceController.POST("/:workspaceId/retry-creation", workspace.RetryWorkspaceCreation)
+		workspaceController.POST("/:workspaceId/extend", workspace.ExtendWorkspace)
+		workspaceController.POST("/:workspaceId/archive", workspace.ArchiveWorkspace)
+		workspaceController.POST("/:workspaceId/unarchive", workspace.UnarchiveWorkspace)
+		workspaceController.GET("/:workspaceId/share", workspace.ListWorkspaceShares)
+		workspaceController.POST("/:workspaceId/share", workspace.ShareWorkspace)
+		workspaceController.DELETE("/:workspaceId/share/:shareName", workspace.RevokeWorkspaceShare)
+		workspaceController.GET("/:workspaceId/forward", workspace.ListPortForwards)
 		workspaceController.DELETE("/:workspaceId", workspace.RemoveWorkspace)
 		workspaceController.POST("/:workspaceId/:projectId/start", workspace.StartProject)
 		workspaceController.POST("/:workspaceId/:projectId/stop", workspace.StopProject)
+		workspaceController.POST("/:workspaceId/:projectId/reset", workspace.ResetProject)
+		workspaceController.POST("/:workspaceId/:projectId/forward", workspace.AddPortForward)
+		workspaceController.DELETE("/:workspaceId/:projectId/forward/:port", workspace.RemovePortForward)
+		workspaceController.POST("/:workspaceId/:projectId/ssh-certificate", workspace.IssueSshCertificate)
+	}
+
+	statsController := protected.Group("/stats")
+	{
+		statsController.GET("/projects", workspace.ListProjectStats)
+		statsController.GET("/prebuilds", workspace.GetPrebuildStats)
+	}
+
+	buildController := protected.Group("/build")
+	buildController.Use(middlewares.BuildOwnerMiddleware())
+	{
+		buildController.GET("/", build.ListBuilds)
+		buildController.GET("/:buildId", build.GetBuild)
+		buildController.POST("/", build.CreateBuild)
 	}
 
 	providerController := protected.Group("/provider")
 	{
-		providerController.POST("/install", provider.InstallProvider)
+		providerController.POST("/install", middlewares.AdminMiddleware(), provider.InstallProvider)
+		providerController.POST("/install/file", middlewares.AdminMiddleware(), provider.InstallProviderFromFile)
 		providerController.GET("/", provider.ListProviders)
-		providerController.POST("/:provider/uninstall", provider.UninstallProvider)
+		providerController.GET("/catalog", provider.GetProviderCatalog)
+		providerController.POST("/:provider/uninstall", middlewares.AdminMiddleware(), provider.UninstallProvider)
 		providerController.GET("/:provider/target-manifest", provider.GetTargetManifest)
 	}
 
 	containerRegistryController := protected.Group("/container-registry")
+	containerRegistryController.Use(middlewares.AdminMiddleware())
 	{
 		containerRegistryController.GET("/", containerregistry.ListContainerRegistries)
 		containerRegistryController.GET("/:server", containerregistry.GetContainerRegistry)
@@ -143,14 +191,33 @@ func (a *ApiServer) Start() error {
 	{
 		targetController.GET("/", target.ListTargets)
 		targetController.PUT("/", target.SetTarget)
+		targetController.POST("/validate", target.ValidateTarget)
 		targetController.DELETE("/:target", target.RemoveTarget)
 	}
 
+	targetGroupController := protected.Group("/target-group")
+	{
+		targetGroupController.GET("/", targetgroup.ListTargetGroups)
+		targetGroupController.PUT("/", middlewares.AdminMiddleware(), targetgroup.SetTargetGroup)
+		targetGroupController.DELETE("/:group", middlewares.AdminMiddleware(), targetgroup.RemoveTargetGroup)
+		targetGroupController.POST("/:group/drain", middlewares.AdminMiddleware(), targetgroup.DrainTargetGroup)
+		targetGroupController.POST("/:group/undrain", middlewares.AdminMiddleware(), targetgroup.UndrainTargetGroup)
+	}
+
+	sessionRecordingController := protected.Group("/session-recording")
+	sessionRecordingController.Use(middlewares.AdminMiddleware())
+	{
+		sessionRecordingController.GET("/", sessionrecording.ListSessionRecordings)
+		sessionRecordingController.GET("/:recordingId/cast", sessionrecording.GetSessionRecordingCast)
+		sessionRecordingController.DELETE("/:recordingId", sessionrecording.RemoveSessionRecording)
+	}
+
 	logController := protected.Group("/log")
 	{
-		logController.GET("/server", log_controller.ReadServerLog)
-		logController.GET("/workspace/:workspaceId", log_controller.ReadWorkspaceLog)
-		logController.GET("/workspace/:workspaceId/:projectName", log_controller.ReadProjectLog)
+		logController.GET("/server", middlewares.AdminMiddleware(), log_controller.ReadServerLog)
+		logController.GET("/workspace/:workspaceId", middlewares.WorkspaceOwnerMiddleware(), log_controller.ReadWorkspaceLog)
+		logController.GET("/workspace/:workspaceId/:projectName", middlewares.WorkspaceOwnerMiddleware(), log_controller.ReadProjectLog)
+		logController.GET("/build/:buildId", middlewares.BuildOwnerMiddleware(), log_controller.ReadBuildLog)
 	}
 
 	gitProviderController := protected.Group("/gitprovider")
@@ -158,12 +225,15 @@ func (a *ApiServer) Start() error {
 		gitProviderController.GET("/", gitprovider.ListGitProviders)
 		gitProviderController.PUT("/", gitprovider.SetGitProvider)
 		gitProviderController.DELETE("/:gitProviderId", gitprovider.RemoveGitProvider)
+		gitProviderController.POST("/:gitProviderId/set-default", gitprovider.SetDefaultGitProvider)
 		gitProviderController.GET("/:gitProviderId/user", gitprovider.GetGitUser)
 		gitProviderController.GET("/:gitProviderId/namespaces", gitprovider.GetNamespaces)
 		gitProviderController.GET("/:gitProviderId/:namespaceId/repositories", gitprovider.GetRepositories)
 		gitProviderController.GET("/:gitProviderId/:namespaceId/:repositoryId/branches", gitprovider.GetRepoBranches)
 		gitProviderController.GET("/:gitProviderId/:namespaceId/:repositoryId/pull-requests", gitprovider.GetRepoPRs)
+		gitProviderController.GET("/:gitProviderId/:namespaceId/:repositoryId/commits", gitprovider.GetRepoCommits)
 		gitProviderController.GET("/context/:gitUrl", gitprovider.GetGitContext)
+		gitProviderController.GET("/repository-config/:gitUrl", gitprovider.GetRepositoryConfig)
 	}
 
 	apiKeyController := protected.Group("/apikey")
@@ -180,11 +250,42 @@ func (a *ApiServer) Start() error {
 		profileDataController.DELETE("/", profiledata.DeleteProfileData)
 	}
 
+	userController := protected.Group("/user")
+	userController.Use(middlewares.AdminMiddleware())
+	{
+		userController.GET("/", user.ListUsers)
+		userController.POST("/", user.CreateUser)
+		userController.POST("/:userName/disable", user.DisableUser)
+		userController.POST("/:userName/enable", user.EnableUser)
+	}
+
+	eventController := protected.Group("/event")
+	eventController.Use(middlewares.AdminMiddleware())
+	{
+		eventController.GET("/", event.ListEvents)
+	}
+
+	// Routes accessible with workspace share keys
+	shareController := a.router.Group("/share")
+	shareController.Use(middlewares.ShareAuthMiddleware())
+	if a.rateLimit > 0 {
+		shareController.Use(middlewares.RateLimitMiddleware(a.rateLimit, a.rateLimitBurst))
+	}
+	{
+		shareController.GET("/workspace", workspace.GetSharedWorkspace)
+		shareController.POST("/network-key", server.GenerateSharedNetworkKey)
+		shareController.POST("/project/:projectId/ssh-certificate", workspace.IssueSharedSshCertificate)
+	}
+
 	projectGroup := protected.Group("/")
 	projectGroup.Use(middlewares.ProjectAuthMiddleware())
+	projectGroup.Use(middlewares.WorkspaceOwnerMiddleware())
 	{
 		projectGroup.POST(workspaceController.BasePath()+"/:workspaceId/:projectId/state", workspace.SetProjectState)
+		projectGroup.POST(workspaceController.BasePath()+"/:workspaceId/:projectId/network-key", workspace.GenerateProjectNetworkKey)
+		projectGroup.POST(workspaceController.BasePath()+"/:workspaceId/:projectId/session-recording", sessionrecording.UploadSessionRecording)
 		projectGroup.GET(gitProviderController.BasePath()+"/for-url/:url", gitprovider.GetGitProviderForUrl)
+		projectGroup.GET(gitProviderController.BasePath()+"/credential/:url", gitprovider.GetGitCredential)
 	}
 
 	a.httpServer = &http.Server{
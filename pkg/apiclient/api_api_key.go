@@ -143,9 +143,23 @@ func (a *ApiKeyAPIService) GenerateApiKeyExecute(r ApiGenerateApiKeyRequest) (st
 type ApiListClientApiKeysRequest struct {
 	ctx        context.Context
 	ApiService *ApiKeyAPIService
+	page       *int32
+	perPage    *int32
 }
 
-func (r ApiListClientApiKeysRequest) Execute() ([]ApiKey, *http.Response, error) {
+// Page number, starting at 1
+func (r ApiListClientApiKeysRequest) Page(page int32) ApiListClientApiKeysRequest {
+	r.page = &page
+	return r
+}
+
+// Number of items per page. All items are returned if not set
+func (r ApiListClientApiKeysRequest) PerPage(perPage int32) ApiListClientApiKeysRequest {
+	r.perPage = &perPage
+	return r
+}
+
+func (r ApiListClientApiKeysRequest) Execute() (*PaginatedListApiKey, *http.Response, error) {
 	return r.ApiService.ListClientApiKeysExecute(r)
 }
 
@@ -166,13 +180,13 @@ func (a *ApiKeyAPIService) ListClientApiKeys(ctx context.Context) ApiListClientA
 
 // Execute executes the request
 //
-//	@return []ApiKey
-func (a *ApiKeyAPIService) ListClientApiKeysExecute(r ApiListClientApiKeysRequest) ([]ApiKey, *http.Response, error) {
+//	@return PaginatedListApiKey
+func (a *ApiKeyAPIService) ListClientApiKeysExecute(r ApiListClientApiKeysRequest) (*PaginatedListApiKey, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue []ApiKey
+		localVarReturnValue *PaginatedListApiKey
 	)
 
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ApiKeyAPIService.ListClientApiKeys")
@@ -186,6 +200,12 @@ func (a *ApiKeyAPIService) ListClientApiKeysExecute(r ApiListClientApiKeysReques
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.page != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "page", r.page, "")
+	}
+	if r.perPage != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "perPage", r.perPage, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
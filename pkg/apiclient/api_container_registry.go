@@ -143,9 +143,23 @@ func (a *ContainerRegistryAPIService) GetContainerRegistryExecute(r ApiGetContai
 type ApiListContainerRegistriesRequest struct {
 	ctx        context.Context
 	ApiService *ContainerRegistryAPIService
+	page       *int32
+	perPage    *int32
 }
 
-func (r ApiListContainerRegistriesRequest) Execute() ([]ContainerRegistry, *http.Response, error) {
+// Page number, starting at 1
+func (r ApiListContainerRegistriesRequest) Page(page int32) ApiListContainerRegistriesRequest {
+	r.page = &page
+	return r
+}
+
+// Number of items per page. All items are returned if not set
+func (r ApiListContainerRegistriesRequest) PerPage(perPage int32) ApiListContainerRegistriesRequest {
+	r.perPage = &perPage
+	return r
+}
+
+func (r ApiListContainerRegistriesRequest) Execute() (*PaginatedListContainerRegistry, *http.Response, error) {
 	return r.ApiService.ListContainerRegistriesExecute(r)
 }
 
@@ -166,13 +180,13 @@ func (a *ContainerRegistryAPIService) ListContainerRegistries(ctx context.Contex
 
 // Execute executes the request
 //
-//	@return []ContainerRegistry
-func (a *ContainerRegistryAPIService) ListContainerRegistriesExecute(r ApiListContainerRegistriesRequest) ([]ContainerRegistry, *http.Response, error) {
+//	@return PaginatedListContainerRegistry
+func (a *ContainerRegistryAPIService) ListContainerRegistriesExecute(r ApiListContainerRegistriesRequest) (*PaginatedListContainerRegistry, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue []ContainerRegistry
+		localVarReturnValue *PaginatedListContainerRegistry
 	)
 
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ContainerRegistryAPIService.ListContainerRegistries")
@@ -186,6 +200,12 @@ func (a *ContainerRegistryAPIService) ListContainerRegistriesExecute(r ApiListCo
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.page != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "page", r.page, "")
+	}
+	if r.perPage != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "perPage", r.perPage, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -140,10 +140,145 @@ func (a *GitProviderAPIService) GetGitContextExecute(r ApiGetGitContextRequest)
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
+type ApiGetGitCredentialRequest struct {
+	ctx                 context.Context
+	ApiService          *GitProviderAPIService
+	url                 string
+	gitProviderConfigId *string
+}
+
+// Git provider config selected for the project
+func (r ApiGetGitCredentialRequest) GitProviderConfigId(gitProviderConfigId string) ApiGetGitCredentialRequest {
+	r.gitProviderConfigId = &gitProviderConfigId
+	return r
+}
+
+func (r ApiGetGitCredentialRequest) Execute() (*GitCredential, *http.Response, error) {
+	return r.ApiService.GetGitCredentialExecute(r)
+}
+
+/*
+GetGitCredential Get Git credential
+
+Get the Git credential for the repository url. GitHub App installation tokens and GitLab project tokens expire on their own
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param url Url
+	@return ApiGetGitCredentialRequest
+*/
+func (a *GitProviderAPIService) GetGitCredential(ctx context.Context, url string) ApiGetGitCredentialRequest {
+	return ApiGetGitCredentialRequest{
+		ApiService: a,
+		ctx:        ctx,
+		url:        url,
+	}
+}
+
+// Execute executes the request
+//
+//	@return GitCredential
+func (a *GitProviderAPIService) GetGitCredentialExecute(r ApiGetGitCredentialRequest) (*GitCredential, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *GitCredential
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GitProviderAPIService.GetGitCredential")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/gitprovider/credential/{url}"
+	localVarPath = strings.Replace(localVarPath, "{"+"url"+"}", url.PathEscape(parameterValueToString(r.url, "url")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.gitProviderConfigId != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "gitProviderConfigId", r.gitProviderConfigId, "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["Bearer"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiGetGitProviderForUrlRequest struct {
-	ctx        context.Context
-	ApiService *GitProviderAPIService
-	url        string
+	ctx                 context.Context
+	ApiService          *GitProviderAPIService
+	url                 string
+	gitProviderConfigId *string
+}
+
+// Git provider config selected for the project
+func (r ApiGetGitProviderForUrlRequest) GitProviderConfigId(gitProviderConfigId string) ApiGetGitProviderForUrlRequest {
+	r.gitProviderConfigId = &gitProviderConfigId
+	return r
 }
 
 func (r ApiGetGitProviderForUrlRequest) Execute() (*GitProvider, *http.Response, error) {
@@ -190,6 +325,9 @@ func (a *GitProviderAPIService) GetGitProviderForUrlExecute(r ApiGetGitProviderF
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.gitProviderConfigId != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "gitProviderConfigId", r.gitProviderConfigId, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -380,9 +518,23 @@ type ApiGetNamespacesRequest struct {
 	ctx           context.Context
 	ApiService    *GitProviderAPIService
 	gitProviderId string
+	page          *int32
+	perPage       *int32
 }
 
-func (r ApiGetNamespacesRequest) Execute() ([]GitNamespace, *http.Response, error) {
+// Page number, starting at 1
+func (r ApiGetNamespacesRequest) Page(page int32) ApiGetNamespacesRequest {
+	r.page = &page
+	return r
+}
+
+// Number of items per page. All items are returned if not set
+func (r ApiGetNamespacesRequest) PerPage(perPage int32) ApiGetNamespacesRequest {
+	r.perPage = &perPage
+	return r
+}
+
+func (r ApiGetNamespacesRequest) Execute() (*PaginatedListGitNamespace, *http.Response, error) {
 	return r.ApiService.GetNamespacesExecute(r)
 }
 
@@ -405,13 +557,13 @@ func (a *GitProviderAPIService) GetNamespaces(ctx context.Context, gitProviderId
 
 // Execute executes the request
 //
-//	@return []GitNamespace
-func (a *GitProviderAPIService) GetNamespacesExecute(r ApiGetNamespacesRequest) ([]GitNamespace, *http.Response, error) {
+//	@return PaginatedListGitNamespace
+func (a *GitProviderAPIService) GetNamespacesExecute(r ApiGetNamespacesRequest) (*PaginatedListGitNamespace, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue []GitNamespace
+		localVarReturnValue *PaginatedListGitNamespace
 	)
 
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GitProviderAPIService.GetNamespaces")
@@ -426,6 +578,12 @@ func (a *GitProviderAPIService) GetNamespacesExecute(r ApiGetNamespacesRequest)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.page != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "page", r.page, "")
+	}
+	if r.perPage != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "perPage", r.perPage, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -500,9 +658,23 @@ type ApiGetRepoBranchesRequest struct {
 	gitProviderId string
 	namespaceId   string
 	repositoryId  string
+	page          *int32
+	perPage       *int32
+}
+
+// Page number, starting at 1
+func (r ApiGetRepoBranchesRequest) Page(page int32) ApiGetRepoBranchesRequest {
+	r.page = &page
+	return r
 }
 
-func (r ApiGetRepoBranchesRequest) Execute() ([]GitBranch, *http.Response, error) {
+// Number of items per page. All items are returned if not set
+func (r ApiGetRepoBranchesRequest) PerPage(perPage int32) ApiGetRepoBranchesRequest {
+	r.perPage = &perPage
+	return r
+}
+
+func (r ApiGetRepoBranchesRequest) Execute() (*PaginatedListGitBranch, *http.Response, error) {
 	return r.ApiService.GetRepoBranchesExecute(r)
 }
 
@@ -529,13 +701,13 @@ func (a *GitProviderAPIService) GetRepoBranches(ctx context.Context, gitProvider
 
 // Execute executes the request
 //
-//	@return []GitBranch
-func (a *GitProviderAPIService) GetRepoBranchesExecute(r ApiGetRepoBranchesRequest) ([]GitBranch, *http.Response, error) {
+//	@return PaginatedListGitBranch
+func (a *GitProviderAPIService) GetRepoBranchesExecute(r ApiGetRepoBranchesRequest) (*PaginatedListGitBranch, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue []GitBranch
+		localVarReturnValue *PaginatedListGitBranch
 	)
 
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GitProviderAPIService.GetRepoBranches")
@@ -552,6 +724,169 @@ func (a *GitProviderAPIService) GetRepoBranchesExecute(r ApiGetRepoBranchesReque
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.page != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "page", r.page, "")
+	}
+	if r.perPage != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "perPage", r.perPage, "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["Bearer"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGetRepoCommitsRequest struct {
+	ctx           context.Context
+	ApiService    *GitProviderAPIService
+	gitProviderId string
+	namespaceId   string
+	repositoryId  string
+	branch        *string
+	page          *int32
+	perPage       *int32
+}
+
+// Branch. Defaults to the default branch of the repository
+func (r ApiGetRepoCommitsRequest) Branch(branch string) ApiGetRepoCommitsRequest {
+	r.branch = &branch
+	return r
+}
+
+// Page number, starting at 1
+func (r ApiGetRepoCommitsRequest) Page(page int32) ApiGetRepoCommitsRequest {
+	r.page = &page
+	return r
+}
+
+// Number of commits per page (max 100)
+func (r ApiGetRepoCommitsRequest) PerPage(perPage int32) ApiGetRepoCommitsRequest {
+	r.perPage = &perPage
+	return r
+}
+
+func (r ApiGetRepoCommitsRequest) Execute() (*PageGitCommit, *http.Response, error) {
+	return r.ApiService.GetRepoCommitsExecute(r)
+}
+
+/*
+GetRepoCommits Get Git repository commits
+
+Get a page of the Git repository commit history, newest first. Git providers do not report the number of commits,
+so the page only reports whether more commits may follow
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param gitProviderId Git provider
+	@param namespaceId Namespace
+	@param repositoryId Repository
+	@return ApiGetRepoCommitsRequest
+*/
+func (a *GitProviderAPIService) GetRepoCommits(ctx context.Context, gitProviderId string, namespaceId string, repositoryId string) ApiGetRepoCommitsRequest {
+	return ApiGetRepoCommitsRequest{
+		ApiService:    a,
+		ctx:           ctx,
+		gitProviderId: gitProviderId,
+		namespaceId:   namespaceId,
+		repositoryId:  repositoryId,
+	}
+}
+
+// Execute executes the request
+//
+//	@return PageGitCommit
+func (a *GitProviderAPIService) GetRepoCommitsExecute(r ApiGetRepoCommitsRequest) (*PageGitCommit, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *PageGitCommit
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GitProviderAPIService.GetRepoCommits")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/gitprovider/{gitProviderId}/{namespaceId}/{repositoryId}/commits"
+	localVarPath = strings.Replace(localVarPath, "{"+"gitProviderId"+"}", url.PathEscape(parameterValueToString(r.gitProviderId, "gitProviderId")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"namespaceId"+"}", url.PathEscape(parameterValueToString(r.namespaceId, "namespaceId")), -1)
+	localVarPath = strings.Replace(localVarPath, "{"+"repositoryId"+"}", url.PathEscape(parameterValueToString(r.repositoryId, "repositoryId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.branch != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "branch", r.branch, "")
+	}
+	if r.page != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "page", r.page, "")
+	}
+	if r.perPage != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "perPage", r.perPage, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -626,9 +961,23 @@ type ApiGetRepoPRsRequest struct {
 	gitProviderId string
 	namespaceId   string
 	repositoryId  string
+	page          *int32
+	perPage       *int32
 }
 
-func (r ApiGetRepoPRsRequest) Execute() ([]GitPullRequest, *http.Response, error) {
+// Page number, starting at 1
+func (r ApiGetRepoPRsRequest) Page(page int32) ApiGetRepoPRsRequest {
+	r.page = &page
+	return r
+}
+
+// Number of items per page. All items are returned if not set
+func (r ApiGetRepoPRsRequest) PerPage(perPage int32) ApiGetRepoPRsRequest {
+	r.perPage = &perPage
+	return r
+}
+
+func (r ApiGetRepoPRsRequest) Execute() (*PaginatedListGitPullRequest, *http.Response, error) {
 	return r.ApiService.GetRepoPRsExecute(r)
 }
 
@@ -655,13 +1004,13 @@ func (a *GitProviderAPIService) GetRepoPRs(ctx context.Context, gitProviderId st
 
 // Execute executes the request
 //
-//	@return []GitPullRequest
-func (a *GitProviderAPIService) GetRepoPRsExecute(r ApiGetRepoPRsRequest) ([]GitPullRequest, *http.Response, error) {
+//	@return PaginatedListGitPullRequest
+func (a *GitProviderAPIService) GetRepoPRsExecute(r ApiGetRepoPRsRequest) (*PaginatedListGitPullRequest, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue []GitPullRequest
+		localVarReturnValue *PaginatedListGitPullRequest
 	)
 
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GitProviderAPIService.GetRepoPRs")
@@ -678,6 +1027,12 @@ func (a *GitProviderAPIService) GetRepoPRsExecute(r ApiGetRepoPRsRequest) ([]Git
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.page != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "page", r.page, "")
+	}
+	if r.perPage != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "perPage", r.perPage, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -751,9 +1106,23 @@ type ApiGetRepositoriesRequest struct {
 	ApiService    *GitProviderAPIService
 	gitProviderId string
 	namespaceId   string
+	page          *int32
+	perPage       *int32
 }
 
-func (r ApiGetRepositoriesRequest) Execute() ([]GitRepository, *http.Response, error) {
+// Page number, starting at 1
+func (r ApiGetRepositoriesRequest) Page(page int32) ApiGetRepositoriesRequest {
+	r.page = &page
+	return r
+}
+
+// Number of items per page. All items are returned if not set
+func (r ApiGetRepositoriesRequest) PerPage(perPage int32) ApiGetRepositoriesRequest {
+	r.perPage = &perPage
+	return r
+}
+
+func (r ApiGetRepositoriesRequest) Execute() (*PaginatedListGitRepository, *http.Response, error) {
 	return r.ApiService.GetRepositoriesExecute(r)
 }
 
@@ -778,13 +1147,13 @@ func (a *GitProviderAPIService) GetRepositories(ctx context.Context, gitProvider
 
 // Execute executes the request
 //
-//	@return []GitRepository
-func (a *GitProviderAPIService) GetRepositoriesExecute(r ApiGetRepositoriesRequest) ([]GitRepository, *http.Response, error) {
+//	@return PaginatedListGitRepository
+func (a *GitProviderAPIService) GetRepositoriesExecute(r ApiGetRepositoriesRequest) (*PaginatedListGitRepository, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue []GitRepository
+		localVarReturnValue *PaginatedListGitRepository
 	)
 
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GitProviderAPIService.GetRepositories")
@@ -800,6 +1169,130 @@ func (a *GitProviderAPIService) GetRepositoriesExecute(r ApiGetRepositoriesReque
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.page != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "page", r.page, "")
+	}
+	if r.perPage != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "perPage", r.perPage, "")
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["Bearer"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type ApiGetRepositoryConfigRequest struct {
+	ctx        context.Context
+	ApiService *GitProviderAPIService
+	gitUrl     string
+}
+
+func (r ApiGetRepositoryConfigRequest) Execute() (*RepositoryConfig, *http.Response, error) {
+	return r.ApiService.GetRepositoryConfigExecute(r)
+}
+
+/*
+GetRepositoryConfig Get repository config
+
+Get the default workspace settings declared in the .daytona/config.yaml file of a repository
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param gitUrl Git URL
+	@return ApiGetRepositoryConfigRequest
+*/
+func (a *GitProviderAPIService) GetRepositoryConfig(ctx context.Context, gitUrl string) ApiGetRepositoryConfigRequest {
+	return ApiGetRepositoryConfigRequest{
+		ApiService: a,
+		ctx:        ctx,
+		gitUrl:     gitUrl,
+	}
+}
+
+// Execute executes the request
+//
+//	@return RepositoryConfig
+func (a *GitProviderAPIService) GetRepositoryConfigExecute(r ApiGetRepositoryConfigRequest) (*RepositoryConfig, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue *RepositoryConfig
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GitProviderAPIService.GetRepositoryConfig")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/gitprovider/repository-config/{gitUrl}"
+	localVarPath = strings.Replace(localVarPath, "{"+"gitUrl"+"}", url.PathEscape(parameterValueToString(r.gitUrl, "gitUrl")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -871,9 +1364,23 @@ func (a *GitProviderAPIService) GetRepositoriesExecute(r ApiGetRepositoriesReque
 type ApiListGitProvidersRequest struct {
 	ctx        context.Context
 	ApiService *GitProviderAPIService
+	page       *int32
+	perPage    *int32
+}
+
+// Page number, starting at 1
+func (r ApiListGitProvidersRequest) Page(page int32) ApiListGitProvidersRequest {
+	r.page = &page
+	return r
+}
+
+// Number of items per page. All items are returned if not set
+func (r ApiListGitProvidersRequest) PerPage(perPage int32) ApiListGitProvidersRequest {
+	r.perPage = &perPage
+	return r
 }
 
-func (r ApiListGitProvidersRequest) Execute() ([]GitProvider, *http.Response, error) {
+func (r ApiListGitProvidersRequest) Execute() (*PaginatedListGitProvider, *http.Response, error) {
 	return r.ApiService.ListGitProvidersExecute(r)
 }
 
@@ -894,13 +1401,13 @@ func (a *GitProviderAPIService) ListGitProviders(ctx context.Context) ApiListGit
 
 // Execute executes the request
 //
-//	@return []GitProvider
-func (a *GitProviderAPIService) ListGitProvidersExecute(r ApiListGitProvidersRequest) ([]GitProvider, *http.Response, error) {
+//	@return PaginatedListGitProvider
+func (a *GitProviderAPIService) ListGitProvidersExecute(r ApiListGitProvidersRequest) (*PaginatedListGitProvider, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue []GitProvider
+		localVarReturnValue *PaginatedListGitProvider
 	)
 
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GitProviderAPIService.ListGitProviders")
@@ -914,6 +1421,12 @@ func (a *GitProviderAPIService) ListGitProvidersExecute(r ApiListGitProvidersReq
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.page != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "page", r.page, "")
+	}
+	if r.perPage != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "perPage", r.perPage, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -1088,6 +1601,112 @@ func (a *GitProviderAPIService) RemoveGitProviderExecute(r ApiRemoveGitProviderR
 	return localVarHTTPResponse, nil
 }
 
+type ApiSetDefaultGitProviderRequest struct {
+	ctx           context.Context
+	ApiService    *GitProviderAPIService
+	gitProviderId string
+}
+
+func (r ApiSetDefaultGitProviderRequest) Execute() (*http.Response, error) {
+	return r.ApiService.SetDefaultGitProviderExecute(r)
+}
+
+/*
+SetDefaultGitProvider Set default Git provider
+
+Use the Git provider config for repositories of its host when no config is selected for the project or matched by alias
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@param gitProviderId Git provider
+	@return ApiSetDefaultGitProviderRequest
+*/
+func (a *GitProviderAPIService) SetDefaultGitProvider(ctx context.Context, gitProviderId string) ApiSetDefaultGitProviderRequest {
+	return ApiSetDefaultGitProviderRequest{
+		ApiService:    a,
+		ctx:           ctx,
+		gitProviderId: gitProviderId,
+	}
+}
+
+// Execute executes the request
+func (a *GitProviderAPIService) SetDefaultGitProviderExecute(r ApiSetDefaultGitProviderRequest) (*http.Response, error) {
+	var (
+		localVarHTTPMethod = http.MethodPost
+		localVarPostBody   interface{}
+		formFiles          []formFile
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "GitProviderAPIService.SetDefaultGitProvider")
+	if err != nil {
+		return nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/gitprovider/{gitProviderId}/set-default"
+	localVarPath = strings.Replace(localVarPath, "{"+"gitProviderId"+"}", url.PathEscape(parameterValueToString(r.gitProviderId, "gitProviderId")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["Bearer"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarHTTPResponse, newErr
+	}
+
+	return localVarHTTPResponse, nil
+}
+
 type ApiSetGitProviderRequest struct {
 	ctx               context.Context
 	ApiService        *GitProviderAPIService
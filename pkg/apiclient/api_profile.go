@@ -33,7 +33,7 @@ func (r ApiDeleteProfileDataRequest) Execute() (*http.Response, error) {
 /*
 DeleteProfileData Delete profile data
 
-Delete profile data
+Delete the profile data of the user
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@return ApiDeleteProfileDataRequest
@@ -135,7 +135,7 @@ func (r ApiGetProfileDataRequest) Execute() (*ProfileData, *http.Response, error
 /*
 GetProfileData Get profile data
 
-Get profile data
+Get the profile data of the user. Workspace and project API keys get the profile data of the workspace owner
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@return ApiGetProfileDataRequest
@@ -256,7 +256,7 @@ func (r ApiSetProfileDataRequest) Execute() (*http.Response, error) {
 /*
 SetProfileData Set profile data
 
-Set profile data
+Set the profile data of the user
 
 	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 	@return ApiSetProfileDataRequest
@@ -22,6 +22,120 @@ import (
 // ProviderAPIService ProviderAPI service
 type ProviderAPIService service
 
+type ApiGetProviderCatalogRequest struct {
+	ctx        context.Context
+	ApiService *ProviderAPIService
+}
+
+func (r ApiGetProviderCatalogRequest) Execute() ([]ProviderCatalogEntry, *http.Response, error) {
+	return r.ApiService.GetProviderCatalogExecute(r)
+}
+
+/*
+GetProviderCatalog Get provider catalog
+
+Get the providers available in the registry and whether the installed ones are outdated
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiGetProviderCatalogRequest
+*/
+func (a *ProviderAPIService) GetProviderCatalog(ctx context.Context) ApiGetProviderCatalogRequest {
+	return ApiGetProviderCatalogRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return []ProviderCatalogEntry
+func (a *ProviderAPIService) GetProviderCatalogExecute(r ApiGetProviderCatalogRequest) ([]ProviderCatalogEntry, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodGet
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue []ProviderCatalogEntry
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ProviderAPIService.GetProviderCatalog")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/provider/catalog"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["Bearer"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
 type ApiGetTargetManifestRequest struct {
 	ctx        context.Context
 	ApiService *ProviderAPIService
@@ -254,12 +368,140 @@ func (a *ProviderAPIService) InstallProviderExecute(r ApiInstallProviderRequest)
 	return localVarHTTPResponse, nil
 }
 
+type ApiInstallProviderFromFileRequest struct {
+	ctx        context.Context
+	ApiService *ProviderAPIService
+	provider   *InstallProviderFromFileRequest
+}
+
+// Provider file to install
+func (r ApiInstallProviderFromFileRequest) Provider(provider InstallProviderFromFileRequest) ApiInstallProviderFromFileRequest {
+	r.provider = &provider
+	return r
+}
+
+func (r ApiInstallProviderFromFileRequest) Execute() (*http.Response, error) {
+	return r.ApiService.InstallProviderFromFileExecute(r)
+}
+
+/*
+InstallProviderFromFile Install a provider from a file
+
+Install a provider from a tarball or binary on the server host, e.g. on servers without internet access
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiInstallProviderFromFileRequest
+*/
+func (a *ProviderAPIService) InstallProviderFromFile(ctx context.Context) ApiInstallProviderFromFileRequest {
+	return ApiInstallProviderFromFileRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+func (a *ProviderAPIService) InstallProviderFromFileExecute(r ApiInstallProviderFromFileRequest) (*http.Response, error) {
+	var (
+		localVarHTTPMethod = http.MethodPost
+		localVarPostBody   interface{}
+		formFiles          []formFile
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ProviderAPIService.InstallProviderFromFile")
+	if err != nil {
+		return nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/provider/install/file"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.provider == nil {
+		return nil, reportError("provider is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.provider
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["Bearer"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarHTTPResponse, newErr
+	}
+
+	return localVarHTTPResponse, nil
+}
+
 type ApiListProvidersRequest struct {
 	ctx        context.Context
 	ApiService *ProviderAPIService
+	page       *int32
+	perPage    *int32
 }
 
-func (r ApiListProvidersRequest) Execute() ([]Provider, *http.Response, error) {
+// Page number, starting at 1
+func (r ApiListProvidersRequest) Page(page int32) ApiListProvidersRequest {
+	r.page = &page
+	return r
+}
+
+// Number of items per page. All items are returned if not set
+func (r ApiListProvidersRequest) PerPage(perPage int32) ApiListProvidersRequest {
+	r.perPage = &perPage
+	return r
+}
+
+func (r ApiListProvidersRequest) Execute() (*PaginatedListProvider, *http.Response, error) {
 	return r.ApiService.ListProvidersExecute(r)
 }
 
@@ -280,13 +522,13 @@ func (a *ProviderAPIService) ListProviders(ctx context.Context) ApiListProviders
 
 // Execute executes the request
 //
-//	@return []Provider
-func (a *ProviderAPIService) ListProvidersExecute(r ApiListProvidersRequest) ([]Provider, *http.Response, error) {
+//	@return PaginatedListProvider
+func (a *ProviderAPIService) ListProvidersExecute(r ApiListProvidersRequest) (*PaginatedListProvider, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue []Provider
+		localVarReturnValue *PaginatedListProvider
 	)
 
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "ProviderAPIService.ListProviders")
@@ -300,6 +542,12 @@ func (a *ProviderAPIService) ListProvidersExecute(r ApiListProvidersRequest) ([]
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.page != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "page", r.page, "")
+	}
+	if r.perPage != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "perPage", r.perPage, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -25,9 +25,23 @@ type TargetAPIService service
 type ApiListTargetsRequest struct {
 	ctx        context.Context
 	ApiService *TargetAPIService
+	page       *int32
+	perPage    *int32
 }
 
-func (r ApiListTargetsRequest) Execute() ([]ProviderTarget, *http.Response, error) {
+// Page number, starting at 1
+func (r ApiListTargetsRequest) Page(page int32) ApiListTargetsRequest {
+	r.page = &page
+	return r
+}
+
+// Number of items per page. All items are returned if not set
+func (r ApiListTargetsRequest) PerPage(perPage int32) ApiListTargetsRequest {
+	r.perPage = &perPage
+	return r
+}
+
+func (r ApiListTargetsRequest) Execute() (*PaginatedListProviderTarget, *http.Response, error) {
 	return r.ApiService.ListTargetsExecute(r)
 }
 
@@ -48,13 +62,13 @@ func (a *TargetAPIService) ListTargets(ctx context.Context) ApiListTargetsReques
 
 // Execute executes the request
 //
-//	@return []ProviderTarget
-func (a *TargetAPIService) ListTargetsExecute(r ApiListTargetsRequest) ([]ProviderTarget, *http.Response, error) {
+//	@return PaginatedListProviderTarget
+func (a *TargetAPIService) ListTargetsExecute(r ApiListTargetsRequest) (*PaginatedListProviderTarget, *http.Response, error) {
 	var (
 		localVarHTTPMethod  = http.MethodGet
 		localVarPostBody    interface{}
 		formFiles           []formFile
-		localVarReturnValue []ProviderTarget
+		localVarReturnValue *PaginatedListProviderTarget
 	)
 
 	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "TargetAPIService.ListTargets")
@@ -68,6 +82,12 @@ func (a *TargetAPIService) ListTargetsExecute(r ApiListTargetsRequest) ([]Provid
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
+	if r.page != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "page", r.page, "")
+	}
+	if r.perPage != nil {
+		parameterAddToHeaderOrQuery(localVarQueryParams, "perPage", r.perPage, "")
+	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
 
@@ -355,3 +375,129 @@ func (a *TargetAPIService) SetTargetExecute(r ApiSetTargetRequest) (*http.Respon
 
 	return localVarHTTPResponse, nil
 }
+
+type ApiValidateTargetRequest struct {
+	ctx        context.Context
+	ApiService *TargetAPIService
+	target     *ProviderTarget
+}
+
+// Target to validate
+func (r ApiValidateTargetRequest) Target(target ProviderTarget) ApiValidateTargetRequest {
+	r.target = &target
+	return r
+}
+
+func (r ApiValidateTargetRequest) Execute() ([]TargetOptionValidationError, *http.Response, error) {
+	return r.ApiService.ValidateTargetExecute(r)
+}
+
+/*
+ValidateTarget Validate a target
+
+Validate the target options with the target provider
+
+	@param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+	@return ApiValidateTargetRequest
+*/
+func (a *TargetAPIService) ValidateTarget(ctx context.Context) ApiValidateTargetRequest {
+	return ApiValidateTargetRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+// Execute executes the request
+//
+//	@return []TargetOptionValidationError
+func (a *TargetAPIService) ValidateTargetExecute(r ApiValidateTargetRequest) ([]TargetOptionValidationError, *http.Response, error) {
+	var (
+		localVarHTTPMethod  = http.MethodPost
+		localVarPostBody    interface{}
+		formFiles           []formFile
+		localVarReturnValue []TargetOptionValidationError
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "TargetAPIService.ValidateTarget")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/target/validate"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.target == nil {
+		return localVarReturnValue, nil, reportError("target is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.target
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["Bearer"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, formFiles)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(localVarHTTPResponse.Body)
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
@@ -50,8 +50,12 @@ type APIClient struct {
 
 	ApiKeyAPI *ApiKeyAPIService
 
+	BuildAPI *BuildAPIService
+
 	ContainerRegistryAPI *ContainerRegistryAPIService
 
+	EventAPI *EventAPIService
+
 	GitProviderAPI *GitProviderAPIService
 
 	ProfileAPI *ProfileAPIService
@@ -60,8 +64,16 @@ type APIClient struct {
 
 	ServerAPI *ServerAPIService
 
+	SessionRecordingAPI *SessionRecordingAPIService
+
+	ShareAPI *ShareAPIService
+
 	TargetAPI *TargetAPIService
 
+	TargetGroupAPI *TargetGroupAPIService
+
+	UserAPI *UserAPIService
+
 	WorkspaceAPI *WorkspaceAPIService
 }
 
@@ -82,12 +94,18 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 
 	// API Services
 	c.ApiKeyAPI = (*ApiKeyAPIService)(&c.common)
+	c.BuildAPI = (*BuildAPIService)(&c.common)
 	c.ContainerRegistryAPI = (*ContainerRegistryAPIService)(&c.common)
+	c.EventAPI = (*EventAPIService)(&c.common)
 	c.GitProviderAPI = (*GitProviderAPIService)(&c.common)
 	c.ProfileAPI = (*ProfileAPIService)(&c.common)
 	c.ProviderAPI = (*ProviderAPIService)(&c.common)
 	c.ServerAPI = (*ServerAPIService)(&c.common)
+	c.SessionRecordingAPI = (*SessionRecordingAPIService)(&c.common)
+	c.ShareAPI = (*ShareAPIService)(&c.common)
 	c.TargetAPI = (*TargetAPIService)(&c.common)
+	c.TargetGroupAPI = (*TargetGroupAPIService)(&c.common)
+	c.UserAPI = (*UserAPIService)(&c.common)
 	c.WorkspaceAPI = (*WorkspaceAPIService)(&c.common)
 
 	return c
@@ -19,10 +19,16 @@ var _ MappedNullable = &ApiKey{}
 
 // ApiKey struct for ApiKey
 type ApiKey struct {
-	KeyHash *string `json:"keyHash,omitempty"`
+	// RFC3339 time after which the key is no longer valid. Empty if the key does not expire
+	ExpiresAt *string `json:"expiresAt,omitempty"`
+	KeyHash   *string `json:"keyHash,omitempty"`
 	// Project or client name
 	Name *string           `json:"name,omitempty"`
 	Type *ApikeyApiKeyType `json:"type,omitempty"`
+	// User the client key belongs to. Keys generated before users were introduced have none and grant admin access
+	UserId *string `json:"userId,omitempty"`
+	// Workspace a share key grants access to
+	WorkspaceId *string `json:"workspaceId,omitempty"`
 }
 
 // NewApiKey instantiates a new ApiKey object
@@ -42,6 +48,38 @@ func NewApiKeyWithDefaults() *ApiKey {
 	return &this
 }
 
+// GetExpiresAt returns the ExpiresAt field value if set, zero value otherwise.
+func (o *ApiKey) GetExpiresAt() string {
+	if o == nil || IsNil(o.ExpiresAt) {
+		var ret string
+		return ret
+	}
+	return *o.ExpiresAt
+}
+
+// GetExpiresAtOk returns a tuple with the ExpiresAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ApiKey) GetExpiresAtOk() (*string, bool) {
+	if o == nil || IsNil(o.ExpiresAt) {
+		return nil, false
+	}
+	return o.ExpiresAt, true
+}
+
+// HasExpiresAt returns a boolean if a field has been set.
+func (o *ApiKey) HasExpiresAt() bool {
+	if o != nil && !IsNil(o.ExpiresAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetExpiresAt gets a reference to the given string and assigns it to the ExpiresAt field.
+func (o *ApiKey) SetExpiresAt(v string) {
+	o.ExpiresAt = &v
+}
+
 // GetKeyHash returns the KeyHash field value if set, zero value otherwise.
 func (o *ApiKey) GetKeyHash() string {
 	if o == nil || IsNil(o.KeyHash) {
@@ -138,6 +176,70 @@ func (o *ApiKey) SetType(v ApikeyApiKeyType) {
 	o.Type = &v
 }
 
+// GetUserId returns the UserId field value if set, zero value otherwise.
+func (o *ApiKey) GetUserId() string {
+	if o == nil || IsNil(o.UserId) {
+		var ret string
+		return ret
+	}
+	return *o.UserId
+}
+
+// GetUserIdOk returns a tuple with the UserId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ApiKey) GetUserIdOk() (*string, bool) {
+	if o == nil || IsNil(o.UserId) {
+		return nil, false
+	}
+	return o.UserId, true
+}
+
+// HasUserId returns a boolean if a field has been set.
+func (o *ApiKey) HasUserId() bool {
+	if o != nil && !IsNil(o.UserId) {
+		return true
+	}
+
+	return false
+}
+
+// SetUserId gets a reference to the given string and assigns it to the UserId field.
+func (o *ApiKey) SetUserId(v string) {
+	o.UserId = &v
+}
+
+// GetWorkspaceId returns the WorkspaceId field value if set, zero value otherwise.
+func (o *ApiKey) GetWorkspaceId() string {
+	if o == nil || IsNil(o.WorkspaceId) {
+		var ret string
+		return ret
+	}
+	return *o.WorkspaceId
+}
+
+// GetWorkspaceIdOk returns a tuple with the WorkspaceId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ApiKey) GetWorkspaceIdOk() (*string, bool) {
+	if o == nil || IsNil(o.WorkspaceId) {
+		return nil, false
+	}
+	return o.WorkspaceId, true
+}
+
+// HasWorkspaceId returns a boolean if a field has been set.
+func (o *ApiKey) HasWorkspaceId() bool {
+	if o != nil && !IsNil(o.WorkspaceId) {
+		return true
+	}
+
+	return false
+}
+
+// SetWorkspaceId gets a reference to the given string and assigns it to the WorkspaceId field.
+func (o *ApiKey) SetWorkspaceId(v string) {
+	o.WorkspaceId = &v
+}
+
 func (o ApiKey) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -148,6 +250,9 @@ func (o ApiKey) MarshalJSON() ([]byte, error) {
 
 func (o ApiKey) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.ExpiresAt) {
+		toSerialize["expiresAt"] = o.ExpiresAt
+	}
 	if !IsNil(o.KeyHash) {
 		toSerialize["keyHash"] = o.KeyHash
 	}
@@ -157,6 +262,12 @@ func (o ApiKey) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Type) {
 		toSerialize["type"] = o.Type
 	}
+	if !IsNil(o.UserId) {
+		toSerialize["userId"] = o.UserId
+	}
+	if !IsNil(o.WorkspaceId) {
+		toSerialize["workspaceId"] = o.WorkspaceId
+	}
 	return toSerialize, nil
 }
 
@@ -23,6 +23,7 @@ const (
 	ApiKeyTypeClient    ApikeyApiKeyType = "client"
 	ApiKeyTypeProject   ApikeyApiKeyType = "project"
 	ApiKeyTypeWorkspace ApikeyApiKeyType = "workspace"
+	ApiKeyTypeShare     ApikeyApiKeyType = "share"
 )
 
 // All allowed values of ApikeyApiKeyType enum
@@ -30,6 +31,7 @@ var AllowedApikeyApiKeyTypeEnumValues = []ApikeyApiKeyType{
 	"client",
 	"project",
 	"workspace",
+	"share",
 }
 
 func (v *ApikeyApiKeyType) UnmarshalJSON(src []byte) error {
@@ -21,10 +21,17 @@ var _ MappedNullable = &CreateWorkspaceRequest{}
 
 // CreateWorkspaceRequest struct for CreateWorkspaceRequest
 type CreateWorkspaceRequest struct {
-	Id       *string                         `json:"id,omitempty"`
-	Name     *string                         `json:"name,omitempty"`
-	Projects []CreateWorkspaceRequestProject `json:"projects"`
-	Target   *string                         `json:"target,omitempty"`
+	ExpiryAction *WorkspaceExpiryAction          `json:"expiryAction,omitempty"`
+	Id           *string                         `json:"id,omitempty"`
+	Name         *string                         `json:"name,omitempty"`
+	Projects     []CreateWorkspaceRequestProject `json:"projects"`
+	// Record the terminal sessions of SSH connections to the workspace projects
+	RecordSessions *bool   `json:"recordSessions,omitempty"`
+	Target         *string `json:"target,omitempty"`
+	// Target group the workspace target is picked from according to the group placement policy. Overrides the target
+	TargetGroup *string `json:"targetGroup,omitempty"`
+	// Duration (e.g. 72h) after which the expiry action is performed on the workspace
+	Ttl *string `json:"ttl,omitempty"`
 }
 
 type _CreateWorkspaceRequest CreateWorkspaceRequest
@@ -47,6 +54,38 @@ func NewCreateWorkspaceRequestWithDefaults() *CreateWorkspaceRequest {
 	return &this
 }
 
+// GetExpiryAction returns the ExpiryAction field value if set, zero value otherwise.
+func (o *CreateWorkspaceRequest) GetExpiryAction() WorkspaceExpiryAction {
+	if o == nil || IsNil(o.ExpiryAction) {
+		var ret WorkspaceExpiryAction
+		return ret
+	}
+	return *o.ExpiryAction
+}
+
+// GetExpiryActionOk returns a tuple with the ExpiryAction field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspaceRequest) GetExpiryActionOk() (*WorkspaceExpiryAction, bool) {
+	if o == nil || IsNil(o.ExpiryAction) {
+		return nil, false
+	}
+	return o.ExpiryAction, true
+}
+
+// HasExpiryAction returns a boolean if a field has been set.
+func (o *CreateWorkspaceRequest) HasExpiryAction() bool {
+	if o != nil && !IsNil(o.ExpiryAction) {
+		return true
+	}
+
+	return false
+}
+
+// SetExpiryAction gets a reference to the given WorkspaceExpiryAction and assigns it to the ExpiryAction field.
+func (o *CreateWorkspaceRequest) SetExpiryAction(v WorkspaceExpiryAction) {
+	o.ExpiryAction = &v
+}
+
 // GetId returns the Id field value if set, zero value otherwise.
 func (o *CreateWorkspaceRequest) GetId() string {
 	if o == nil || IsNil(o.Id) {
@@ -135,6 +174,38 @@ func (o *CreateWorkspaceRequest) SetProjects(v []CreateWorkspaceRequestProject)
 	o.Projects = v
 }
 
+// GetRecordSessions returns the RecordSessions field value if set, zero value otherwise.
+func (o *CreateWorkspaceRequest) GetRecordSessions() bool {
+	if o == nil || IsNil(o.RecordSessions) {
+		var ret bool
+		return ret
+	}
+	return *o.RecordSessions
+}
+
+// GetRecordSessionsOk returns a tuple with the RecordSessions field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspaceRequest) GetRecordSessionsOk() (*bool, bool) {
+	if o == nil || IsNil(o.RecordSessions) {
+		return nil, false
+	}
+	return o.RecordSessions, true
+}
+
+// HasRecordSessions returns a boolean if a field has been set.
+func (o *CreateWorkspaceRequest) HasRecordSessions() bool {
+	if o != nil && !IsNil(o.RecordSessions) {
+		return true
+	}
+
+	return false
+}
+
+// SetRecordSessions gets a reference to the given bool and assigns it to the RecordSessions field.
+func (o *CreateWorkspaceRequest) SetRecordSessions(v bool) {
+	o.RecordSessions = &v
+}
+
 // GetTarget returns the Target field value if set, zero value otherwise.
 func (o *CreateWorkspaceRequest) GetTarget() string {
 	if o == nil || IsNil(o.Target) {
@@ -167,6 +238,70 @@ func (o *CreateWorkspaceRequest) SetTarget(v string) {
 	o.Target = &v
 }
 
+// GetTargetGroup returns the TargetGroup field value if set, zero value otherwise.
+func (o *CreateWorkspaceRequest) GetTargetGroup() string {
+	if o == nil || IsNil(o.TargetGroup) {
+		var ret string
+		return ret
+	}
+	return *o.TargetGroup
+}
+
+// GetTargetGroupOk returns a tuple with the TargetGroup field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspaceRequest) GetTargetGroupOk() (*string, bool) {
+	if o == nil || IsNil(o.TargetGroup) {
+		return nil, false
+	}
+	return o.TargetGroup, true
+}
+
+// HasTargetGroup returns a boolean if a field has been set.
+func (o *CreateWorkspaceRequest) HasTargetGroup() bool {
+	if o != nil && !IsNil(o.TargetGroup) {
+		return true
+	}
+
+	return false
+}
+
+// SetTargetGroup gets a reference to the given string and assigns it to the TargetGroup field.
+func (o *CreateWorkspaceRequest) SetTargetGroup(v string) {
+	o.TargetGroup = &v
+}
+
+// GetTtl returns the Ttl field value if set, zero value otherwise.
+func (o *CreateWorkspaceRequest) GetTtl() string {
+	if o == nil || IsNil(o.Ttl) {
+		var ret string
+		return ret
+	}
+	return *o.Ttl
+}
+
+// GetTtlOk returns a tuple with the Ttl field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspaceRequest) GetTtlOk() (*string, bool) {
+	if o == nil || IsNil(o.Ttl) {
+		return nil, false
+	}
+	return o.Ttl, true
+}
+
+// HasTtl returns a boolean if a field has been set.
+func (o *CreateWorkspaceRequest) HasTtl() bool {
+	if o != nil && !IsNil(o.Ttl) {
+		return true
+	}
+
+	return false
+}
+
+// SetTtl gets a reference to the given string and assigns it to the Ttl field.
+func (o *CreateWorkspaceRequest) SetTtl(v string) {
+	o.Ttl = &v
+}
+
 func (o CreateWorkspaceRequest) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -177,6 +312,9 @@ func (o CreateWorkspaceRequest) MarshalJSON() ([]byte, error) {
 
 func (o CreateWorkspaceRequest) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.ExpiryAction) {
+		toSerialize["expiryAction"] = o.ExpiryAction
+	}
 	if !IsNil(o.Id) {
 		toSerialize["id"] = o.Id
 	}
@@ -184,9 +322,18 @@ func (o CreateWorkspaceRequest) ToMap() (map[string]interface{}, error) {
 		toSerialize["name"] = o.Name
 	}
 	toSerialize["projects"] = o.Projects
+	if !IsNil(o.RecordSessions) {
+		toSerialize["recordSessions"] = o.RecordSessions
+	}
 	if !IsNil(o.Target) {
 		toSerialize["target"] = o.Target
 	}
+	if !IsNil(o.TargetGroup) {
+		toSerialize["targetGroup"] = o.TargetGroup
+	}
+	if !IsNil(o.Ttl) {
+		toSerialize["ttl"] = o.Ttl
+	}
 	return toSerialize, nil
 }
 
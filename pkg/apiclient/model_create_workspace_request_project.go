@@ -21,13 +21,23 @@ var _ MappedNullable = &CreateWorkspaceRequestProject{}
 
 // CreateWorkspaceRequestProject struct for CreateWorkspaceRequestProject
 type CreateWorkspaceRequestProject struct {
-	Build             *ProjectBuild                        `json:"build,omitempty"`
-	EnvVars           *map[string]string                   `json:"envVars,omitempty"`
-	Image             *string                              `json:"image,omitempty"`
-	Name              string                               `json:"name"`
-	PostStartCommands []string                             `json:"postStartCommands,omitempty"`
-	Source            *CreateWorkspaceRequestProjectSource `json:"source,omitempty"`
-	User              *string                              `json:"user,omitempty"`
+	Build *ProjectBuild `json:"build,omitempty"`
+	// Names of the workspace projects started before the project
+	DependsOn []string           `json:"dependsOn,omitempty"`
+	EnvVars   *map[string]string `json:"envVars,omitempty"`
+	// Git provider config used for the repository. Resolved from the repository URL if not set
+	GitProviderConfigId *string `json:"gitProviderConfigId,omitempty"`
+	Image               *string `json:"image,omitempty"`
+	Name                string  `json:"name"`
+	// Ports forwarded by the server while the project is running
+	PortForwards []ProjectPortForward `json:"portForwards,omitempty"`
+	// Commands run once in the project directory after the project is created
+	PostCreateCommands []string                             `json:"postCreateCommands,omitempty"`
+	PostStartCommands  []string                             `json:"postStartCommands,omitempty"`
+	Source             *CreateWorkspaceRequestProjectSource `json:"source,omitempty"`
+	User               *string                              `json:"user,omitempty"`
+	// Clone a bare repository and check out branches as worktrees on demand
+	WorktreeMode *bool `json:"worktreeMode,omitempty"`
 }
 
 type _CreateWorkspaceRequestProject CreateWorkspaceRequestProject
@@ -82,6 +92,38 @@ func (o *CreateWorkspaceRequestProject) SetBuild(v ProjectBuild) {
 	o.Build = &v
 }
 
+// GetDependsOn returns the DependsOn field value if set, zero value otherwise.
+func (o *CreateWorkspaceRequestProject) GetDependsOn() []string {
+	if o == nil || IsNil(o.DependsOn) {
+		var ret []string
+		return ret
+	}
+	return o.DependsOn
+}
+
+// GetDependsOnOk returns a tuple with the DependsOn field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspaceRequestProject) GetDependsOnOk() ([]string, bool) {
+	if o == nil || IsNil(o.DependsOn) {
+		return nil, false
+	}
+	return o.DependsOn, true
+}
+
+// HasDependsOn returns a boolean if a field has been set.
+func (o *CreateWorkspaceRequestProject) HasDependsOn() bool {
+	if o != nil && !IsNil(o.DependsOn) {
+		return true
+	}
+
+	return false
+}
+
+// SetDependsOn gets a reference to the given []string and assigns it to the DependsOn field.
+func (o *CreateWorkspaceRequestProject) SetDependsOn(v []string) {
+	o.DependsOn = v
+}
+
 // GetEnvVars returns the EnvVars field value if set, zero value otherwise.
 func (o *CreateWorkspaceRequestProject) GetEnvVars() map[string]string {
 	if o == nil || IsNil(o.EnvVars) {
@@ -114,6 +156,38 @@ func (o *CreateWorkspaceRequestProject) SetEnvVars(v map[string]string) {
 	o.EnvVars = &v
 }
 
+// GetGitProviderConfigId returns the GitProviderConfigId field value if set, zero value otherwise.
+func (o *CreateWorkspaceRequestProject) GetGitProviderConfigId() string {
+	if o == nil || IsNil(o.GitProviderConfigId) {
+		var ret string
+		return ret
+	}
+	return *o.GitProviderConfigId
+}
+
+// GetGitProviderConfigIdOk returns a tuple with the GitProviderConfigId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspaceRequestProject) GetGitProviderConfigIdOk() (*string, bool) {
+	if o == nil || IsNil(o.GitProviderConfigId) {
+		return nil, false
+	}
+	return o.GitProviderConfigId, true
+}
+
+// HasGitProviderConfigId returns a boolean if a field has been set.
+func (o *CreateWorkspaceRequestProject) HasGitProviderConfigId() bool {
+	if o != nil && !IsNil(o.GitProviderConfigId) {
+		return true
+	}
+
+	return false
+}
+
+// SetGitProviderConfigId gets a reference to the given string and assigns it to the GitProviderConfigId field.
+func (o *CreateWorkspaceRequestProject) SetGitProviderConfigId(v string) {
+	o.GitProviderConfigId = &v
+}
+
 // GetImage returns the Image field value if set, zero value otherwise.
 func (o *CreateWorkspaceRequestProject) GetImage() string {
 	if o == nil || IsNil(o.Image) {
@@ -170,6 +244,70 @@ func (o *CreateWorkspaceRequestProject) SetName(v string) {
 	o.Name = v
 }
 
+// GetPortForwards returns the PortForwards field value if set, zero value otherwise.
+func (o *CreateWorkspaceRequestProject) GetPortForwards() []ProjectPortForward {
+	if o == nil || IsNil(o.PortForwards) {
+		var ret []ProjectPortForward
+		return ret
+	}
+	return o.PortForwards
+}
+
+// GetPortForwardsOk returns a tuple with the PortForwards field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspaceRequestProject) GetPortForwardsOk() ([]ProjectPortForward, bool) {
+	if o == nil || IsNil(o.PortForwards) {
+		return nil, false
+	}
+	return o.PortForwards, true
+}
+
+// HasPortForwards returns a boolean if a field has been set.
+func (o *CreateWorkspaceRequestProject) HasPortForwards() bool {
+	if o != nil && !IsNil(o.PortForwards) {
+		return true
+	}
+
+	return false
+}
+
+// SetPortForwards gets a reference to the given []ProjectPortForward and assigns it to the PortForwards field.
+func (o *CreateWorkspaceRequestProject) SetPortForwards(v []ProjectPortForward) {
+	o.PortForwards = v
+}
+
+// GetPostCreateCommands returns the PostCreateCommands field value if set, zero value otherwise.
+func (o *CreateWorkspaceRequestProject) GetPostCreateCommands() []string {
+	if o == nil || IsNil(o.PostCreateCommands) {
+		var ret []string
+		return ret
+	}
+	return o.PostCreateCommands
+}
+
+// GetPostCreateCommandsOk returns a tuple with the PostCreateCommands field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspaceRequestProject) GetPostCreateCommandsOk() ([]string, bool) {
+	if o == nil || IsNil(o.PostCreateCommands) {
+		return nil, false
+	}
+	return o.PostCreateCommands, true
+}
+
+// HasPostCreateCommands returns a boolean if a field has been set.
+func (o *CreateWorkspaceRequestProject) HasPostCreateCommands() bool {
+	if o != nil && !IsNil(o.PostCreateCommands) {
+		return true
+	}
+
+	return false
+}
+
+// SetPostCreateCommands gets a reference to the given []string and assigns it to the PostCreateCommands field.
+func (o *CreateWorkspaceRequestProject) SetPostCreateCommands(v []string) {
+	o.PostCreateCommands = v
+}
+
 // GetPostStartCommands returns the PostStartCommands field value if set, zero value otherwise.
 func (o *CreateWorkspaceRequestProject) GetPostStartCommands() []string {
 	if o == nil || IsNil(o.PostStartCommands) {
@@ -266,6 +404,38 @@ func (o *CreateWorkspaceRequestProject) SetUser(v string) {
 	o.User = &v
 }
 
+// GetWorktreeMode returns the WorktreeMode field value if set, zero value otherwise.
+func (o *CreateWorkspaceRequestProject) GetWorktreeMode() bool {
+	if o == nil || IsNil(o.WorktreeMode) {
+		var ret bool
+		return ret
+	}
+	return *o.WorktreeMode
+}
+
+// GetWorktreeModeOk returns a tuple with the WorktreeMode field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreateWorkspaceRequestProject) GetWorktreeModeOk() (*bool, bool) {
+	if o == nil || IsNil(o.WorktreeMode) {
+		return nil, false
+	}
+	return o.WorktreeMode, true
+}
+
+// HasWorktreeMode returns a boolean if a field has been set.
+func (o *CreateWorkspaceRequestProject) HasWorktreeMode() bool {
+	if o != nil && !IsNil(o.WorktreeMode) {
+		return true
+	}
+
+	return false
+}
+
+// SetWorktreeMode gets a reference to the given bool and assigns it to the WorktreeMode field.
+func (o *CreateWorkspaceRequestProject) SetWorktreeMode(v bool) {
+	o.WorktreeMode = &v
+}
+
 func (o CreateWorkspaceRequestProject) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -279,13 +449,25 @@ func (o CreateWorkspaceRequestProject) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Build) {
 		toSerialize["build"] = o.Build
 	}
+	if !IsNil(o.DependsOn) {
+		toSerialize["dependsOn"] = o.DependsOn
+	}
 	if !IsNil(o.EnvVars) {
 		toSerialize["envVars"] = o.EnvVars
 	}
+	if !IsNil(o.GitProviderConfigId) {
+		toSerialize["gitProviderConfigId"] = o.GitProviderConfigId
+	}
 	if !IsNil(o.Image) {
 		toSerialize["image"] = o.Image
 	}
 	toSerialize["name"] = o.Name
+	if !IsNil(o.PortForwards) {
+		toSerialize["portForwards"] = o.PortForwards
+	}
+	if !IsNil(o.PostCreateCommands) {
+		toSerialize["postCreateCommands"] = o.PostCreateCommands
+	}
 	if !IsNil(o.PostStartCommands) {
 		toSerialize["postStartCommands"] = o.PostStartCommands
 	}
@@ -295,6 +477,9 @@ func (o CreateWorkspaceRequestProject) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.User) {
 		toSerialize["user"] = o.User
 	}
+	if !IsNil(o.WorktreeMode) {
+		toSerialize["worktreeMode"] = o.WorktreeMode
+	}
 	return toSerialize, nil
 }
 
@@ -19,8 +19,17 @@ var _ MappedNullable = &GitBranch{}
 
 // GitBranch struct for GitBranch
 type GitBranch struct {
+	// Commits on the branch that are not on the default branch. Not set if the git provider can not compare branches
+	Ahead *int32 `json:"ahead,omitempty"`
+	// Author of the last commit
+	Author *string `json:"author,omitempty"`
+	// Commits on the default branch that are not on the branch
+	Behind *int32 `json:"behind,omitempty"`
+	// RFC3339 authoring date of the last commit
+	Date *string `json:"date,omitempty"`
 	Name *string `json:"name,omitempty"`
-	Sha  *string `json:"sha,omitempty"`
+	// SHA of the last commit
+	Sha *string `json:"sha,omitempty"`
 }
 
 // NewGitBranch instantiates a new GitBranch object
@@ -40,6 +49,134 @@ func NewGitBranchWithDefaults() *GitBranch {
 	return &this
 }
 
+// GetAhead returns the Ahead field value if set, zero value otherwise.
+func (o *GitBranch) GetAhead() int32 {
+	if o == nil || IsNil(o.Ahead) {
+		var ret int32
+		return ret
+	}
+	return *o.Ahead
+}
+
+// GetAheadOk returns a tuple with the Ahead field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *GitBranch) GetAheadOk() (*int32, bool) {
+	if o == nil || IsNil(o.Ahead) {
+		return nil, false
+	}
+	return o.Ahead, true
+}
+
+// HasAhead returns a boolean if a field has been set.
+func (o *GitBranch) HasAhead() bool {
+	if o != nil && !IsNil(o.Ahead) {
+		return true
+	}
+
+	return false
+}
+
+// SetAhead gets a reference to the given int32 and assigns it to the Ahead field.
+func (o *GitBranch) SetAhead(v int32) {
+	o.Ahead = &v
+}
+
+// GetAuthor returns the Author field value if set, zero value otherwise.
+func (o *GitBranch) GetAuthor() string {
+	if o == nil || IsNil(o.Author) {
+		var ret string
+		return ret
+	}
+	return *o.Author
+}
+
+// GetAuthorOk returns a tuple with the Author field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *GitBranch) GetAuthorOk() (*string, bool) {
+	if o == nil || IsNil(o.Author) {
+		return nil, false
+	}
+	return o.Author, true
+}
+
+// HasAuthor returns a boolean if a field has been set.
+func (o *GitBranch) HasAuthor() bool {
+	if o != nil && !IsNil(o.Author) {
+		return true
+	}
+
+	return false
+}
+
+// SetAuthor gets a reference to the given string and assigns it to the Author field.
+func (o *GitBranch) SetAuthor(v string) {
+	o.Author = &v
+}
+
+// GetBehind returns the Behind field value if set, zero value otherwise.
+func (o *GitBranch) GetBehind() int32 {
+	if o == nil || IsNil(o.Behind) {
+		var ret int32
+		return ret
+	}
+	return *o.Behind
+}
+
+// GetBehindOk returns a tuple with the Behind field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *GitBranch) GetBehindOk() (*int32, bool) {
+	if o == nil || IsNil(o.Behind) {
+		return nil, false
+	}
+	return o.Behind, true
+}
+
+// HasBehind returns a boolean if a field has been set.
+func (o *GitBranch) HasBehind() bool {
+	if o != nil && !IsNil(o.Behind) {
+		return true
+	}
+
+	return false
+}
+
+// SetBehind gets a reference to the given int32 and assigns it to the Behind field.
+func (o *GitBranch) SetBehind(v int32) {
+	o.Behind = &v
+}
+
+// GetDate returns the Date field value if set, zero value otherwise.
+func (o *GitBranch) GetDate() string {
+	if o == nil || IsNil(o.Date) {
+		var ret string
+		return ret
+	}
+	return *o.Date
+}
+
+// GetDateOk returns a tuple with the Date field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *GitBranch) GetDateOk() (*string, bool) {
+	if o == nil || IsNil(o.Date) {
+		return nil, false
+	}
+	return o.Date, true
+}
+
+// HasDate returns a boolean if a field has been set.
+func (o *GitBranch) HasDate() bool {
+	if o != nil && !IsNil(o.Date) {
+		return true
+	}
+
+	return false
+}
+
+// SetDate gets a reference to the given string and assigns it to the Date field.
+func (o *GitBranch) SetDate(v string) {
+	o.Date = &v
+}
+
 // GetName returns the Name field value if set, zero value otherwise.
 func (o *GitBranch) GetName() string {
 	if o == nil || IsNil(o.Name) {
@@ -114,6 +251,18 @@ func (o GitBranch) MarshalJSON() ([]byte, error) {
 
 func (o GitBranch) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.Ahead) {
+		toSerialize["ahead"] = o.Ahead
+	}
+	if !IsNil(o.Author) {
+		toSerialize["author"] = o.Author
+	}
+	if !IsNil(o.Behind) {
+		toSerialize["behind"] = o.Behind
+	}
+	if !IsNil(o.Date) {
+		toSerialize["date"] = o.Date
+	}
 	if !IsNil(o.Name) {
 		toSerialize["name"] = o.Name
 	}
@@ -19,8 +19,19 @@ var _ MappedNullable = &GitProvider{}
 
 // GitProvider struct for GitProvider
 type GitProvider struct {
+	// Selects the config for repositories owned by the user or organization with the same name
+	Alias      *string `json:"alias,omitempty"`
 	BaseApiUrl *string `json:"baseApiUrl,omitempty"`
-	Id         *string `json:"id,omitempty"`
+	// Set by the server when the repository listing cache is warmed. Not persisted
+	CacheStatus *GitProviderCacheStatus `json:"cacheStatus,omitempty"`
+	GithubApp   *GitHubAppConfig        `json:"githubApp,omitempty"`
+	Id          *string                 `json:"id,omitempty"`
+	// Used when several configs match a repository URL and none of them is selected by the alias
+	IsDefault *bool `json:"isDefault,omitempty"`
+	// ID of the user who registered the config. Empty for configs registered before users were introduced
+	OwnerId *string `json:"ownerId,omitempty"`
+	// Git provider type (e.g. github, gitlab). Empty for configs registered before multiple configs per provider were supported, in which case the ID is the provider type
+	ProviderId *string `json:"providerId,omitempty"`
 	Token      *string `json:"token,omitempty"`
 	Username   *string `json:"username,omitempty"`
 }
@@ -42,6 +53,38 @@ func NewGitProviderWithDefaults() *GitProvider {
 	return &this
 }
 
+// GetAlias returns the Alias field value if set, zero value otherwise.
+func (o *GitProvider) GetAlias() string {
+	if o == nil || IsNil(o.Alias) {
+		var ret string
+		return ret
+	}
+	return *o.Alias
+}
+
+// GetAliasOk returns a tuple with the Alias field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *GitProvider) GetAliasOk() (*string, bool) {
+	if o == nil || IsNil(o.Alias) {
+		return nil, false
+	}
+	return o.Alias, true
+}
+
+// HasAlias returns a boolean if a field has been set.
+func (o *GitProvider) HasAlias() bool {
+	if o != nil && !IsNil(o.Alias) {
+		return true
+	}
+
+	return false
+}
+
+// SetAlias gets a reference to the given string and assigns it to the Alias field.
+func (o *GitProvider) SetAlias(v string) {
+	o.Alias = &v
+}
+
 // GetBaseApiUrl returns the BaseApiUrl field value if set, zero value otherwise.
 func (o *GitProvider) GetBaseApiUrl() string {
 	if o == nil || IsNil(o.BaseApiUrl) {
@@ -74,6 +117,70 @@ func (o *GitProvider) SetBaseApiUrl(v string) {
 	o.BaseApiUrl = &v
 }
 
+// GetCacheStatus returns the CacheStatus field value if set, zero value otherwise.
+func (o *GitProvider) GetCacheStatus() GitProviderCacheStatus {
+	if o == nil || IsNil(o.CacheStatus) {
+		var ret GitProviderCacheStatus
+		return ret
+	}
+	return *o.CacheStatus
+}
+
+// GetCacheStatusOk returns a tuple with the CacheStatus field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *GitProvider) GetCacheStatusOk() (*GitProviderCacheStatus, bool) {
+	if o == nil || IsNil(o.CacheStatus) {
+		return nil, false
+	}
+	return o.CacheStatus, true
+}
+
+// HasCacheStatus returns a boolean if a field has been set.
+func (o *GitProvider) HasCacheStatus() bool {
+	if o != nil && !IsNil(o.CacheStatus) {
+		return true
+	}
+
+	return false
+}
+
+// SetCacheStatus gets a reference to the given GitProviderCacheStatus and assigns it to the CacheStatus field.
+func (o *GitProvider) SetCacheStatus(v GitProviderCacheStatus) {
+	o.CacheStatus = &v
+}
+
+// GetGithubApp returns the GithubApp field value if set, zero value otherwise.
+func (o *GitProvider) GetGithubApp() GitHubAppConfig {
+	if o == nil || IsNil(o.GithubApp) {
+		var ret GitHubAppConfig
+		return ret
+	}
+	return *o.GithubApp
+}
+
+// GetGithubAppOk returns a tuple with the GithubApp field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *GitProvider) GetGithubAppOk() (*GitHubAppConfig, bool) {
+	if o == nil || IsNil(o.GithubApp) {
+		return nil, false
+	}
+	return o.GithubApp, true
+}
+
+// HasGithubApp returns a boolean if a field has been set.
+func (o *GitProvider) HasGithubApp() bool {
+	if o != nil && !IsNil(o.GithubApp) {
+		return true
+	}
+
+	return false
+}
+
+// SetGithubApp gets a reference to the given GitHubAppConfig and assigns it to the GithubApp field.
+func (o *GitProvider) SetGithubApp(v GitHubAppConfig) {
+	o.GithubApp = &v
+}
+
 // GetId returns the Id field value if set, zero value otherwise.
 func (o *GitProvider) GetId() string {
 	if o == nil || IsNil(o.Id) {
@@ -106,6 +213,102 @@ func (o *GitProvider) SetId(v string) {
 	o.Id = &v
 }
 
+// GetIsDefault returns the IsDefault field value if set, zero value otherwise.
+func (o *GitProvider) GetIsDefault() bool {
+	if o == nil || IsNil(o.IsDefault) {
+		var ret bool
+		return ret
+	}
+	return *o.IsDefault
+}
+
+// GetIsDefaultOk returns a tuple with the IsDefault field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *GitProvider) GetIsDefaultOk() (*bool, bool) {
+	if o == nil || IsNil(o.IsDefault) {
+		return nil, false
+	}
+	return o.IsDefault, true
+}
+
+// HasIsDefault returns a boolean if a field has been set.
+func (o *GitProvider) HasIsDefault() bool {
+	if o != nil && !IsNil(o.IsDefault) {
+		return true
+	}
+
+	return false
+}
+
+// SetIsDefault gets a reference to the given bool and assigns it to the IsDefault field.
+func (o *GitProvider) SetIsDefault(v bool) {
+	o.IsDefault = &v
+}
+
+// GetOwnerId returns the OwnerId field value if set, zero value otherwise.
+func (o *GitProvider) GetOwnerId() string {
+	if o == nil || IsNil(o.OwnerId) {
+		var ret string
+		return ret
+	}
+	return *o.OwnerId
+}
+
+// GetOwnerIdOk returns a tuple with the OwnerId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *GitProvider) GetOwnerIdOk() (*string, bool) {
+	if o == nil || IsNil(o.OwnerId) {
+		return nil, false
+	}
+	return o.OwnerId, true
+}
+
+// HasOwnerId returns a boolean if a field has been set.
+func (o *GitProvider) HasOwnerId() bool {
+	if o != nil && !IsNil(o.OwnerId) {
+		return true
+	}
+
+	return false
+}
+
+// SetOwnerId gets a reference to the given string and assigns it to the OwnerId field.
+func (o *GitProvider) SetOwnerId(v string) {
+	o.OwnerId = &v
+}
+
+// GetProviderId returns the ProviderId field value if set, zero value otherwise.
+func (o *GitProvider) GetProviderId() string {
+	if o == nil || IsNil(o.ProviderId) {
+		var ret string
+		return ret
+	}
+	return *o.ProviderId
+}
+
+// GetProviderIdOk returns a tuple with the ProviderId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *GitProvider) GetProviderIdOk() (*string, bool) {
+	if o == nil || IsNil(o.ProviderId) {
+		return nil, false
+	}
+	return o.ProviderId, true
+}
+
+// HasProviderId returns a boolean if a field has been set.
+func (o *GitProvider) HasProviderId() bool {
+	if o != nil && !IsNil(o.ProviderId) {
+		return true
+	}
+
+	return false
+}
+
+// SetProviderId gets a reference to the given string and assigns it to the ProviderId field.
+func (o *GitProvider) SetProviderId(v string) {
+	o.ProviderId = &v
+}
+
 // GetToken returns the Token field value if set, zero value otherwise.
 func (o *GitProvider) GetToken() string {
 	if o == nil || IsNil(o.Token) {
@@ -180,12 +383,30 @@ func (o GitProvider) MarshalJSON() ([]byte, error) {
 
 func (o GitProvider) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.Alias) {
+		toSerialize["alias"] = o.Alias
+	}
 	if !IsNil(o.BaseApiUrl) {
 		toSerialize["baseApiUrl"] = o.BaseApiUrl
 	}
+	if !IsNil(o.CacheStatus) {
+		toSerialize["cacheStatus"] = o.CacheStatus
+	}
+	if !IsNil(o.GithubApp) {
+		toSerialize["githubApp"] = o.GithubApp
+	}
 	if !IsNil(o.Id) {
 		toSerialize["id"] = o.Id
 	}
+	if !IsNil(o.IsDefault) {
+		toSerialize["isDefault"] = o.IsDefault
+	}
+	if !IsNil(o.OwnerId) {
+		toSerialize["ownerId"] = o.OwnerId
+	}
+	if !IsNil(o.ProviderId) {
+		toSerialize["providerId"] = o.ProviderId
+	}
 	if !IsNil(o.Token) {
 		toSerialize["token"] = o.Token
 	}
@@ -19,8 +19,10 @@ var _ MappedNullable = &GitPullRequest{}
 
 // GitPullRequest struct for GitPullRequest
 type GitPullRequest struct {
-	Branch          *string `json:"branch,omitempty"`
-	Name            *string `json:"name,omitempty"`
+	Branch *string `json:"branch,omitempty"`
+	Name   *string `json:"name,omitempty"`
+	// Ref the change request is checked out from when it is not available as a branch, e.g. refs/changes/45/12345/2
+	Ref             *string `json:"ref,omitempty"`
 	Sha             *string `json:"sha,omitempty"`
 	SourceRepoId    *string `json:"sourceRepoId,omitempty"`
 	SourceRepoName  *string `json:"sourceRepoName,omitempty"`
@@ -109,6 +111,38 @@ func (o *GitPullRequest) SetName(v string) {
 	o.Name = &v
 }
 
+// GetRef returns the Ref field value if set, zero value otherwise.
+func (o *GitPullRequest) GetRef() string {
+	if o == nil || IsNil(o.Ref) {
+		var ret string
+		return ret
+	}
+	return *o.Ref
+}
+
+// GetRefOk returns a tuple with the Ref field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *GitPullRequest) GetRefOk() (*string, bool) {
+	if o == nil || IsNil(o.Ref) {
+		return nil, false
+	}
+	return o.Ref, true
+}
+
+// HasRef returns a boolean if a field has been set.
+func (o *GitPullRequest) HasRef() bool {
+	if o != nil && !IsNil(o.Ref) {
+		return true
+	}
+
+	return false
+}
+
+// SetRef gets a reference to the given string and assigns it to the Ref field.
+func (o *GitPullRequest) SetRef(v string) {
+	o.Ref = &v
+}
+
 // GetSha returns the Sha field value if set, zero value otherwise.
 func (o *GitPullRequest) GetSha() string {
 	if o == nil || IsNil(o.Sha) {
@@ -285,6 +319,9 @@ func (o GitPullRequest) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Name) {
 		toSerialize["name"] = o.Name
 	}
+	if !IsNil(o.Ref) {
+		toSerialize["ref"] = o.Ref
+	}
 	if !IsNil(o.Sha) {
 		toSerialize["sha"] = o.Sha
 	}
@@ -19,15 +19,21 @@ var _ MappedNullable = &GitRepository{}
 
 // GitRepository struct for GitRepository
 type GitRepository struct {
-	Branch   *string `json:"branch,omitempty"`
-	Id       *string `json:"id,omitempty"`
-	Name     *string `json:"name,omitempty"`
-	Owner    *string `json:"owner,omitempty"`
-	Path     *string `json:"path,omitempty"`
-	PrNumber *int32  `json:"prNumber,omitempty"`
-	Sha      *string `json:"sha,omitempty"`
-	Source   *string `json:"source,omitempty"`
-	Url      *string `json:"url,omitempty"`
+	Branch *string `json:"branch,omitempty"`
+	Id     *string `json:"id,omitempty"`
+	Name   *string `json:"name,omitempty"`
+	// Branch created from Branch after the repository is cloned
+	NewBranch *string `json:"newBranch,omitempty"`
+	Owner     *string `json:"owner,omitempty"`
+	Path      *string `json:"path,omitempty"`
+	// Check out exactly Sha instead of the branch head
+	Pinned   *bool  `json:"pinned,omitempty"`
+	PrNumber *int32 `json:"prNumber,omitempty"`
+	// Fetched and checked out after the repository is cloned. Set for change requests that are not available as a branch
+	Ref    *string `json:"ref,omitempty"`
+	Sha    *string `json:"sha,omitempty"`
+	Source *string `json:"source,omitempty"`
+	Url    *string `json:"url,omitempty"`
 }
 
 // NewGitRepository instantiates a new GitRepository object
@@ -143,6 +149,38 @@ func (o *GitRepository) SetName(v string) {
 	o.Name = &v
 }
 
+// GetNewBranch returns the NewBranch field value if set, zero value otherwise.
+func (o *GitRepository) GetNewBranch() string {
+	if o == nil || IsNil(o.NewBranch) {
+		var ret string
+		return ret
+	}
+	return *o.NewBranch
+}
+
+// GetNewBranchOk returns a tuple with the NewBranch field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *GitRepository) GetNewBranchOk() (*string, bool) {
+	if o == nil || IsNil(o.NewBranch) {
+		return nil, false
+	}
+	return o.NewBranch, true
+}
+
+// HasNewBranch returns a boolean if a field has been set.
+func (o *GitRepository) HasNewBranch() bool {
+	if o != nil && !IsNil(o.NewBranch) {
+		return true
+	}
+
+	return false
+}
+
+// SetNewBranch gets a reference to the given string and assigns it to the NewBranch field.
+func (o *GitRepository) SetNewBranch(v string) {
+	o.NewBranch = &v
+}
+
 // GetOwner returns the Owner field value if set, zero value otherwise.
 func (o *GitRepository) GetOwner() string {
 	if o == nil || IsNil(o.Owner) {
@@ -207,6 +245,38 @@ func (o *GitRepository) SetPath(v string) {
 	o.Path = &v
 }
 
+// GetPinned returns the Pinned field value if set, zero value otherwise.
+func (o *GitRepository) GetPinned() bool {
+	if o == nil || IsNil(o.Pinned) {
+		var ret bool
+		return ret
+	}
+	return *o.Pinned
+}
+
+// GetPinnedOk returns a tuple with the Pinned field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *GitRepository) GetPinnedOk() (*bool, bool) {
+	if o == nil || IsNil(o.Pinned) {
+		return nil, false
+	}
+	return o.Pinned, true
+}
+
+// HasPinned returns a boolean if a field has been set.
+func (o *GitRepository) HasPinned() bool {
+	if o != nil && !IsNil(o.Pinned) {
+		return true
+	}
+
+	return false
+}
+
+// SetPinned gets a reference to the given bool and assigns it to the Pinned field.
+func (o *GitRepository) SetPinned(v bool) {
+	o.Pinned = &v
+}
+
 // GetPrNumber returns the PrNumber field value if set, zero value otherwise.
 func (o *GitRepository) GetPrNumber() int32 {
 	if o == nil || IsNil(o.PrNumber) {
@@ -239,6 +309,38 @@ func (o *GitRepository) SetPrNumber(v int32) {
 	o.PrNumber = &v
 }
 
+// GetRef returns the Ref field value if set, zero value otherwise.
+func (o *GitRepository) GetRef() string {
+	if o == nil || IsNil(o.Ref) {
+		var ret string
+		return ret
+	}
+	return *o.Ref
+}
+
+// GetRefOk returns a tuple with the Ref field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *GitRepository) GetRefOk() (*string, bool) {
+	if o == nil || IsNil(o.Ref) {
+		return nil, false
+	}
+	return o.Ref, true
+}
+
+// HasRef returns a boolean if a field has been set.
+func (o *GitRepository) HasRef() bool {
+	if o != nil && !IsNil(o.Ref) {
+		return true
+	}
+
+	return false
+}
+
+// SetRef gets a reference to the given string and assigns it to the Ref field.
+func (o *GitRepository) SetRef(v string) {
+	o.Ref = &v
+}
+
 // GetSha returns the Sha field value if set, zero value otherwise.
 func (o *GitRepository) GetSha() string {
 	if o == nil || IsNil(o.Sha) {
@@ -354,15 +456,24 @@ func (o GitRepository) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Name) {
 		toSerialize["name"] = o.Name
 	}
+	if !IsNil(o.NewBranch) {
+		toSerialize["newBranch"] = o.NewBranch
+	}
 	if !IsNil(o.Owner) {
 		toSerialize["owner"] = o.Owner
 	}
 	if !IsNil(o.Path) {
 		toSerialize["path"] = o.Path
 	}
+	if !IsNil(o.Pinned) {
+		toSerialize["pinned"] = o.Pinned
+	}
 	if !IsNil(o.PrNumber) {
 		toSerialize["prNumber"] = o.PrNumber
 	}
+	if !IsNil(o.Ref) {
+		toSerialize["ref"] = o.Ref
+	}
 	if !IsNil(o.Sha) {
 		toSerialize["sha"] = o.Sha
 	}
@@ -21,6 +21,7 @@ var _ MappedNullable = &GitStatus{}
 type GitStatus struct {
 	CurrentBranch *string      `json:"currentBranch,omitempty"`
 	FileStatus    []FileStatus `json:"fileStatus,omitempty"`
+	HeadSha       *string      `json:"headSha,omitempty"`
 }
 
 // NewGitStatus instantiates a new GitStatus object
@@ -104,6 +105,38 @@ func (o *GitStatus) SetFileStatus(v []FileStatus) {
 	o.FileStatus = v
 }
 
+// GetHeadSha returns the HeadSha field value if set, zero value otherwise.
+func (o *GitStatus) GetHeadSha() string {
+	if o == nil || IsNil(o.HeadSha) {
+		var ret string
+		return ret
+	}
+	return *o.HeadSha
+}
+
+// GetHeadShaOk returns a tuple with the HeadSha field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *GitStatus) GetHeadShaOk() (*string, bool) {
+	if o == nil || IsNil(o.HeadSha) {
+		return nil, false
+	}
+	return o.HeadSha, true
+}
+
+// HasHeadSha returns a boolean if a field has been set.
+func (o *GitStatus) HasHeadSha() bool {
+	if o != nil && !IsNil(o.HeadSha) {
+		return true
+	}
+
+	return false
+}
+
+// SetHeadSha gets a reference to the given string and assigns it to the HeadSha field.
+func (o *GitStatus) SetHeadSha(v string) {
+	o.HeadSha = &v
+}
+
 func (o GitStatus) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -120,6 +153,9 @@ func (o GitStatus) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.FileStatus) {
 		toSerialize["fileStatus"] = o.FileStatus
 	}
+	if !IsNil(o.HeadSha) {
+		toSerialize["headSha"] = o.HeadSha
+	}
 	return toSerialize, nil
 }
 
@@ -19,6 +19,7 @@ var _ MappedNullable = &InstallProviderRequest{}
 
 // InstallProviderRequest struct for InstallProviderRequest
 type InstallProviderRequest struct {
+	Checksums    *map[string]string `json:"checksums,omitempty"`
 	DownloadUrls *map[string]string `json:"downloadUrls,omitempty"`
 	Name         *string            `json:"name,omitempty"`
 }
@@ -40,6 +41,38 @@ func NewInstallProviderRequestWithDefaults() *InstallProviderRequest {
 	return &this
 }
 
+// GetChecksums returns the Checksums field value if set, zero value otherwise.
+func (o *InstallProviderRequest) GetChecksums() map[string]string {
+	if o == nil || IsNil(o.Checksums) {
+		var ret map[string]string
+		return ret
+	}
+	return *o.Checksums
+}
+
+// GetChecksumsOk returns a tuple with the Checksums field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *InstallProviderRequest) GetChecksumsOk() (*map[string]string, bool) {
+	if o == nil || IsNil(o.Checksums) {
+		return nil, false
+	}
+	return o.Checksums, true
+}
+
+// HasChecksums returns a boolean if a field has been set.
+func (o *InstallProviderRequest) HasChecksums() bool {
+	if o != nil && !IsNil(o.Checksums) {
+		return true
+	}
+
+	return false
+}
+
+// SetChecksums gets a reference to the given map[string]string and assigns it to the Checksums field.
+func (o *InstallProviderRequest) SetChecksums(v map[string]string) {
+	o.Checksums = &v
+}
+
 // GetDownloadUrls returns the DownloadUrls field value if set, zero value otherwise.
 func (o *InstallProviderRequest) GetDownloadUrls() map[string]string {
 	if o == nil || IsNil(o.DownloadUrls) {
@@ -114,6 +147,9 @@ func (o InstallProviderRequest) MarshalJSON() ([]byte, error) {
 
 func (o InstallProviderRequest) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.Checksums) {
+		toSerialize["checksums"] = o.Checksums
+	}
 	if !IsNil(o.DownloadUrls) {
 		toSerialize["downloadUrls"] = o.DownloadUrls
 	}
@@ -19,7 +19,9 @@ var _ MappedNullable = &NetworkKey{}
 
 // NetworkKey struct for NetworkKey
 type NetworkKey struct {
-	Key *string `json:"key,omitempty"`
+	// Set if the key belongs to an external tailnet control server
+	ControlUrl *string `json:"controlUrl,omitempty"`
+	Key        *string `json:"key,omitempty"`
 }
 
 // NewNetworkKey instantiates a new NetworkKey object
@@ -39,6 +41,38 @@ func NewNetworkKeyWithDefaults() *NetworkKey {
 	return &this
 }
 
+// GetControlUrl returns the ControlUrl field value if set, zero value otherwise.
+func (o *NetworkKey) GetControlUrl() string {
+	if o == nil || IsNil(o.ControlUrl) {
+		var ret string
+		return ret
+	}
+	return *o.ControlUrl
+}
+
+// GetControlUrlOk returns a tuple with the ControlUrl field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *NetworkKey) GetControlUrlOk() (*string, bool) {
+	if o == nil || IsNil(o.ControlUrl) {
+		return nil, false
+	}
+	return o.ControlUrl, true
+}
+
+// HasControlUrl returns a boolean if a field has been set.
+func (o *NetworkKey) HasControlUrl() bool {
+	if o != nil && !IsNil(o.ControlUrl) {
+		return true
+	}
+
+	return false
+}
+
+// SetControlUrl gets a reference to the given string and assigns it to the ControlUrl field.
+func (o *NetworkKey) SetControlUrl(v string) {
+	o.ControlUrl = &v
+}
+
 // GetKey returns the Key field value if set, zero value otherwise.
 func (o *NetworkKey) GetKey() string {
 	if o == nil || IsNil(o.Key) {
@@ -81,6 +115,9 @@ func (o NetworkKey) MarshalJSON() ([]byte, error) {
 
 func (o NetworkKey) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.ControlUrl) {
+		toSerialize["controlUrl"] = o.ControlUrl
+	}
 	if !IsNil(o.Key) {
 		toSerialize["key"] = o.Key
 	}
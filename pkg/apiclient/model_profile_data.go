@@ -19,7 +19,8 @@ var _ MappedNullable = &ProfileData{}
 
 // ProfileData struct for ProfileData
 type ProfileData struct {
-	EnvVars *map[string]string `json:"envVars,omitempty"`
+	EnvVars     *map[string]string `json:"envVars,omitempty"`
+	GitIdentity *GitIdentity       `json:"gitIdentity,omitempty"`
 }
 
 // NewProfileData instantiates a new ProfileData object
@@ -71,6 +72,38 @@ func (o *ProfileData) SetEnvVars(v map[string]string) {
 	o.EnvVars = &v
 }
 
+// GetGitIdentity returns the GitIdentity field value if set, zero value otherwise.
+func (o *ProfileData) GetGitIdentity() GitIdentity {
+	if o == nil || IsNil(o.GitIdentity) {
+		var ret GitIdentity
+		return ret
+	}
+	return *o.GitIdentity
+}
+
+// GetGitIdentityOk returns a tuple with the GitIdentity field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProfileData) GetGitIdentityOk() (*GitIdentity, bool) {
+	if o == nil || IsNil(o.GitIdentity) {
+		return nil, false
+	}
+	return o.GitIdentity, true
+}
+
+// HasGitIdentity returns a boolean if a field has been set.
+func (o *ProfileData) HasGitIdentity() bool {
+	if o != nil && !IsNil(o.GitIdentity) {
+		return true
+	}
+
+	return false
+}
+
+// SetGitIdentity gets a reference to the given GitIdentity and assigns it to the GitIdentity field.
+func (o *ProfileData) SetGitIdentity(v GitIdentity) {
+	o.GitIdentity = &v
+}
+
 func (o ProfileData) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -84,6 +117,9 @@ func (o ProfileData) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.EnvVars) {
 		toSerialize["envVars"] = o.EnvVars
 	}
+	if !IsNil(o.GitIdentity) {
+		toSerialize["gitIdentity"] = o.GitIdentity
+	}
 	return toSerialize, nil
 }
 
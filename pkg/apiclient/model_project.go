@@ -19,16 +19,36 @@ var _ MappedNullable = &Project{}
 
 // Project struct for Project
 type Project struct {
-	Build              *ProjectBuild  `json:"build,omitempty"`
-	Image              *string        `json:"image,omitempty"`
-	Name               *string        `json:"name,omitempty"`
-	PostCreateCommands []string       `json:"postCreateCommands,omitempty"`
-	PostStartCommands  []string       `json:"postStartCommands,omitempty"`
-	Repository         *GitRepository `json:"repository,omitempty"`
-	State              *ProjectState  `json:"state,omitempty"`
-	Target             *string        `json:"target,omitempty"`
-	User               *string        `json:"user,omitempty"`
-	WorkspaceId        *string        `json:"workspaceId,omitempty"`
+	Build *ProjectBuild `json:"build,omitempty"`
+	// Names of the workspace projects that are started and healthy before the project is started
+	DependsOn []string `json:"dependsOn,omitempty"`
+	// Digest reference (name@sha256:...) of the build image pushed to the export registry
+	ExportedImage *string `json:"exportedImage,omitempty"`
+	// Git provider config used for the repository. Resolved from the repository URL if empty
+	GitProviderConfigId *string `json:"gitProviderConfigId,omitempty"`
+	Image               *string `json:"image,omitempty"`
+	Name                *string `json:"name,omitempty"`
+	// Ports forwarded by the server while the project is running
+	PortForwards       []ProjectPortForward `json:"portForwards,omitempty"`
+	PostCreateCommands []string             `json:"postCreateCommands,omitempty"`
+	PostStartCommands  []string             `json:"postStartCommands,omitempty"`
+	// Set if the project image is built from its build config during workspace creation
+	Prebuild *ProjectPrebuild `json:"prebuild,omitempty"`
+	// Terminal sessions of SSH connections to the project are recorded by the agent and uploaded to the server
+	RecordSessions *bool          `json:"recordSessions,omitempty"`
+	Repository     *GitRepository `json:"repository,omitempty"`
+	State          *ProjectState  `json:"state,omitempty"`
+	// Time (RFC3339) the status was last verified with the provider
+	StateLastVerifiedAt *string `json:"stateLastVerifiedAt,omitempty"`
+	// Last known state of the project container or VM. Updated by the server and verified against the provider periodically
+	Status *ProjectStatus `json:"status,omitempty"`
+	// Reason the project is in the error status
+	StatusError *string `json:"statusError,omitempty"`
+	Target      *string `json:"target,omitempty"`
+	User        *string `json:"user,omitempty"`
+	WorkspaceId *string `json:"workspaceId,omitempty"`
+	// The repository is cloned as a bare repository and branches are checked out as worktrees in the project directory
+	WorktreeMode *bool `json:"worktreeMode,omitempty"`
 }
 
 // NewProject instantiates a new Project object
@@ -80,6 +100,102 @@ func (o *Project) SetBuild(v ProjectBuild) {
 	o.Build = &v
 }
 
+// GetDependsOn returns the DependsOn field value if set, zero value otherwise.
+func (o *Project) GetDependsOn() []string {
+	if o == nil || IsNil(o.DependsOn) {
+		var ret []string
+		return ret
+	}
+	return o.DependsOn
+}
+
+// GetDependsOnOk returns a tuple with the DependsOn field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetDependsOnOk() ([]string, bool) {
+	if o == nil || IsNil(o.DependsOn) {
+		return nil, false
+	}
+	return o.DependsOn, true
+}
+
+// HasDependsOn returns a boolean if a field has been set.
+func (o *Project) HasDependsOn() bool {
+	if o != nil && !IsNil(o.DependsOn) {
+		return true
+	}
+
+	return false
+}
+
+// SetDependsOn gets a reference to the given []string and assigns it to the DependsOn field.
+func (o *Project) SetDependsOn(v []string) {
+	o.DependsOn = v
+}
+
+// GetExportedImage returns the ExportedImage field value if set, zero value otherwise.
+func (o *Project) GetExportedImage() string {
+	if o == nil || IsNil(o.ExportedImage) {
+		var ret string
+		return ret
+	}
+	return *o.ExportedImage
+}
+
+// GetExportedImageOk returns a tuple with the ExportedImage field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetExportedImageOk() (*string, bool) {
+	if o == nil || IsNil(o.ExportedImage) {
+		return nil, false
+	}
+	return o.ExportedImage, true
+}
+
+// HasExportedImage returns a boolean if a field has been set.
+func (o *Project) HasExportedImage() bool {
+	if o != nil && !IsNil(o.ExportedImage) {
+		return true
+	}
+
+	return false
+}
+
+// SetExportedImage gets a reference to the given string and assigns it to the ExportedImage field.
+func (o *Project) SetExportedImage(v string) {
+	o.ExportedImage = &v
+}
+
+// GetGitProviderConfigId returns the GitProviderConfigId field value if set, zero value otherwise.
+func (o *Project) GetGitProviderConfigId() string {
+	if o == nil || IsNil(o.GitProviderConfigId) {
+		var ret string
+		return ret
+	}
+	return *o.GitProviderConfigId
+}
+
+// GetGitProviderConfigIdOk returns a tuple with the GitProviderConfigId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetGitProviderConfigIdOk() (*string, bool) {
+	if o == nil || IsNil(o.GitProviderConfigId) {
+		return nil, false
+	}
+	return o.GitProviderConfigId, true
+}
+
+// HasGitProviderConfigId returns a boolean if a field has been set.
+func (o *Project) HasGitProviderConfigId() bool {
+	if o != nil && !IsNil(o.GitProviderConfigId) {
+		return true
+	}
+
+	return false
+}
+
+// SetGitProviderConfigId gets a reference to the given string and assigns it to the GitProviderConfigId field.
+func (o *Project) SetGitProviderConfigId(v string) {
+	o.GitProviderConfigId = &v
+}
+
 // GetImage returns the Image field value if set, zero value otherwise.
 func (o *Project) GetImage() string {
 	if o == nil || IsNil(o.Image) {
@@ -144,6 +260,38 @@ func (o *Project) SetName(v string) {
 	o.Name = &v
 }
 
+// GetPortForwards returns the PortForwards field value if set, zero value otherwise.
+func (o *Project) GetPortForwards() []ProjectPortForward {
+	if o == nil || IsNil(o.PortForwards) {
+		var ret []ProjectPortForward
+		return ret
+	}
+	return o.PortForwards
+}
+
+// GetPortForwardsOk returns a tuple with the PortForwards field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetPortForwardsOk() ([]ProjectPortForward, bool) {
+	if o == nil || IsNil(o.PortForwards) {
+		return nil, false
+	}
+	return o.PortForwards, true
+}
+
+// HasPortForwards returns a boolean if a field has been set.
+func (o *Project) HasPortForwards() bool {
+	if o != nil && !IsNil(o.PortForwards) {
+		return true
+	}
+
+	return false
+}
+
+// SetPortForwards gets a reference to the given []ProjectPortForward and assigns it to the PortForwards field.
+func (o *Project) SetPortForwards(v []ProjectPortForward) {
+	o.PortForwards = v
+}
+
 // GetPostCreateCommands returns the PostCreateCommands field value if set, zero value otherwise.
 func (o *Project) GetPostCreateCommands() []string {
 	if o == nil || IsNil(o.PostCreateCommands) {
@@ -208,6 +356,70 @@ func (o *Project) SetPostStartCommands(v []string) {
 	o.PostStartCommands = v
 }
 
+// GetPrebuild returns the Prebuild field value if set, zero value otherwise.
+func (o *Project) GetPrebuild() ProjectPrebuild {
+	if o == nil || IsNil(o.Prebuild) {
+		var ret ProjectPrebuild
+		return ret
+	}
+	return *o.Prebuild
+}
+
+// GetPrebuildOk returns a tuple with the Prebuild field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetPrebuildOk() (*ProjectPrebuild, bool) {
+	if o == nil || IsNil(o.Prebuild) {
+		return nil, false
+	}
+	return o.Prebuild, true
+}
+
+// HasPrebuild returns a boolean if a field has been set.
+func (o *Project) HasPrebuild() bool {
+	if o != nil && !IsNil(o.Prebuild) {
+		return true
+	}
+
+	return false
+}
+
+// SetPrebuild gets a reference to the given ProjectPrebuild and assigns it to the Prebuild field.
+func (o *Project) SetPrebuild(v ProjectPrebuild) {
+	o.Prebuild = &v
+}
+
+// GetRecordSessions returns the RecordSessions field value if set, zero value otherwise.
+func (o *Project) GetRecordSessions() bool {
+	if o == nil || IsNil(o.RecordSessions) {
+		var ret bool
+		return ret
+	}
+	return *o.RecordSessions
+}
+
+// GetRecordSessionsOk returns a tuple with the RecordSessions field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetRecordSessionsOk() (*bool, bool) {
+	if o == nil || IsNil(o.RecordSessions) {
+		return nil, false
+	}
+	return o.RecordSessions, true
+}
+
+// HasRecordSessions returns a boolean if a field has been set.
+func (o *Project) HasRecordSessions() bool {
+	if o != nil && !IsNil(o.RecordSessions) {
+		return true
+	}
+
+	return false
+}
+
+// SetRecordSessions gets a reference to the given bool and assigns it to the RecordSessions field.
+func (o *Project) SetRecordSessions(v bool) {
+	o.RecordSessions = &v
+}
+
 // GetRepository returns the Repository field value if set, zero value otherwise.
 func (o *Project) GetRepository() GitRepository {
 	if o == nil || IsNil(o.Repository) {
@@ -272,6 +484,102 @@ func (o *Project) SetState(v ProjectState) {
 	o.State = &v
 }
 
+// GetStateLastVerifiedAt returns the StateLastVerifiedAt field value if set, zero value otherwise.
+func (o *Project) GetStateLastVerifiedAt() string {
+	if o == nil || IsNil(o.StateLastVerifiedAt) {
+		var ret string
+		return ret
+	}
+	return *o.StateLastVerifiedAt
+}
+
+// GetStateLastVerifiedAtOk returns a tuple with the StateLastVerifiedAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetStateLastVerifiedAtOk() (*string, bool) {
+	if o == nil || IsNil(o.StateLastVerifiedAt) {
+		return nil, false
+	}
+	return o.StateLastVerifiedAt, true
+}
+
+// HasStateLastVerifiedAt returns a boolean if a field has been set.
+func (o *Project) HasStateLastVerifiedAt() bool {
+	if o != nil && !IsNil(o.StateLastVerifiedAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetStateLastVerifiedAt gets a reference to the given string and assigns it to the StateLastVerifiedAt field.
+func (o *Project) SetStateLastVerifiedAt(v string) {
+	o.StateLastVerifiedAt = &v
+}
+
+// GetStatus returns the Status field value if set, zero value otherwise.
+func (o *Project) GetStatus() ProjectStatus {
+	if o == nil || IsNil(o.Status) {
+		var ret ProjectStatus
+		return ret
+	}
+	return *o.Status
+}
+
+// GetStatusOk returns a tuple with the Status field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetStatusOk() (*ProjectStatus, bool) {
+	if o == nil || IsNil(o.Status) {
+		return nil, false
+	}
+	return o.Status, true
+}
+
+// HasStatus returns a boolean if a field has been set.
+func (o *Project) HasStatus() bool {
+	if o != nil && !IsNil(o.Status) {
+		return true
+	}
+
+	return false
+}
+
+// SetStatus gets a reference to the given ProjectStatus and assigns it to the Status field.
+func (o *Project) SetStatus(v ProjectStatus) {
+	o.Status = &v
+}
+
+// GetStatusError returns the StatusError field value if set, zero value otherwise.
+func (o *Project) GetStatusError() string {
+	if o == nil || IsNil(o.StatusError) {
+		var ret string
+		return ret
+	}
+	return *o.StatusError
+}
+
+// GetStatusErrorOk returns a tuple with the StatusError field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetStatusErrorOk() (*string, bool) {
+	if o == nil || IsNil(o.StatusError) {
+		return nil, false
+	}
+	return o.StatusError, true
+}
+
+// HasStatusError returns a boolean if a field has been set.
+func (o *Project) HasStatusError() bool {
+	if o != nil && !IsNil(o.StatusError) {
+		return true
+	}
+
+	return false
+}
+
+// SetStatusError gets a reference to the given string and assigns it to the StatusError field.
+func (o *Project) SetStatusError(v string) {
+	o.StatusError = &v
+}
+
 // GetTarget returns the Target field value if set, zero value otherwise.
 func (o *Project) GetTarget() string {
 	if o == nil || IsNil(o.Target) {
@@ -368,6 +676,38 @@ func (o *Project) SetWorkspaceId(v string) {
 	o.WorkspaceId = &v
 }
 
+// GetWorktreeMode returns the WorktreeMode field value if set, zero value otherwise.
+func (o *Project) GetWorktreeMode() bool {
+	if o == nil || IsNil(o.WorktreeMode) {
+		var ret bool
+		return ret
+	}
+	return *o.WorktreeMode
+}
+
+// GetWorktreeModeOk returns a tuple with the WorktreeMode field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Project) GetWorktreeModeOk() (*bool, bool) {
+	if o == nil || IsNil(o.WorktreeMode) {
+		return nil, false
+	}
+	return o.WorktreeMode, true
+}
+
+// HasWorktreeMode returns a boolean if a field has been set.
+func (o *Project) HasWorktreeMode() bool {
+	if o != nil && !IsNil(o.WorktreeMode) {
+		return true
+	}
+
+	return false
+}
+
+// SetWorktreeMode gets a reference to the given bool and assigns it to the WorktreeMode field.
+func (o *Project) SetWorktreeMode(v bool) {
+	o.WorktreeMode = &v
+}
+
 func (o Project) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -381,24 +721,51 @@ func (o Project) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Build) {
 		toSerialize["build"] = o.Build
 	}
+	if !IsNil(o.DependsOn) {
+		toSerialize["dependsOn"] = o.DependsOn
+	}
+	if !IsNil(o.ExportedImage) {
+		toSerialize["exportedImage"] = o.ExportedImage
+	}
+	if !IsNil(o.GitProviderConfigId) {
+		toSerialize["gitProviderConfigId"] = o.GitProviderConfigId
+	}
 	if !IsNil(o.Image) {
 		toSerialize["image"] = o.Image
 	}
 	if !IsNil(o.Name) {
 		toSerialize["name"] = o.Name
 	}
+	if !IsNil(o.PortForwards) {
+		toSerialize["portForwards"] = o.PortForwards
+	}
 	if !IsNil(o.PostCreateCommands) {
 		toSerialize["postCreateCommands"] = o.PostCreateCommands
 	}
 	if !IsNil(o.PostStartCommands) {
 		toSerialize["postStartCommands"] = o.PostStartCommands
 	}
+	if !IsNil(o.Prebuild) {
+		toSerialize["prebuild"] = o.Prebuild
+	}
+	if !IsNil(o.RecordSessions) {
+		toSerialize["recordSessions"] = o.RecordSessions
+	}
 	if !IsNil(o.Repository) {
 		toSerialize["repository"] = o.Repository
 	}
 	if !IsNil(o.State) {
 		toSerialize["state"] = o.State
 	}
+	if !IsNil(o.StateLastVerifiedAt) {
+		toSerialize["stateLastVerifiedAt"] = o.StateLastVerifiedAt
+	}
+	if !IsNil(o.Status) {
+		toSerialize["status"] = o.Status
+	}
+	if !IsNil(o.StatusError) {
+		toSerialize["statusError"] = o.StatusError
+	}
 	if !IsNil(o.Target) {
 		toSerialize["target"] = o.Target
 	}
@@ -408,6 +775,9 @@ func (o Project) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.WorkspaceId) {
 		toSerialize["workspaceId"] = o.WorkspaceId
 	}
+	if !IsNil(o.WorktreeMode) {
+		toSerialize["worktreeMode"] = o.WorktreeMode
+	}
 	return toSerialize, nil
 }
 
@@ -20,6 +20,7 @@ var _ MappedNullable = &ProjectBuild{}
 // ProjectBuild struct for ProjectBuild
 type ProjectBuild struct {
 	Devcontainer *ProjectBuildDevcontainer `json:"devcontainer,omitempty"`
+	Export       *ProjectBuildExport       `json:"export,omitempty"`
 }
 
 // NewProjectBuild instantiates a new ProjectBuild object
@@ -71,6 +72,38 @@ func (o *ProjectBuild) SetDevcontainer(v ProjectBuildDevcontainer) {
 	o.Devcontainer = &v
 }
 
+// GetExport returns the Export field value if set, zero value otherwise.
+func (o *ProjectBuild) GetExport() ProjectBuildExport {
+	if o == nil || IsNil(o.Export) {
+		var ret ProjectBuildExport
+		return ret
+	}
+	return *o.Export
+}
+
+// GetExportOk returns a tuple with the Export field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectBuild) GetExportOk() (*ProjectBuildExport, bool) {
+	if o == nil || IsNil(o.Export) {
+		return nil, false
+	}
+	return o.Export, true
+}
+
+// HasExport returns a boolean if a field has been set.
+func (o *ProjectBuild) HasExport() bool {
+	if o != nil && !IsNil(o.Export) {
+		return true
+	}
+
+	return false
+}
+
+// SetExport gets a reference to the given ProjectBuildExport and assigns it to the Export field.
+func (o *ProjectBuild) SetExport(v ProjectBuildExport) {
+	o.Export = &v
+}
+
 func (o ProjectBuild) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -84,6 +117,9 @@ func (o ProjectBuild) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Devcontainer) {
 		toSerialize["devcontainer"] = o.Devcontainer
 	}
+	if !IsNil(o.Export) {
+		toSerialize["export"] = o.Export
+	}
 	return toSerialize, nil
 }
 
@@ -20,8 +20,13 @@ var _ MappedNullable = &ProjectState{}
 // ProjectState struct for ProjectState
 type ProjectState struct {
 	GitStatus *GitStatus `json:"gitStatus,omitempty"`
-	UpdatedAt *string    `json:"updatedAt,omitempty"`
-	Uptime    *int32     `json:"uptime,omitempty"`
+	// Primary language of the project sources detected by the agent after cloning (e.g. go, typescript)
+	Language  *string           `json:"language,omitempty"`
+	Resources *ProjectResources `json:"resources,omitempty"`
+	UpdatedAt *string           `json:"updatedAt,omitempty"`
+	Uptime    *int32            `json:"uptime,omitempty"`
+	// Markdown content of the project welcome file (.daytona/welcome.md)
+	Welcome *string `json:"welcome,omitempty"`
 }
 
 // NewProjectState instantiates a new ProjectState object
@@ -73,6 +78,70 @@ func (o *ProjectState) SetGitStatus(v GitStatus) {
 	o.GitStatus = &v
 }
 
+// GetLanguage returns the Language field value if set, zero value otherwise.
+func (o *ProjectState) GetLanguage() string {
+	if o == nil || IsNil(o.Language) {
+		var ret string
+		return ret
+	}
+	return *o.Language
+}
+
+// GetLanguageOk returns a tuple with the Language field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectState) GetLanguageOk() (*string, bool) {
+	if o == nil || IsNil(o.Language) {
+		return nil, false
+	}
+	return o.Language, true
+}
+
+// HasLanguage returns a boolean if a field has been set.
+func (o *ProjectState) HasLanguage() bool {
+	if o != nil && !IsNil(o.Language) {
+		return true
+	}
+
+	return false
+}
+
+// SetLanguage gets a reference to the given string and assigns it to the Language field.
+func (o *ProjectState) SetLanguage(v string) {
+	o.Language = &v
+}
+
+// GetResources returns the Resources field value if set, zero value otherwise.
+func (o *ProjectState) GetResources() ProjectResources {
+	if o == nil || IsNil(o.Resources) {
+		var ret ProjectResources
+		return ret
+	}
+	return *o.Resources
+}
+
+// GetResourcesOk returns a tuple with the Resources field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectState) GetResourcesOk() (*ProjectResources, bool) {
+	if o == nil || IsNil(o.Resources) {
+		return nil, false
+	}
+	return o.Resources, true
+}
+
+// HasResources returns a boolean if a field has been set.
+func (o *ProjectState) HasResources() bool {
+	if o != nil && !IsNil(o.Resources) {
+		return true
+	}
+
+	return false
+}
+
+// SetResources gets a reference to the given ProjectResources and assigns it to the Resources field.
+func (o *ProjectState) SetResources(v ProjectResources) {
+	o.Resources = &v
+}
+
 // GetUpdatedAt returns the UpdatedAt field value if set, zero value otherwise.
 func (o *ProjectState) GetUpdatedAt() string {
 	if o == nil || IsNil(o.UpdatedAt) {
@@ -137,6 +206,38 @@ func (o *ProjectState) SetUptime(v int32) {
 	o.Uptime = &v
 }
 
+// GetWelcome returns the Welcome field value if set, zero value otherwise.
+func (o *ProjectState) GetWelcome() string {
+	if o == nil || IsNil(o.Welcome) {
+		var ret string
+		return ret
+	}
+	return *o.Welcome
+}
+
+// GetWelcomeOk returns a tuple with the Welcome field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProjectState) GetWelcomeOk() (*string, bool) {
+	if o == nil || IsNil(o.Welcome) {
+		return nil, false
+	}
+	return o.Welcome, true
+}
+
+// HasWelcome returns a boolean if a field has been set.
+func (o *ProjectState) HasWelcome() bool {
+	if o != nil && !IsNil(o.Welcome) {
+		return true
+	}
+
+	return false
+}
+
+// SetWelcome gets a reference to the given string and assigns it to the Welcome field.
+func (o *ProjectState) SetWelcome(v string) {
+	o.Welcome = &v
+}
+
 func (o ProjectState) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -150,12 +251,21 @@ func (o ProjectState) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.GitStatus) {
 		toSerialize["gitStatus"] = o.GitStatus
 	}
+	if !IsNil(o.Language) {
+		toSerialize["language"] = o.Language
+	}
+	if !IsNil(o.Resources) {
+		toSerialize["resources"] = o.Resources
+	}
 	if !IsNil(o.UpdatedAt) {
 		toSerialize["updatedAt"] = o.UpdatedAt
 	}
 	if !IsNil(o.Uptime) {
 		toSerialize["uptime"] = o.Uptime
 	}
+	if !IsNil(o.Welcome) {
+		toSerialize["welcome"] = o.Welcome
+	}
 	return toSerialize, nil
 }
 
@@ -19,8 +19,10 @@ var _ MappedNullable = &Provider{}
 
 // Provider struct for Provider
 type Provider struct {
-	Name    *string `json:"name,omitempty"`
-	Version *string `json:"version,omitempty"`
+	// Set if the provider was installed from a file instead of the registry
+	InstalledFromFile *bool   `json:"installedFromFile,omitempty"`
+	Name              *string `json:"name,omitempty"`
+	Version           *string `json:"version,omitempty"`
 }
 
 // NewProvider instantiates a new Provider object
@@ -40,6 +42,38 @@ func NewProviderWithDefaults() *Provider {
 	return &this
 }
 
+// GetInstalledFromFile returns the InstalledFromFile field value if set, zero value otherwise.
+func (o *Provider) GetInstalledFromFile() bool {
+	if o == nil || IsNil(o.InstalledFromFile) {
+		var ret bool
+		return ret
+	}
+	return *o.InstalledFromFile
+}
+
+// GetInstalledFromFileOk returns a tuple with the InstalledFromFile field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Provider) GetInstalledFromFileOk() (*bool, bool) {
+	if o == nil || IsNil(o.InstalledFromFile) {
+		return nil, false
+	}
+	return o.InstalledFromFile, true
+}
+
+// HasInstalledFromFile returns a boolean if a field has been set.
+func (o *Provider) HasInstalledFromFile() bool {
+	if o != nil && !IsNil(o.InstalledFromFile) {
+		return true
+	}
+
+	return false
+}
+
+// SetInstalledFromFile gets a reference to the given bool and assigns it to the InstalledFromFile field.
+func (o *Provider) SetInstalledFromFile(v bool) {
+	o.InstalledFromFile = &v
+}
+
 // GetName returns the Name field value if set, zero value otherwise.
 func (o *Provider) GetName() string {
 	if o == nil || IsNil(o.Name) {
@@ -114,6 +148,9 @@ func (o Provider) MarshalJSON() ([]byte, error) {
 
 func (o Provider) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.InstalledFromFile) {
+		toSerialize["installedFromFile"] = o.InstalledFromFile
+	}
 	if !IsNil(o.Name) {
 		toSerialize["name"] = o.Name
 	}
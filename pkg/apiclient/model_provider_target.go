@@ -20,8 +20,13 @@ var _ MappedNullable = &ProviderTarget{}
 // ProviderTarget struct for ProviderTarget
 type ProviderTarget struct {
 	Name *string `json:"name,omitempty"`
+	// Overrides the server network mode for projects created on the target
+	NetworkMode   *NetworkMode   `json:"networkMode,omitempty"`
+	NetworkPolicy *NetworkPolicy `json:"networkPolicy,omitempty"`
 	// JSON encoded map of options
-	Options      *string               `json:"options,omitempty"`
+	Options *string `json:"options,omitempty"`
+	// ID of the user who set the target. Targets without an owner are shared with all users and managed by admins
+	OwnerId      *string               `json:"ownerId,omitempty"`
 	ProviderInfo *ProviderProviderInfo `json:"providerInfo,omitempty"`
 }
 
@@ -74,6 +79,70 @@ func (o *ProviderTarget) SetName(v string) {
 	o.Name = &v
 }
 
+// GetNetworkMode returns the NetworkMode field value if set, zero value otherwise.
+func (o *ProviderTarget) GetNetworkMode() NetworkMode {
+	if o == nil || IsNil(o.NetworkMode) {
+		var ret NetworkMode
+		return ret
+	}
+	return *o.NetworkMode
+}
+
+// GetNetworkModeOk returns a tuple with the NetworkMode field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProviderTarget) GetNetworkModeOk() (*NetworkMode, bool) {
+	if o == nil || IsNil(o.NetworkMode) {
+		return nil, false
+	}
+	return o.NetworkMode, true
+}
+
+// HasNetworkMode returns a boolean if a field has been set.
+func (o *ProviderTarget) HasNetworkMode() bool {
+	if o != nil && !IsNil(o.NetworkMode) {
+		return true
+	}
+
+	return false
+}
+
+// SetNetworkMode gets a reference to the given NetworkMode and assigns it to the NetworkMode field.
+func (o *ProviderTarget) SetNetworkMode(v NetworkMode) {
+	o.NetworkMode = &v
+}
+
+// GetNetworkPolicy returns the NetworkPolicy field value if set, zero value otherwise.
+func (o *ProviderTarget) GetNetworkPolicy() NetworkPolicy {
+	if o == nil || IsNil(o.NetworkPolicy) {
+		var ret NetworkPolicy
+		return ret
+	}
+	return *o.NetworkPolicy
+}
+
+// GetNetworkPolicyOk returns a tuple with the NetworkPolicy field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProviderTarget) GetNetworkPolicyOk() (*NetworkPolicy, bool) {
+	if o == nil || IsNil(o.NetworkPolicy) {
+		return nil, false
+	}
+	return o.NetworkPolicy, true
+}
+
+// HasNetworkPolicy returns a boolean if a field has been set.
+func (o *ProviderTarget) HasNetworkPolicy() bool {
+	if o != nil && !IsNil(o.NetworkPolicy) {
+		return true
+	}
+
+	return false
+}
+
+// SetNetworkPolicy gets a reference to the given NetworkPolicy and assigns it to the NetworkPolicy field.
+func (o *ProviderTarget) SetNetworkPolicy(v NetworkPolicy) {
+	o.NetworkPolicy = &v
+}
+
 // GetOptions returns the Options field value if set, zero value otherwise.
 func (o *ProviderTarget) GetOptions() string {
 	if o == nil || IsNil(o.Options) {
@@ -106,6 +175,38 @@ func (o *ProviderTarget) SetOptions(v string) {
 	o.Options = &v
 }
 
+// GetOwnerId returns the OwnerId field value if set, zero value otherwise.
+func (o *ProviderTarget) GetOwnerId() string {
+	if o == nil || IsNil(o.OwnerId) {
+		var ret string
+		return ret
+	}
+	return *o.OwnerId
+}
+
+// GetOwnerIdOk returns a tuple with the OwnerId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ProviderTarget) GetOwnerIdOk() (*string, bool) {
+	if o == nil || IsNil(o.OwnerId) {
+		return nil, false
+	}
+	return o.OwnerId, true
+}
+
+// HasOwnerId returns a boolean if a field has been set.
+func (o *ProviderTarget) HasOwnerId() bool {
+	if o != nil && !IsNil(o.OwnerId) {
+		return true
+	}
+
+	return false
+}
+
+// SetOwnerId gets a reference to the given string and assigns it to the OwnerId field.
+func (o *ProviderTarget) SetOwnerId(v string) {
+	o.OwnerId = &v
+}
+
 // GetProviderInfo returns the ProviderInfo field value if set, zero value otherwise.
 func (o *ProviderTarget) GetProviderInfo() ProviderProviderInfo {
 	if o == nil || IsNil(o.ProviderInfo) {
@@ -151,9 +252,18 @@ func (o ProviderTarget) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Name) {
 		toSerialize["name"] = o.Name
 	}
+	if !IsNil(o.NetworkMode) {
+		toSerialize["networkMode"] = o.NetworkMode
+	}
+	if !IsNil(o.NetworkPolicy) {
+		toSerialize["networkPolicy"] = o.NetworkPolicy
+	}
 	if !IsNil(o.Options) {
 		toSerialize["options"] = o.Options
 	}
+	if !IsNil(o.OwnerId) {
+		toSerialize["ownerId"] = o.OwnerId
+	}
 	if !IsNil(o.ProviderInfo) {
 		toSerialize["providerInfo"] = o.ProviderInfo
 	}
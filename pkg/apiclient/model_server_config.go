@@ -19,22 +19,39 @@ var _ MappedNullable = &ServerConfig{}
 
 // ServerConfig struct for ServerConfig
 type ServerConfig struct {
-	ApiPort                         *int32      `json:"apiPort,omitempty"`
-	BinariesPath                    *string     `json:"binariesPath,omitempty"`
-	BuildImageNamespace             *string     `json:"buildImageNamespace,omitempty"`
-	BuilderImage                    *string     `json:"builderImage,omitempty"`
-	BuilderRegistryServer           *string     `json:"builderRegistryServer,omitempty"`
-	DefaultProjectImage             *string     `json:"defaultProjectImage,omitempty"`
-	DefaultProjectPostStartCommands []string    `json:"defaultProjectPostStartCommands,omitempty"`
-	DefaultProjectUser              *string     `json:"defaultProjectUser,omitempty"`
-	Frps                            *FRPSConfig `json:"frps,omitempty"`
-	HeadscalePort                   *int32      `json:"headscalePort,omitempty"`
-	Id                              *string     `json:"id,omitempty"`
-	LocalBuilderRegistryPort        *int32      `json:"localBuilderRegistryPort,omitempty"`
-	LogFilePath                     *string     `json:"logFilePath,omitempty"`
-	ProvidersDir                    *string     `json:"providersDir,omitempty"`
-	RegistryUrl                     *string     `json:"registryUrl,omitempty"`
-	ServerDownloadUrl               *string     `json:"serverDownloadUrl,omitempty"`
+	ApiPort *int32 `json:"apiPort,omitempty"`
+	// S3-compatible storage workspaces are archived to
+	ArchiveStorage      *S3Config `json:"archiveStorage,omitempty"`
+	BinariesPath        *string   `json:"binariesPath,omitempty"`
+	BuildImageNamespace *string   `json:"buildImageNamespace,omitempty"`
+	// Builds the images of projects with a build config on workspace creation, reusing the prebuilt image of the same config. Projects are created with their configured image if not set
+	BuildProjectsOnCreate           *bool               `json:"buildProjectsOnCreate,omitempty"`
+	BuilderImage                    *string             `json:"builderImage,omitempty"`
+	BuilderRegistryServer           *string             `json:"builderRegistryServer,omitempty"`
+	Database                        *DatabaseConfig     `json:"database,omitempty"`
+	DefaultProjectImage             *string             `json:"defaultProjectImage,omitempty"`
+	DefaultProjectPostStartCommands []string            `json:"defaultProjectPostStartCommands,omitempty"`
+	DefaultProjectUser              *string             `json:"defaultProjectUser,omitempty"`
+	Frps                            *FRPSConfig         `json:"frps,omitempty"`
+	HeadscalePort                   *int32              `json:"headscalePort,omitempty"`
+	Id                              *string             `json:"id,omitempty"`
+	LocalBuilderRegistryPort        *int32              `json:"localBuilderRegistryPort,omitempty"`
+	LogFilePath                     *string             `json:"logFilePath,omitempty"`
+	LogRetention                    *LogRetentionConfig `json:"logRetention,omitempty"`
+	NetworkMode                     *NetworkMode        `json:"networkMode,omitempty"`
+	// Sinks notified about workspace and build events
+	Notifications     []NotificationSink `json:"notifications,omitempty"`
+	ProvidersDir      *string            `json:"providersDir,omitempty"`
+	RateLimit         *RateLimitConfig   `json:"rateLimit,omitempty"`
+	RegistryUrl       *string            `json:"registryUrl,omitempty"`
+	ServerDownloadUrl *string            `json:"serverDownloadUrl,omitempty"`
+	// Requires SSH certificates issued by the server to connect to projects
+	SshCertificateAuthority *SshCertificateAuthorityConfig `json:"sshCertificateAuthority,omitempty"`
+	Tailnet                 *TailnetConfig                 `json:"tailnet,omitempty"`
+	// Fetch and cache the namespace and repository lists of newly added Git providers in the background
+	WarmGitProviderCache *bool `json:"warmGitProviderCache,omitempty"`
+	// Time before a workspace expires at which a warning is written to its logs and emitted as an event, e.g. 30m. Defaults to 1h
+	WorkspaceExpiryWarning *string `json:"workspaceExpiryWarning,omitempty"`
 }
 
 // NewServerConfig instantiates a new ServerConfig object
@@ -86,6 +103,38 @@ func (o *ServerConfig) SetApiPort(v int32) {
 	o.ApiPort = &v
 }
 
+// GetArchiveStorage returns the ArchiveStorage field value if set, zero value otherwise.
+func (o *ServerConfig) GetArchiveStorage() S3Config {
+	if o == nil || IsNil(o.ArchiveStorage) {
+		var ret S3Config
+		return ret
+	}
+	return *o.ArchiveStorage
+}
+
+// GetArchiveStorageOk returns a tuple with the ArchiveStorage field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetArchiveStorageOk() (*S3Config, bool) {
+	if o == nil || IsNil(o.ArchiveStorage) {
+		return nil, false
+	}
+	return o.ArchiveStorage, true
+}
+
+// HasArchiveStorage returns a boolean if a field has been set.
+func (o *ServerConfig) HasArchiveStorage() bool {
+	if o != nil && !IsNil(o.ArchiveStorage) {
+		return true
+	}
+
+	return false
+}
+
+// SetArchiveStorage gets a reference to the given S3Config and assigns it to the ArchiveStorage field.
+func (o *ServerConfig) SetArchiveStorage(v S3Config) {
+	o.ArchiveStorage = &v
+}
+
 // GetBinariesPath returns the BinariesPath field value if set, zero value otherwise.
 func (o *ServerConfig) GetBinariesPath() string {
 	if o == nil || IsNil(o.BinariesPath) {
@@ -150,6 +199,38 @@ func (o *ServerConfig) SetBuildImageNamespace(v string) {
 	o.BuildImageNamespace = &v
 }
 
+// GetBuildProjectsOnCreate returns the BuildProjectsOnCreate field value if set, zero value otherwise.
+func (o *ServerConfig) GetBuildProjectsOnCreate() bool {
+	if o == nil || IsNil(o.BuildProjectsOnCreate) {
+		var ret bool
+		return ret
+	}
+	return *o.BuildProjectsOnCreate
+}
+
+// GetBuildProjectsOnCreateOk returns a tuple with the BuildProjectsOnCreate field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetBuildProjectsOnCreateOk() (*bool, bool) {
+	if o == nil || IsNil(o.BuildProjectsOnCreate) {
+		return nil, false
+	}
+	return o.BuildProjectsOnCreate, true
+}
+
+// HasBuildProjectsOnCreate returns a boolean if a field has been set.
+func (o *ServerConfig) HasBuildProjectsOnCreate() bool {
+	if o != nil && !IsNil(o.BuildProjectsOnCreate) {
+		return true
+	}
+
+	return false
+}
+
+// SetBuildProjectsOnCreate gets a reference to the given bool and assigns it to the BuildProjectsOnCreate field.
+func (o *ServerConfig) SetBuildProjectsOnCreate(v bool) {
+	o.BuildProjectsOnCreate = &v
+}
+
 // GetBuilderImage returns the BuilderImage field value if set, zero value otherwise.
 func (o *ServerConfig) GetBuilderImage() string {
 	if o == nil || IsNil(o.BuilderImage) {
@@ -214,6 +295,38 @@ func (o *ServerConfig) SetBuilderRegistryServer(v string) {
 	o.BuilderRegistryServer = &v
 }
 
+// GetDatabase returns the Database field value if set, zero value otherwise.
+func (o *ServerConfig) GetDatabase() DatabaseConfig {
+	if o == nil || IsNil(o.Database) {
+		var ret DatabaseConfig
+		return ret
+	}
+	return *o.Database
+}
+
+// GetDatabaseOk returns a tuple with the Database field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetDatabaseOk() (*DatabaseConfig, bool) {
+	if o == nil || IsNil(o.Database) {
+		return nil, false
+	}
+	return o.Database, true
+}
+
+// HasDatabase returns a boolean if a field has been set.
+func (o *ServerConfig) HasDatabase() bool {
+	if o != nil && !IsNil(o.Database) {
+		return true
+	}
+
+	return false
+}
+
+// SetDatabase gets a reference to the given DatabaseConfig and assigns it to the Database field.
+func (o *ServerConfig) SetDatabase(v DatabaseConfig) {
+	o.Database = &v
+}
+
 // GetDefaultProjectImage returns the DefaultProjectImage field value if set, zero value otherwise.
 func (o *ServerConfig) GetDefaultProjectImage() string {
 	if o == nil || IsNil(o.DefaultProjectImage) {
@@ -470,6 +583,102 @@ func (o *ServerConfig) SetLogFilePath(v string) {
 	o.LogFilePath = &v
 }
 
+// GetLogRetention returns the LogRetention field value if set, zero value otherwise.
+func (o *ServerConfig) GetLogRetention() LogRetentionConfig {
+	if o == nil || IsNil(o.LogRetention) {
+		var ret LogRetentionConfig
+		return ret
+	}
+	return *o.LogRetention
+}
+
+// GetLogRetentionOk returns a tuple with the LogRetention field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetLogRetentionOk() (*LogRetentionConfig, bool) {
+	if o == nil || IsNil(o.LogRetention) {
+		return nil, false
+	}
+	return o.LogRetention, true
+}
+
+// HasLogRetention returns a boolean if a field has been set.
+func (o *ServerConfig) HasLogRetention() bool {
+	if o != nil && !IsNil(o.LogRetention) {
+		return true
+	}
+
+	return false
+}
+
+// SetLogRetention gets a reference to the given LogRetentionConfig and assigns it to the LogRetention field.
+func (o *ServerConfig) SetLogRetention(v LogRetentionConfig) {
+	o.LogRetention = &v
+}
+
+// GetNetworkMode returns the NetworkMode field value if set, zero value otherwise.
+func (o *ServerConfig) GetNetworkMode() NetworkMode {
+	if o == nil || IsNil(o.NetworkMode) {
+		var ret NetworkMode
+		return ret
+	}
+	return *o.NetworkMode
+}
+
+// GetNetworkModeOk returns a tuple with the NetworkMode field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetNetworkModeOk() (*NetworkMode, bool) {
+	if o == nil || IsNil(o.NetworkMode) {
+		return nil, false
+	}
+	return o.NetworkMode, true
+}
+
+// HasNetworkMode returns a boolean if a field has been set.
+func (o *ServerConfig) HasNetworkMode() bool {
+	if o != nil && !IsNil(o.NetworkMode) {
+		return true
+	}
+
+	return false
+}
+
+// SetNetworkMode gets a reference to the given NetworkMode and assigns it to the NetworkMode field.
+func (o *ServerConfig) SetNetworkMode(v NetworkMode) {
+	o.NetworkMode = &v
+}
+
+// GetNotifications returns the Notifications field value if set, zero value otherwise.
+func (o *ServerConfig) GetNotifications() []NotificationSink {
+	if o == nil || IsNil(o.Notifications) {
+		var ret []NotificationSink
+		return ret
+	}
+	return o.Notifications
+}
+
+// GetNotificationsOk returns a tuple with the Notifications field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetNotificationsOk() ([]NotificationSink, bool) {
+	if o == nil || IsNil(o.Notifications) {
+		return nil, false
+	}
+	return o.Notifications, true
+}
+
+// HasNotifications returns a boolean if a field has been set.
+func (o *ServerConfig) HasNotifications() bool {
+	if o != nil && !IsNil(o.Notifications) {
+		return true
+	}
+
+	return false
+}
+
+// SetNotifications gets a reference to the given []NotificationSink and assigns it to the Notifications field.
+func (o *ServerConfig) SetNotifications(v []NotificationSink) {
+	o.Notifications = v
+}
+
 // GetProvidersDir returns the ProvidersDir field value if set, zero value otherwise.
 func (o *ServerConfig) GetProvidersDir() string {
 	if o == nil || IsNil(o.ProvidersDir) {
@@ -502,6 +711,38 @@ func (o *ServerConfig) SetProvidersDir(v string) {
 	o.ProvidersDir = &v
 }
 
+// GetRateLimit returns the RateLimit field value if set, zero value otherwise.
+func (o *ServerConfig) GetRateLimit() RateLimitConfig {
+	if o == nil || IsNil(o.RateLimit) {
+		var ret RateLimitConfig
+		return ret
+	}
+	return *o.RateLimit
+}
+
+// GetRateLimitOk returns a tuple with the RateLimit field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetRateLimitOk() (*RateLimitConfig, bool) {
+	if o == nil || IsNil(o.RateLimit) {
+		return nil, false
+	}
+	return o.RateLimit, true
+}
+
+// HasRateLimit returns a boolean if a field has been set.
+func (o *ServerConfig) HasRateLimit() bool {
+	if o != nil && !IsNil(o.RateLimit) {
+		return true
+	}
+
+	return false
+}
+
+// SetRateLimit gets a reference to the given RateLimitConfig and assigns it to the RateLimit field.
+func (o *ServerConfig) SetRateLimit(v RateLimitConfig) {
+	o.RateLimit = &v
+}
+
 // GetRegistryUrl returns the RegistryUrl field value if set, zero value otherwise.
 func (o *ServerConfig) GetRegistryUrl() string {
 	if o == nil || IsNil(o.RegistryUrl) {
@@ -566,6 +807,134 @@ func (o *ServerConfig) SetServerDownloadUrl(v string) {
 	o.ServerDownloadUrl = &v
 }
 
+// GetSshCertificateAuthority returns the SshCertificateAuthority field value if set, zero value otherwise.
+func (o *ServerConfig) GetSshCertificateAuthority() SshCertificateAuthorityConfig {
+	if o == nil || IsNil(o.SshCertificateAuthority) {
+		var ret SshCertificateAuthorityConfig
+		return ret
+	}
+	return *o.SshCertificateAuthority
+}
+
+// GetSshCertificateAuthorityOk returns a tuple with the SshCertificateAuthority field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetSshCertificateAuthorityOk() (*SshCertificateAuthorityConfig, bool) {
+	if o == nil || IsNil(o.SshCertificateAuthority) {
+		return nil, false
+	}
+	return o.SshCertificateAuthority, true
+}
+
+// HasSshCertificateAuthority returns a boolean if a field has been set.
+func (o *ServerConfig) HasSshCertificateAuthority() bool {
+	if o != nil && !IsNil(o.SshCertificateAuthority) {
+		return true
+	}
+
+	return false
+}
+
+// SetSshCertificateAuthority gets a reference to the given SshCertificateAuthorityConfig and assigns it to the SshCertificateAuthority field.
+func (o *ServerConfig) SetSshCertificateAuthority(v SshCertificateAuthorityConfig) {
+	o.SshCertificateAuthority = &v
+}
+
+// GetTailnet returns the Tailnet field value if set, zero value otherwise.
+func (o *ServerConfig) GetTailnet() TailnetConfig {
+	if o == nil || IsNil(o.Tailnet) {
+		var ret TailnetConfig
+		return ret
+	}
+	return *o.Tailnet
+}
+
+// GetTailnetOk returns a tuple with the Tailnet field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetTailnetOk() (*TailnetConfig, bool) {
+	if o == nil || IsNil(o.Tailnet) {
+		return nil, false
+	}
+	return o.Tailnet, true
+}
+
+// HasTailnet returns a boolean if a field has been set.
+func (o *ServerConfig) HasTailnet() bool {
+	if o != nil && !IsNil(o.Tailnet) {
+		return true
+	}
+
+	return false
+}
+
+// SetTailnet gets a reference to the given TailnetConfig and assigns it to the Tailnet field.
+func (o *ServerConfig) SetTailnet(v TailnetConfig) {
+	o.Tailnet = &v
+}
+
+// GetWarmGitProviderCache returns the WarmGitProviderCache field value if set, zero value otherwise.
+func (o *ServerConfig) GetWarmGitProviderCache() bool {
+	if o == nil || IsNil(o.WarmGitProviderCache) {
+		var ret bool
+		return ret
+	}
+	return *o.WarmGitProviderCache
+}
+
+// GetWarmGitProviderCacheOk returns a tuple with the WarmGitProviderCache field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetWarmGitProviderCacheOk() (*bool, bool) {
+	if o == nil || IsNil(o.WarmGitProviderCache) {
+		return nil, false
+	}
+	return o.WarmGitProviderCache, true
+}
+
+// HasWarmGitProviderCache returns a boolean if a field has been set.
+func (o *ServerConfig) HasWarmGitProviderCache() bool {
+	if o != nil && !IsNil(o.WarmGitProviderCache) {
+		return true
+	}
+
+	return false
+}
+
+// SetWarmGitProviderCache gets a reference to the given bool and assigns it to the WarmGitProviderCache field.
+func (o *ServerConfig) SetWarmGitProviderCache(v bool) {
+	o.WarmGitProviderCache = &v
+}
+
+// GetWorkspaceExpiryWarning returns the WorkspaceExpiryWarning field value if set, zero value otherwise.
+func (o *ServerConfig) GetWorkspaceExpiryWarning() string {
+	if o == nil || IsNil(o.WorkspaceExpiryWarning) {
+		var ret string
+		return ret
+	}
+	return *o.WorkspaceExpiryWarning
+}
+
+// GetWorkspaceExpiryWarningOk returns a tuple with the WorkspaceExpiryWarning field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *ServerConfig) GetWorkspaceExpiryWarningOk() (*string, bool) {
+	if o == nil || IsNil(o.WorkspaceExpiryWarning) {
+		return nil, false
+	}
+	return o.WorkspaceExpiryWarning, true
+}
+
+// HasWorkspaceExpiryWarning returns a boolean if a field has been set.
+func (o *ServerConfig) HasWorkspaceExpiryWarning() bool {
+	if o != nil && !IsNil(o.WorkspaceExpiryWarning) {
+		return true
+	}
+
+	return false
+}
+
+// SetWorkspaceExpiryWarning gets a reference to the given string and assigns it to the WorkspaceExpiryWarning field.
+func (o *ServerConfig) SetWorkspaceExpiryWarning(v string) {
+	o.WorkspaceExpiryWarning = &v
+}
+
 func (o ServerConfig) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -579,18 +948,27 @@ func (o ServerConfig) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.ApiPort) {
 		toSerialize["apiPort"] = o.ApiPort
 	}
+	if !IsNil(o.ArchiveStorage) {
+		toSerialize["archiveStorage"] = o.ArchiveStorage
+	}
 	if !IsNil(o.BinariesPath) {
 		toSerialize["binariesPath"] = o.BinariesPath
 	}
 	if !IsNil(o.BuildImageNamespace) {
 		toSerialize["buildImageNamespace"] = o.BuildImageNamespace
 	}
+	if !IsNil(o.BuildProjectsOnCreate) {
+		toSerialize["buildProjectsOnCreate"] = o.BuildProjectsOnCreate
+	}
 	if !IsNil(o.BuilderImage) {
 		toSerialize["builderImage"] = o.BuilderImage
 	}
 	if !IsNil(o.BuilderRegistryServer) {
 		toSerialize["builderRegistryServer"] = o.BuilderRegistryServer
 	}
+	if !IsNil(o.Database) {
+		toSerialize["database"] = o.Database
+	}
 	if !IsNil(o.DefaultProjectImage) {
 		toSerialize["defaultProjectImage"] = o.DefaultProjectImage
 	}
@@ -615,15 +993,39 @@ func (o ServerConfig) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.LogFilePath) {
 		toSerialize["logFilePath"] = o.LogFilePath
 	}
+	if !IsNil(o.LogRetention) {
+		toSerialize["logRetention"] = o.LogRetention
+	}
+	if !IsNil(o.NetworkMode) {
+		toSerialize["networkMode"] = o.NetworkMode
+	}
+	if !IsNil(o.Notifications) {
+		toSerialize["notifications"] = o.Notifications
+	}
 	if !IsNil(o.ProvidersDir) {
 		toSerialize["providersDir"] = o.ProvidersDir
 	}
+	if !IsNil(o.RateLimit) {
+		toSerialize["rateLimit"] = o.RateLimit
+	}
 	if !IsNil(o.RegistryUrl) {
 		toSerialize["registryUrl"] = o.RegistryUrl
 	}
 	if !IsNil(o.ServerDownloadUrl) {
 		toSerialize["serverDownloadUrl"] = o.ServerDownloadUrl
 	}
+	if !IsNil(o.SshCertificateAuthority) {
+		toSerialize["sshCertificateAuthority"] = o.SshCertificateAuthority
+	}
+	if !IsNil(o.Tailnet) {
+		toSerialize["tailnet"] = o.Tailnet
+	}
+	if !IsNil(o.WarmGitProviderCache) {
+		toSerialize["warmGitProviderCache"] = o.WarmGitProviderCache
+	}
+	if !IsNil(o.WorkspaceExpiryWarning) {
+		toSerialize["workspaceExpiryWarning"] = o.WorkspaceExpiryWarning
+	}
 	return toSerialize, nil
 }
 
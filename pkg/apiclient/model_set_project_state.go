@@ -19,8 +19,11 @@ var _ MappedNullable = &SetProjectState{}
 
 // SetProjectState struct for SetProjectState
 type SetProjectState struct {
-	GitStatus *GitStatus `json:"gitStatus,omitempty"`
-	Uptime    *int32     `json:"uptime,omitempty"`
+	GitStatus *GitStatus        `json:"gitStatus,omitempty"`
+	Language  *string           `json:"language,omitempty"`
+	Resources *ProjectResources `json:"resources,omitempty"`
+	Uptime    *int32            `json:"uptime,omitempty"`
+	Welcome   *string           `json:"welcome,omitempty"`
 }
 
 // NewSetProjectState instantiates a new SetProjectState object
@@ -72,6 +75,70 @@ func (o *SetProjectState) SetGitStatus(v GitStatus) {
 	o.GitStatus = &v
 }
 
+// GetLanguage returns the Language field value if set, zero value otherwise.
+func (o *SetProjectState) GetLanguage() string {
+	if o == nil || IsNil(o.Language) {
+		var ret string
+		return ret
+	}
+	return *o.Language
+}
+
+// GetLanguageOk returns a tuple with the Language field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SetProjectState) GetLanguageOk() (*string, bool) {
+	if o == nil || IsNil(o.Language) {
+		return nil, false
+	}
+	return o.Language, true
+}
+
+// HasLanguage returns a boolean if a field has been set.
+func (o *SetProjectState) HasLanguage() bool {
+	if o != nil && !IsNil(o.Language) {
+		return true
+	}
+
+	return false
+}
+
+// SetLanguage gets a reference to the given string and assigns it to the Language field.
+func (o *SetProjectState) SetLanguage(v string) {
+	o.Language = &v
+}
+
+// GetResources returns the Resources field value if set, zero value otherwise.
+func (o *SetProjectState) GetResources() ProjectResources {
+	if o == nil || IsNil(o.Resources) {
+		var ret ProjectResources
+		return ret
+	}
+	return *o.Resources
+}
+
+// GetResourcesOk returns a tuple with the Resources field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SetProjectState) GetResourcesOk() (*ProjectResources, bool) {
+	if o == nil || IsNil(o.Resources) {
+		return nil, false
+	}
+	return o.Resources, true
+}
+
+// HasResources returns a boolean if a field has been set.
+func (o *SetProjectState) HasResources() bool {
+	if o != nil && !IsNil(o.Resources) {
+		return true
+	}
+
+	return false
+}
+
+// SetResources gets a reference to the given ProjectResources and assigns it to the Resources field.
+func (o *SetProjectState) SetResources(v ProjectResources) {
+	o.Resources = &v
+}
+
 // GetUptime returns the Uptime field value if set, zero value otherwise.
 func (o *SetProjectState) GetUptime() int32 {
 	if o == nil || IsNil(o.Uptime) {
@@ -104,6 +171,38 @@ func (o *SetProjectState) SetUptime(v int32) {
 	o.Uptime = &v
 }
 
+// GetWelcome returns the Welcome field value if set, zero value otherwise.
+func (o *SetProjectState) GetWelcome() string {
+	if o == nil || IsNil(o.Welcome) {
+		var ret string
+		return ret
+	}
+	return *o.Welcome
+}
+
+// GetWelcomeOk returns a tuple with the Welcome field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *SetProjectState) GetWelcomeOk() (*string, bool) {
+	if o == nil || IsNil(o.Welcome) {
+		return nil, false
+	}
+	return o.Welcome, true
+}
+
+// HasWelcome returns a boolean if a field has been set.
+func (o *SetProjectState) HasWelcome() bool {
+	if o != nil && !IsNil(o.Welcome) {
+		return true
+	}
+
+	return false
+}
+
+// SetWelcome gets a reference to the given string and assigns it to the Welcome field.
+func (o *SetProjectState) SetWelcome(v string) {
+	o.Welcome = &v
+}
+
 func (o SetProjectState) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -117,9 +216,18 @@ func (o SetProjectState) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.GitStatus) {
 		toSerialize["gitStatus"] = o.GitStatus
 	}
+	if !IsNil(o.Language) {
+		toSerialize["language"] = o.Language
+	}
+	if !IsNil(o.Resources) {
+		toSerialize["resources"] = o.Resources
+	}
 	if !IsNil(o.Uptime) {
 		toSerialize["uptime"] = o.Uptime
 	}
+	if !IsNil(o.Welcome) {
+		toSerialize["welcome"] = o.Welcome
+	}
 	return toSerialize, nil
 }
 
@@ -19,10 +19,21 @@ var _ MappedNullable = &Workspace{}
 
 // Workspace struct for Workspace
 type Workspace struct {
-	Id       *string   `json:"id,omitempty"`
-	Name     *string   `json:"name,omitempty"`
+	// Set if the provider reports cost estimates
+	Cost *WorkspaceCost `json:"cost,omitempty"`
+	// Set until the workspace is created successfully
+	Creation *WorkspaceCreation `json:"creation,omitempty"`
+	// RFC3339 timestamp after which the expiry action is performed
+	ExpiresAt    *string                `json:"expiresAt,omitempty"`
+	ExpiryAction *WorkspaceExpiryAction `json:"expiryAction,omitempty"`
+	Id           *string                `json:"id,omitempty"`
+	Name         *string                `json:"name,omitempty"`
+	// ID of the user who created the workspace. Empty for workspaces created before users were introduced
+	OwnerId  *string   `json:"ownerId,omitempty"`
 	Projects []Project `json:"projects,omitempty"`
 	Target   *string   `json:"target,omitempty"`
+	// Set if the target was picked from a target group
+	TargetGroup *string `json:"targetGroup,omitempty"`
 }
 
 // NewWorkspace instantiates a new Workspace object
@@ -42,6 +53,134 @@ func NewWorkspaceWithDefaults() *Workspace {
 	return &this
 }
 
+// GetCost returns the Cost field value if set, zero value otherwise.
+func (o *Workspace) GetCost() WorkspaceCost {
+	if o == nil || IsNil(o.Cost) {
+		var ret WorkspaceCost
+		return ret
+	}
+	return *o.Cost
+}
+
+// GetCostOk returns a tuple with the Cost field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Workspace) GetCostOk() (*WorkspaceCost, bool) {
+	if o == nil || IsNil(o.Cost) {
+		return nil, false
+	}
+	return o.Cost, true
+}
+
+// HasCost returns a boolean if a field has been set.
+func (o *Workspace) HasCost() bool {
+	if o != nil && !IsNil(o.Cost) {
+		return true
+	}
+
+	return false
+}
+
+// SetCost gets a reference to the given WorkspaceCost and assigns it to the Cost field.
+func (o *Workspace) SetCost(v WorkspaceCost) {
+	o.Cost = &v
+}
+
+// GetCreation returns the Creation field value if set, zero value otherwise.
+func (o *Workspace) GetCreation() WorkspaceCreation {
+	if o == nil || IsNil(o.Creation) {
+		var ret WorkspaceCreation
+		return ret
+	}
+	return *o.Creation
+}
+
+// GetCreationOk returns a tuple with the Creation field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Workspace) GetCreationOk() (*WorkspaceCreation, bool) {
+	if o == nil || IsNil(o.Creation) {
+		return nil, false
+	}
+	return o.Creation, true
+}
+
+// HasCreation returns a boolean if a field has been set.
+func (o *Workspace) HasCreation() bool {
+	if o != nil && !IsNil(o.Creation) {
+		return true
+	}
+
+	return false
+}
+
+// SetCreation gets a reference to the given WorkspaceCreation and assigns it to the Creation field.
+func (o *Workspace) SetCreation(v WorkspaceCreation) {
+	o.Creation = &v
+}
+
+// GetExpiresAt returns the ExpiresAt field value if set, zero value otherwise.
+func (o *Workspace) GetExpiresAt() string {
+	if o == nil || IsNil(o.ExpiresAt) {
+		var ret string
+		return ret
+	}
+	return *o.ExpiresAt
+}
+
+// GetExpiresAtOk returns a tuple with the ExpiresAt field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Workspace) GetExpiresAtOk() (*string, bool) {
+	if o == nil || IsNil(o.ExpiresAt) {
+		return nil, false
+	}
+	return o.ExpiresAt, true
+}
+
+// HasExpiresAt returns a boolean if a field has been set.
+func (o *Workspace) HasExpiresAt() bool {
+	if o != nil && !IsNil(o.ExpiresAt) {
+		return true
+	}
+
+	return false
+}
+
+// SetExpiresAt gets a reference to the given string and assigns it to the ExpiresAt field.
+func (o *Workspace) SetExpiresAt(v string) {
+	o.ExpiresAt = &v
+}
+
+// GetExpiryAction returns the ExpiryAction field value if set, zero value otherwise.
+func (o *Workspace) GetExpiryAction() WorkspaceExpiryAction {
+	if o == nil || IsNil(o.ExpiryAction) {
+		var ret WorkspaceExpiryAction
+		return ret
+	}
+	return *o.ExpiryAction
+}
+
+// GetExpiryActionOk returns a tuple with the ExpiryAction field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Workspace) GetExpiryActionOk() (*WorkspaceExpiryAction, bool) {
+	if o == nil || IsNil(o.ExpiryAction) {
+		return nil, false
+	}
+	return o.ExpiryAction, true
+}
+
+// HasExpiryAction returns a boolean if a field has been set.
+func (o *Workspace) HasExpiryAction() bool {
+	if o != nil && !IsNil(o.ExpiryAction) {
+		return true
+	}
+
+	return false
+}
+
+// SetExpiryAction gets a reference to the given WorkspaceExpiryAction and assigns it to the ExpiryAction field.
+func (o *Workspace) SetExpiryAction(v WorkspaceExpiryAction) {
+	o.ExpiryAction = &v
+}
+
 // GetId returns the Id field value if set, zero value otherwise.
 func (o *Workspace) GetId() string {
 	if o == nil || IsNil(o.Id) {
@@ -106,6 +245,38 @@ func (o *Workspace) SetName(v string) {
 	o.Name = &v
 }
 
+// GetOwnerId returns the OwnerId field value if set, zero value otherwise.
+func (o *Workspace) GetOwnerId() string {
+	if o == nil || IsNil(o.OwnerId) {
+		var ret string
+		return ret
+	}
+	return *o.OwnerId
+}
+
+// GetOwnerIdOk returns a tuple with the OwnerId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Workspace) GetOwnerIdOk() (*string, bool) {
+	if o == nil || IsNil(o.OwnerId) {
+		return nil, false
+	}
+	return o.OwnerId, true
+}
+
+// HasOwnerId returns a boolean if a field has been set.
+func (o *Workspace) HasOwnerId() bool {
+	if o != nil && !IsNil(o.OwnerId) {
+		return true
+	}
+
+	return false
+}
+
+// SetOwnerId gets a reference to the given string and assigns it to the OwnerId field.
+func (o *Workspace) SetOwnerId(v string) {
+	o.OwnerId = &v
+}
+
 // GetProjects returns the Projects field value if set, zero value otherwise.
 func (o *Workspace) GetProjects() []Project {
 	if o == nil || IsNil(o.Projects) {
@@ -170,6 +341,38 @@ func (o *Workspace) SetTarget(v string) {
 	o.Target = &v
 }
 
+// GetTargetGroup returns the TargetGroup field value if set, zero value otherwise.
+func (o *Workspace) GetTargetGroup() string {
+	if o == nil || IsNil(o.TargetGroup) {
+		var ret string
+		return ret
+	}
+	return *o.TargetGroup
+}
+
+// GetTargetGroupOk returns a tuple with the TargetGroup field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Workspace) GetTargetGroupOk() (*string, bool) {
+	if o == nil || IsNil(o.TargetGroup) {
+		return nil, false
+	}
+	return o.TargetGroup, true
+}
+
+// HasTargetGroup returns a boolean if a field has been set.
+func (o *Workspace) HasTargetGroup() bool {
+	if o != nil && !IsNil(o.TargetGroup) {
+		return true
+	}
+
+	return false
+}
+
+// SetTargetGroup gets a reference to the given string and assigns it to the TargetGroup field.
+func (o *Workspace) SetTargetGroup(v string) {
+	o.TargetGroup = &v
+}
+
 func (o Workspace) MarshalJSON() ([]byte, error) {
 	toSerialize, err := o.ToMap()
 	if err != nil {
@@ -180,18 +383,36 @@ func (o Workspace) MarshalJSON() ([]byte, error) {
 
 func (o Workspace) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.Cost) {
+		toSerialize["cost"] = o.Cost
+	}
+	if !IsNil(o.Creation) {
+		toSerialize["creation"] = o.Creation
+	}
+	if !IsNil(o.ExpiresAt) {
+		toSerialize["expiresAt"] = o.ExpiresAt
+	}
+	if !IsNil(o.ExpiryAction) {
+		toSerialize["expiryAction"] = o.ExpiryAction
+	}
 	if !IsNil(o.Id) {
 		toSerialize["id"] = o.Id
 	}
 	if !IsNil(o.Name) {
 		toSerialize["name"] = o.Name
 	}
+	if !IsNil(o.OwnerId) {
+		toSerialize["ownerId"] = o.OwnerId
+	}
 	if !IsNil(o.Projects) {
 		toSerialize["projects"] = o.Projects
 	}
 	if !IsNil(o.Target) {
 		toSerialize["target"] = o.Target
 	}
+	if !IsNil(o.TargetGroup) {
+		toSerialize["targetGroup"] = o.TargetGroup
+	}
 	return toSerialize, nil
 }
 
@@ -3,12 +3,16 @@
 
 package apikey
 
+import "time"
+
 type ApiKeyType string
 
 const (
 	ApiKeyTypeClient    ApiKeyType = "client"
 	ApiKeyTypeProject   ApiKeyType = "project"
 	ApiKeyTypeWorkspace ApiKeyType = "workspace"
+	// Expiring key which only grants access to a single shared workspace
+	ApiKeyTypeShare ApiKeyType = "share"
 )
 
 type ApiKey struct {
@@ -16,4 +20,23 @@ type ApiKey struct {
 	Type    ApiKeyType `json:"type"`
 	// Project or client name
 	Name string `json:"name"`
+	// Workspace a share key grants access to
+	WorkspaceId string `json:"workspaceId,omitempty"`
+	// RFC3339 time after which the key is no longer valid. Empty if the key does not expire
+	ExpiresAt string `json:"expiresAt,omitempty"`
+	// User the client key belongs to. Keys generated before users were introduced have none and grant admin access
+	UserId string `json:"userId,omitempty"`
 } // @name ApiKey
+
+func (k *ApiKey) IsExpired() bool {
+	if k.ExpiresAt == "" {
+		return false
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, k.ExpiresAt)
+	if err != nil {
+		return false
+	}
+
+	return time.Now().After(expiresAt)
+}
@@ -0,0 +1,42 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package builder
+
+import "github.com/daytonaio/daytona/pkg/gitprovider"
+
+type BuildState string // @name BuildState
+
+const (
+	BuildStatePending BuildState = "pending"
+	BuildStateRunning BuildState = "running"
+	BuildStateSuccess BuildState = "success"
+	BuildStateError   BuildState = "error"
+)
+
+// Build is a one-off image build of a repository requested outside of workspace creation
+type Build struct {
+	Id                   string                     `json:"id" validate:"required"`
+	State                BuildState                 `json:"state" validate:"required"`
+	ProjectName          string                     `json:"projectName" validate:"required"`
+	Repository           *gitprovider.GitRepository `json:"repository" validate:"required"`
+	DevcontainerFilePath string                     `json:"devcontainerFilePath" validate:"required"`
+	// Reference of the build image pushed to the builder registry
+	Image string `json:"image,omitempty"`
+	User  string `json:"user,omitempty"`
+	// Digest reference of the image pushed to the export registry, if the build is exported
+	ExportedImage string `json:"exportedImage,omitempty"`
+	Error         string `json:"error,omitempty"`
+	// Image used as the build cache source, empty if no previous build was found
+	CacheFrom   string `json:"cacheFrom,omitempty"`
+	CacheHits   int    `json:"cacheHits,omitempty"`
+	CacheMisses int    `json:"cacheMisses,omitempty"`
+	// ID of the user who created the build
+	OwnerId   string `json:"ownerId,omitempty"`
+	CreatedAt string `json:"createdAt" validate:"required"`
+	UpdatedAt string `json:"updatedAt" validate:"required"`
+} // @name Build
+
+func (b *Build) IsFinished() bool {
+	return b.State == BuildStateSuccess || b.State == BuildStateError
+}
@@ -4,10 +4,6 @@
 package builder
 
 import (
-	"encoding/json"
-	"os"
-	"path/filepath"
-
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/server/containerregistries"
@@ -15,17 +11,29 @@ import (
 )
 
 type BuildResult struct {
+	// ID of the build that produced the result
+	BuildId            string
 	User               string
 	ImageName          string
 	ProjectVolumePath  string
 	PostCreateCommands []string
 	PostStartCommands  []string
+	// Digest reference of the image pushed to the export registry, if the build is exported
+	ExportedImage string
+	// Repository URL and branch the image was built from. Used to find a cache source for later builds
+	RepositoryUrl string
+	Branch        string
+	Sha           string
+	// Image used as the build cache source, empty if no previous build was found
+	CacheFrom   string
+	CacheHits   int
+	CacheMisses int
 }
 
 type BuilderConfig struct {
 	Image                    string
 	ContainerRegistryService containerregistries.IContainerRegistryService
-	ServerConfigFolder       string
+	BuildResultStore         BuildResultStore
 	ContainerRegistryServer  string
 	// Namespace to be used when tagging and pushing the build image
 	BuildImageNamespace             string
@@ -39,7 +47,9 @@ type BuilderConfig struct {
 type IBuilder interface {
 	Build() (*BuildResult, error)
 	CleanUp() error
-	Publish() error
+	// Pushes the build image to the builder registry and exports it if requested.
+	// The export digest reference is recorded in the build result.
+	Publish(r *BuildResult) error
 	SaveBuildResults(r BuildResult) error
 }
 
@@ -54,7 +64,7 @@ type Builder struct {
 	containerRegistryService        containerregistries.IContainerRegistryService
 	containerRegistryServer         string
 	buildImageNamespace             string
-	serverConfigFolder              string
+	buildResultStore                BuildResultStore
 	basePath                        string
 	loggerFactory                   logs.LoggerFactory
 	defaultProjectImage             string
@@ -63,22 +73,5 @@ type Builder struct {
 }
 
 func (b *Builder) SaveBuildResults(r BuildResult) error {
-	err := os.MkdirAll(filepath.Join(b.serverConfigFolder, "builds", b.hash), 0755)
-	if err != nil {
-		return err
-	}
-
-	file, err := os.Create(filepath.Join(b.serverConfigFolder, "builds", b.hash, "build.json"))
-	if err != nil {
-		return err
-	}
-	defer file.Close()
-
-	encoder := json.NewEncoder(file)
-	err = encoder.Encode(r)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return b.buildResultStore.Save(b.hash, &r)
 }
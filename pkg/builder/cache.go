@@ -0,0 +1,140 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package builder
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/docker"
+)
+
+// Matches a build step in the buildkit output, e.g. "#8 [2/5] RUN ..." (plain) or "=> CACHED [stage-1 2/5] RUN ..." (tty)
+var buildStepRegex = regexp.MustCompile(`^(?:#(\d+)\s+)?(?:=>\s+)?(CACHED\s+)?(\[[^\]]*\d+/\d+\])`)
+
+// Matches a cached step reported on its own line in the plain buildkit output, e.g. "#8 CACHED"
+var cachedStepRegex = regexp.MustCompile(`^#(\d+)\s+CACHED\s*$`)
+
+// buildCacheStats counts the cached and rebuilt steps from the buildkit output
+type buildCacheStats struct {
+	// Step label by the plain output step number
+	stepLabels map[string]string
+	cached     map[string]bool
+}
+
+func newBuildCacheStats() *buildCacheStats {
+	return &buildCacheStats{
+		stepLabels: map[string]string{},
+		cached:     map[string]bool{},
+	}
+}
+
+func (s *buildCacheStats) parseLine(line string) {
+	line = strings.TrimSpace(line)
+
+	if match := cachedStepRegex.FindStringSubmatch(line); match != nil {
+		if label, ok := s.stepLabels[match[1]]; ok {
+			s.cached[label] = true
+		}
+		return
+	}
+
+	match := buildStepRegex.FindStringSubmatch(line)
+	if match == nil {
+		return
+	}
+
+	label := match[3]
+	if match[1] != "" {
+		s.stepLabels[match[1]] = label
+	}
+
+	// The tty output repeats the steps while refreshing so a step stays cached once reported as cached
+	s.cached[label] = s.cached[label] || match[2] != ""
+}
+
+func (s *buildCacheStats) hits() int {
+	hits := 0
+	for _, cached := range s.cached {
+		if cached {
+			hits++
+		}
+	}
+	return hits
+}
+
+func (s *buildCacheStats) misses() int {
+	return len(s.cached) - s.hits()
+}
+
+// Returns the image of the most recent successful build of the same repository branch.
+// Images pushed to a different builder registry are not used.
+func (b *Builder) findCacheImage() (string, error) {
+	if b.project.Repository == nil {
+		return "", nil
+	}
+
+	branch := ""
+	if b.project.Repository.Branch != nil {
+		branch = *b.project.Repository.Branch
+	}
+
+	result, err := b.buildResultStore.FindLatest(b.project.Repository.Url, branch)
+	if err != nil {
+		if IsBuildResultNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	if !strings.HasPrefix(result.ImageName, fmt.Sprintf("%s%s", b.containerRegistryServer, b.buildImageNamespace)) {
+		return "", nil
+	}
+
+	return result.ImageName, nil
+}
+
+// Pulls the cache image into the builder docker daemon so it can be passed to the build as "--cache-from".
+// Returns an empty string if there is no cache image or it could not be pulled; the build then runs without cache.
+func (b *DevcontainerBuilder) pullCacheImage(logWriter io.Writer) string {
+	cacheImage, err := b.findCacheImage()
+	if err != nil {
+		logWriter.Write([]byte(fmt.Sprintf("Failed to find a build cache image: %s\n", err.Error())))
+		return ""
+	}
+
+	if cacheImage == "" {
+		logWriter.Write([]byte("No previous build found, building without cache\n"))
+		return ""
+	}
+
+	builderCli, err := b.getBuilderDockerClient()
+	if err != nil {
+		logWriter.Write([]byte(fmt.Sprintf("Failed to pull build cache image: %s\n", err.Error())))
+		return ""
+	}
+
+	cr, err := b.containerRegistryService.Find(b.containerRegistryServer)
+	if err != nil && !containerregistry.IsContainerRegistryNotFound(err) {
+		logWriter.Write([]byte(fmt.Sprintf("Failed to pull build cache image: %s\n", err.Error())))
+		return ""
+	}
+
+	dockerClient := docker.NewDockerClient(docker.DockerClientConfig{
+		ApiClient: builderCli,
+	})
+
+	logWriter.Write([]byte(fmt.Sprintf("Using %s as build cache\n", cacheImage)))
+
+	err = dockerClient.PullImage(cacheImage, cr, logWriter)
+	if err != nil {
+		logWriter.Write([]byte(fmt.Sprintf("Failed to pull build cache image, building without cache: %s\n", err.Error())))
+		return ""
+	}
+
+	return cacheImage
+}
@@ -0,0 +1,46 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package builder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildCacheStatsPlainOutput(t *testing.T) {
+	stats := newBuildCacheStats()
+
+	for _, line := range []string{
+		"#5 [1/3] FROM mcr.microsoft.com/devcontainers/base:ubuntu",
+		"#5 CACHED",
+		"#6 [2/3] RUN apt-get update",
+		"#6 CACHED",
+		"#7 [3/3] COPY . /workspace",
+		"#7 DONE 0.1s",
+		"#8 exporting to image",
+	} {
+		stats.parseLine(line)
+	}
+
+	require.Equal(t, 2, stats.hits())
+	require.Equal(t, 1, stats.misses())
+}
+
+func TestBuildCacheStatsTtyOutput(t *testing.T) {
+	stats := newBuildCacheStats()
+
+	for _, line := range []string{
+		" => [internal] load build definition from Dockerfile",
+		" => CACHED [dev_container_auto_added_stage_label 1/2] FROM docker.io/library/node",
+		" => [dev_container_auto_added_stage_label 2/2] RUN npm install",
+		" => CACHED [dev_container_auto_added_stage_label 1/2] FROM docker.io/library/node",
+		" => [dev_container_auto_added_stage_label 2/2] RUN npm install  2.1s",
+	} {
+		stats.parseLine(line)
+	}
+
+	require.Equal(t, 1, stats.hits())
+	require.Equal(t, 1, stats.misses())
+}
@@ -20,7 +20,12 @@ var (
 )
 
 func DetectProjectBuilderType(project *workspace.Project, projectDir string, sshClient *ssh.Client) (BuilderType, error) {
-	if project.Build != nil && project.Build.Devcontainer != nil {
+	// A nil build config means the builder was explicitly set to none or a custom image
+	if project.Build == nil {
+		return BuilderTypeImage, nil
+	}
+
+	if project.Build.Devcontainer != nil {
 		return BuilderTypeDevcontainer, nil
 	}
 
@@ -38,7 +43,7 @@ func DetectProjectBuilderType(project *workspace.Project, projectDir string, ssh
 			return BuilderTypeDevcontainer, nil
 		}
 	} else {
-		if devcontainerFilePath, pathError := findDevcontainerConfigFilePath(projectDir); pathError == nil {
+		if devcontainerFilePath, pathError := FindDevcontainerConfigFilePath(projectDir); pathError == nil {
 			project.Build.Devcontainer = &workspace.ProjectBuildDevcontainer{
 				DevContainerFilePath: devcontainerFilePath,
 			}
@@ -50,19 +55,17 @@ func DetectProjectBuilderType(project *workspace.Project, projectDir string, ssh
 	return BuilderTypeImage, nil
 }
 
-func findDevcontainerConfigFilePath(projectDir string) (string, error) {
-	devcontainerPath := ".devcontainer/devcontainer.json"
-	isDevcontainer, err := fileExists(filepath.Join(projectDir, devcontainerPath))
-	if err != nil {
-		devcontainerPath = ".devcontainer.json"
-		isDevcontainer, err = fileExists(filepath.Join(projectDir, devcontainerPath))
+// FindDevcontainerConfigFilePath returns the path of the devcontainer configuration relative to the project directory
+func FindDevcontainerConfigFilePath(projectDir string) (string, error) {
+	for _, devcontainerPath := range []string{".devcontainer/devcontainer.json", ".devcontainer.json"} {
+		isDevcontainer, err := fileExists(filepath.Join(projectDir, devcontainerPath))
 		if err != nil {
-			return devcontainerPath, nil
+			return "", err
 		}
-	}
 
-	if isDevcontainer {
-		return devcontainerPath, nil
+		if isDevcontainer {
+			return devcontainerPath, nil
+		}
 	}
 
 	return "", os.ErrNotExist
@@ -42,6 +42,8 @@ type DevcontainerBuilder struct {
 	builderDockerPort  uint16
 	postCreateCommands []string
 	postStartCommands  []string
+	cacheFrom          string
+	cacheStats         *buildCacheStats
 }
 
 func (b *DevcontainerBuilder) Build() (*BuildResult, error) {
@@ -50,6 +52,10 @@ func (b *DevcontainerBuilder) Build() (*BuildResult, error) {
 		return nil, err
 	}
 
+	projectLogger := b.loggerFactory.CreateProjectLogger(b.project.WorkspaceId, b.project.Name, logs.LogSourceBuilder)
+	b.cacheFrom = b.pullCacheImage(projectLogger)
+	projectLogger.Close()
+
 	err = b.buildDevcontainer()
 	if err != nil {
 		return nil, err
@@ -60,13 +66,27 @@ func (b *DevcontainerBuilder) Build() (*BuildResult, error) {
 		return nil, err
 	}
 
-	return &BuildResult{
+	result := &BuildResult{
+		BuildId:            b.id,
 		User:               b.user,
 		ImageName:          b.buildImageName,
 		ProjectVolumePath:  b.projectVolumePath,
 		PostCreateCommands: b.postCreateCommands,
 		PostStartCommands:  b.postStartCommands,
-	}, nil
+		CacheFrom:          b.cacheFrom,
+		CacheHits:          b.cacheStats.hits(),
+		CacheMisses:        b.cacheStats.misses(),
+	}
+
+	if b.project.Repository != nil {
+		result.RepositoryUrl = b.project.Repository.Url
+		result.Sha = b.project.Repository.Sha
+		if b.project.Repository.Branch != nil {
+			result.Branch = *b.project.Repository.Branch
+		}
+	}
+
+	return result, nil
 }
 
 func (b *DevcontainerBuilder) CleanUp() error {
@@ -92,7 +112,7 @@ func (b *DevcontainerBuilder) CleanUp() error {
 	return nil
 }
 
-func (b *DevcontainerBuilder) Publish() error {
+func (b *DevcontainerBuilder) Publish(r *BuildResult) error {
 	projectLogger := b.loggerFactory.CreateProjectLogger(b.project.WorkspaceId, b.project.Name, logs.LogSourceBuilder)
 	defer projectLogger.Close()
 
@@ -110,7 +130,61 @@ func (b *DevcontainerBuilder) Publish() error {
 		return err
 	}
 
-	return dockerClient.PushImage(b.buildImageName, cr, projectLogger)
+	_, err = dockerClient.PushImage(b.buildImageName, cr, projectLogger)
+	if err != nil {
+		return err
+	}
+
+	if b.project.Build == nil || b.project.Build.Export == nil {
+		return nil
+	}
+
+	exportedImage, err := b.exportImage(cliBuilder, dockerClient, b.project.Build.Export.Image, projectLogger)
+	if err != nil {
+		return err
+	}
+
+	r.ExportedImage = exportedImage
+	return nil
+}
+
+// Tags the build image with the export image name and pushes it using the stored credentials of the export registry.
+// Returns the digest reference of the pushed image.
+func (b *DevcontainerBuilder) exportImage(cliBuilder *client.Client, dockerClient docker.IDockerClient, exportImageName string, logWriter io.Writer) (string, error) {
+	logWriter.Write([]byte(fmt.Sprintf("Exporting build image to %s\n", exportImageName)))
+
+	err := cliBuilder.ImageTag(context.Background(), b.buildImageName, exportImageName)
+	if err != nil {
+		return "", err
+	}
+
+	cr, err := b.containerRegistryService.FindByImageName(exportImageName)
+	if err != nil && !containerregistry.IsContainerRegistryNotFound(err) {
+		return "", err
+	}
+
+	digest, err := dockerClient.PushImage(exportImageName, cr, logWriter)
+	if err != nil {
+		return "", fmt.Errorf("failed to export build image: %w", err)
+	}
+
+	if digest == "" {
+		return exportImageName, nil
+	}
+
+	exportedImage := fmt.Sprintf("%s@%s", getImageRepository(exportImageName), digest)
+	logWriter.Write([]byte(fmt.Sprintf("Build image exported as %s\n", exportedImage)))
+
+	return exportedImage, nil
+}
+
+// Strips the tag from the image name
+func getImageRepository(imageName string) string {
+	if i := strings.LastIndex(imageName, ":"); i > strings.LastIndex(imageName, "/") {
+		return imageName[:i]
+	}
+
+	return imageName
 }
 
 func (b *DevcontainerBuilder) buildDevcontainer() error {
@@ -130,6 +204,9 @@ func (b *DevcontainerBuilder) buildDevcontainer() error {
 	if b.project.Build.Devcontainer.DevContainerFilePath != "" {
 		cmd = append(cmd, "--config", filepath.Join("/project", b.project.Build.Devcontainer.DevContainerFilePath))
 	}
+	if b.cacheFrom != "" {
+		cmd = append(cmd, "--cache-from", b.cacheFrom)
+	}
 
 	execConfig := types.ExecConfig{
 		AttachStdout: true,
@@ -138,6 +215,8 @@ func (b *DevcontainerBuilder) buildDevcontainer() error {
 		Tty:          true,
 	}
 
+	b.cacheStats = newBuildCacheStats()
+
 	r, w := io.Pipe()
 	var buildOutcome BuildOutcome
 
@@ -147,6 +226,7 @@ func (b *DevcontainerBuilder) buildDevcontainer() error {
 		for scanner.Scan() {
 			lastLine = scanner.Text()
 			projectLogger.Write([]byte(lastLine + "\n"))
+			b.cacheStats.parseLine(lastLine)
 
 			if strings.Contains(lastLine, `{"outcome"`) {
 				start := strings.Index(lastLine, "{")
@@ -201,6 +281,10 @@ func (b *DevcontainerBuilder) buildDevcontainer() error {
 
 	b.buildImageName = imageName
 
+	if b.cacheFrom != "" {
+		projectLogger.Write([]byte(fmt.Sprintf("Build cache: %d steps cached, %d steps rebuilt\n", b.cacheStats.hits(), b.cacheStats.misses())))
+	}
+
 	return nil
 }
 
@@ -217,15 +301,15 @@ func (b *DevcontainerBuilder) readConfiguration() error {
 		ApiClient: cli,
 	})
 
-	cmd := []string{"devcontainer", "read-configuration", "--include-features-configuration", "--include-merged-configuration", "--workspace-folder", "/project"}
+	configFilePath := ""
 	if b.project.Build.Devcontainer.DevContainerFilePath != "" {
-		cmd = append(cmd, "--config", filepath.Join("/project", b.project.Build.Devcontainer.DevContainerFilePath))
+		configFilePath = filepath.Join("/project", b.project.Build.Devcontainer.DevContainerFilePath)
 	}
 
 	execConfig := types.ExecConfig{
 		AttachStdout: true,
 		AttachStderr: true,
-		Cmd:          cmd,
+		Cmd:          devcontainer.GetReadConfigurationCommand("/project", configFilePath),
 		Tty:          true,
 	}
 
@@ -238,23 +322,7 @@ func (b *DevcontainerBuilder) readConfiguration() error {
 		return errors.New(result.StdErr)
 	}
 
-	// Convert result.Stdout to string
-	stdoutStr := string(result.StdOut)
-	stdoutStr = strings.TrimSuffix(stdoutStr, "\n")
-
-	// Find the index of the last newline character
-	lastNewline := strings.LastIndex(stdoutStr, "\n")
-
-	// If there is a newline character, slice the string from the index after the newline to the end
-	if lastNewline != -1 {
-		stdoutStr = stdoutStr[lastNewline+1:]
-	}
-
-	// Create a new Root object
-	root := &devcontainer.Root{}
-
-	// Unmarshal the JSON into the Root object
-	err = json.Unmarshal([]byte(stdoutStr), root)
+	root, err := devcontainer.ParseReadConfigurationOutput(string(result.StdOut))
 	if err != nil {
 		return err
 	}
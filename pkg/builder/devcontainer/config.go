@@ -4,10 +4,42 @@
 package devcontainer
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 )
 
+// GetReadConfigurationCommand returns the devcontainer CLI command that reads the configuration of the project
+// in workspaceFolder. The config file path is optional and defaults to the lookup of the devcontainer CLI
+func GetReadConfigurationCommand(workspaceFolder, configFilePath string) []string {
+	cmd := []string{"devcontainer", "read-configuration", "--include-features-configuration", "--include-merged-configuration", "--workspace-folder", workspaceFolder}
+	if configFilePath != "" {
+		cmd = append(cmd, "--config", configFilePath)
+	}
+
+	return cmd
+}
+
+// ParseReadConfigurationOutput parses the output of the read-configuration command.
+// The configuration is printed on the last line, after the log output of the devcontainer CLI
+func ParseReadConfigurationOutput(output string) (*Root, error) {
+	output = strings.TrimSuffix(output, "\n")
+
+	lastNewline := strings.LastIndex(output, "\n")
+	if lastNewline != -1 {
+		output = output[lastNewline+1:]
+	}
+
+	root := &Root{}
+
+	err := json.Unmarshal([]byte(output), root)
+	if err != nil {
+		return nil, err
+	}
+
+	return root, nil
+}
+
 func ConvertToArray(mergedCommands interface{}) ([]string, error) {
 	switch mergedCommands := mergedCommands.(type) {
 	case string:
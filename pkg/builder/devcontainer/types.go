@@ -7,8 +7,8 @@ type Configuration struct {
 	Name              string                 `json:"name"`
 	DockerFile        string                 `json:"dockerFile"`
 	RunArgs           []string               `json:"runArgs"`
-	InitializeCommand string                 `json:"initializeCommand"`
-	PostCreateCommand string                 `json:"postCreateCommand"`
+	InitializeCommand interface{}            `json:"initializeCommand"`
+	PostCreateCommand interface{}            `json:"postCreateCommand"`
 	RemoteUser        string                 `json:"remoteUser"`
 	Features          map[string]interface{} `json:"features"`
 	ForwardPorts      []int                  `json:"forwardPorts"`
@@ -0,0 +1,452 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package devcontainer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/compose-spec/compose-go/v2/cli"
+	"github.com/tailscale/hujson"
+)
+
+type ValidationSeverity string
+
+const (
+	ValidationSeverityError   ValidationSeverity = "error"
+	ValidationSeverityWarning ValidationSeverity = "warning"
+	ValidationSeverityInfo    ValidationSeverity = "info"
+)
+
+type ValidationIssue struct {
+	Severity ValidationSeverity `json:"severity"`
+	Property string             `json:"property,omitempty"`
+	Message  string             `json:"message"`
+}
+
+type BuildSource string
+
+const (
+	BuildSourceImage      BuildSource = "image"
+	BuildSourceDockerfile BuildSource = "dockerfile"
+	BuildSourceCompose    BuildSource = "compose"
+)
+
+type ValidationConfig struct {
+	ProjectDir string
+	// Relative to the project directory
+	ConfigFilePath string
+	// Used to predict the default workspace folder
+	ProjectName string
+	// If set, the devcontainer CLI is used to resolve the features and the image metadata
+	DevcontainerCliPath string
+}
+
+type ValidationResult struct {
+	ConfigFilePath     string            `json:"configFilePath"`
+	Source             BuildSource       `json:"source,omitempty"`
+	Image              string            `json:"image,omitempty"`
+	Dockerfile         string            `json:"dockerfile,omitempty"`
+	BuildContext       string            `json:"buildContext,omitempty"`
+	ComposeFile        string            `json:"composeFile,omitempty"`
+	Service            string            `json:"service,omitempty"`
+	Features           []string          `json:"features,omitempty"`
+	RemoteUser         string            `json:"remoteUser,omitempty"`
+	WorkspaceFolder    string            `json:"workspaceFolder,omitempty"`
+	PostCreateCommands []string          `json:"postCreateCommands,omitempty"`
+	PostStartCommands  []string          `json:"postStartCommands,omitempty"`
+	Issues             []ValidationIssue `json:"issues"`
+}
+
+func (r *ValidationResult) HasErrors() bool {
+	for _, issue := range r.Issues {
+		if issue.Severity == ValidationSeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *ValidationResult) addIssue(severity ValidationSeverity, property, message string) {
+	r.Issues = append(r.Issues, ValidationIssue{
+		Severity: severity,
+		Property: property,
+		Message:  message,
+	})
+}
+
+// Properties of the devcontainer.json reference
+var knownProperties = map[string]bool{
+	"$schema": true, "name": true, "image": true, "build": true, "dockerFile": true, "context": true,
+	"dockerComposeFile": true, "service": true, "runServices": true, "workspaceFolder": true, "workspaceMount": true,
+	"shutdownAction": true, "overrideCommand": true, "features": true, "overrideFeatureInstallOrder": true,
+	"forwardPorts": true, "portsAttributes": true, "otherPortsAttributes": true, "appPort": true,
+	"containerEnv": true, "remoteEnv": true, "containerUser": true, "remoteUser": true, "updateRemoteUserUID": true,
+	"userEnvProbe": true, "mounts": true, "runArgs": true, "init": true, "privileged": true, "capAdd": true,
+	"securityOpt": true, "initializeCommand": true, "onCreateCommand": true, "updateContentCommand": true,
+	"postCreateCommand": true, "postStartCommand": true, "postAttachCommand": true, "waitFor": true,
+	"customizations": true, "hostRequirements": true, "settings": true, "extensions": true, "devPort": true,
+}
+
+// Properties that are valid but are not applied when Daytona creates the project
+var unsupportedProperties = map[string]string{
+	"workspaceMount":    "Daytona mounts the project directory into the workspace folder, the value is ignored",
+	"shutdownAction":    "the container lifecycle is managed by Daytona, the value is ignored",
+	"hostRequirements":  "host requirements are not checked, the available resources depend on the target",
+	"postAttachCommand": "Daytona does not attach to the container through the devcontainer CLI, the command is not run",
+}
+
+var commandProperties = []string{"initializeCommand", "onCreateCommand", "updateContentCommand", "postCreateCommand", "postStartCommand", "postAttachCommand"}
+
+// Validate checks the devcontainer configuration of a project without creating a workspace.
+// An error is only returned if the configuration file can not be read, problems with the configuration are reported as issues
+func Validate(config ValidationConfig) (*ValidationResult, error) {
+	result := &ValidationResult{
+		ConfigFilePath: config.ConfigFilePath,
+		Issues:         []ValidationIssue{},
+	}
+
+	content, err := os.ReadFile(filepath.Join(config.ProjectDir, config.ConfigFilePath))
+	if err != nil {
+		return nil, err
+	}
+
+	standardized, err := hujson.Standardize(content)
+	if err != nil {
+		result.addIssue(ValidationSeverityError, "", fmt.Sprintf("invalid JSON: %s", err))
+		return result, nil
+	}
+
+	var properties map[string]interface{}
+	err = json.Unmarshal(standardized, &properties)
+	if err != nil {
+		result.addIssue(ValidationSeverityError, "", "invalid JSON: the configuration must be an object")
+		return result, nil
+	}
+
+	// The configuration is decoded into the same type during provisioning so type mismatches fail the project creation
+	err = json.Unmarshal(standardized, &Configuration{})
+	if err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			result.addIssue(ValidationSeverityError, typeErr.Field, fmt.Sprintf("unsupported value of type %s, expected %s", typeErr.Value, typeErr.Type))
+		} else {
+			result.addIssue(ValidationSeverityError, "", err.Error())
+		}
+	}
+
+	configDir := filepath.Join(config.ProjectDir, filepath.Dir(config.ConfigFilePath))
+
+	result.validateProperties(properties)
+	result.validateSource(config.ProjectDir, configDir, properties)
+	result.validateFeatures(configDir, properties)
+	result.validateCommands(properties)
+
+	if workspaceFolder, ok := properties["workspaceFolder"].(string); ok {
+		result.WorkspaceFolder = workspaceFolder
+	} else if config.ProjectName != "" {
+		result.WorkspaceFolder = fmt.Sprintf("/workspaces/%s", config.ProjectName)
+	}
+
+	if remoteUser, ok := properties["remoteUser"].(string); ok {
+		result.RemoteUser = remoteUser
+	} else if containerUser, ok := properties["containerUser"].(string); ok {
+		result.RemoteUser = containerUser
+	}
+
+	if config.DevcontainerCliPath == "" {
+		if result.RemoteUser == "" {
+			result.addIssue(ValidationSeverityInfo, "remoteUser", "not set, the user is read from the image metadata during the build")
+		}
+		result.addIssue(ValidationSeverityInfo, "", "the devcontainer CLI was not found, features and image metadata were not resolved")
+		return result, nil
+	}
+
+	root, err := readConfiguration(config)
+	if err != nil {
+		result.addIssue(ValidationSeverityError, "", fmt.Sprintf("the devcontainer CLI failed to read the configuration: %s", err))
+		return result, nil
+	}
+
+	result.applyMergedConfiguration(root.MergedConfiguration)
+
+	return result, nil
+}
+
+func (r *ValidationResult) validateProperties(properties map[string]interface{}) {
+	for _, property := range getSortedKeys(properties) {
+		if !knownProperties[property] {
+			r.addIssue(ValidationSeverityWarning, property, "unknown property, it is ignored")
+			continue
+		}
+
+		if message, ok := unsupportedProperties[property]; ok {
+			r.addIssue(ValidationSeverityWarning, property, message)
+		}
+	}
+
+	if _, ok := properties["settings"]; ok {
+		r.addIssue(ValidationSeverityWarning, "settings", "deprecated, move the settings to customizations.vscode.settings")
+	}
+	if _, ok := properties["extensions"]; ok {
+		r.addIssue(ValidationSeverityWarning, "extensions", "deprecated, move the extensions to customizations.vscode.extensions")
+	}
+	if _, ok := properties["initializeCommand"]; ok {
+		r.addIssue(ValidationSeverityInfo, "initializeCommand", "runs on the target host before the project container is created")
+	}
+}
+
+// The source precedence follows the devcontainer CLI: a compose file, then a Dockerfile, then an image
+func (r *ValidationResult) validateSource(projectDir, configDir string, properties map[string]interface{}) {
+	image, _ := properties["image"].(string)
+
+	dockerfile, _ := properties["dockerFile"].(string)
+	buildContext, _ := properties["context"].(string)
+	if build, ok := properties["build"].(map[string]interface{}); ok {
+		if buildDockerfile, ok := build["dockerfile"].(string); ok {
+			dockerfile = buildDockerfile
+		}
+		if context, ok := build["context"].(string); ok {
+			buildContext = context
+		}
+	}
+
+	if composeFile, ok := properties["dockerComposeFile"]; ok {
+		r.Source = BuildSourceCompose
+
+		if image != "" {
+			r.addIssue(ValidationSeverityWarning, "image", "ignored because dockerComposeFile is set")
+		}
+		if dockerfile != "" {
+			r.addIssue(ValidationSeverityWarning, "build.dockerfile", "ignored because dockerComposeFile is set")
+		}
+
+		r.validateCompose(projectDir, configDir, composeFile, properties)
+		return
+	}
+
+	if _, ok := properties["appPort"]; ok {
+		r.addIssue(ValidationSeverityWarning, "appPort", "overridden by the ports allowed by the target network policy")
+	}
+
+	if dockerfile != "" {
+		r.Source = BuildSourceDockerfile
+
+		if image != "" {
+			r.addIssue(ValidationSeverityWarning, "image", "ignored because a Dockerfile is set")
+		}
+
+		if buildContext == "" {
+			buildContext = "."
+		}
+
+		dockerfilePath := filepath.Join(configDir, dockerfile)
+		if !pathExists(dockerfilePath) {
+			r.addIssue(ValidationSeverityError, "build.dockerfile", fmt.Sprintf("file %s not found", dockerfile))
+		}
+
+		contextPath := filepath.Join(configDir, buildContext)
+		if !pathExists(contextPath) {
+			r.addIssue(ValidationSeverityError, "build.context", fmt.Sprintf("directory %s not found", buildContext))
+		}
+
+		r.Dockerfile = getRelativePath(projectDir, dockerfilePath)
+		r.BuildContext = getRelativePath(projectDir, contextPath)
+		return
+	}
+
+	if image != "" {
+		r.Source = BuildSourceImage
+		r.Image = image
+		return
+	}
+
+	r.addIssue(ValidationSeverityError, "", "one of image, build.dockerfile or dockerComposeFile must be set")
+}
+
+func (r *ValidationResult) validateCompose(projectDir, configDir string, composeFile interface{}, properties map[string]interface{}) {
+	composeFilePath, ok := composeFile.(string)
+	if !ok {
+		r.addIssue(ValidationSeverityError, "dockerComposeFile", "must be the path of a single compose file, lists of compose files are not supported")
+		return
+	}
+
+	serviceName, ok := properties["service"].(string)
+	if !ok || serviceName == "" {
+		r.addIssue(ValidationSeverityError, "service", "required when dockerComposeFile is set")
+	}
+	r.Service = serviceName
+
+	composeFilePath = filepath.Join(configDir, composeFilePath)
+	r.ComposeFile = getRelativePath(projectDir, composeFilePath)
+
+	if !pathExists(composeFilePath) {
+		r.addIssue(ValidationSeverityError, "dockerComposeFile", fmt.Sprintf("file %s not found", r.ComposeFile))
+		return
+	}
+
+	// Loaded the same way as during the project creation, which also resolves the extended services
+	options, err := cli.NewProjectOptions([]string{composeFilePath}, cli.WithOsEnv, cli.WithDotEnv)
+	if err != nil {
+		r.addIssue(ValidationSeverityError, "dockerComposeFile", fmt.Sprintf("failed to load the compose file: %s", err))
+		return
+	}
+
+	project, err := cli.ProjectFromOptions(context.Background(), options)
+	if err != nil {
+		r.addIssue(ValidationSeverityError, "dockerComposeFile", fmt.Sprintf("failed to load the compose file: %s", err))
+		return
+	}
+
+	if serviceName == "" {
+		return
+	}
+
+	service, ok := project.Services[serviceName]
+	if !ok {
+		r.addIssue(ValidationSeverityError, "service", fmt.Sprintf("service %s not found in %s", serviceName, r.ComposeFile))
+		return
+	}
+
+	r.Image = service.Image
+	if service.Build != nil {
+		r.BuildContext = getRelativePath(projectDir, service.Build.Context)
+		r.Dockerfile = service.Build.Dockerfile
+	}
+}
+
+func (r *ValidationResult) validateFeatures(configDir string, properties map[string]interface{}) {
+	value, ok := properties["features"]
+	if !ok {
+		return
+	}
+
+	features, ok := value.(map[string]interface{})
+	if !ok {
+		r.addIssue(ValidationSeverityError, "features", "must be an object")
+		return
+	}
+
+	for _, id := range getSortedKeys(features) {
+		r.Features = append(r.Features, id)
+
+		switch features[id].(type) {
+		case map[string]interface{}, string, bool:
+		default:
+			r.addIssue(ValidationSeverityError, "features", fmt.Sprintf("the options of %s must be an object, a version or a boolean", id))
+		}
+
+		switch {
+		case strings.HasPrefix(id, "./") || strings.HasPrefix(id, "../"):
+			if !pathExists(filepath.Join(configDir, id, "devcontainer-feature.json")) {
+				r.addIssue(ValidationSeverityError, "features", fmt.Sprintf("local feature %s does not contain a devcontainer-feature.json", id))
+			}
+		case strings.HasPrefix(id, "https://") || strings.HasPrefix(id, "http://"):
+		case !strings.Contains(id, "/"):
+			r.addIssue(ValidationSeverityWarning, "features", fmt.Sprintf("%s uses a deprecated short feature id, use the full OCI reference instead", id))
+		case !strings.ContainsAny(id[strings.LastIndex(id, "/")+1:], ":@"):
+			r.addIssue(ValidationSeverityWarning, "features", fmt.Sprintf("%s does not pin a version, the latest version is installed on every build", id))
+		}
+	}
+}
+
+func (r *ValidationResult) validateCommands(properties map[string]interface{}) {
+	for _, property := range commandProperties {
+		value, ok := properties[property]
+		if !ok {
+			continue
+		}
+
+		commands, err := ConvertToArray(value)
+		if err != nil {
+			r.addIssue(ValidationSeverityError, property, "must be a string, an array of strings or an object of commands")
+			continue
+		}
+
+		switch property {
+		case "postCreateCommand":
+			r.PostCreateCommands = commands
+		case "postStartCommand":
+			r.PostStartCommands = commands
+		}
+	}
+}
+
+// Resolves the commands the same way as the devcontainer builder
+func (r *ValidationResult) applyMergedConfiguration(mergedConfiguration MergedConfiguration) {
+	r.RemoteUser = mergedConfiguration.RemoteUser
+	if r.RemoteUser == "" {
+		r.addIssue(ValidationSeverityError, "remoteUser", "unable to determine remote user from devcontainer configuration")
+	}
+
+	r.Features = getSortedKeys(mergedConfiguration.Features)
+
+	r.PostCreateCommands = nil
+	if mergedConfiguration.PostCreateCommands != nil {
+		postCreateCommands, err := ConvertToArray(mergedConfiguration.PostCreateCommands)
+		if err != nil {
+			r.addIssue(ValidationSeverityError, "postCreateCommand", fmt.Sprintf("error converting post create commands: %s", err))
+		}
+		r.PostCreateCommands = postCreateCommands
+	}
+
+	r.PostStartCommands = append([]string{}, mergedConfiguration.Entrypoints...)
+	if mergedConfiguration.PostStartCommands != nil {
+		postStartCommands, err := ConvertToArray(mergedConfiguration.PostStartCommands)
+		if err != nil {
+			r.addIssue(ValidationSeverityError, "postStartCommand", fmt.Sprintf("error converting post start commands: %s", err))
+		}
+		r.PostStartCommands = append(r.PostStartCommands, postStartCommands...)
+	}
+}
+
+func readConfiguration(config ValidationConfig) (*Root, error) {
+	args := GetReadConfigurationCommand(config.ProjectDir, filepath.Join(config.ProjectDir, config.ConfigFilePath))
+
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.Command(config.DevcontainerCliPath, args[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if err != nil {
+		if stderr.Len() > 0 {
+			return nil, errors.New(strings.TrimSpace(stderr.String()))
+		}
+		return nil, err
+	}
+
+	return ParseReadConfigurationOutput(stdout.String())
+}
+
+func getSortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func getRelativePath(projectDir, path string) string {
+	relativePath, err := filepath.Rel(projectDir, path)
+	if err != nil {
+		return path
+	}
+	return relativePath
+}
+
+func pathExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
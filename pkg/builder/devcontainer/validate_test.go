@@ -0,0 +1,140 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package devcontainer
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+}
+
+// Matched by prefix since the path of nested properties depends on the decoder
+func findIssue(result *ValidationResult, severity ValidationSeverity, property string) *ValidationIssue {
+	for _, issue := range result.Issues {
+		if issue.Severity == severity && strings.HasPrefix(issue.Property, property) {
+			return &issue
+		}
+	}
+	return nil
+}
+
+func TestValidateDockerfile(t *testing.T) {
+	projectDir := t.TempDir()
+	writeFile(t, filepath.Join(projectDir, ".devcontainer", "Dockerfile"), "FROM alpine\n")
+	writeFile(t, filepath.Join(projectDir, ".devcontainer", "local-feature", "devcontainer-feature.json"), "{}")
+	writeFile(t, filepath.Join(projectDir, ".devcontainer", "devcontainer.json"), `{
+		// Comments and trailing commas are allowed
+		"image": "alpine",
+		"build": {
+			"dockerfile": "Dockerfile",
+			"context": "..",
+		},
+		"features": {
+			"ghcr.io/devcontainers/features/go:1": {},
+			"ghcr.io/devcontainers/features/node": {},
+			"./local-feature": {},
+		},
+		"postCreateCommand": ["npm", "install"],
+		"workspaceMount": "source=/tmp,target=/workspace,type=bind",
+		"unknownProperty": true,
+	}`)
+
+	result, err := Validate(ValidationConfig{
+		ProjectDir:     projectDir,
+		ConfigFilePath: ".devcontainer/devcontainer.json",
+		ProjectName:    "project",
+	})
+	require.NoError(t, err)
+
+	require.False(t, result.HasErrors())
+	require.Equal(t, BuildSourceDockerfile, result.Source)
+	require.Equal(t, filepath.Join(".devcontainer", "Dockerfile"), result.Dockerfile)
+	require.Equal(t, ".", result.BuildContext)
+	require.Equal(t, []string{"./local-feature", "ghcr.io/devcontainers/features/go:1", "ghcr.io/devcontainers/features/node"}, result.Features)
+	require.Equal(t, []string{"npm install"}, result.PostCreateCommands)
+	require.Equal(t, "/workspaces/project", result.WorkspaceFolder)
+
+	require.NotNil(t, findIssue(result, ValidationSeverityWarning, "image"))
+	require.NotNil(t, findIssue(result, ValidationSeverityWarning, "workspaceMount"))
+	require.NotNil(t, findIssue(result, ValidationSeverityWarning, "unknownProperty"))
+	require.NotNil(t, findIssue(result, ValidationSeverityWarning, "features"))
+}
+
+func TestValidateErrors(t *testing.T) {
+	projectDir := t.TempDir()
+	writeFile(t, filepath.Join(projectDir, ".devcontainer.json"), `{
+		"build": {
+			"dockerfile": "Dockerfile"
+		},
+		"features": {
+			"./missing-feature": {}
+		},
+		"forwardPorts": ["db:5432"],
+		"postStartCommand": 1
+	}`)
+
+	result, err := Validate(ValidationConfig{
+		ProjectDir:     projectDir,
+		ConfigFilePath: ".devcontainer.json",
+	})
+	require.NoError(t, err)
+
+	require.True(t, result.HasErrors())
+	require.NotNil(t, findIssue(result, ValidationSeverityError, "build.dockerfile"))
+	require.NotNil(t, findIssue(result, ValidationSeverityError, "features"))
+	require.NotNil(t, findIssue(result, ValidationSeverityError, "forwardPorts"))
+	require.NotNil(t, findIssue(result, ValidationSeverityError, "postStartCommand"))
+}
+
+func TestValidateCompose(t *testing.T) {
+	projectDir := t.TempDir()
+	writeFile(t, filepath.Join(projectDir, ".devcontainer", "docker-compose.yml"), `services:
+  base:
+    image: alpine
+  app:
+    extends:
+      service: base
+`)
+	writeFile(t, filepath.Join(projectDir, ".devcontainer", "devcontainer.json"), `{
+		"dockerComposeFile": "docker-compose.yml",
+		"service": "app"
+	}`)
+
+	result, err := Validate(ValidationConfig{
+		ProjectDir:     projectDir,
+		ConfigFilePath: ".devcontainer/devcontainer.json",
+	})
+	require.NoError(t, err)
+
+	require.False(t, result.HasErrors())
+	require.Equal(t, BuildSourceCompose, result.Source)
+	require.Equal(t, "alpine", result.Image)
+
+	writeFile(t, filepath.Join(projectDir, ".devcontainer", "devcontainer.json"), `{
+		"dockerComposeFile": ["docker-compose.yml"],
+		"service": "app"
+	}`)
+
+	result, err = Validate(ValidationConfig{
+		ProjectDir:     projectDir,
+		ConfigFilePath: ".devcontainer/devcontainer.json",
+	})
+	require.NoError(t, err)
+
+	require.NotNil(t, findIssue(result, ValidationSeverityError, "dockerComposeFile"))
+}
+
+func TestParseReadConfigurationOutput(t *testing.T) {
+	root, err := ParseReadConfigurationOutput("[1 ms] @devcontainers/cli 0.65.0\n{\"mergedConfiguration\":{\"remoteUser\":\"daytona\"}}\n")
+	require.NoError(t, err)
+	require.Equal(t, "daytona", root.MergedConfiguration.RemoteUser)
+}
@@ -4,7 +4,6 @@
 package builder
 
 import (
-	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -27,7 +26,7 @@ type IBuilderFactory interface {
 }
 
 type BuilderFactory struct {
-	serverConfigFolder              string
+	buildResultStore                BuildResultStore
 	containerRegistryServer         string
 	buildImageNamespace             string
 	basePath                        string
@@ -42,7 +41,7 @@ type BuilderFactory struct {
 func NewBuilderFactory(config BuilderConfig) IBuilderFactory {
 	return &BuilderFactory{
 		image:                           config.Image,
-		serverConfigFolder:              config.ServerConfigFolder,
+		buildResultStore:                config.BuildResultStore,
 		containerRegistryServer:         config.ContainerRegistryServer,
 		buildImageNamespace:             config.BuildImageNamespace,
 		containerRegistryService:        config.ContainerRegistryService,
@@ -91,7 +90,14 @@ func (f *BuilderFactory) Create(p workspace.Project, gpc *gitprovider.GitProvide
 		return nil, err
 	}
 
-	if p.Build == nil || *p.Build != (workspace.ProjectBuild{}) {
+	// The export option does not select a builder
+	buildConfig := workspace.ProjectBuild{}
+	if p.Build != nil {
+		buildConfig = *p.Build
+		buildConfig.Export = nil
+	}
+
+	if p.Build == nil || buildConfig != (workspace.ProjectBuild{}) {
 		if p.Build != nil && p.Build.Devcontainer != nil {
 			return f.newDevcontainerBuilder(buildId, p, gpc, hash, projectDir)
 		}
@@ -121,35 +127,20 @@ func (f *BuilderFactory) CheckExistingBuild(p workspace.Project) (*BuildResult,
 		return nil, err
 	}
 
-	filePath := filepath.Join(f.serverConfigFolder, "builds", hash, "build.json")
-
-	_, err = os.Stat(filePath)
+	result, err := f.buildResultStore.Find(hash)
 	if err != nil {
-		if os.IsNotExist(err) {
+		if IsBuildResultNotFound(err) {
 			return nil, nil
 		}
 		return nil, err
 	}
 
-	file, err := os.Open(filePath)
-	if err != nil {
-		return nil, err
-	}
-	defer file.Close()
-
-	var result BuildResult
-	decoder := json.NewDecoder(file)
-	err = decoder.Decode(&result)
-	if err != nil {
-		return nil, err
-	}
-
 	// If the builder registry changed, we need to rebuild and push again
 	if !strings.HasPrefix(result.ImageName, fmt.Sprintf("%s%s", f.containerRegistryServer, f.buildImageNamespace)) {
 		return nil, nil
 	}
 
-	return &result, nil
+	return result, nil
 }
 
 func (f *BuilderFactory) newDevcontainerBuilder(buildId string, p workspace.Project, gpc *gitprovider.GitProviderConfig, hash, projectDir string) (*DevcontainerBuilder, error) {
@@ -167,7 +158,7 @@ func (f *BuilderFactory) newDevcontainerBuilder(buildId string, p workspace.Proj
 			projectVolumePath:               projectDir,
 			image:                           f.image,
 			containerRegistryService:        f.containerRegistryService,
-			serverConfigFolder:              f.serverConfigFolder,
+			buildResultStore:                f.buildResultStore,
 			containerRegistryServer:         f.containerRegistryServer,
 			buildImageNamespace:             f.buildImageNamespace,
 			basePath:                        f.basePath,
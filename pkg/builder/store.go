@@ -0,0 +1,78 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package builder
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// BuildResultStore holds the results of finished builds keyed by the project config hash
+type BuildResultStore interface {
+	Find(hash string) (*BuildResult, error)
+	Save(hash string, result *BuildResult) error
+	// Returns the most recently saved result built from the repository branch
+	FindLatest(repositoryUrl, branch string) (*BuildResult, error)
+}
+
+var (
+	ErrBuildResultNotFound = errors.New("build result not found")
+)
+
+func IsBuildResultNotFound(err error) bool {
+	return err.Error() == ErrBuildResultNotFound.Error()
+}
+
+// ImportBuildResultFiles moves the results stored as <buildsDir>/<hash>/build.json by earlier server versions
+// into the store. Results already in the store are kept. Returns the number of imported results
+func ImportBuildResultFiles(buildsDir string, store BuildResultStore) (int, error) {
+	filePaths, err := filepath.Glob(filepath.Join(buildsDir, "*", "build.json"))
+	if err != nil {
+		return 0, err
+	}
+
+	imported := 0
+	for _, filePath := range filePaths {
+		hash := filepath.Base(filepath.Dir(filePath))
+
+		_, err := store.Find(hash)
+		if err == nil {
+			err = os.Remove(filePath)
+			if err != nil {
+				return imported, err
+			}
+			continue
+		}
+		if !IsBuildResultNotFound(err) {
+			return imported, err
+		}
+
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			return imported, err
+		}
+
+		var result BuildResult
+		err = json.Unmarshal(content, &result)
+		if err != nil {
+			return imported, fmt.Errorf("failed to read build result %s: %w", filePath, err)
+		}
+
+		err = store.Save(hash, &result)
+		if err != nil {
+			return imported, err
+		}
+
+		err = os.Remove(filePath)
+		if err != nil {
+			return imported, err
+		}
+		imported++
+	}
+
+	return imported, nil
+}
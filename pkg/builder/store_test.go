@@ -0,0 +1,89 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package builder
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type inMemoryBuildResultStore struct {
+	results map[string]*BuildResult
+}
+
+func (s *inMemoryBuildResultStore) Find(hash string) (*BuildResult, error) {
+	result, ok := s.results[hash]
+	if !ok {
+		return nil, ErrBuildResultNotFound
+	}
+	return result, nil
+}
+
+func (s *inMemoryBuildResultStore) Save(hash string, result *BuildResult) error {
+	s.results[hash] = result
+	return nil
+}
+
+func (s *inMemoryBuildResultStore) FindLatest(repositoryUrl, branch string) (*BuildResult, error) {
+	return nil, ErrBuildResultNotFound
+}
+
+func writeBuildResultFile(t *testing.T, buildsDir, hash string, result BuildResult) string {
+	content, err := json.Marshal(result)
+	require.Nil(t, err)
+
+	filePath := filepath.Join(buildsDir, hash, "build.json")
+	require.Nil(t, os.MkdirAll(filepath.Dir(filePath), 0755))
+	require.Nil(t, os.WriteFile(filePath, content, 0644))
+
+	return filePath
+}
+
+func TestImportBuildResultFiles(t *testing.T) {
+	buildsDir := t.TempDir()
+
+	store := &inMemoryBuildResultStore{results: map[string]*BuildResult{
+		"existing": {ImageName: "registry/existing:stored"},
+	}}
+
+	newFile := writeBuildResultFile(t, buildsDir, "new", BuildResult{
+		User:              "daytona",
+		ImageName:         "registry/new:1",
+		ProjectVolumePath: "/workspaces/new",
+		PostStartCommands: []string{"make dev"},
+	})
+	existingFile := writeBuildResultFile(t, buildsDir, "existing", BuildResult{ImageName: "registry/existing:file"})
+	// Build directories without a result are left alone
+	require.Nil(t, os.MkdirAll(filepath.Join(buildsDir, "in-progress"), 0755))
+
+	imported, err := ImportBuildResultFiles(buildsDir, store)
+	require.Nil(t, err)
+	require.Equal(t, 1, imported)
+
+	require.Equal(t, &BuildResult{
+		User:              "daytona",
+		ImageName:         "registry/new:1",
+		ProjectVolumePath: "/workspaces/new",
+		PostStartCommands: []string{"make dev"},
+	}, store.results["new"])
+	require.Equal(t, "registry/existing:stored", store.results["existing"].ImageName)
+
+	require.NoFileExists(t, newFile)
+	require.NoFileExists(t, existingFile)
+	require.DirExists(t, filepath.Join(buildsDir, "in-progress"))
+
+	imported, err = ImportBuildResultFiles(buildsDir, store)
+	require.Nil(t, err)
+	require.Equal(t, 0, imported)
+}
+
+func TestImportBuildResultFilesWithoutBuildsDir(t *testing.T) {
+	imported, err := ImportBuildResultFiles(filepath.Join(t.TempDir(), "builds"), &inMemoryBuildResultStore{results: map[string]*BuildResult{}})
+	require.Nil(t, err)
+	require.Equal(t, 0, imported)
+}
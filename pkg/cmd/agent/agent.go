@@ -59,34 +59,50 @@ var AgentCmd = &cobra.Command{
 			agentLogWriter = logFile
 		}
 
+		gitCredentialHelperPath := filepath.Join(os.Getenv("HOME"), ".daytona", "bin", "git-credential-daytona")
+
 		git := &git.Service{
 			ProjectDir:        c.ProjectDir,
 			GitConfigFileName: filepath.Join(os.Getenv("HOME"), ".gitconfig"),
+			CredentialHelper:  gitCredentialHelperPath,
 			LogWriter:         gitLogWriter,
 		}
 
-		sshServer := &ssh.Server{
-			ProjectDir:        c.ProjectDir,
-			DefaultProjectDir: os.Getenv("HOME"),
-		}
-
 		tailscaleHostname := workspace.GetProjectHostname(c.WorkspaceId, c.ProjectName)
 		if hostModeFlag {
 			tailscaleHostname = c.WorkspaceId
 		}
 
+		sshServer := &ssh.Server{
+			ProjectDir:        c.ProjectDir,
+			DefaultProjectDir: os.Getenv("HOME"),
+			CaPublicKey:       c.SshCaPublicKey,
+			Principal:         tailscaleHostname,
+		}
+
 		tailscaleServer := &tailscale.Server{
 			Hostname: tailscaleHostname,
 			Server:   c.Server,
 		}
+		if !hostModeFlag {
+			tailscaleServer.WorkspaceId = c.WorkspaceId
+			tailscaleServer.ProjectName = c.ProjectName
+		}
 
 		agent := agent.Agent{
-			Config:                 c,
-			Git:                    git,
-			Ssh:                    sshServer,
-			Tailscale:              tailscaleServer,
-			LogWriter:              agentLogWriter,
-			PostCreateLockFilePath: filepath.Join(os.Getenv("HOME"), ".daytona_post_create.lock"),
+			Config:                  c,
+			Git:                     git,
+			Ssh:                     sshServer,
+			Tailscale:               tailscaleServer,
+			LogWriter:               agentLogWriter,
+			PostCreateLockFilePath:  filepath.Join(os.Getenv("HOME"), ".daytona_post_create.lock"),
+			GitCredentialSocketPath: filepath.Join(os.Getenv("HOME"), ".daytona", "git-credential.sock"),
+			GitCredentialHelperPath: gitCredentialHelperPath,
+		}
+
+		// Host mode agents have no project the sessions could be stored for
+		if c.RecordSshSessions && !hostModeFlag {
+			sshServer.UploadRecording = agent.UploadSessionRecording
 		}
 
 		err = agent.Start()
@@ -36,7 +36,7 @@ var logsCmd = &cobra.Command{
 		msgChan := make(chan []byte)
 		errChan := make(chan error)
 
-		go util.ReadLog(context.Background(), file, followFlag, msgChan, errChan)
+		go util.ReadLog(context.Background(), file, followFlag, nil, msgChan, errChan)
 
 		for {
 			select {
@@ -0,0 +1,93 @@
+//go:build !windows
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+
+	"github.com/daytonaio/daytona/pkg/agent/tasks"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var tasksCmd = &cobra.Command{
+	Use:   "tasks",
+	Short: "Output the tasks declared in the project as JSON",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		projectDir, err := getProjectDir()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		projectTasks, err := tasks.Discover(projectDir)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		data, err := json.Marshal(projectTasks)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		fmt.Println(string(data))
+	},
+}
+
+var runTaskCmd = &cobra.Command{
+	Use:   "run TASK",
+	Short: "Run a task declared in the project",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectDir, err := getProjectDir()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		projectTasks, err := tasks.Discover(projectDir)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		task, err := tasks.Find(projectTasks, args[0])
+		if err != nil {
+			log.Fatalf("%s: %s", err, args[0])
+		}
+
+		taskCmd := exec.Command("sh", "-c", task.Command)
+		taskCmd.Dir = projectDir
+		taskCmd.Stdin = os.Stdin
+		taskCmd.Stdout = os.Stdout
+		taskCmd.Stderr = os.Stderr
+
+		err = taskCmd.Run()
+		if err != nil {
+			var exitErr *exec.ExitError
+			if errors.As(err, &exitErr) {
+				os.Exit(exitErr.ExitCode())
+			}
+			log.Fatal(err)
+		}
+	},
+}
+
+// SSH sessions of the agent start in the project directory
+func getProjectDir() (string, error) {
+	if projectDir := os.Getenv("DAYTONA_PROJECT_DIR"); projectDir != "" {
+		return projectDir, nil
+	}
+
+	return os.Getwd()
+}
+
+func init() {
+	tasksCmd.AddCommand(runTaskCmd)
+	AgentCmd.AddCommand(tasksCmd)
+}
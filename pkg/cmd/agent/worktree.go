@@ -0,0 +1,109 @@
+//go:build !windows
+
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/daytonaio/daytona/pkg/git"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var forceRemoveWorktreeFlag bool
+
+var worktreeCmd = &cobra.Command{
+	Use:   "worktree",
+	Short: "Manage the worktrees of a project in worktree mode",
+}
+
+var listWorktreesCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Output the worktrees of the project as JSON",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		gitService, err := getWorktreeGitService()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		worktrees, err := gitService.ListWorktrees()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		outputJson(worktrees)
+	},
+}
+
+var addWorktreeCmd = &cobra.Command{
+	Use:   "add BRANCH",
+	Short: "Check out a branch in a new worktree and output the worktree as JSON",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		gitService, err := getWorktreeGitService()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		worktree, err := gitService.AddWorktree(args[0])
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		outputJson(worktree)
+	},
+}
+
+var removeWorktreeCmd = &cobra.Command{
+	Use:   "remove BRANCH",
+	Short: "Remove the worktree of a branch",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		gitService, err := getWorktreeGitService()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		err = gitService.RemoveWorktree(args[0], forceRemoveWorktreeFlag)
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+// Progress is written to stderr so that stdout only contains the JSON output
+func getWorktreeGitService() (*git.Service, error) {
+	projectDir, err := getProjectDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return &git.Service{
+		ProjectDir: projectDir,
+		LogWriter:  os.Stderr,
+	}, nil
+}
+
+func outputJson(v interface{}) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(string(data))
+}
+
+func init() {
+	removeWorktreeCmd.Flags().BoolVarP(&forceRemoveWorktreeFlag, "force", "f", false, "Remove the worktree even if it has uncommitted changes")
+
+	worktreeCmd.AddCommand(listWorktreesCmd)
+	worktreeCmd.AddCommand(addWorktreeCmd)
+	worktreeCmd.AddCommand(removeWorktreeCmd)
+	AgentCmd.AddCommand(worktreeCmd)
+}
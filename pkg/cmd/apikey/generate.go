@@ -43,10 +43,10 @@ var GenerateCmd = &cobra.Command{
 		if len(args) == 1 {
 			keyName = args[0]
 		} else {
-			apikey.ApiKeyCreationView(&keyName, &saveFlag, apiKeyList)
+			apikey.ApiKeyCreationView(&keyName, &saveFlag, apiKeyList.Items)
 		}
 
-		for _, key := range apiKeyList {
+		for _, key := range apiKeyList.Items {
 			if *key.Name == keyName {
 				log.Fatal("key name already exists, please choose a different one")
 			}
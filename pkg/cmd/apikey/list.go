@@ -30,6 +30,6 @@ var listCmd = &cobra.Command{
 			log.Fatal(apiclient.HandleErrorResponse(nil, err))
 		}
 
-		apikey.ListApiKeys(apiKeyList)
+		apikey.ListApiKeys(apiKeyList.Items)
 	},
 }
@@ -52,14 +52,14 @@ var revokeCmd = &cobra.Command{
 		}
 
 		if len(args) == 1 {
-			for _, apiKey := range apiKeyList {
+			for _, apiKey := range apiKeyList.Items {
 				if *apiKey.Name == args[0] {
 					selectedApiKey = &apiKey
 					break
 				}
 			}
 		} else {
-			selectedApiKey, err = apikey.GetApiKeyFromPrompt(apiKeyList, "Select an API key to revoke", false)
+			selectedApiKey, err = apikey.GetApiKeyFromPrompt(apiKeyList.Items, "Select an API key to revoke", false)
 			if err != nil {
 				log.Fatal(err)
 			}
@@ -0,0 +1,132 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package build
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/views/workspace/create"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var BuildCmd = &cobra.Command{
+	Use:   "build [REPOSITORY_URL]",
+	Short: "Build the project image of a repository",
+	Long:  "Build the project image of a repository from its devcontainer configuration without creating a workspace.\nUseful to validate devcontainer changes before pushing them.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		c, err := config.GetConfig()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		repoUrl, err := util.GetValidatedUrl(args[0])
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		repository, res, err := apiClient.GitProviderAPI.GetGitContext(ctx, url.QueryEscape(repoUrl)).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		if branchFlag != "" {
+			repository.Branch = &branchFlag
+			// The server resolves the head of the branch
+			repository.Sha = nil
+		}
+
+		createBuildRequest := apiclient.CreateBuildRequest{
+			Repository:           *repository,
+			DevcontainerFilePath: devcontainerPathFlag,
+		}
+
+		if exportImageFlag != "" {
+			createBuildRequest.ExportImage = &exportImageFlag
+		}
+
+		if gitProviderConfigFlag != "" {
+			createBuildRequest.GitProviderConfigId = &gitProviderConfigFlag
+		}
+
+		build, res, err := apiClient.BuildAPI.CreateBuild(ctx).Build(createBuildRequest).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		stopLogs := false
+		go apiclient_util.ReadBuildLogs(activeProfile, build.Id, build.ProjectName, &stopLogs)
+
+		build, err = waitForBuild(apiClient, build.Id)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		// Give the log reader a moment to display the last entries
+		time.Sleep(500 * time.Millisecond)
+		stopLogs = true
+
+		if build.State == apiclient.BuildStateError {
+			log.Fatal(errors.New(build.GetError()))
+		}
+
+		views.RenderInfoMessageBold(fmt.Sprintf("Build %s finished", build.Id))
+		views.RenderListLine(fmt.Sprintf("Image: %s", build.GetImage()))
+		if build.ExportedImage != nil {
+			views.RenderListLine(fmt.Sprintf("Exported image: %s", build.GetExportedImage()))
+		}
+		if build.CacheFrom != nil {
+			views.RenderListLine(fmt.Sprintf("Cache: %d steps cached, %d steps rebuilt (from %s)", build.GetCacheHits(), build.GetCacheMisses(), build.GetCacheFrom()))
+		}
+	},
+}
+
+var branchFlag string
+var devcontainerPathFlag string
+var exportImageFlag string
+var gitProviderConfigFlag string
+
+func init() {
+	BuildCmd.Flags().StringVarP(&branchFlag, "branch", "b", "", "Build the head of the given branch instead of the branch from the repository URL")
+	BuildCmd.Flags().StringVar(&devcontainerPathFlag, "devcontainer-path", create.DEVCONTAINER_FILEPATH, "Path of the devcontainer configuration relative to the repository root")
+	BuildCmd.Flags().StringVar(&exportImageFlag, "export-image", "", "Push the built image to an external registry under the given name (e.g. 'registry.example.com/team/project:latest'); Uses the credentials of the matching container registry")
+	BuildCmd.Flags().StringVar(&gitProviderConfigFlag, "git-provider-config", "", "Use the Git provider config with the given ID (see 'daytona git-providers list') to clone the repository")
+}
+
+func waitForBuild(apiClient *apiclient.APIClient, buildId string) (*apiclient.Build, error) {
+	for {
+		build, res, err := apiClient.BuildAPI.GetBuild(context.Background(), buildId).Execute()
+		if err != nil {
+			return nil, apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		if build.State == apiclient.BuildStateSuccess || build.State == apiclient.BuildStateError {
+			return build, nil
+		}
+
+		time.Sleep(time.Second)
+	}
+}
@@ -6,13 +6,16 @@ package cmd
 import (
 	"os"
 
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	. "github.com/daytonaio/daytona/pkg/cmd/apikey"
+	. "github.com/daytonaio/daytona/pkg/cmd/build"
 	. "github.com/daytonaio/daytona/pkg/cmd/containerregistry"
 	. "github.com/daytonaio/daytona/pkg/cmd/gitprovider"
 	"github.com/daytonaio/daytona/pkg/cmd/output"
 	. "github.com/daytonaio/daytona/pkg/cmd/ports"
 	. "github.com/daytonaio/daytona/pkg/cmd/profile"
 	. "github.com/daytonaio/daytona/pkg/cmd/profiledata/env"
+	. "github.com/daytonaio/daytona/pkg/cmd/profiledata/gitidentity"
 	. "github.com/daytonaio/daytona/pkg/cmd/provider"
 	. "github.com/daytonaio/daytona/pkg/cmd/server"
 	. "github.com/daytonaio/daytona/pkg/cmd/target"
@@ -36,8 +39,10 @@ var originalStdout *os.File
 func Execute() {
 	rootCmd.AddCommand(CodeCmd)
 	rootCmd.AddCommand(SshCmd)
+	rootCmd.AddCommand(RunCmd)
 	rootCmd.AddCommand(SshProxyCmd)
 	rootCmd.AddCommand(CreateCmd)
+	rootCmd.AddCommand(BuildCmd)
 	rootCmd.AddCommand(DeleteCmd)
 	rootCmd.AddCommand(ServeCmd)
 	rootCmd.AddCommand(ServerCmd)
@@ -50,12 +55,24 @@ func Execute() {
 	rootCmd.AddCommand(ProfileUseCmd)
 	rootCmd.AddCommand(whoamiCmd)
 	rootCmd.AddCommand(purgeCmd)
+	rootCmd.AddCommand(updateCmd)
 	rootCmd.AddCommand(GitProviderCmd)
 	rootCmd.AddCommand(StartCmd)
 	rootCmd.AddCommand(StopCmd)
+	rootCmd.AddCommand(ExtendCmd)
+	rootCmd.AddCommand(ArchiveCmd)
+	rootCmd.AddCommand(UnarchiveCmd)
+	rootCmd.AddCommand(TopCmd)
+	rootCmd.AddCommand(ResetCmd)
 	rootCmd.AddCommand(InfoCmd)
+	rootCmd.AddCommand(LogsCmd)
+	rootCmd.AddCommand(SessionsCmd)
+	rootCmd.AddCommand(WorktreeCmd)
 	rootCmd.AddCommand(PortForwardCmd)
 	rootCmd.AddCommand(EnvCmd)
+	rootCmd.AddCommand(GitIdentityCmd)
+	rootCmd.AddCommand(ShareCmd)
+	rootCmd.AddCommand(ConnectCmd)
 
 	SetupRootCommand(rootCmd)
 
@@ -71,10 +88,12 @@ func SetupRootCommand(cmd *cobra.Command) {
 	cmd.AddCommand(ListCmd)
 	cmd.AddCommand(generateDocsCmd)
 	cmd.AddCommand(DocsCmd)
+	cmd.AddCommand(validateCmd)
 
 	cmd.CompletionOptions.HiddenDefaultCmd = true
 	cmd.PersistentFlags().BoolP("help", "", false, "help for daytona")
 	cmd.PersistentFlags().StringVarP(&output.FormatFlag, "output", "o", output.FormatFlag, `Output format. Must be one of (yaml, json)`)
+	cmd.PersistentFlags().BoolVar(&apiclient_util.SkipVersionCheck, "skip-version-check", false, "Use a Daytona Server with an incompatible version")
 
 	cmd.PersistentPreRun = func(cmd *cobra.Command, args []string) {
 		if output.FormatFlag == "" {
@@ -46,12 +46,12 @@ var containerRegistryDeleteCmd = &cobra.Command{
 				log.Fatal(apiclient_util.HandleErrorResponse(res, err))
 			}
 
-			if len(containerRegistries) == 0 {
+			if len(containerRegistries.Items) == 0 {
 				views.RenderInfoMessage("No container registries found")
 				return
 			}
 
-			registryDto, err = containerregistry_view.GetRegistryFromPrompt(containerRegistries, activeProfile.Name, false)
+			registryDto, err = containerregistry_view.GetRegistryFromPrompt(containerRegistries.Items, activeProfile.Name, false)
 			if err != nil {
 				log.Fatal(err)
 			}
@@ -31,16 +31,16 @@ var containerRegistryListCmd = &cobra.Command{
 			log.Fatal(apiclient.HandleErrorResponse(res, err))
 		}
 
-		if len(containerRegistries) == 0 {
+		if len(containerRegistries.Items) == 0 {
 			views.RenderInfoMessage("No container registries found. Set a new container registry by running 'daytona container-registry set'")
 			return
 		}
 
 		if output.FormatFlag != "" {
-			output.Output = containerRegistries
+			output.Output = containerRegistries.Items
 			return
 		}
 
-		containerregistry_view.ListRegistries(containerRegistries)
+		containerregistry_view.ListRegistries(containerRegistries.Items)
 	},
 }
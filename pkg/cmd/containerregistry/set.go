@@ -53,11 +53,11 @@ var containerRegistrySetCmd = &cobra.Command{
 		}
 
 		if serverFlag == "" || usernameFlag == "" || passwordFlag == "" {
-			if len(containerRegistries) == 0 {
-				containerregistry_view.RegistryCreationView(&registryView, containerRegistries, false)
+			if len(containerRegistries.Items) == 0 {
+				containerregistry_view.RegistryCreationView(&registryView, containerRegistries.Items, false)
 				selectedServer = registryView.Server
 			} else {
-				registryDto, err := containerregistry_view.GetRegistryFromPrompt(containerRegistries, activeProfile.Name, true)
+				registryDto, err := containerregistry_view.GetRegistryFromPrompt(containerRegistries.Items, activeProfile.Name, true)
 				if err != nil {
 					log.Fatal(err)
 				}
@@ -74,7 +74,7 @@ var containerRegistrySetCmd = &cobra.Command{
 					registryView.Password = *registryDto.Password
 				}
 
-				containerregistry_view.RegistryCreationView(&registryView, containerRegistries, editing)
+				containerregistry_view.RegistryCreationView(&registryView, containerRegistries.Items, editing)
 			}
 		}
 
@@ -5,6 +5,7 @@ package gitprovider
 
 import (
 	"context"
+	"os"
 
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/apiclient"
@@ -27,14 +28,29 @@ var GitProviderAddCmd = &cobra.Command{
 		}
 
 		gitProviderData := apiclient.GitProvider{}
-		gitProviderData.Id = new(string)
+		gitProviderData.ProviderId = new(string)
 		gitProviderData.Username = new(string)
 		gitProviderData.Token = new(string)
 		gitProviderData.BaseApiUrl = new(string)
+		gitProviderData.Alias = &aliasFlag
 
-		gitprovider_view.GitProviderSelectionView(&gitProviderData, nil, false)
+		if gitHubAppIdFlag != 0 {
+			privateKey, err := os.ReadFile(gitHubAppPrivateKeyFileFlag)
+			if err != nil {
+				log.Fatal(err)
+			}
 
-		if *gitProviderData.Id == "" {
+			*gitProviderData.ProviderId = "github"
+			gitProviderData.GithubApp = &apiclient.GitHubAppConfig{
+				AppId:          int32(gitHubAppIdFlag),
+				InstallationId: int32(gitHubAppInstallationIdFlag),
+				PrivateKey:     string(privateKey),
+			}
+		} else {
+			gitprovider_view.GitProviderSelectionView(&gitProviderData, nil, false)
+		}
+
+		if *gitProviderData.ProviderId == "" {
 			return
 		}
 
@@ -46,3 +62,17 @@ var GitProviderAddCmd = &cobra.Command{
 		views.RenderInfoMessage("Git provider has been registered")
 	},
 }
+
+var aliasFlag string
+var gitHubAppIdFlag int64
+var gitHubAppInstallationIdFlag int64
+var gitHubAppPrivateKeyFileFlag string
+
+func init() {
+	GitProviderAddCmd.Flags().StringVar(&aliasFlag, "alias", "", "Select the config for repositories owned by the user or organization with the given name when several configs are registered for the provider")
+	GitProviderAddCmd.Flags().Int64Var(&gitHubAppIdFlag, "github-app-id", 0, "Authenticate to GitHub as the GitHub App with the given ID instead of using a personal access token")
+	GitProviderAddCmd.Flags().Int64Var(&gitHubAppInstallationIdFlag, "github-app-installation-id", 0, "ID of the GitHub App installation")
+	GitProviderAddCmd.Flags().StringVar(&gitHubAppPrivateKeyFileFlag, "github-app-private-key-file", "", "Path to the GitHub App private key (PEM)")
+
+	GitProviderAddCmd.MarkFlagsRequiredTogether("github-app-id", "github-app-installation-id", "github-app-private-key-file")
+}
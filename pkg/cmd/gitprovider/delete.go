@@ -33,16 +33,18 @@ var gitProviderDeleteCmd = &cobra.Command{
 
 		var gitProviderData apiclient.GitProvider
 		gitProviderData.Id = new(string)
+		gitProviderData.ProviderId = new(string)
 		gitProviderData.Username = new(string)
 		gitProviderData.Token = new(string)
 		gitProviderData.BaseApiUrl = new(string)
+		gitProviderData.Alias = new(string)
 
-		if len(gitProviders) == 0 {
+		if len(gitProviders.Items) == 0 {
 			views.RenderInfoMessage("No git providers registered")
 			return
 		}
 
-		gitprovider_view.GitProviderSelectionView(&gitProviderData, gitProviders, true)
+		gitprovider_view.GitProviderSelectionView(&gitProviderData, gitProviders.Items, true)
 
 		if *gitProviderData.Id == "" {
 			log.Fatal("Git provider id can not be blank")
@@ -17,4 +17,5 @@ func init() {
 	GitProviderCmd.AddCommand(GitProviderAddCmd)
 	GitProviderCmd.AddCommand(gitProviderDeleteCmd)
 	GitProviderCmd.AddCommand(gitProviderListCmd)
+	GitProviderCmd.AddCommand(gitProviderSetDefaultCmd)
 }
@@ -31,7 +31,7 @@ var gitProviderListCmd = &cobra.Command{
 			log.Fatal(apiclient.HandleErrorResponse(res, err))
 		}
 
-		if len(gitProviders) == 0 {
+		if len(gitProviders.Items) == 0 {
 			views.RenderInfoMessage("No git providers registered. Add a new git provider by\npreparing a Personal Access Token and running 'daytona git-providers add'")
 			return
 		}
@@ -41,14 +41,18 @@ var gitProviderListCmd = &cobra.Command{
 		supportedProviders := config.GetSupportedGitProviders()
 		var gitProviderViewList []gitprovider_view.GitProviderView
 
-		for _, gitProvider := range gitProviders {
+		for _, gitProvider := range gitProviders.Items {
 			for _, supportedProvider := range supportedProviders {
-				if *gitProvider.Id == supportedProvider.Id {
+				if gitprovider_view.GetProviderId(gitProvider) == supportedProvider.Id {
 					gitProviderViewList = append(gitProviderViewList,
 						gitprovider_view.GitProviderView{
-							Id:       *gitProvider.Id,
-							Name:     supportedProvider.Name,
-							Username: *gitProvider.Username,
+							Id:          *gitProvider.Id,
+							ProviderId:  supportedProvider.Id,
+							Name:        supportedProvider.Name,
+							Username:    *gitProvider.Username,
+							Alias:       gitProvider.GetAlias(),
+							IsDefault:   gitProvider.GetIsDefault(),
+							CacheStatus: gitprovider_view.GetCacheStatusLabel(gitProvider),
 						},
 					)
 				}
@@ -60,8 +64,12 @@ var gitProviderListCmd = &cobra.Command{
 			return
 		}
 
-		for _, gitProviderView := range gitProviderViewList {
-			views.RenderListLine(fmt.Sprintf("%s (%s)", gitProviderView.Name, gitProviderView.Username))
+		for _, gitProvider := range gitProviders.Items {
+			line := fmt.Sprintf("%s [%s]", gitprovider_view.GetConfigLabel(gitProvider), gitProvider.GetId())
+			if cacheStatus := gitprovider_view.GetCacheStatusLabel(gitProvider); cacheStatus != "" {
+				line += fmt.Sprintf(" - %s", cacheStatus)
+			}
+			views.RenderListLine(line)
 		}
 	},
 }
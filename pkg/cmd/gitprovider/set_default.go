@@ -0,0 +1,74 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitprovider
+
+import (
+	"context"
+	"fmt"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	gitprovider_view "github.com/daytonaio/daytona/pkg/views/gitprovider"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var gitProviderSetDefaultCmd = &cobra.Command{
+	Use:   "set-default [GIT_PROVIDER_ID]",
+	Short: "Set the default Git provider for repositories of its host",
+	Long:  "Set the default Git provider for repositories of its host.\nThe default is used when no Git provider is selected for the project and no alias matches the repository owner.",
+	Args:  cobra.RangeArgs(0, 1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		gitProviders, res, err := apiClient.GitProviderAPI.ListGitProviders(ctx).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		if len(gitProviders.Items) == 0 {
+			views.RenderInfoMessage("No git providers registered")
+			return
+		}
+
+		var gitProviderData apiclient.GitProvider
+		gitProviderData.Id = new(string)
+		gitProviderData.ProviderId = new(string)
+		gitProviderData.Username = new(string)
+		gitProviderData.Token = new(string)
+		gitProviderData.BaseApiUrl = new(string)
+		gitProviderData.Alias = new(string)
+
+		if len(args) == 1 {
+			*gitProviderData.Id = args[0]
+		} else {
+			gitprovider_view.GitProviderSelectionView(&gitProviderData, gitProviders.Items, true)
+		}
+
+		var selected *apiclient.GitProvider
+		for _, gitProvider := range gitProviders.Items {
+			if gitProvider.GetId() == *gitProviderData.Id {
+				selected = &gitProvider
+				break
+			}
+		}
+
+		if selected == nil {
+			log.Fatal(fmt.Sprintf("Git provider '%s' not found", *gitProviderData.Id))
+		}
+
+		res, err = apiClient.GitProviderAPI.SetDefaultGitProvider(ctx, *selected.Id).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("%s is now the default Git provider", gitprovider_view.GetConfigLabel(*selected)))
+	},
+}
@@ -4,9 +4,11 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/views"
 	"github.com/daytonaio/daytona/pkg/views/ide"
 
@@ -14,15 +16,56 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var ideLanguageFlag string
+var ideWorkspaceFlag string
+var ideProjectFlag string
+var ideRemoveFlag bool
+
 var ideCmd = &cobra.Command{
 	Use:   "ide",
 	Short: "Choose the default IDE",
+	Long:  "Choose the default IDE. Use --language to choose the IDE for projects of a language detected after cloning, or --workspace and --project to choose the IDE for a single project",
 	Run: func(cmd *cobra.Command, args []string) {
 		c, err := config.GetConfig()
 		if err != nil {
 			log.Fatal(err)
 		}
 
+		if (ideWorkspaceFlag == "") != (ideProjectFlag == "") {
+			log.Fatal(errors.New("--workspace and --project must be set together"))
+		}
+
+		if ideWorkspaceFlag != "" && ideLanguageFlag != "" {
+			log.Fatal(errors.New("--language can not be set together with --workspace and --project"))
+		}
+
+		var projectIdeKey string
+		if ideWorkspaceFlag != "" {
+			workspace, err := apiclient_util.GetWorkspace(ideWorkspaceFlag)
+			if err != nil {
+				log.Fatal(err)
+			}
+			projectIdeKey = config.GetProjectIdeKey(*workspace.Id, ideProjectFlag)
+		}
+
+		if ideRemoveFlag {
+			if projectIdeKey != "" {
+				delete(c.ProjectIdeIds, projectIdeKey)
+			} else if ideLanguageFlag != "" {
+				delete(c.LanguageIdeIds, ideLanguageFlag)
+			} else {
+				log.Fatal(errors.New("--remove requires --language or --workspace and --project"))
+			}
+
+			err = c.Save()
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			views.RenderInfoMessage("IDE mapping removed")
+			return
+		}
+
 		ideList := config.GetIdeList()
 		var chosenIde config.Ide
 
@@ -38,14 +81,36 @@ var ideCmd = &cobra.Command{
 			}
 		}
 
-		c.DefaultIdeId = chosenIde.Id
+		var content string
+		if projectIdeKey != "" {
+			if c.ProjectIdeIds == nil {
+				c.ProjectIdeIds = map[string]string{}
+			}
+			c.ProjectIdeIds[projectIdeKey] = chosenIde.Id
+			content = fmt.Sprintf("%s %s", views.GetPropertyKey(fmt.Sprintf("IDE for project '%s': ", ideProjectFlag)), chosenIde.Name)
+		} else if ideLanguageFlag != "" {
+			if c.LanguageIdeIds == nil {
+				c.LanguageIdeIds = map[string]string{}
+			}
+			c.LanguageIdeIds[ideLanguageFlag] = chosenIde.Id
+			content = fmt.Sprintf("%s %s", views.GetPropertyKey(fmt.Sprintf("IDE for %s projects: ", ideLanguageFlag)), chosenIde.Name)
+		} else {
+			c.DefaultIdeId = chosenIde.Id
+			content = fmt.Sprintf("%s %s", views.GetPropertyKey("Default IDE: "), chosenIde.Name)
+		}
 
 		err = c.Save()
 		if err != nil {
 			log.Fatal(err)
 		}
 
-		content := fmt.Sprintf("%s %s", views.GetPropertyKey("Default IDE: "), chosenIde.Name)
 		views.RenderContainerLayout(views.GetInfoMessage(content))
 	},
 }
+
+func init() {
+	ideCmd.Flags().StringVarP(&ideLanguageFlag, "language", "l", "", "Choose the IDE for projects of the language (e.g. go, typescript, python)")
+	ideCmd.Flags().StringVarP(&ideWorkspaceFlag, "workspace", "w", "", "Workspace of the project to choose the IDE for")
+	ideCmd.Flags().StringVarP(&ideProjectFlag, "project", "p", "", "Project to choose the IDE for")
+	ideCmd.Flags().BoolVar(&ideRemoveFlag, "remove", false, "Remove the IDE chosen for the language or project")
+}
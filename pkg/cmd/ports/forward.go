@@ -5,13 +5,12 @@ package ports
 
 import (
 	"context"
-	"encoding/base64"
 	"fmt"
-	"hash/fnv"
 	"strconv"
 	"time"
 
 	"github.com/daytonaio/daytona/internal/cmd/tailscale"
+	"github.com/daytonaio/daytona/internal/util"
 	"github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/frpc"
 	"github.com/daytonaio/daytona/pkg/views"
@@ -78,6 +77,10 @@ var PortForwardCmd = &cobra.Command{
 
 func init() {
 	PortForwardCmd.Flags().BoolVar(&publicPreview, "public", false, "Should be port be available publicly via an URL")
+
+	PortForwardCmd.AddCommand(portForwardAddCmd)
+	PortForwardCmd.AddCommand(portForwardListCmd)
+	PortForwardCmd.AddCommand(portForwardRemoveCmd)
 }
 
 func ForwardPublicPort(workspaceId, projectName string, hostPort, targetPort uint16) error {
@@ -93,14 +96,11 @@ func ForwardPublicPort(workspaceId, projectName string, hostPort, targetPort uin
 		return apiclient.HandleErrorResponse(res, err)
 	}
 
-	h := fnv.New64()
-	h.Write([]byte(fmt.Sprintf("%s-%s-%s", workspaceId, projectName, *serverConfig.Id)))
-
-	subDomain := fmt.Sprintf("%d-%s", targetPort, base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprint(h.Sum64()))))
+	subDomain := util.GetFrpcPortForwardSubDomain(*serverConfig.Id, workspaceId, projectName, targetPort)
 
 	go func() {
 		time.Sleep(1 * time.Second)
-		var url = fmt.Sprintf("%s://%s.%s", *serverConfig.Frps.Protocol, subDomain, *serverConfig.Frps.Domain)
+		var url = util.GetFrpcPortForwardUrl(*serverConfig.Frps.Protocol, *serverConfig.Id, *serverConfig.Frps.Domain, workspaceId, projectName, targetPort)
 		views.RenderInfoMessage(fmt.Sprintf("Port available at %s", url))
 		renderQr(url)
 	}()
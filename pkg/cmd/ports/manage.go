@@ -0,0 +1,154 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ports
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/output"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/views"
+	forward_view "github.com/daytonaio/daytona/pkg/views/workspace/forward"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var addPublicFlag bool
+
+var portForwardAddCmd = &cobra.Command{
+	Use:   "add [WORKSPACE] [PROJECT] [PORT]",
+	Short: "Add a persistent port forward to a project",
+	Long:  "Add a port forward that is kept by the server and re-established whenever the project starts. The port is available on the server host and, with --public, at a public URL",
+	Args:  cobra.ExactArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		port, err := strconv.ParseUint(args[2], 10, 16)
+		if err != nil {
+			log.Fatalf("invalid port %s", args[2])
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		err = apiclient_util.RequireCapability(server.CapabilityPortForwards)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		workspace, err := apiclient_util.GetWorkspace(args[0])
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		portForward, res, err := apiClient.WorkspaceAPI.AddPortForward(ctx, *workspace.Id, args[1]).PortForward(apiclient.ProjectPortForward{
+			Port:   int32(port),
+			Public: addPublicFlag,
+		}).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		if !portForward.Active {
+			views.RenderInfoMessage(fmt.Sprintf("Port forward %d added. It is established when project %s starts", port, args[1]))
+			return
+		}
+
+		message := fmt.Sprintf("Port %d is available on the server host at localhost:%d", port, portForward.GetHostPort())
+		if portForward.GetPublicUrl() != "" {
+			message += fmt.Sprintf("\n\nPublic URL: %s", portForward.GetPublicUrl())
+		}
+
+		views.RenderContainerLayout(views.GetInfoMessage(message))
+	},
+}
+
+var portForwardListCmd = &cobra.Command{
+	Use:     "list [WORKSPACE]",
+	Short:   "List the persistent port forwards of a workspace",
+	Args:    cobra.ExactArgs(1),
+	Aliases: []string{"ls"},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		err = apiclient_util.RequireCapability(server.CapabilityPortForwards)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		workspace, err := apiclient_util.GetWorkspace(args[0])
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		portForwards, res, err := apiClient.WorkspaceAPI.ListPortForwards(ctx, *workspace.Id).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		if output.FormatFlag != "" {
+			output.Output = portForwards.Items
+			return
+		}
+
+		if len(portForwards.Items) == 0 {
+			views.RenderInfoMessage("The workspace has no port forwards")
+			return
+		}
+
+		forward_view.ListPortForwards(portForwards.Items)
+	},
+}
+
+var portForwardRemoveCmd = &cobra.Command{
+	Use:     "remove [WORKSPACE] [PROJECT] [PORT]",
+	Short:   "Remove a persistent port forward from a project",
+	Args:    cobra.ExactArgs(3),
+	Aliases: []string{"rm", "delete"},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		port, err := strconv.ParseUint(args[2], 10, 16)
+		if err != nil {
+			log.Fatalf("invalid port %s", args[2])
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		err = apiclient_util.RequireCapability(server.CapabilityPortForwards)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		workspace, err := apiclient_util.GetWorkspace(args[0])
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		res, err := apiClient.WorkspaceAPI.RemovePortForward(ctx, *workspace.Id, args[1], int32(port)).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Port forward %d removed from project %s", port, args[1]))
+	},
+}
+
+func init() {
+	portForwardAddCmd.Flags().BoolVar(&addPublicFlag, "public", false, "Make the port available at a public URL through the server's reverse proxy")
+}
@@ -0,0 +1,19 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitidentity
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var GitIdentityCmd = &cobra.Command{
+	Use:   "git-identity",
+	Short: "Manage the git identity and commit signing key that are added to all new projects",
+}
+
+func init() {
+	GitIdentityCmd.AddCommand(setCmd)
+	GitIdentityCmd.AddCommand(showCmd)
+	GitIdentityCmd.AddCommand(deleteCmd)
+}
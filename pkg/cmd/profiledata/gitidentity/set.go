@@ -0,0 +1,149 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitidentity
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var nameFlag string
+var emailFlag string
+var signingKeyFlag string
+var signingFormatFlag string
+
+var setCmd = &cobra.Command{
+	Use:   "set",
+	Short: "Set the git identity",
+	Long: "Set the git identity written to the git config of new projects. Commits are signed if a signing key is set.\n" +
+		"SSH signing keys are used through the forwarded SSH agent so the key must be loaded in the local SSH agent (ssh-add).\n" +
+		"GPG signing requires the key to be available in the project",
+	Aliases: []string{"s", "update", "add"},
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+		ctx := context.Background()
+
+		profileData, res, err := apiClient.ProfileAPI.GetProfileData(ctx).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		identity := apiclient.GitIdentity{}
+		if profileData.GitIdentity != nil {
+			identity = *profileData.GitIdentity
+		}
+
+		name := identity.GetName()
+		email := identity.GetEmail()
+		signingKey := identity.GetSigningKey()
+		signingFormat := string(identity.GetSigningFormat())
+		if signingFormat == "" {
+			signingFormat = string(apiclient.GitSigningFormatSsh)
+		}
+
+		if cmd.Flags().NFlag() == 0 {
+			form := huh.NewForm(
+				huh.NewGroup(
+					huh.NewInput().Title("Name").Value(&name),
+					huh.NewInput().Title("Email").Value(&email),
+					huh.NewInput().
+						Title("Signing key").
+						Description("Optional. Public SSH key, path to a public SSH key file or GPG key ID").
+						Value(&signingKey),
+					huh.NewSelect[string]().
+						Title("Signing format").
+						Options(
+							huh.NewOption("SSH", string(apiclient.GitSigningFormatSsh)),
+							huh.NewOption("GPG", string(apiclient.GitSigningFormatOpenPgp)),
+						).
+						Value(&signingFormat),
+				),
+			).WithTheme(views.GetCustomTheme())
+
+			err = form.Run()
+			if err != nil {
+				log.Fatal(err)
+			}
+		} else {
+			if cmd.Flags().Changed("name") {
+				name = nameFlag
+			}
+			if cmd.Flags().Changed("email") {
+				email = emailFlag
+			}
+			if cmd.Flags().Changed("signing-key") {
+				signingKey = signingKeyFlag
+			}
+			if cmd.Flags().Changed("signing-format") {
+				signingFormat = signingFormatFlag
+			}
+		}
+
+		if signingFormat != string(apiclient.GitSigningFormatSsh) && signingFormat != string(apiclient.GitSigningFormatOpenPgp) {
+			log.Fatal(fmt.Errorf("invalid signing format '%s'. Must be one of (ssh, openpgp)", signingFormat))
+		}
+
+		signingKey, err = readSigningKey(signingKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		identity.SetName(name)
+		identity.SetEmail(email)
+		identity.SetSigningKey(signingKey)
+		identity.SetSigningFormat(apiclient.GitSigningFormat(signingFormat))
+		profileData.GitIdentity = &identity
+
+		res, err = apiClient.ProfileAPI.SetProfileData(ctx).ProfileData(*profileData).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		views.RenderInfoMessageBold("Git identity has been successfully set. It is added to projects created from now on")
+	},
+}
+
+// Reads the key from the file if the signing key is a path to a public key file
+func readSigningKey(signingKey string) (string, error) {
+	signingKey = strings.TrimSpace(signingKey)
+	if signingKey == "" || !strings.HasSuffix(signingKey, ".pub") {
+		return signingKey, nil
+	}
+
+	if strings.HasPrefix(signingKey, "~/") {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		signingKey = homeDir + strings.TrimPrefix(signingKey, "~")
+	}
+
+	content, err := os.ReadFile(signingKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to read signing key: %w", err)
+	}
+
+	return strings.TrimSpace(string(content)), nil
+}
+
+func init() {
+	setCmd.Flags().StringVar(&nameFlag, "name", "", "Name used for commits")
+	setCmd.Flags().StringVar(&emailFlag, "email", "", "Email used for commits")
+	setCmd.Flags().StringVar(&signingKeyFlag, "signing-key", "", "Public SSH key, path to a public SSH key file or GPG key ID used to sign commits")
+	setCmd.Flags().StringVar(&signingFormatFlag, "signing-format", "ssh", "Signing key format (ssh, openpgp)")
+}
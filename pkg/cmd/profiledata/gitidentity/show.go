@@ -0,0 +1,54 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitidentity
+
+import (
+	"context"
+	"fmt"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/output"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/spf13/cobra"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var showCmd = &cobra.Command{
+	Use:     "show",
+	Short:   "Show the git identity",
+	Aliases: []string{"info"},
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		profileData, res, err := apiClient.ProfileAPI.GetProfileData(context.Background()).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		if output.FormatFlag != "" {
+			output.Output = profileData.GitIdentity
+			return
+		}
+
+		identity := profileData.GitIdentity
+		if identity == nil {
+			views.RenderInfoMessageBold("No git identity set")
+			return
+		}
+
+		content := fmt.Sprintf("%s %s\n\n", views.GetPropertyKey("Name: "), identity.GetName())
+		content += fmt.Sprintf("%s %s", views.GetPropertyKey("Email: "), identity.GetEmail())
+		if identity.GetSigningKey() != "" {
+			content += fmt.Sprintf("\n\n%s %s", views.GetPropertyKey("Signing key: "), identity.GetSigningKey())
+			content += fmt.Sprintf("\n\n%s %s", views.GetPropertyKey("Signing format: "), identity.GetSigningFormat())
+		}
+
+		views.RenderContainerLayout(views.GetInfoMessage(content))
+	},
+}
@@ -4,12 +4,15 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"path/filepath"
 
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/os"
 	"github.com/daytonaio/daytona/pkg/provider/manager"
+	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/daytonaio/daytona/pkg/views"
 	"github.com/daytonaio/daytona/pkg/views/provider"
 	"github.com/spf13/cobra"
@@ -28,6 +31,14 @@ var providerInstallCmd = &cobra.Command{
 			log.Fatal(err)
 		}
 
+		if fromFileFlag != "" {
+			err = installProviderFromFile(apiClient)
+			if err != nil {
+				log.Fatal(err)
+			}
+			return
+		}
+
 		serverConfig, res, err := apiClient.ServerAPI.GetConfigExecute(apiclient.ApiGetConfigRequest{})
 		if err != nil {
 			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
@@ -76,10 +87,13 @@ var providerInstallCmd = &cobra.Command{
 			}
 		}
 
-		downloadUrls := convertToStringMap((*providersManifest)[*providerToInstall.Name].Versions[*providerToInstall.Version].DownloadUrls)
+		version := (*providersManifest)[*providerToInstall.Name].Versions[*providerToInstall.Version]
+		downloadUrls := convertToStringMap(version.DownloadUrls)
+		checksums := convertToStringMap(version.Checksums)
 		res, err = apiClient.ProviderAPI.InstallProviderExecute(apiclient.ApiInstallProviderRequest{}.Provider(apiclient.InstallProviderRequest{
 			Name:         providerToInstall.Name,
 			DownloadUrls: &downloadUrls,
+			Checksums:    &checksums,
 		}))
 		if err != nil {
 			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
@@ -93,6 +107,44 @@ var providerInstallCmd = &cobra.Command{
 	},
 }
 
+var fromFileFlag string
+var manifestFlag string
+
+func init() {
+	providerInstallCmd.Flags().StringVar(&fromFileFlag, "from-file", "", "Install the provider from a tarball or binary on the Daytona Server host instead of the registry")
+	providerInstallCmd.Flags().StringVar(&manifestFlag, "manifest", "", "Manifest with the name, version and checksums of the provider binary passed with --from-file")
+}
+
+func installProviderFromFile(apiClient *apiclient.APIClient) error {
+	err := apiclient_util.RequireCapability(server.CapabilityProviderFileInstall)
+	if err != nil {
+		return fmt.Errorf("Can't use the --from-file flag: %w", err)
+	}
+
+	// The server resolves the paths, which are the same as long as it runs on this host
+	path, err := filepath.Abs(fromFileFlag)
+	if err != nil {
+		return err
+	}
+
+	req := apiclient.InstallProviderFromFileRequest{Path: path}
+	if manifestFlag != "" {
+		manifestPath, err := filepath.Abs(manifestFlag)
+		if err != nil {
+			return err
+		}
+		req.ManifestPath = &manifestPath
+	}
+
+	res, err := apiClient.ProviderAPI.InstallProviderFromFile(context.Background()).Provider(req).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	views.RenderInfoMessageBold(fmt.Sprintf("Provider from %s has been successfully installed", path))
+	return nil
+}
+
 func convertToDTO(manifest *manager.ProvidersManifest) []apiclient.Provider {
 	pluginList := []apiclient.Provider{}
 	for pluginName, pluginManifest := range *manifest {
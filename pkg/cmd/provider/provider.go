@@ -17,4 +17,5 @@ func init() {
 	ProviderCmd.AddCommand(providerUninstallCmd)
 	ProviderCmd.AddCommand(providerInstallCmd)
 	ProviderCmd.AddCommand(providerUpdateCmd)
+	ProviderCmd.AddCommand(providerCatalogCmd)
 }
@@ -47,6 +47,11 @@ var providerUpdateCmd = &cobra.Command{
 
 		if allFlag {
 			for _, provider := range providerList {
+				if !providersManifest.HasUpdateAvailable(*provider.Name, *provider.Version) {
+					fmt.Printf("Provider %s is up to date\n", *provider.Name)
+					continue
+				}
+
 				fmt.Printf("Updating provider %s\n", *provider.Name)
 				err := updateProvider(&provider, providersManifest, apiClient)
 				if err != nil {
@@ -82,17 +87,18 @@ func updateProvider(providerToUpdate *apiclient.Provider, providersManifest *man
 		return fmt.Errorf("Provider %s not found in manifest", *providerToUpdate.Name)
 	}
 
-	version, ok := providerManifest.Versions["latest"]
-	if !ok {
-		_, latest := providerManifest.FindLatestVersion()
-		version = *latest
+	_, version := providerManifest.GetLatestVersion()
+	if version == nil {
+		return fmt.Errorf("No versions of provider %s found in manifest", *providerToUpdate.Name)
 	}
 
 	downloadUrls := convertToStringMap(version.DownloadUrls)
+	checksums := convertToStringMap(version.Checksums)
 
 	res, err := apiClient.ProviderAPI.InstallProviderExecute(apiclient.ApiInstallProviderRequest{}.Provider(apiclient.InstallProviderRequest{
 		Name:         providerToUpdate.Name,
 		DownloadUrls: &downloadUrls,
+		Checksums:    &checksums,
 	}))
 	if err != nil {
 		return apiclient_util.HandleErrorResponse(res, err)
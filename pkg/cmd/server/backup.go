@@ -0,0 +1,82 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/daytonaio/daytona/internal"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/backup"
+	"github.com/daytonaio/daytona/pkg/views"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var backupOutputFlag string
+var backupExcludeLogsFlag bool
+
+var backupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Create a backup archive of the Daytona Server data",
+	Long:  "Create a backup archive of the Daytona Server config, database, provider targets, keys and logs. The server can keep running while the backup is created",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		c, err := server.GetConfig()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		configDir, err := server.GetConfigDir()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		dbPath := ""
+		if c.Database == nil || c.Database.Type != server.DatabaseTypePostgres {
+			dbPath, err = getDbPath()
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+
+		outputPath := backupOutputFlag
+		if outputPath == "" {
+			outputPath = fmt.Sprintf("daytona-backup-%s.tar.gz", time.Now().Format("20060102150405"))
+		}
+
+		file, err := os.OpenFile(outputPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		_, err = backup.Create(backup.BackupConfig{
+			Version:     internal.Version,
+			ServerId:    c.Id,
+			ConfigDir:   configDir,
+			DbPath:      dbPath,
+			ExcludeLogs: backupExcludeLogsFlag,
+		}, file)
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			os.Remove(outputPath)
+			log.Fatal(err)
+		}
+
+		views.RenderInfoMessageBold(fmt.Sprintf("Backup saved to %s", outputPath))
+
+		if dbPath == "" {
+			views.RenderInfoMessage("The server uses an external Postgres database which is not included in the backup. Back it up separately, e.g. with pg_dump")
+		}
+	},
+}
+
+func init() {
+	backupCmd.Flags().StringVarP(&backupOutputFlag, "output", "o", "", "Path of the backup archive")
+	backupCmd.Flags().BoolVar(&backupExcludeLogsFlag, "exclude-logs", false, "Do not include the workspace and build logs")
+}
@@ -32,7 +32,7 @@ var configureCmd = &cobra.Command{
 			log.Fatal(apiclient.HandleErrorResponse(res, err))
 		}
 
-		apiServerConfig = server_view.ConfigurationForm(apiServerConfig, containerRegistries)
+		apiServerConfig = server_view.ConfigurationForm(apiServerConfig, containerRegistries.Items)
 
 		_, res, err = apiClient.ServerAPI.SetConfig(context.Background()).Config(*apiServerConfig).Execute()
 		if err != nil {
@@ -5,6 +5,7 @@ package server
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/internal/util/apiclient"
@@ -13,6 +14,8 @@ import (
 )
 
 var followFlag bool
+var grepFlag string
+var levelFlag string
 
 var logsCmd = &cobra.Command{
 	Use:   "logs",
@@ -28,9 +31,13 @@ var logsCmd = &cobra.Command{
 			log.Fatal(err)
 		}
 
-		query := ""
-		if followFlag {
-			query = "follow=true"
+		query, err := apiclient.LogQuery{
+			Follow: followFlag,
+			Grep:   grepFlag,
+			Level:  levelFlag,
+		}.Encode()
+		if err != nil {
+			log.Fatal(err)
 		}
 
 		ws, res, err := apiclient.GetWebsocketConn("/log/server", &activeProfile, &query)
@@ -44,11 +51,14 @@ var logsCmd = &cobra.Command{
 				return
 			}
 
-			fmt.Println(string(msg))
+			// Filtered logs are sent line by line
+			fmt.Println(strings.TrimSuffix(string(msg), "\n"))
 		}
 	},
 }
 
 func init() {
 	logsCmd.Flags().BoolVarP(&followFlag, "follow", "f", false, "Follow logs")
+	logsCmd.Flags().StringVarP(&grepFlag, "grep", "g", "", "Only output log lines matching the regular expression")
+	logsCmd.Flags().StringVar(&levelFlag, "level", "", "Only output log lines of the given severity or higher (e.g. 'warn')")
 }
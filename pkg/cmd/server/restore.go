@@ -0,0 +1,138 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/internal"
+	"github.com/daytonaio/daytona/pkg/api"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/server/backup"
+	"github.com/daytonaio/daytona/pkg/views"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var restoreYesFlag bool
+var restoreForceFlag bool
+
+var restoreCmd = &cobra.Command{
+	Use:   "restore [ARCHIVE]",
+	Short: "Restore the Daytona Server data from a backup archive",
+	Long:  "Restore the Daytona Server data from a backup archive created with 'daytona server backup'. The server must be stopped. The replaced data is kept next to its original location",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		archivePath := args[0]
+
+		manifest, err := backup.ReadManifest(archivePath)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		c, err := server.GetConfig()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if api.NewApiServer(api.ApiServerConfig{ApiPort: int(c.ApiPort)}).HealthCheck() == nil {
+			log.Fatal("the Daytona Server is running. Stop it with 'daytona server stop' before restoring a backup")
+		}
+
+		if !restoreYesFlag {
+			confirm := false
+			form := huh.NewForm(
+				huh.NewGroup(
+					huh.NewConfirm().
+						Title(fmt.Sprintf("Restore the backup of server %s created on %s?", manifest.ServerId, manifest.CreatedAt.Format(time.DateTime))).
+						Description("The current server data will be replaced").
+						Value(&confirm),
+				),
+			).WithTheme(views.GetCustomTheme())
+
+			err := form.Run()
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			if !confirm {
+				views.RenderInfoMessage("Operation cancelled.")
+				return
+			}
+		}
+
+		configDir, err := server.GetConfigDir()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		dbPath, err := getDbPath()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		result, err := backup.Restore(backup.RestoreConfig{
+			Version:   internal.Version,
+			ConfigDir: configDir,
+			DbPath:    dbPath,
+			Force:     restoreForceFlag,
+		}, archivePath)
+		if err != nil {
+			if backup.IsNewerVersion(err) {
+				log.Fatal(fmt.Errorf("%w. Update Daytona or use --force to restore it anyway", err))
+			}
+			log.Fatal(err)
+		}
+
+		err = relocateConfigPaths(manifest.ConfigDir, configDir)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		views.RenderInfoMessageBold("Backup restored successfully")
+
+		if result.PreviousConfigDir != "" {
+			views.RenderInfoMessage(fmt.Sprintf("The previous server config was moved to %s", result.PreviousConfigDir))
+		}
+		if result.PreviousDbPath != "" {
+			views.RenderInfoMessage(fmt.Sprintf("The previous database was moved to %s", result.PreviousDbPath))
+		}
+		if !result.Manifest.IncludesDatabase {
+			views.RenderInfoMessage("The backup does not include the database. Restore the external database separately")
+		}
+	},
+}
+
+// Paths in the restored config that point to the Daytona config dir of the machine the backup was created on
+// are moved to the config dir of this machine
+func relocateConfigPaths(backupConfigDir, configDir string) error {
+	if backupConfigDir == "" || backupConfigDir == configDir {
+		return nil
+	}
+
+	c, err := server.GetConfig()
+	if err != nil {
+		return err
+	}
+
+	oldRoot := filepath.Dir(backupConfigDir)
+	newRoot := filepath.Dir(configDir)
+
+	for _, path := range []*string{&c.ProvidersDir, &c.BinariesPath, &c.LogFilePath} {
+		if *path == oldRoot || strings.HasPrefix(*path, oldRoot+string(filepath.Separator)) {
+			*path = newRoot + strings.TrimPrefix(*path, oldRoot)
+		}
+	}
+
+	return server.Save(*c)
+}
+
+func init() {
+	restoreCmd.Flags().BoolVarP(&restoreYesFlag, "yes", "y", false, "Restore without prompt")
+	restoreCmd.Flags().BoolVar(&restoreForceFlag, "force", false, "Restore a backup created by a newer version of Daytona")
+}
@@ -4,6 +4,7 @@
 package server
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
 	"os"
@@ -22,19 +23,34 @@ import (
 	"github.com/daytonaio/daytona/pkg/provisioner"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	"github.com/daytonaio/daytona/pkg/server/builds"
 	"github.com/daytonaio/daytona/pkg/server/containerregistries"
+	"github.com/daytonaio/daytona/pkg/server/events"
 	"github.com/daytonaio/daytona/pkg/server/gitproviders"
 	"github.com/daytonaio/daytona/pkg/server/headscale"
+	"github.com/daytonaio/daytona/pkg/server/notifications"
+	"github.com/daytonaio/daytona/pkg/server/portforwards"
 	"github.com/daytonaio/daytona/pkg/server/profiledata"
 	"github.com/daytonaio/daytona/pkg/server/providertargets"
 	"github.com/daytonaio/daytona/pkg/server/registry"
+	"github.com/daytonaio/daytona/pkg/server/sessionrecordings"
+	"github.com/daytonaio/daytona/pkg/server/targetgroups"
+	"github.com/daytonaio/daytona/pkg/server/users"
 	"github.com/daytonaio/daytona/pkg/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/sshca"
 	started_view "github.com/daytonaio/daytona/pkg/views/server/started"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
+	"gorm.io/gorm"
 )
 
+// Time to wait for the projects other projects depend on to become healthy when starting a workspace
+const projectDependencyTimeout = 5 * time.Minute
+
+// Time before a workspace expires at which it is warned about, unless configured otherwise
+const defaultWorkspaceExpiryWarning = time.Hour
+
 var ServeCmd = &cobra.Command{
 	Use:   "serve",
 	Short: "Run the server process in the current terminal session",
@@ -55,22 +71,34 @@ var ServeCmd = &cobra.Command{
 			log.Fatal(err)
 		}
 
-		apiServer := api.NewApiServer(api.ApiServerConfig{
+		apiServerConfig := api.ApiServerConfig{
 			ApiPort: int(c.ApiPort),
-		})
+		}
+		if c.RateLimit != nil {
+			apiServerConfig.RateLimit = c.RateLimit.RequestsPerSecond
+			apiServerConfig.RateLimitBurst = int(c.RateLimit.Burst)
+		}
+
+		apiServer := api.NewApiServer(apiServerConfig)
 
 		logsDir, err := server.GetWorkspaceLogsDir()
 		if err != nil {
 			log.Fatal(err)
 		}
 		loggerFactory := logs.NewLoggerFactory(logsDir)
+		if c.LogRetention != nil {
+			loggerFactory = logs.NewLoggerFactoryWithRetention(logsDir, logs.RetentionConfig{
+				MaxFileSizeMB: c.LogRetention.MaxFileSizeMB,
+				MaxBackups:    c.LogRetention.MaxBackups,
+				MaxAgeDays:    c.LogRetention.MaxAgeDays,
+			})
+		}
 
-		dbPath, err := getDbPath()
+		dbConnection, err := getDbConnection(c)
 		if err != nil {
 			log.Fatal(err)
 		}
 
-		dbConnection := db.GetSQLiteConnection(dbPath)
 		apiKeyStore, err := db.NewApiKeyStore(dbConnection)
 		if err != nil {
 			log.Fatal(err)
@@ -91,10 +119,33 @@ var ServeCmd = &cobra.Command{
 		if err != nil {
 			log.Fatal(err)
 		}
+		targetGroupStore, err := db.NewTargetGroupStore(dbConnection)
+		if err != nil {
+			log.Fatal(err)
+		}
+		sessionRecordingStore, err := db.NewSessionRecordingStore(dbConnection)
+		if err != nil {
+			log.Fatal(err)
+		}
 		profileDataStore, err := db.NewProfileDataStore(dbConnection)
 		if err != nil {
 			log.Fatal(err)
 		}
+		buildResultStore, err := db.NewBuildResultStore(dbConnection)
+		if err != nil {
+			log.Fatal(err)
+		}
+		imported, err := builder.ImportBuildResultFiles(filepath.Join(configDir, "builds"), buildResultStore)
+		if err != nil {
+			log.Fatal(fmt.Errorf("failed to import build results: %w", err))
+		}
+		if imported > 0 {
+			log.Infof("Imported %d build results into the database", imported)
+		}
+		userStore, err := db.NewUserStore(dbConnection)
+		if err != nil {
+			log.Fatal(err)
+		}
 
 		headscaleServer := headscale.NewHeadscaleServer(&headscale.HeadscaleServerConfig{
 			ServerId:      c.Id,
@@ -133,9 +184,18 @@ var ServeCmd = &cobra.Command{
 		providerTargetService := providertargets.NewProviderTargetService(providertargets.ProviderTargetServiceConfig{
 			TargetStore: providerTargetStore,
 		})
+		targetGroupService := targetgroups.NewTargetGroupService(targetgroups.TargetGroupServiceConfig{
+			TargetGroupStore: targetGroupStore,
+			TargetStore:      providerTargetStore,
+			WorkspaceStore:   workspaceStore,
+		})
 		apiKeyService := apikeys.NewApiKeyService(apikeys.ApiKeyServiceConfig{
 			ApiKeyStore: apiKeyStore,
 		})
+		userService := users.NewUserService(users.UserServiceConfig{
+			UserStore:     userStore,
+			ApiKeyService: apiKeyService,
+		})
 
 		headscaleUrl := util.GetFrpcHeadscaleUrl(c.Frps.Protocol, c.Id, c.Frps.Domain)
 
@@ -161,7 +221,7 @@ var ServeCmd = &cobra.Command{
 		buildImageNamespace = strings.TrimSuffix(buildImageNamespace, "/")
 
 		builderFactory := builder.NewBuilderFactory(builder.BuilderConfig{
-			ServerConfigFolder:              configDir,
+			BuildResultStore:                buildResultStore,
 			ContainerRegistryServer:         c.BuilderRegistryServer,
 			BasePath:                        filepath.Join(configDir, "builds"),
 			BuildImageNamespace:             buildImageNamespace,
@@ -176,9 +236,43 @@ var ServeCmd = &cobra.Command{
 			ProviderManager: providerManager,
 		})
 		gitProviderService := gitproviders.NewGitProviderService(gitproviders.GitProviderServiceConfig{
-			ConfigStore: gitProviderConfigStore,
+			ConfigStore:  gitProviderConfigStore,
+			CacheWarming: c.WarmGitProviderCache,
 		})
 
+		eventService := events.NewEventService(events.EventServiceConfig{})
+		if len(c.Notifications) > 0 {
+			notificationService := notifications.NewNotificationService(notifications.NotificationServiceConfig{
+				Sinks: c.Notifications,
+			})
+			eventService.Subscribe(notificationService.Notify)
+		}
+
+		portForwardService := portforwards.NewPortForwardService(portforwards.PortForwardServiceConfig{
+			Dial:         headscaleServer.Dial,
+			ServerId:     c.Id,
+			FrpsDomain:   c.Frps.Domain,
+			FrpsPort:     c.Frps.Port,
+			FrpsProtocol: c.Frps.Protocol,
+		})
+
+		var sshCertificateAuthority *sshca.CertificateAuthority
+		var sshCertificateTtl time.Duration
+		if c.SshCertificateAuthority != nil {
+			sshCertificateAuthority, sshCertificateTtl, err = getSshCertificateAuthority(*c.SshCertificateAuthority, configDir)
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+
+		workspaceExpiryWarning := defaultWorkspaceExpiryWarning
+		if c.WorkspaceExpiryWarning != "" {
+			workspaceExpiryWarning, err = time.ParseDuration(c.WorkspaceExpiryWarning)
+			if err != nil || workspaceExpiryWarning < 0 {
+				log.Fatalf("invalid workspace expiry warning: %s", c.WorkspaceExpiryWarning)
+			}
+		}
+
 		workspaceService := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
 			WorkspaceStore:                  workspaceStore,
 			TargetStore:                     providerTargetStore,
@@ -193,15 +287,48 @@ var ServeCmd = &cobra.Command{
 			Provisioner:                     provisioner,
 			LoggerFactory:                   loggerFactory,
 			BuilderFactory:                  builderFactory,
+			EventService:                    eventService,
+			PortForwardService:              portForwardService,
+			ArchiveStorage:                  c.ArchiveStorage,
+			SshCertificateAuthority:         sshCertificateAuthority,
+			SshCertificateTtl:               sshCertificateTtl,
+			ProjectDependencyTimeout:        projectDependencyTimeout,
+			TargetGroupService:              targetGroupService,
+			UserService:                     userService,
+			NetworkAccess:                   headscaleServer,
+			ExpiryWarning:                   workspaceExpiryWarning,
+			BuildProjects:                   c.BuildProjectsOnCreate,
+		})
+		sessionRecordingService := sessionrecordings.NewSessionRecordingService(sessionrecordings.SessionRecordingServiceConfig{
+			Store:          sessionRecordingStore,
+			WorkspaceStore: workspaceStore,
+			RecordingsDir:  filepath.Join(configDir, "session-recordings"),
 		})
 		profileDataService := profiledata.NewProfileDataService(profiledata.ProfileDataServiceConfig{
 			ProfileDataStore: profileDataStore,
 		})
 
+		buildService := builds.NewBuildService(builds.BuildServiceConfig{
+			BuilderFactory:     builderFactory,
+			GitProviderService: gitProviderService,
+			LoggerFactory:      loggerFactory,
+			EventService:       eventService,
+			UserService:        userService,
+		})
+
+		var leaderLock server.LeaderLock
+		if c.Database != nil && c.Database.Type == server.DatabaseTypePostgres {
+			leaderLock, err = db.NewPostgresLeaderLock(dbConnection, "daytona-server-background-jobs")
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+
 		server := server.GetInstance(&server.ServerInstanceConfig{
 			Config:                   *c,
 			TailscaleServer:          headscaleServer,
 			ProviderTargetService:    providerTargetService,
+			TargetGroupService:       targetGroupService,
 			ContainerRegistryService: containerRegistryService,
 			LocalContainerRegistry:   localContainerRegistry,
 			ApiKeyService:            apiKeyService,
@@ -209,6 +336,11 @@ var ServeCmd = &cobra.Command{
 			GitProviderService:       gitProviderService,
 			ProviderManager:          providerManager,
 			ProfileDataService:       profileDataService,
+			BuildService:             buildService,
+			EventService:             eventService,
+			SessionRecordingService:  sessionRecordingService,
+			UserService:              userService,
+			LeaderLock:               leaderLock,
 		})
 
 		errCh := make(chan error)
@@ -276,6 +408,46 @@ func printServerStartedMessage(c *server.Config, runAsDaemon bool) {
 	started_view.Render(c.ApiPort, util.GetFrpcApiUrl(c.Frps.Protocol, c.Id, c.Frps.Domain), runAsDaemon)
 }
 
+func getDbConnection(c *server.Config) (*gorm.DB, error) {
+	if c.Database != nil && c.Database.Type == server.DatabaseTypePostgres {
+		url, err := c.Database.GetUrl()
+		if err != nil {
+			return nil, err
+		}
+		return db.GetPostgresConnection(url)
+	}
+
+	dbPath, err := getDbPath()
+	if err != nil {
+		return nil, err
+	}
+
+	return db.GetSQLiteConnection(dbPath), nil
+}
+
+func getSshCertificateAuthority(config server.SshCertificateAuthorityConfig, configDir string) (*sshca.CertificateAuthority, time.Duration, error) {
+	ttl := 8 * time.Hour
+	if config.CertificateTtl != "" {
+		var err error
+		ttl, err = time.ParseDuration(config.CertificateTtl)
+		if err != nil || ttl <= 0 {
+			return nil, 0, fmt.Errorf("invalid SSH certificate TTL: %s", config.CertificateTtl)
+		}
+	}
+
+	keyPath := config.KeyPath
+	if keyPath == "" {
+		keyPath = filepath.Join(configDir, "ssh_ca")
+	}
+
+	ca, err := sshca.LoadOrCreate(keyPath)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return ca, ttl, nil
+}
+
 func getDbPath() (string, error) {
 	userConfigDir, err := os.UserConfigDir()
 	if err != nil {
@@ -307,6 +479,13 @@ func setDefaultConfig(server *server.Server, apiPort uint32) error {
 	}
 
 	apiKey, err := server.ApiKeyService.Generate(apikey.ApiKeyTypeClient, "default")
+	if errors.Is(err, apikeys.ErrApiKeyAlreadyExists) {
+		// The key of a removed default profile can not be recovered so it is replaced
+		err = server.ApiKeyService.RevokeClientKey("", "default")
+		if err == nil {
+			apiKey, err = server.ApiKeyService.Generate(apikey.ApiKeyTypeClient, "default")
+		}
+	}
 	if err != nil {
 		return err
 	}
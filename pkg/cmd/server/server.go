@@ -71,5 +71,8 @@ func init() {
 	ServerCmd.AddCommand(startCmd)
 	ServerCmd.AddCommand(stopCmd)
 	ServerCmd.AddCommand(restartCmd)
+	ServerCmd.AddCommand(backupCmd)
+	ServerCmd.AddCommand(restoreCmd)
+	ServerCmd.AddCommand(usersCmd)
 	ServerCmd.Flags().BoolVarP(&yesFlag, "yes", "y", false, "Execute purge without prompt")
 }
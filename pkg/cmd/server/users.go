@@ -0,0 +1,168 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/daytonaio/daytona/internal/util"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/output"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/views"
+	apikey_view "github.com/daytonaio/daytona/pkg/views/server/apikey"
+	user_view "github.com/daytonaio/daytona/pkg/views/server/user"
+	"github.com/spf13/cobra"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var adminFlag bool
+
+var usersCmd = &cobra.Command{
+	Use:     "users",
+	Short:   "Manage the users of the Daytona Server",
+	Long:    "Manage the users of the Daytona Server. Each user has their own API keys and only sees their own workspaces, Git providers and targets. Admins see and manage everything",
+	Aliases: []string{"user"},
+}
+
+var usersListCmd = &cobra.Command{
+	Use:     "list",
+	Short:   "List users",
+	Args:    cobra.NoArgs,
+	Aliases: []string{"ls"},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		err = apiclient_util.RequireCapability(server.CapabilityUsers)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		users, res, err := apiClient.UserAPI.ListUsers(ctx).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		if len(users.Items) == 0 {
+			views.RenderInfoMessageBold("No users found")
+			views.RenderInfoMessage("Use 'daytona server users add' to add a user")
+			return
+		}
+
+		if output.FormatFlag != "" {
+			output.Output = users.Items
+			return
+		}
+
+		user_view.ListUsers(users.Items)
+	},
+}
+
+var usersAddCmd = &cobra.Command{
+	Use:   "add NAME",
+	Short: "Add a user",
+	Long:  "Add a user and generate its first API key. The key is only shown once",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		err = apiclient_util.RequireCapability(server.CapabilityUsers)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		request := apiclient.NewCreateUserRequest(args[0])
+		if adminFlag {
+			request.SetRole(apiclient.RoleAdmin)
+		}
+
+		created, res, err := apiClient.UserAPI.CreateUser(ctx).User(*request).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		serverConfig, res, err := apiClient.ServerAPI.GetConfig(ctx).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		views.RenderInfoMessageBold(fmt.Sprintf("User %s added successfully", created.User.Name))
+
+		apiUrl := util.GetFrpcApiUrl(*serverConfig.Frps.Protocol, *serverConfig.Id, *serverConfig.Frps.Domain)
+
+		apikey_view.Render(created.ApiKey, apiUrl)
+	},
+}
+
+var usersDisableCmd = &cobra.Command{
+	Use:   "disable NAME",
+	Short: "Disable a user",
+	Long:  "Disable a user. The API keys of the user are rejected until the user is enabled again. The workspaces and other resources of the user are kept",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		setUserDisabled(args[0], true)
+	},
+}
+
+var usersEnableCmd = &cobra.Command{
+	Use:   "enable NAME",
+	Short: "Enable a disabled user",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		setUserDisabled(args[0], false)
+	},
+}
+
+func setUserDisabled(userName string, disabled bool) {
+	ctx := context.Background()
+
+	apiClient, err := apiclient_util.GetApiClient(nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = apiclient_util.RequireCapability(server.CapabilityUsers)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var res *http.Response
+	if disabled {
+		_, res, err = apiClient.UserAPI.DisableUser(ctx, userName).Execute()
+	} else {
+		_, res, err = apiClient.UserAPI.EnableUser(ctx, userName).Execute()
+	}
+	if err != nil {
+		log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+	}
+
+	if disabled {
+		views.RenderInfoMessageBold(fmt.Sprintf("User %s disabled successfully", userName))
+	} else {
+		views.RenderInfoMessageBold(fmt.Sprintf("User %s enabled successfully", userName))
+	}
+}
+
+func init() {
+	usersAddCmd.Flags().BoolVar(&adminFlag, "admin", false, "Add the user as an admin. Admins can manage all users, workspaces and server settings")
+
+	usersCmd.AddCommand(usersListCmd)
+	usersCmd.AddCommand(usersAddCmd)
+	usersCmd.AddCommand(usersDisableCmd)
+	usersCmd.AddCommand(usersEnableCmd)
+}
@@ -0,0 +1,194 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package target
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/output"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/views"
+	group_view "github.com/daytonaio/daytona/pkg/views/target/group"
+	"github.com/spf13/cobra"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var placementPolicyFlag string
+
+var targetGroupCmd = &cobra.Command{
+	Use:   "group",
+	Short: "Manage target groups",
+	Long:  "Manage target groups. Workspaces created with 'daytona create --target-group' are placed on a member target according to the group placement policy",
+}
+
+var targetGroupListCmd = &cobra.Command{
+	Use:     "list",
+	Short:   "List target groups",
+	Args:    cobra.NoArgs,
+	Aliases: []string{"ls"},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		err = apiclient_util.RequireCapability(server.CapabilityTargetGroups)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		groups, res, err := apiClient.TargetGroupAPI.ListTargetGroups(ctx).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		if len(groups.Items) == 0 {
+			views.RenderInfoMessageBold("No target groups found")
+			views.RenderInfoMessage("Use 'daytona target group set' to add a target group")
+			return
+		}
+
+		if output.FormatFlag != "" {
+			output.Output = groups.Items
+			return
+		}
+
+		group_view.ListTargetGroups(groups.Items)
+	},
+}
+
+var targetGroupSetCmd = &cobra.Command{
+	Use:   "set GROUP TARGET...",
+	Short: "Create or replace a target group",
+	Long:  "Create or replace a target group. Members that are kept in the group stay drained",
+	Args:  cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		err = apiclient_util.RequireCapability(server.CapabilityTargetGroups)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		members := []apiclient.TargetGroupMember{}
+		for _, target := range args[1:] {
+			members = append(members, apiclient.TargetGroupMember{Target: target})
+		}
+
+		group := apiclient.NewTargetGroup(members, args[0], apiclient.PlacementPolicy(placementPolicyFlag))
+
+		res, err := apiClient.TargetGroupAPI.SetTargetGroup(ctx).TargetGroup(*group).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		views.RenderInfoMessageBold(fmt.Sprintf("Target group %s set successfully", args[0]))
+	},
+}
+
+var targetGroupRemoveCmd = &cobra.Command{
+	Use:     "remove GROUP",
+	Short:   "Remove a target group",
+	Long:    "Remove a target group. The member targets and their workspaces are kept",
+	Args:    cobra.ExactArgs(1),
+	Aliases: []string{"rm", "delete"},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		err = apiclient_util.RequireCapability(server.CapabilityTargetGroups)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		res, err := apiClient.TargetGroupAPI.RemoveTargetGroup(ctx, args[0]).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		views.RenderInfoMessageBold(fmt.Sprintf("Target group %s removed successfully", args[0]))
+	},
+}
+
+var targetGroupDrainCmd = &cobra.Command{
+	Use:   "drain GROUP [TARGET...]",
+	Short: "Stop placing new workspaces on target group members",
+	Long:  "Stop placing new workspaces on target group members. Existing workspaces are kept. All members are drained if no targets are given",
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		setTargetGroupDrained(args[0], args[1:], true)
+	},
+}
+
+var targetGroupUndrainCmd = &cobra.Command{
+	Use:   "undrain GROUP [TARGET...]",
+	Short: "Resume placing new workspaces on target group members",
+	Long:  "Resume placing new workspaces on target group members. All members are undrained if no targets are given",
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		setTargetGroupDrained(args[0], args[1:], false)
+	},
+}
+
+func setTargetGroupDrained(groupName string, targets []string, drained bool) {
+	ctx := context.Background()
+
+	apiClient, err := apiclient_util.GetApiClient(nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = apiclient_util.RequireCapability(server.CapabilityTargetGroups)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	request := apiclient.DrainTargetGroupRequest{Targets: targets}
+
+	var group *apiclient.TargetGroup
+	var res *http.Response
+	if drained {
+		group, res, err = apiClient.TargetGroupAPI.DrainTargetGroup(ctx, groupName).Request(request).Execute()
+	} else {
+		group, res, err = apiClient.TargetGroupAPI.UndrainTargetGroup(ctx, groupName).Request(request).Execute()
+	}
+	if err != nil {
+		log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+	}
+
+	drainedCount := 0
+	for _, member := range group.Members {
+		if member.Drained {
+			drainedCount++
+		}
+	}
+
+	views.RenderInfoMessage(fmt.Sprintf("%d of %d members of target group %s are drained", drainedCount, len(group.Members), groupName))
+}
+
+func init() {
+	targetGroupSetCmd.Flags().StringVarP(&placementPolicyFlag, "policy", "p", "spread", "Placement policy of the group: 'spread' places workspaces on the member with the fewest workspaces, 'ordered' on the first member that is not drained")
+
+	targetGroupCmd.AddCommand(targetGroupListCmd)
+	targetGroupCmd.AddCommand(targetGroupSetCmd)
+	targetGroupCmd.AddCommand(targetGroupRemoveCmd)
+	targetGroupCmd.AddCommand(targetGroupDrainCmd)
+	targetGroupCmd.AddCommand(targetGroupUndrainCmd)
+}
@@ -111,7 +111,7 @@ func RemoveTargetWorkspaces(ctx context.Context, client *apiclient.APIClient, ta
 		return apiclient_util.HandleErrorResponse(res, err)
 	}
 
-	for _, workspace := range workspaceList {
+	for _, workspace := range workspaceList.Items {
 		if *workspace.Target != target {
 			continue
 		}
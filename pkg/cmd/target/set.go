@@ -5,7 +5,11 @@ package target
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/charmbracelet/huh"
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	internal_util "github.com/daytonaio/daytona/internal/util"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
@@ -85,14 +89,62 @@ var TargetSetCmd = &cobra.Command{
 			}
 		}
 
-		err = target.SetTargetForm(selectedTarget, *targetManifest)
+		selectedTarget.ProviderInfo = &apiclient.ProviderProviderInfo{
+			Name:    selectedProvider.Name,
+			Version: selectedProvider.Version,
+		}
+
+		var validationErrors []apiclient.TargetOptionValidationError
+		for {
+			err = target.SetTargetForm(selectedTarget, *targetManifest, validationErrors)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			if skipValidationFlag {
+				break
+			}
+
+			validationErrors, res, err = client.TargetAPI.ValidateTarget(context.Background()).Target(*selectedTarget).Execute()
+			if err != nil {
+				log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+			}
+
+			if len(validationErrors) == 0 {
+				break
+			}
+
+			target.RenderValidationErrors(validationErrors)
+
+			retry := true
+			form := huh.NewForm(
+				huh.NewGroup(
+					huh.NewConfirm().
+						Title("Edit the target options?").
+						Description("Choose no to cancel. Use --skip-validation to save the target without validation").
+						Value(&retry),
+				),
+			).WithTheme(views.GetCustomTheme())
+
+			err = form.Run()
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			if !retry {
+				views.RenderInfoMessage("Operation cancelled.")
+				return
+			}
+		}
+
+		err = setNetworkPolicy(cmd, selectedTarget)
 		if err != nil {
 			log.Fatal(err)
 		}
 
-		selectedTarget.ProviderInfo = &apiclient.ProviderProviderInfo{
-			Name:    selectedProvider.Name,
-			Version: selectedProvider.Version,
+		err = setNetworkMode(cmd, selectedTarget)
+		if err != nil {
+			log.Fatal(err)
 		}
 
 		res, err = client.TargetAPI.SetTarget(context.Background()).Target(*selectedTarget).Execute()
@@ -103,3 +155,90 @@ var TargetSetCmd = &cobra.Command{
 		views.RenderInfoMessage("Target set successfully")
 	},
 }
+
+var allowInboundPortsFlag []string
+var denyAllEgressFlag bool
+var networkModeFlag string
+var skipValidationFlag bool
+
+func init() {
+	TargetSetCmd.Flags().StringSliceVar(&allowInboundPortsFlag, "allow-inbound-ports", nil, "Ports or port ranges exposed on the target host (e.g. '3000,8000-8100')")
+	TargetSetCmd.Flags().BoolVar(&denyAllEgressFlag, "deny-all-egress", false, "Block all outbound traffic from projects except to the Daytona server")
+	TargetSetCmd.Flags().BoolVar(&skipValidationFlag, "skip-validation", false, "Save the target without validating the options with the provider")
+	TargetSetCmd.Flags().StringVar(&networkModeFlag, "network-mode", "", fmt.Sprintf("Network mode of the target projects (%s, %s). Pass an empty value to use the server network mode", apiclient.NetworkModeDaytona, apiclient.NetworkModeTailnet))
+}
+
+func setNetworkPolicy(cmd *cobra.Command, selectedTarget *apiclient.ProviderTarget) error {
+	if !cmd.Flags().Changed("allow-inbound-ports") && !cmd.Flags().Changed("deny-all-egress") {
+		return nil
+	}
+
+	if selectedTarget.NetworkPolicy == nil {
+		selectedTarget.NetworkPolicy = &apiclient.NetworkPolicy{}
+	}
+
+	if cmd.Flags().Changed("allow-inbound-ports") {
+		portRanges, err := parsePortRanges(allowInboundPortsFlag)
+		if err != nil {
+			return err
+		}
+		selectedTarget.NetworkPolicy.AllowedInboundPorts = portRanges
+	}
+
+	if cmd.Flags().Changed("deny-all-egress") {
+		selectedTarget.NetworkPolicy.DenyAllEgress = &denyAllEgressFlag
+	}
+
+	return nil
+}
+
+func setNetworkMode(cmd *cobra.Command, selectedTarget *apiclient.ProviderTarget) error {
+	if !cmd.Flags().Changed("network-mode") {
+		return nil
+	}
+
+	if networkModeFlag == "" {
+		selectedTarget.NetworkMode = nil
+		return nil
+	}
+
+	networkMode, err := apiclient.NewNetworkModeFromValue(networkModeFlag)
+	if err != nil {
+		return err
+	}
+
+	selectedTarget.NetworkMode = networkMode
+	return nil
+}
+
+func parsePortRanges(portSpecs []string) ([]apiclient.PortRange, error) {
+	portRanges := []apiclient.PortRange{}
+
+	for _, portSpec := range portSpecs {
+		if portSpec == "" {
+			continue
+		}
+
+		startPort, endPort, isRange := strings.Cut(portSpec, "-")
+		if !isRange {
+			endPort = startPort
+		}
+
+		start, err := strconv.ParseUint(startPort, 10, 16)
+		if err != nil || start == 0 {
+			return nil, fmt.Errorf("invalid port: %s", portSpec)
+		}
+
+		end, err := strconv.ParseUint(endPort, 10, 16)
+		if err != nil || end < start {
+			return nil, fmt.Errorf("invalid port range: %s", portSpec)
+		}
+
+		portRanges = append(portRanges, apiclient.PortRange{
+			Start: int32(start),
+			End:   int32(end),
+		})
+	}
+
+	return portRanges, nil
+}
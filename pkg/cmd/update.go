@@ -0,0 +1,101 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/daytonaio/daytona/internal"
+	os_util "github.com/daytonaio/daytona/pkg/os"
+	"github.com/daytonaio/daytona/pkg/selfupdate"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var updateCheckFlag bool
+var updateChannelFlag string
+
+var updateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Update the Daytona CLI to the latest release",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		channel := selfupdate.Channel(updateChannelFlag)
+		if !slices.Contains(selfupdate.Channels, channel) {
+			log.Fatalf("Invalid channel '%s'. Must be one of %v", updateChannelFlag, selfupdate.Channels)
+		}
+
+		releasesUrl := selfupdate.DefaultReleasesUrl
+		if envReleasesUrl := os.Getenv("DAYTONA_RELEASES_URL"); envReleasesUrl != "" {
+			releasesUrl = envReleasesUrl
+		}
+
+		var release *selfupdate.Release
+		err := views_util.With(func() error {
+			var err error
+			release, err = selfupdate.GetLatestRelease(releasesUrl, channel)
+			return err
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if !release.IsNewerThan(internal.Version) {
+			views.RenderInfoMessage(fmt.Sprintf("Daytona %s is up to date", internal.Version))
+			return
+		}
+
+		if updateCheckFlag {
+			views.RenderInfoMessage(fmt.Sprintf("Daytona %s is available (current version %s). Run 'daytona update' to install it", release.Version, internal.Version))
+			return
+		}
+
+		publicKey, err := selfupdate.ParsePublicKey(internal.ReleasePublicKey)
+		if err != nil {
+			if errors.Is(err, selfupdate.ErrMissingPublicKey) {
+				log.Fatal(err)
+			}
+			log.Fatalf("Invalid release public key: %s", err)
+		}
+
+		executablePath, err := os.Executable()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		executablePath, err = filepath.EvalSymlinks(executablePath)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		operatingSystem, err := os_util.GetOperatingSystem()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		err = views_util.With(func() error {
+			return release.Apply(*operatingSystem, executablePath, publicKey)
+		})
+		if err != nil {
+			if errors.Is(err, fs.ErrPermission) {
+				log.Fatalf("Failed to replace %s: %s. Rerun the command with elevated privileges", executablePath, err)
+			}
+			log.Fatal(err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Daytona updated from %s to %s", internal.Version, release.Version))
+	},
+}
+
+func init() {
+	updateCmd.Flags().BoolVar(&updateCheckFlag, "check", false, "Only check whether a newer release is available")
+	updateCmd.Flags().StringVar(&updateChannelFlag, "channel", string(selfupdate.ChannelStable), fmt.Sprintf("Release channel (%s, %s)", selfupdate.ChannelStable, selfupdate.ChannelBeta))
+}
@@ -0,0 +1,75 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/daytonaio/daytona/pkg/builder/detect"
+	"github.com/daytonaio/daytona/pkg/builder/devcontainer"
+	"github.com/daytonaio/daytona/pkg/cmd/output"
+	devcontainer_view "github.com/daytonaio/daytona/pkg/views/devcontainer"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var validateDevcontainerPathFlag string
+
+var validateCmd = &cobra.Command{
+	Use:   "validate [PATH]",
+	Short: "Validate the devcontainer configuration of a repository",
+	Long:  "Validate the devcontainer configuration of a local repository without creating a workspace. Features and image metadata are resolved if the devcontainer CLI is installed",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		projectDir := "."
+		if len(args) > 0 {
+			projectDir = args[0]
+		}
+
+		projectDir, err := filepath.Abs(projectDir)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		configFilePath := validateDevcontainerPathFlag
+		if configFilePath == "" {
+			configFilePath, err = detect.FindDevcontainerConfigFilePath(projectDir)
+			if err != nil {
+				log.Fatalf("no devcontainer configuration found in %s", projectDir)
+			}
+		}
+
+		devcontainerCliPath, err := exec.LookPath("devcontainer")
+		if err != nil {
+			devcontainerCliPath = ""
+		}
+
+		result, err := devcontainer.Validate(devcontainer.ValidationConfig{
+			ProjectDir:          projectDir,
+			ConfigFilePath:      configFilePath,
+			ProjectName:         filepath.Base(projectDir),
+			DevcontainerCliPath: devcontainerCliPath,
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if output.FormatFlag != "" {
+			output.Output = result
+			return
+		}
+
+		devcontainer_view.RenderValidationResult(result)
+
+		if result.HasErrors() {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	validateCmd.Flags().StringVar(&validateDevcontainerPathFlag, "devcontainer-path", "", "Path to the devcontainer configuration relative to the repository root")
+}
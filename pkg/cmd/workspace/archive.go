@@ -0,0 +1,66 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var ArchiveCmd = &cobra.Command{
+	Use:   "archive [WORKSPACE]",
+	Short: "Archive a workspace to the server archive storage",
+	Args:  cobra.RangeArgs(0, 1),
+	Run: func(cmd *cobra.Command, args []string) {
+		var workspaceId string
+
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		err = apiclient_util.RequireCapability(server.CapabilityWorkspaceArchive)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if len(args) == 0 {
+			workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+			if err != nil {
+				log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+			}
+
+			workspace := selection.GetWorkspaceFromPrompt(workspaceList.Items, "Archive")
+			if workspace == nil {
+				return
+			}
+			workspaceId = *workspace.Name
+		} else {
+			workspaceId = args[0]
+		}
+
+		res, err := apiClient.WorkspaceAPI.ArchiveWorkspace(ctx, workspaceId).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Workspace '%s' successfully archived", workspaceId))
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) >= 1 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
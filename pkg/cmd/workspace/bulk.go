@@ -0,0 +1,140 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	list_view "github.com/daytonaio/daytona/pkg/views/workspace/list"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+)
+
+// Lets the user select several workspaces from the list and stop or delete them at once
+func runBulkActionFromPrompt(ctx context.Context, apiClient *apiclient.APIClient, workspaceList []apiclient.WorkspaceDTO) error {
+	workspaces := selection.GetMarkedWorkspacesFromPrompt(workspaceList)
+	if len(workspaces) == 0 {
+		return nil
+	}
+
+	workspaceNames := []string{}
+	for _, workspace := range workspaces {
+		workspaceNames = append(workspaceNames, *workspace.Name)
+	}
+
+	action, err := list_view.GetBulkActionFromPrompt(workspaceNames)
+	if err != nil {
+		return err
+	}
+
+	if action == list_view.BulkActionCancel {
+		fmt.Println("Operation canceled.")
+		return nil
+	}
+
+	if action == list_view.BulkActionDelete {
+		confirmed := false
+		form := huh.NewForm(
+			huh.NewGroup(
+				huh.NewConfirm().
+					Title(fmt.Sprintf("Delete workspace(s): [%s]?", strings.Join(workspaceNames, ", "))).
+					Description(fmt.Sprintf("Are you sure you want to delete the workspace(s): [%s]?", strings.Join(workspaceNames, ", "))).
+					Value(&confirmed),
+			),
+		).WithTheme(views.GetCustomTheme())
+
+		err := form.Run()
+		if err != nil {
+			return err
+		}
+
+		if !confirmed {
+			fmt.Println("Operation canceled.")
+			return nil
+		}
+	}
+
+	var results []list_view.BulkActionResult
+	err = views_util.With(func() error {
+		results = runBulkAction(ctx, apiClient, workspaces, action)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if action == list_view.BulkActionDelete {
+		removeSshEntries(workspaces, results)
+	}
+
+	list_view.RenderBulkActionResults(action, results)
+	return nil
+}
+
+// Runs the action for all workspaces concurrently. Results are in the order of the workspaces
+func runBulkAction(ctx context.Context, apiClient *apiclient.APIClient, workspaces []*apiclient.WorkspaceDTO, action list_view.BulkAction) []list_view.BulkActionResult {
+	results := make([]list_view.BulkActionResult, len(workspaces))
+
+	var wg sync.WaitGroup
+	for i, workspace := range workspaces {
+		wg.Add(1)
+		go func(i int, workspace *apiclient.WorkspaceDTO) {
+			defer wg.Done()
+
+			var res *http.Response
+			var err error
+
+			switch action {
+			case list_view.BulkActionStop:
+				res, err = apiClient.WorkspaceAPI.StopWorkspace(ctx, *workspace.Id).Execute()
+			case list_view.BulkActionDelete:
+				res, err = apiClient.WorkspaceAPI.RemoveWorkspace(ctx, *workspace.Id).Execute()
+			}
+			if err != nil {
+				err = apiclient_util.HandleErrorResponse(res, err)
+			}
+
+			results[i] = list_view.BulkActionResult{
+				WorkspaceName: *workspace.Name,
+				Err:           err,
+			}
+		}(i, workspace)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// The SSH config is updated sequentially after the concurrent removals since all entries share the same file
+func removeSshEntries(workspaces []*apiclient.WorkspaceDTO, results []list_view.BulkActionResult) {
+	c, err := config.GetConfig()
+	if err != nil {
+		return
+	}
+
+	activeProfile, err := c.GetActiveProfile()
+	if err != nil {
+		return
+	}
+
+	for i, workspace := range workspaces {
+		if results[i].Err != nil {
+			continue
+		}
+
+		err := config.RemoveWorkspaceSshEntries(activeProfile.Id, *workspace.Id)
+		if err != nil {
+			results[i].Err = fmt.Errorf("workspace deleted but failed to remove its SSH config entries: %w", err)
+		}
+	}
+}
@@ -34,7 +34,6 @@ var CodeCmd = &cobra.Command{
 		ctx := context.Background()
 		var workspaceId string
 		var projectName string
-		var ideId string
 		var workspace *apiclient.WorkspaceDTO
 
 		activeProfile, err := c.GetActiveProfile()
@@ -42,8 +41,6 @@ var CodeCmd = &cobra.Command{
 			log.Fatal(err)
 		}
 
-		ideId = c.DefaultIdeId
-
 		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
 		if err != nil {
 			log.Fatal(err)
@@ -55,7 +52,7 @@ var CodeCmd = &cobra.Command{
 				log.Fatal(apiclient_util.HandleErrorResponse(res, err))
 			}
 
-			workspace = selection.GetWorkspaceFromPrompt(workspaceList, "Open")
+			workspace = selection.GetWorkspaceFromPrompt(workspaceList.Items, "Open")
 			if workspace == nil {
 				return
 			}
@@ -83,13 +80,24 @@ var CodeCmd = &cobra.Command{
 			projectName = args[1]
 		}
 
+		ideId := getProjectIdeId(c, workspace, projectName)
 		if ideFlag != "" {
 			ideId = ideFlag
 		}
 
-		views.RenderInfoMessage(fmt.Sprintf("Opening the project '%s' from workspace '%s' in your preferred IDE.", projectName, *workspace.Name))
+		var projectDir string
+		if worktreeFlag != "" {
+			projectDir, err = getWorktreePath(activeProfile, workspaceId, projectName, worktreeFlag)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			views.RenderInfoMessage(fmt.Sprintf("Opening the worktree of branch '%s' of the project '%s' from workspace '%s' in your preferred IDE.", worktreeFlag, projectName, *workspace.Name))
+		} else {
+			views.RenderInfoMessage(fmt.Sprintf("Opening the project '%s' from workspace '%s' in your preferred IDE.", projectName, *workspace.Name))
+		}
 
-		err = openIDE(ideId, activeProfile, workspaceId, projectName)
+		err = openIDE(ideId, activeProfile, workspaceId, projectName, projectDir)
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -132,18 +140,41 @@ func selectWorkspaceProject(workspaceId string, profile *config.Profile) (*strin
 	return nil, errors.New("no projects found in workspace")
 }
 
-func openIDE(ideId string, activeProfile config.Profile, workspaceId string, projectName string) error {
+// Returns the IDE configured for the project or its detected language. Suggests configuring an IDE for the language if none is set
+func getProjectIdeId(c *config.Config, workspace *apiclient.WorkspaceDTO, projectName string) string {
+	var language string
+	for _, project := range workspace.Projects {
+		if project.Name != nil && *project.Name == projectName && project.State != nil {
+			language = project.State.GetLanguage()
+		}
+	}
+
+	ideId := c.GetProjectIdeId(*workspace.Id, projectName, language)
+
+	if _, ok := c.LanguageIdeIds[language]; language != "" && !ok && ideId == c.DefaultIdeId {
+		views.RenderInfoMessage(fmt.Sprintf("Detected a %s project. Run 'daytona ide --language %s' to choose the IDE for %s projects", language, language, language))
+	}
+
+	return ideId
+}
+
+// The project directory is opened if projectDir is empty
+func openIDE(ideId string, activeProfile config.Profile, workspaceId string, projectName string, projectDir string) error {
 	switch ideId {
 	case "vscode":
-		return ide.OpenVSCode(activeProfile, workspaceId, projectName)
+		return ide.OpenVSCode(activeProfile, workspaceId, projectName, projectDir)
 	case "ssh":
+		// SSH sessions always start in the project directory
+		if projectDir != "" {
+			views.RenderInfoMessage(fmt.Sprintf("Run 'cd %s' to switch to the worktree", projectDir))
+		}
 		return ide.OpenTerminalSsh(activeProfile, workspaceId, projectName)
 	case "browser":
-		return ide.OpenBrowserIDE(activeProfile, workspaceId, projectName)
+		return ide.OpenBrowserIDE(activeProfile, workspaceId, projectName, projectDir)
 	default:
 		_, ok := jetbrains.GetIdes()[jetbrains.Id(ideId)]
 		if ok {
-			return ide.OpenJetbrainsIDE(activeProfile, ideId, workspaceId, projectName)
+			return ide.OpenJetbrainsIDE(activeProfile, ideId, workspaceId, projectName, projectDir)
 		}
 	}
 
@@ -151,7 +182,9 @@ func openIDE(ideId string, activeProfile config.Profile, workspaceId string, pro
 }
 
 var ideFlag string
+var worktreeFlag string
 
 func init() {
 	CodeCmd.Flags().StringVarP(&ideFlag, "ide", "i", "", "Specify the IDE ('vscode' or 'browser')")
+	CodeCmd.Flags().StringVarP(&worktreeFlag, "worktree", "w", "", "Open the worktree of a branch of a project in worktree mode (see 'daytona worktree')")
 }
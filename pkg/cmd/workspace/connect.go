@@ -0,0 +1,129 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var connectProjectFlag string
+var connectIdeFlag string
+
+var ConnectCmd = &cobra.Command{
+	Use:   "connect LINK",
+	Short: "Connect to a workspace shared with 'daytona share'",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		c, err := config.GetConfig()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		serverApiUrl, token, err := parseShareLink(args[0])
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		profile := config.Profile{
+			Api: config.ServerApi{
+				Url: serverApiUrl,
+				Key: token,
+			},
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(&profile)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		workspace, res, err := apiClient.ShareAPI.GetSharedWorkspace(context.Background()).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		// Shared profiles are saved so the ssh config entries can reference them, but they never become active
+		profile.Id = fmt.Sprintf("shared-%s", workspace.GetId())
+		profile.Name = fmt.Sprintf("shared-%s", workspace.GetName())
+		profile.SharedWorkspaceId = workspace.GetId()
+
+		err = saveSharedProfile(c, profile)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		var projectName string
+		if connectProjectFlag != "" {
+			projectName = connectProjectFlag
+		} else if len(workspace.Projects) == 1 {
+			projectName = *workspace.Projects[0].Name
+		} else if len(workspace.Projects) > 1 {
+			selectedProject := selection.GetProjectFromPrompt(workspace.Projects, "Connect to")
+			if selectedProject == nil {
+				return
+			}
+			projectName = *selectedProject.Name
+		} else {
+			log.Fatal(errors.New("no projects found in workspace"))
+		}
+
+		ideId := "ssh"
+		if connectIdeFlag != "" {
+			ideId = connectIdeFlag
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Connecting to the project '%s' from shared workspace '%s'", projectName, workspace.GetName()))
+
+		err = openIDE(ideId, profile, workspace.GetId(), projectName, "")
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
+
+// Returns the server API URL and the share token from a link created with 'daytona share'
+func parseShareLink(link string) (string, string, error) {
+	parsedUrl, err := url.Parse(link)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid link: %s", err.Error())
+	}
+
+	token := parsedUrl.Query().Get("token")
+	if token == "" || !strings.HasSuffix(parsedUrl.Path, "/share") {
+		return "", "", errors.New("invalid link: not a workspace share link")
+	}
+
+	parsedUrl.Path = strings.TrimSuffix(parsedUrl.Path, "/share")
+	parsedUrl.RawQuery = ""
+
+	return parsedUrl.String(), token, nil
+}
+
+func saveSharedProfile(c *config.Config, profile config.Profile) error {
+	for i, p := range c.Profiles {
+		if p.Id == profile.Id {
+			c.Profiles[i] = profile
+			return c.Save()
+		}
+	}
+
+	c.Profiles = append(c.Profiles, profile)
+	return c.Save()
+}
+
+func init() {
+	ConnectCmd.Flags().StringVarP(&connectProjectFlag, "project", "p", "", "Project to connect to")
+	ConnectCmd.Flags().StringVarP(&connectIdeFlag, "ide", "i", "", "Open the project in an IDE instead of an SSH session ('vscode' or 'browser')")
+}
@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -19,6 +21,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	workspace_util "github.com/daytonaio/daytona/pkg/cmd/workspace/util"
 	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/daytonaio/daytona/pkg/views"
 	logs_view "github.com/daytonaio/daytona/pkg/views/logs"
 	"github.com/daytonaio/daytona/pkg/views/target"
@@ -26,6 +29,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/views/workspace/info"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/docker/docker/pkg/stringid"
+	"github.com/go-git/go-git/v5/plumbing"
 	"tailscale.com/tsnet"
 
 	log "github.com/sirupsen/logrus"
@@ -37,6 +41,7 @@ import (
 var CreateCmd = &cobra.Command{
 	Use:   "create [REPOSITORY_URL]",
 	Short: "Create a workspace",
+	Long:  "Create a workspace. Repositories can declare the default branch, builder, environment variables, forwarded ports and post-create commands of their projects in a .daytona/config.yaml file. The flags of the command take precedence over the repository defaults",
 	Args:  cobra.RangeArgs(0, 1),
 	Run: func(cmd *cobra.Command, args []string) {
 		ctx := context.Background()
@@ -44,11 +49,25 @@ var CreateCmd = &cobra.Command{
 		var workspaceName string
 		var existingWorkspaceNames []string
 
+		err := validateTtlFlags()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if cmd.Flags().Changed("on-expiry") && ttlFlag == "" {
+			log.Fatal("The --on-expiry flag requires setting the --ttl flag as well.")
+		}
+
 		apiClient, err := apiclient_util.GetApiClient(nil)
 		if err != nil {
 			log.Fatal(err)
 		}
 
+		err = validateFlagCapabilities(cmd)
+		if err != nil {
+			log.Fatal(err)
+		}
+
 		c, err := config.GetConfig()
 		if err != nil {
 			log.Fatal(err)
@@ -59,6 +78,14 @@ var CreateCmd = &cobra.Command{
 			log.Fatal(err)
 		}
 
+		if retryFlag != "" {
+			err = retryWorkspaceCreation(ctx, apiClient, c, activeProfile, retryFlag)
+			if err != nil {
+				log.Fatal(err)
+			}
+			return
+		}
+
 		profileData, res, err := apiClient.ProfileAPI.GetProfileData(ctx).Execute()
 		if err != nil {
 			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
@@ -72,7 +99,7 @@ var CreateCmd = &cobra.Command{
 		if err != nil {
 			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
 		}
-		for _, workspaceInfo := range workspaceList {
+		for _, workspaceInfo := range workspaceList.Items {
 			existingWorkspaceNames = append(existingWorkspaceNames, *workspaceInfo.Name)
 		}
 
@@ -108,6 +135,14 @@ var CreateCmd = &cobra.Command{
 			}
 			visited[*projects[i].Source.Repository.Url] = true
 			projects[i].EnvVars = getEnvVariables(&projects[i], profileData)
+			if worktreesFlag {
+				projects[i].WorktreeMode = &worktreesFlag
+			}
+		}
+
+		err = setProjectDependencies(projects, dependsOnFlag)
+		if err != nil {
+			log.Fatal(err)
 		}
 
 		projectNames := []string{}
@@ -123,9 +158,14 @@ var CreateCmd = &cobra.Command{
 
 		logs_view.DisplayLogEntry(requestSubmittedLog, logs_view.WORKSPACE_INDEX)
 
-		target, err := getTarget(activeProfile.Name)
-		if err != nil {
-			log.Fatal(err)
+		// The server places workspaces created in a target group
+		var targetName *string
+		if targetGroupFlag == "" {
+			target, err := getTarget(activeProfile.Name)
+			if err != nil {
+				log.Fatal(err)
+			}
+			targetName = target.Name
 		}
 
 		activeProfile, err = c.GetActiveProfile()
@@ -144,68 +184,100 @@ var CreateCmd = &cobra.Command{
 
 		go apiclient_util.ReadWorkspaceLogs(activeProfile, id, projectNames, &stopLogs)
 
-		createdWorkspace, res, err := apiClient.WorkspaceAPI.CreateWorkspace(ctx).Workspace(apiclient.CreateWorkspaceRequest{
+		createWorkspaceRequest := apiclient.CreateWorkspaceRequest{
 			Id:       &id,
 			Name:     &workspaceName,
-			Target:   target.Name,
+			Target:   targetName,
 			Projects: projects,
-		}).Execute()
-		if err != nil {
-			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
 		}
 
-		dialStartTime := time.Now()
-		dialTimeout := 3 * time.Minute
+		if targetGroupFlag != "" {
+			createWorkspaceRequest.TargetGroup = &targetGroupFlag
+		}
 
-		err = waitForDial(tsConn, *createdWorkspace.Id, *createdWorkspace.Projects[0].Name, dialStartTime, dialTimeout)
-		if err != nil {
-			log.Fatal(err)
+		if recordSessionsFlag {
+			createWorkspaceRequest.RecordSessions = &recordSessionsFlag
 		}
 
-		stopLogs = true
+		if ttlFlag != "" {
+			createWorkspaceRequest.Ttl = &ttlFlag
+			expiryAction := apiclient.WorkspaceExpiryAction(onExpiryFlag)
+			createWorkspaceRequest.ExpiryAction = &expiryAction
+		}
 
-		wsInfo, res, err := apiClient.WorkspaceAPI.GetWorkspace(ctx, workspaceName).Execute()
+		createdWorkspace, res, err := apiClient.WorkspaceAPI.CreateWorkspace(ctx).Workspace(createWorkspaceRequest).Execute()
 		if err != nil {
 			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
 		}
 
-		chosenIdeId := c.DefaultIdeId
-		if ideFlag != "" {
-			chosenIdeId = ideFlag
+		err = openCreatedWorkspace(ctx, apiClient, c, activeProfile, tsConn, createdWorkspace, &stopLogs)
+		if err != nil {
+			log.Fatal(err)
 		}
+	},
+}
 
-		ideList := config.GetIdeList()
-		var chosenIde config.Ide
+// Waits until the created workspace is reachable, renders its info and opens the IDE if requested
+func openCreatedWorkspace(ctx context.Context, apiClient *apiclient.APIClient, c *config.Config, activeProfile config.Profile, tsConn *tsnet.Server, createdWorkspace *apiclient.Workspace, stopLogs *bool) error {
+	dialStartTime := time.Now()
+	dialTimeout := 3 * time.Minute
 
-		for _, ide := range ideList {
-			if ide.Id == chosenIdeId {
-				chosenIde = ide
-			}
-		}
+	err := waitForDial(tsConn, *createdWorkspace.Id, *createdWorkspace.Projects[0].Name, dialStartTime, dialTimeout)
+	if err != nil {
+		return err
+	}
 
-		fmt.Println()
-		info.Render(wsInfo, chosenIde.Name, false)
+	*stopLogs = true
 
-		if !codeFlag {
-			views.RenderCreationInfoMessage("Run 'daytona code' when you're ready to start developing")
-			return
-		}
+	wsInfo, res, err := apiClient.WorkspaceAPI.GetWorkspace(ctx, *createdWorkspace.Id).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
 
-		views.RenderCreationInfoMessage("Opening the workspace in your preferred editor ...")
+	chosenIdeId := getProjectIdeId(c, wsInfo, *wsInfo.Projects[0].Name)
+	if ideFlag != "" {
+		chosenIdeId = ideFlag
+	}
 
-		err = openIDE(chosenIdeId, activeProfile, *createdWorkspace.Id, *wsInfo.Projects[0].Name)
-		if err != nil {
-			log.Fatal(err)
+	ideList := config.GetIdeList()
+	var chosenIde config.Ide
+
+	for _, ide := range ideList {
+		if ide.Id == chosenIdeId {
+			chosenIde = ide
 		}
-	},
+	}
+
+	fmt.Println()
+	info.Render(wsInfo, chosenIde.Name, false)
+
+	if !codeFlag {
+		views.RenderCreationInfoMessage("Run 'daytona code' when you're ready to start developing")
+		return nil
+	}
+
+	views.RenderCreationInfoMessage("Opening the workspace in your preferred editor ...")
+
+	return openIDE(chosenIdeId, activeProfile, *createdWorkspace.Id, *wsInfo.Projects[0].Name, "")
 }
 
 var providerFlag string
 var nameFlag string
 var targetNameFlag string
+var targetGroupFlag string
 var customImageFlag string
 var customImageUserFlag string
 var devcontainerPathFlag string
+var ttlFlag string
+var onExpiryFlag string
+var commitFlag string
+var newBranchFlag string
+var exportImageFlag string
+var gitProviderConfigFlag string
+var retryFlag string
+var dependsOnFlag []string
+var recordSessionsFlag bool
+var worktreesFlag bool
 
 var builderFlag create.BuildChoice
 
@@ -218,11 +290,27 @@ func init() {
 	CreateCmd.Flags().StringVar(&providerFlag, "provider", "", "Specify the provider (e.g. 'docker-provider')")
 	CreateCmd.Flags().StringVarP(&ideFlag, "ide", "i", "", "Specify the IDE ('vscode' or 'browser')")
 	CreateCmd.Flags().StringVarP(&targetNameFlag, "target", "t", "", "Specify the target (e.g. 'local')")
-	CreateCmd.Flags().StringVar(&customImageFlag, "custom-image", "", "Create the project with the custom image passed as the flag value; Requires setting --custom-image-user flag as well")
+	CreateCmd.Flags().StringVar(&targetGroupFlag, "target-group", "", "Create the workspace on a member of the target group (see 'daytona target group list') picked by the group placement policy")
+	CreateCmd.Flags().StringVar(&customImageFlag, "custom-image", "", "Create the project with the custom image passed as the flag value; Automatically assigns the custom-image builder")
 	CreateCmd.Flags().StringVar(&customImageUserFlag, "custom-image-user", "", "Create the project with the custom image user passed as the flag value; Requires setting --custom-image flag as well")
 	CreateCmd.Flags().StringVar(&devcontainerPathFlag, "devcontainer-path", "", "Automatically assign the devcontainer builder with the path passed as the flag value")
 
-	CreateCmd.Flags().Var(&builderFlag, "builder", fmt.Sprintf("Specify the builder (currently %s/%s/%s)", create.AUTOMATIC, create.DEVCONTAINER, create.NONE))
+	CreateCmd.Flags().Var(&builderFlag, "builder", fmt.Sprintf("Specify the builder (currently %s/%s/%s/%s)", create.AUTOMATIC, create.DEVCONTAINER, create.CUSTOMIMAGE, create.NONE))
+
+	CreateCmd.Flags().StringVar(&ttlFlag, "ttl", "", "Automatically remove (or stop, see --on-expiry) the workspace after the given duration (e.g. '72h')")
+	CreateCmd.Flags().StringVar(&onExpiryFlag, "on-expiry", string(workspace.ExpiryActionDelete), fmt.Sprintf("Action performed when the workspace TTL expires (%s/%s); Requires setting --ttl flag as well", workspace.ExpiryActionDelete, workspace.ExpiryActionStop))
+
+	CreateCmd.Flags().StringVar(&commitFlag, "commit", "", "Pin the project to the given commit SHA; The commit is checked out in detached HEAD mode unless --new-branch is set")
+	CreateCmd.Flags().StringVar(&newBranchFlag, "new-branch", "", "Create and check out a new branch after cloning the repository")
+	CreateCmd.Flags().StringVar(&gitProviderConfigFlag, "git-provider-config", "", "Use the Git provider config with the given ID (see 'daytona git-providers list') to clone the repository")
+	CreateCmd.Flags().StringVar(&exportImageFlag, "export-image", "", "Push the prebuilt image to an external registry under the given name (e.g. 'registry.example.com/team/project:latest'); Uses the credentials of the matching container registry")
+
+	CreateCmd.Flags().StringArrayVar(&dependsOnFlag, "depends-on", nil, "Start a project after another project of the workspace is healthy, as PROJECT=DEPENDENCY (e.g. 'api=db'); Can be set multiple times")
+
+	CreateCmd.Flags().BoolVar(&worktreesFlag, "worktrees", false, "Clone bare repositories and check out branches as worktrees of the projects on demand (see 'daytona worktree')")
+	CreateCmd.Flags().BoolVar(&recordSessionsFlag, "record-sessions", false, "Record the terminal sessions of SSH connections to the workspace projects (see 'daytona sessions')")
+
+	CreateCmd.Flags().StringVar(&retryFlag, "retry", "", "Resume the interrupted creation of the workspace with the given name from the step that failed")
 
 	CreateCmd.Flags().BoolVar(&manualFlag, "manual", false, "Manually enter the git repositories")
 	CreateCmd.Flags().BoolVar(&multiProjectFlag, "multi-project", false, "Workspace with multiple projects/repos")
@@ -232,12 +320,12 @@ func init() {
 	CreateCmd.MarkFlagsMutuallyExclusive("multi-project", "custom-image-user")
 	CreateCmd.MarkFlagsMutuallyExclusive("multi-project", "devcontainer-path")
 	CreateCmd.MarkFlagsMutuallyExclusive("multi-project", "builder")
-	CreateCmd.MarkFlagsMutuallyExclusive("builder", "custom-image")
-	CreateCmd.MarkFlagsMutuallyExclusive("builder", "custom-image-user")
 	CreateCmd.MarkFlagsMutuallyExclusive("devcontainer-path", "custom-image")
 	CreateCmd.MarkFlagsMutuallyExclusive("devcontainer-path", "custom-image-user")
-
-	CreateCmd.MarkFlagsRequiredTogether("custom-image", "custom-image-user")
+	CreateCmd.MarkFlagsMutuallyExclusive("multi-project", "commit")
+	CreateCmd.MarkFlagsMutuallyExclusive("multi-project", "new-branch")
+	CreateCmd.MarkFlagsMutuallyExclusive("target", "target-group")
+	CreateCmd.MarkFlagsMutuallyExclusive("worktrees", "commit")
 }
 
 func getTarget(activeProfileName string) (*apiclient.ProviderTarget, error) {
@@ -263,7 +351,7 @@ func getTarget(activeProfileName string) (*apiclient.ProviderTarget, error) {
 }
 
 func processPrompting(apiClient *apiclient.APIClient, workspaceName *string, projects *[]apiclient.CreateWorkspaceRequestProject, workspaceNames []string, ctx context.Context) error {
-	if builderFlag != "" || customImageFlag != "" || customImageUserFlag != "" || devcontainerPathFlag != "" {
+	if builderFlag != "" || customImageFlag != "" || customImageUserFlag != "" || devcontainerPathFlag != "" || commitFlag != "" || newBranchFlag != "" || gitProviderConfigFlag != "" {
 		return fmt.Errorf("Please provide repository URL in order to set up custom project details through CLI.")
 	}
 
@@ -279,7 +367,7 @@ func processPrompting(apiClient *apiclient.APIClient, workspaceName *string, pro
 
 	*workspaceName, *projects, err = workspace_util.GetCreationDataFromPrompt(workspace_util.CreateDataPromptConfig{
 		ExistingWorkspaceNames: workspaceNames,
-		UserGitProviders:       gitProviders,
+		UserGitProviders:       gitProviders.Items,
 		Manual:                 manualFlag,
 		MultiProject:           multiProjectFlag,
 		ApiClient:              apiClient,
@@ -298,13 +386,19 @@ func processPrompting(apiClient *apiclient.APIClient, workspaceName *string, pro
 }
 
 func processCmdArguments(args []string, apiClient *apiclient.APIClient, projects *[]apiclient.CreateWorkspaceRequestProject, ctx context.Context) error {
-	if builderFlag != "" && builderFlag != create.DEVCONTAINER && devcontainerPathFlag != "" {
-		return fmt.Errorf("Can't set devcontainer file path if builder is not set to %s.", create.DEVCONTAINER)
+	err := validateBuilderFlags()
+	if err != nil {
+		return err
+	}
+
+	err = validateGitFlags()
+	if err != nil {
+		return err
 	}
 
 	repoUrl := args[0]
 
-	repoUrl, err := util.GetValidatedUrl(repoUrl)
+	repoUrl, err = util.GetValidatedUrl(repoUrl)
 	if err != nil {
 		return err
 	}
@@ -328,6 +422,31 @@ func processCmdArguments(args []string, apiClient *apiclient.APIClient, projects
 		Build: &apiclient.ProjectBuild{},
 	}
 
+	repoConfig, err := workspace_util.GetRepositoryConfig(ctx, apiClient, repoUrl)
+	if err != nil {
+		return err
+	}
+	if repoConfig != nil {
+		workspace_util.ApplyRepositoryConfig(project, repoConfig)
+	}
+
+	if commitFlag != "" {
+		pinned := true
+		repoResponse.Sha = &commitFlag
+		repoResponse.Pinned = &pinned
+	}
+
+	if newBranchFlag != "" {
+		repoResponse.NewBranch = &newBranchFlag
+	}
+
+	// Builder flags replace the builder of the repository config
+	if builderFlag != "" || devcontainerPathFlag != "" || customImageFlag != "" {
+		project.Build = &apiclient.ProjectBuild{}
+		project.Image = nil
+		project.User = nil
+	}
+
 	if builderFlag == create.DEVCONTAINER || devcontainerPathFlag != "" {
 		devcontainerFilePath := create.DEVCONTAINER_FILEPATH
 		if devcontainerPathFlag != "" {
@@ -339,10 +458,25 @@ func processCmdArguments(args []string, apiClient *apiclient.APIClient, projects
 
 	}
 
-	if builderFlag == create.NONE || customImageFlag != "" || customImageUserFlag != "" {
+	if gitProviderConfigFlag != "" {
+		project.GitProviderConfigId = &gitProviderConfigFlag
+	}
+
+	if exportImageFlag != "" {
+		if project.Build == nil {
+			return errors.New("The --export-image flag requires a builder that builds an image.")
+		}
+		project.Build.Export = &apiclient.ProjectBuildExport{
+			Image: exportImageFlag,
+		}
+	}
+
+	if builderFlag == create.NONE || builderFlag == create.CUSTOMIMAGE || customImageFlag != "" {
 		project.Build = nil
-		if customImageFlag != "" || customImageUserFlag != "" {
+		if customImageFlag != "" {
 			project.Image = &customImageFlag
+		}
+		if customImageUserFlag != "" {
 			project.User = &customImageUserFlag
 		}
 	}
@@ -352,6 +486,117 @@ func processCmdArguments(args []string, apiClient *apiclient.APIClient, projects
 	return nil
 }
 
+func validateGitFlags() error {
+	if commitFlag != "" && !regexp.MustCompile(`^[0-9a-fA-F]{7,40}$`).MatchString(commitFlag) {
+		return fmt.Errorf("Invalid commit SHA '%s'.", commitFlag)
+	}
+
+	// Git prints and compares commit SHAs in lowercase
+	commitFlag = strings.ToLower(commitFlag)
+
+	if newBranchFlag != "" && plumbing.NewBranchReferenceName(newBranchFlag).Validate() != nil {
+		return fmt.Errorf("Invalid branch name '%s'.", newBranchFlag)
+	}
+
+	return nil
+}
+
+func validateBuilderFlags() error {
+	if builderFlag != "" && builderFlag != create.DEVCONTAINER && devcontainerPathFlag != "" {
+		return fmt.Errorf("Can't set devcontainer file path if builder is not set to %s.", create.DEVCONTAINER)
+	}
+
+	if builderFlag != "" && builderFlag != create.CUSTOMIMAGE && customImageFlag != "" {
+		return fmt.Errorf("Can't set custom image if builder is not set to %s.", create.CUSTOMIMAGE)
+	}
+
+	if builderFlag == create.CUSTOMIMAGE && customImageFlag == "" {
+		return fmt.Errorf("The --custom-image flag is required when builder is set to %s.", create.CUSTOMIMAGE)
+	}
+
+	if customImageUserFlag != "" && customImageFlag == "" {
+		return errors.New("The --custom-image-user flag requires setting the --custom-image flag as well.")
+	}
+
+	if exportImageFlag != "" && (builderFlag == create.NONE || builderFlag == create.CUSTOMIMAGE || customImageFlag != "") {
+		return errors.New("The --export-image flag requires a builder that builds an image.")
+	}
+
+	return nil
+}
+
+// Flags of newer features are rejected if the server does not support them instead of being ignored
+func validateFlagCapabilities(cmd *cobra.Command) error {
+	flagCapabilities := []struct {
+		flag       string
+		capability server.Capability
+	}{
+		{"ttl", server.CapabilityWorkspaceTtl},
+		{"retry", server.CapabilityWorkspaceRetry},
+		{"commit", server.CapabilityRepoCommits},
+		{"export-image", server.CapabilityImageExport},
+		{"depends-on", server.CapabilityProjectDependencies},
+		{"target-group", server.CapabilityTargetGroups},
+		{"record-sessions", server.CapabilitySessionRecording},
+		{"worktrees", server.CapabilityWorktrees},
+	}
+
+	for _, fc := range flagCapabilities {
+		if !cmd.Flags().Changed(fc.flag) {
+			continue
+		}
+
+		err := apiclient_util.RequireCapability(fc.capability)
+		if err != nil {
+			return fmt.Errorf("Can't use the --%s flag: %w", fc.flag, err)
+		}
+	}
+
+	return nil
+}
+
+// Dependencies are set as PROJECT=DEPENDENCY pairs. The server rejects dependency cycles
+func setProjectDependencies(projects []apiclient.CreateWorkspaceRequestProject, dependencies []string) error {
+	for _, dependency := range dependencies {
+		projectName, dependencyName, ok := strings.Cut(dependency, "=")
+		if !ok || projectName == "" || dependencyName == "" {
+			return fmt.Errorf("Invalid dependency '%s'. Dependencies must be set as PROJECT=DEPENDENCY.", dependency)
+		}
+
+		projectIndex := slices.IndexFunc(projects, func(p apiclient.CreateWorkspaceRequestProject) bool {
+			return p.Name == projectName
+		})
+		if projectIndex == -1 {
+			return fmt.Errorf("Project '%s' of dependency '%s' not found in the workspace.", projectName, dependency)
+		}
+
+		if !slices.ContainsFunc(projects, func(p apiclient.CreateWorkspaceRequestProject) bool {
+			return p.Name == dependencyName
+		}) {
+			return fmt.Errorf("Project '%s' of dependency '%s' not found in the workspace.", dependencyName, dependency)
+		}
+
+		projects[projectIndex].DependsOn = append(projects[projectIndex].DependsOn, dependencyName)
+	}
+
+	return nil
+}
+
+func validateTtlFlags() error {
+	if ttlFlag != "" {
+		ttl, err := time.ParseDuration(ttlFlag)
+		if err != nil || ttl <= 0 {
+			return fmt.Errorf("Invalid TTL '%s'. The TTL must be a positive duration (e.g. 72h).", ttlFlag)
+		}
+	}
+
+	if onExpiryFlag != string(workspace.ExpiryActionDelete) && onExpiryFlag != string(workspace.ExpiryActionStop) {
+		return fmt.Errorf("The --on-expiry flag must be one of %s/%s.", workspace.ExpiryActionDelete, workspace.ExpiryActionStop)
+	}
+
+	return nil
+}
+
 func waitForDial(tsConn *tsnet.Server, workspaceId string, projectName string, dialStartTime time.Time, dialTimeout time.Duration) error {
 	for {
 		if time.Since(dialStartTime) > dialTimeout {
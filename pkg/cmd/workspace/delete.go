@@ -75,7 +75,7 @@ var DeleteCmd = &cobra.Command{
 			if err != nil {
 				log.Fatal(apiclient_util.HandleErrorResponse(res, err))
 			}
-			workspaceDeleteList = selection.GetWorkspacesFromPrompt(workspaceList, "Delete")
+			workspaceDeleteList = selection.GetWorkspacesFromPrompt(workspaceList.Items, "Delete")
 			for _, workspace := range workspaceDeleteList {
 				workspaceDeleteListNames = append(workspaceDeleteListNames, *workspace.Name)
 			}
@@ -149,7 +149,7 @@ func DeleteAllWorkspaces(force bool) error {
 		return apiclient_util.HandleErrorResponse(res, err)
 	}
 
-	for _, workspace := range workspaceList {
+	for _, workspace := range workspaceList.Items {
 		res, err := apiClient.WorkspaceAPI.RemoveWorkspace(ctx, *workspace.Id).Force(force).Execute()
 		if err != nil {
 			log.Errorf("Failed to delete workspace %s: %v", *workspace.Name, apiclient_util.HandleErrorResponse(res, err))
@@ -0,0 +1,76 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var ExtendCmd = &cobra.Command{
+	Use:   "extend [WORKSPACE] DURATION",
+	Short: "Extend the TTL of a workspace",
+	Args:  cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		var workspaceId string
+
+		duration := args[len(args)-1]
+		_, err := time.ParseDuration(duration)
+		if err != nil {
+			log.Fatalf("Invalid duration '%s'. Use a duration such as 24h.", duration)
+		}
+
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		err = apiclient_util.RequireCapability(server.CapabilityWorkspaceTtl)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if len(args) == 1 {
+			workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+			if err != nil {
+				log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+			}
+
+			workspace := selection.GetWorkspaceFromPrompt(workspaceList.Items, "Extend")
+			if workspace == nil {
+				return
+			}
+			workspaceId = *workspace.Name
+		} else {
+			workspaceId = args[0]
+		}
+
+		ws, res, err := apiClient.WorkspaceAPI.ExtendWorkspace(ctx, workspaceId).Extend(apiclient.ExtendWorkspace{
+			Duration: duration,
+		}).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Workspace '%s' now expires at %s", workspaceId, ws.GetExpiresAt()))
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) >= 1 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
@@ -36,7 +36,7 @@ var InfoCmd = &cobra.Command{
 				log.Fatal(apiclient_util.HandleErrorResponse(res, err))
 			}
 
-			workspace = selection.GetWorkspaceFromPrompt(workspaceList, "View")
+			workspace = selection.GetWorkspaceFromPrompt(workspaceList.Items, "View")
 		} else {
 			workspace, err = apiclient_util.GetWorkspace(args[0])
 			if err != nil {
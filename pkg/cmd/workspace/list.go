@@ -9,6 +9,7 @@ import (
 	"github.com/daytonaio/daytona/internal/util/apiclient"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/cmd/output"
+	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/daytonaio/daytona/pkg/views"
 	list_view "github.com/daytonaio/daytona/pkg/views/workspace/list"
 	log "github.com/sirupsen/logrus"
@@ -16,6 +17,8 @@ import (
 )
 
 var verbose bool
+var interactiveFlag bool
+var showCostFlag bool
 
 var ListCmd = &cobra.Command{
 	Use:     "list",
@@ -31,6 +34,13 @@ var ListCmd = &cobra.Command{
 			log.Fatal(err)
 		}
 
+		if showCostFlag {
+			err = apiclient_util.RequireCapability(server.CapabilityWorkspaceCost)
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+
 		workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Verbose(verbose).Execute()
 
 		if err != nil {
@@ -42,24 +52,34 @@ var ListCmd = &cobra.Command{
 			log.Fatal(apiclient.HandleErrorResponse(res, err))
 		}
 
-		if len(gitProviders) > 1 {
+		if len(gitProviders.Items) > 1 {
 			specifyGitProviders = true
 		}
 
 		if output.FormatFlag != "" {
-			output.Output = workspaceList
+			output.Output = workspaceList.Items
 			return
 		}
 
-		if len(workspaceList) == 0 {
+		if len(workspaceList.Items) == 0 {
 			views.RenderInfoMessage("The workspace list is empty. Start off by running 'daytona create'.")
 			return
 		}
 
-		list_view.ListWorkspaces(workspaceList, specifyGitProviders, verbose)
+		if interactiveFlag {
+			err := runBulkActionFromPrompt(ctx, apiClient, workspaceList.Items)
+			if err != nil {
+				log.Fatal(err)
+			}
+			return
+		}
+
+		list_view.ListWorkspaces(workspaceList.Items, specifyGitProviders, verbose, showCostFlag)
 	},
 }
 
 func init() {
 	ListCmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "Show verbose output")
+	ListCmd.Flags().BoolVarP(&interactiveFlag, "interactive", "i", false, "Select workspaces from the list to stop or delete them at once")
+	ListCmd.Flags().BoolVar(&showCostFlag, "show-cost", false, "Show the estimated cost of workspaces on cloud targets")
 }
@@ -0,0 +1,119 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/logs"
+	logs_view "github.com/daytonaio/daytona/pkg/views/logs"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var logsFollowFlag bool
+var logsGrepFlag string
+var logsLevelFlag string
+var logsSinceFlag string
+var logsUntilFlag string
+
+var LogsCmd = &cobra.Command{
+	Use:   "logs [WORKSPACE] [PROJECT]",
+	Short: "Output workspace or project logs",
+	Long:  "Output the logs of a workspace, or of one of its projects if the project is set. The filters are applied by the Daytona Server so only the matching log entries are downloaded.",
+	Args:  cobra.RangeArgs(0, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		var workspaceId string
+
+		ctx := context.Background()
+
+		c, err := config.GetConfig()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if len(args) == 0 {
+			workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+			if err != nil {
+				log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+			}
+
+			workspace := selection.GetWorkspaceFromPrompt(workspaceList.Items, "View Logs")
+			if workspace == nil {
+				return
+			}
+			workspaceId = *workspace.Id
+		} else {
+			workspace, err := apiclient_util.GetWorkspace(args[0])
+			if err != nil {
+				log.Fatal(err)
+			}
+			workspaceId = *workspace.Id
+		}
+
+		query, err := apiclient_util.LogQuery{
+			Follow: logsFollowFlag,
+			Grep:   logsGrepFlag,
+			Level:  logsLevelFlag,
+			Since:  logsSinceFlag,
+			Until:  logsUntilFlag,
+		}.Encode()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		path := fmt.Sprintf("/log/workspace/%s", workspaceId)
+		index := logs_view.WORKSPACE_INDEX
+		if len(args) == 2 {
+			path = fmt.Sprintf("/log/workspace/%s/%s", workspaceId, args[1])
+			index = 0
+			logs_view.CalculateLongestPrefixLength([]string{args[1]})
+		}
+
+		ws, res, err := apiclient_util.GetWebsocketConn(path, &activeProfile, &query)
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+		defer ws.Close()
+
+		for {
+			var logEntry logs.LogEntry
+			err := ws.ReadJSON(&logEntry)
+			if err != nil {
+				return
+			}
+
+			logs_view.DisplayLogEntry(logEntry, index)
+		}
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) >= 1 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
+
+func init() {
+	LogsCmd.Flags().BoolVarP(&logsFollowFlag, "follow", "f", false, "Follow logs")
+	LogsCmd.Flags().StringVarP(&logsGrepFlag, "grep", "g", "", "Only output log entries matching the regular expression")
+	LogsCmd.Flags().StringVar(&logsLevelFlag, "level", "", "Only output log entries of the given severity or higher (e.g. 'warn'); Entries without a severity are always output")
+	LogsCmd.Flags().StringVar(&logsSinceFlag, "since", "", "Only output log entries written after the RFC3339 timestamp or the duration before now (e.g. '1h')")
+	LogsCmd.Flags().StringVar(&logsUntilFlag, "until", "", "Only output log entries written before the RFC3339 timestamp or the duration before now (e.g. '30m')")
+}
@@ -0,0 +1,116 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/charmbracelet/huh"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	views_util "github.com/daytonaio/daytona/pkg/views/util"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var hardResetFlag bool
+var resetYesFlag bool
+
+var ResetCmd = &cobra.Command{
+	Use:   "reset [WORKSPACE] [PROJECT]",
+	Short: "Re-clone and rebuild a project",
+	Long:  "Re-run the clone and build steps of a single project without affecting the rest of the workspace. The project data is kept unless the --hard flag is set.",
+	Args:  cobra.RangeArgs(0, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		var workspaceId string
+		var projectName string
+
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if len(args) == 0 {
+			workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+			if err != nil {
+				log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+			}
+
+			workspace := selection.GetWorkspaceFromPrompt(workspaceList.Items, "Reset")
+			if workspace == nil {
+				return
+			}
+			workspaceId = *workspace.Id
+		} else {
+			workspace, err := apiclient_util.GetWorkspace(args[0])
+			if err != nil {
+				log.Fatal(err)
+			}
+			workspaceId = *workspace.Id
+		}
+
+		if len(args) < 2 {
+			selectedProject, err := selectWorkspaceProject(workspaceId, nil)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if selectedProject == nil {
+				return
+			}
+			projectName = *selectedProject
+		} else {
+			projectName = args[1]
+		}
+
+		if hardResetFlag && !resetYesFlag {
+			form := huh.NewForm(
+				huh.NewGroup(
+					huh.NewConfirm().
+						Title(fmt.Sprintf("Hard reset project %s?", projectName)).
+						Description("All changes in the project will be lost.").
+						Value(&resetYesFlag),
+				),
+			).WithTheme(views.GetCustomTheme())
+
+			err := form.Run()
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			if !resetYesFlag {
+				fmt.Println("Operation canceled.")
+				return
+			}
+		}
+
+		err = views_util.With(func() error {
+			res, err := apiClient.WorkspaceAPI.ResetProject(ctx, workspaceId, projectName).Hard(hardResetFlag).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+			return nil
+		})
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Project '%s' has been reset", projectName))
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) >= 1 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
+
+func init() {
+	ResetCmd.Flags().BoolVar(&hardResetFlag, "hard", false, "Wipe the project data and clone the repository again")
+	ResetCmd.Flags().BoolVarP(&resetYesFlag, "yes", "y", false, "Confirm the hard reset without prompt")
+}
@@ -0,0 +1,54 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/cmd/tailscale"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+	logs_view "github.com/daytonaio/daytona/pkg/views/logs"
+)
+
+// Resumes the interrupted creation of the workspace from the step that failed
+func retryWorkspaceCreation(ctx context.Context, apiClient *apiclient.APIClient, c *config.Config, activeProfile config.Profile, workspaceName string) error {
+	ws, res, err := apiClient.WorkspaceAPI.GetWorkspace(ctx, workspaceName).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	if ws.Creation == nil {
+		return fmt.Errorf("workspace %s is already created", workspaceName)
+	}
+
+	projectNames := []string{}
+	for _, project := range ws.Projects {
+		projectNames = append(projectNames, *project.Name)
+	}
+
+	logs_view.CalculateLongestPrefixLength(projectNames)
+
+	if ws.Creation.FailedStep != nil {
+		views.RenderInfoMessage(fmt.Sprintf("Resuming the creation of workspace %s from step %s", workspaceName, *ws.Creation.FailedStep))
+	}
+
+	tsConn, err := tailscale.GetConnection(&activeProfile)
+	if err != nil {
+		return err
+	}
+
+	stopLogs := false
+	go apiclient_util.ReadWorkspaceLogs(activeProfile, *ws.Id, projectNames, &stopLogs)
+
+	createdWorkspace, res, err := apiClient.WorkspaceAPI.RetryWorkspaceCreation(ctx, *ws.Id).Execute()
+	if err != nil {
+		return apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	return openCreatedWorkspace(ctx, apiClient, c, activeProfile, tsConn, createdWorkspace, &stopLogs)
+}
@@ -0,0 +1,187 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/agent/tasks"
+	"github.com/daytonaio/daytona/pkg/cmd/output"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	tasks_view "github.com/daytonaio/daytona/pkg/views/workspace/tasks"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+var listTasksFlag bool
+
+var RunCmd = &cobra.Command{
+	Use:   "run [WORKSPACE] [PROJECT] [TASK]",
+	Short: "Run a task declared in a project",
+	Long:  "Run a task declared in the project devcontainer configuration under customizations.daytona.tasks, a Makefile target or a package.json script",
+	Args:  cobra.RangeArgs(0, 3),
+	Run: func(cmd *cobra.Command, args []string) {
+		c, err := config.GetConfig()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		ctx := context.Background()
+		var workspaceId string
+		var projectName string
+
+		apiClient, err := apiclient.GetApiClient(&activeProfile)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if len(args) == 0 {
+			workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(ctx).Execute()
+			if err != nil {
+				log.Fatal(apiclient.HandleErrorResponse(res, err))
+			}
+
+			workspace := selection.GetWorkspaceFromPrompt(workspaceList.Items, "Run a task in")
+			if workspace == nil {
+				return
+			}
+			workspaceId = *workspace.Id
+		} else {
+			workspace, err := apiclient.GetWorkspace(args[0])
+			if err != nil {
+				log.Fatal(err)
+			}
+			workspaceId = *workspace.Id
+		}
+
+		if len(args) < 2 {
+			selectedProject, err := selectWorkspaceProject(workspaceId, &activeProfile)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if selectedProject == nil {
+				return
+			}
+			projectName = *selectedProject
+		} else {
+			projectName = args[1]
+		}
+
+		err = config.EnsureSshConfigEntryAdded(activeProfile.Id, workspaceId, projectName)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		err = apiclient.EnsureSshCertificate(activeProfile, workspaceId, projectName)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		projectHostname := config.GetProjectHostname(activeProfile.Id, workspaceId, projectName)
+
+		var taskName string
+		if len(args) == 3 && !listTasksFlag {
+			taskName = args[2]
+		} else {
+			taskList, err := getProjectTasks(projectHostname)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			if listTasksFlag {
+				if output.FormatFlag != "" {
+					output.Output = taskList
+					return
+				}
+
+				tasks_view.ListTasks(taskList)
+				return
+			}
+
+			if len(taskList) == 0 {
+				log.Fatal("No tasks found in the project")
+			}
+
+			task, err := tasks_view.GetTaskFromPrompt(taskList)
+			if err != nil {
+				log.Fatal(err)
+			}
+			taskName = task.Name
+		}
+
+		err = runProjectTask(projectHostname, taskName)
+		if err != nil {
+			var exitErr *exec.ExitError
+			if errors.As(err, &exitErr) {
+				os.Exit(exitErr.ExitCode())
+			}
+			log.Fatal(err)
+		}
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) >= 2 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		if len(args) == 1 {
+			return getProjectNameCompletions(cmd, args, toComplete)
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
+
+// Tasks are discovered by the agent inside the project
+func getProjectTasks(projectHostname string) ([]tasks.Task, error) {
+	data, err := exec.Command("ssh", projectHostname, "daytona", "agent", "tasks").Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+			return nil, fmt.Errorf("failed to list the project tasks: %s", strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return nil, fmt.Errorf("failed to list the project tasks: %w", err)
+	}
+
+	taskList := []tasks.Task{}
+	err = json.Unmarshal(data, &taskList)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the project tasks: %w", err)
+	}
+
+	return taskList, nil
+}
+
+func runProjectTask(projectHostname, taskName string) error {
+	sshArgs := []string{}
+	// A terminal is allocated so that interactive tasks work and interrupts reach the task
+	if term.IsTerminal(int(os.Stdin.Fd())) {
+		sshArgs = append(sshArgs, "-t")
+	}
+	sshArgs = append(sshArgs, projectHostname, "daytona", "agent", "tasks", "run", fmt.Sprintf("'%s'", strings.ReplaceAll(taskName, "'", `'\''`)))
+
+	sshCommand := exec.Command("ssh", sshArgs...)
+	sshCommand.Stdin = os.Stdin
+	sshCommand.Stdout = os.Stdout
+	sshCommand.Stderr = os.Stderr
+
+	return sshCommand.Run()
+}
+
+func init() {
+	RunCmd.Flags().BoolVarP(&listTasksFlag, "list", "l", false, "List the tasks declared in the project")
+}
@@ -0,0 +1,186 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/output"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/sessionrecording"
+	"github.com/daytonaio/daytona/pkg/views"
+	sessions_view "github.com/daytonaio/daytona/pkg/views/workspace/sessions"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var playSpeedFlag float64
+var playMaxIdleFlag time.Duration
+var playOutputFileFlag string
+
+var SessionsCmd = &cobra.Command{
+	Use:   "sessions",
+	Short: "Manage recorded SSH sessions",
+	Long:  "Manage the terminal sessions recorded in workspaces created with 'daytona create --record-sessions'",
+}
+
+var sessionsListCmd = &cobra.Command{
+	Use:     "list [WORKSPACE]",
+	Short:   "List recorded sessions",
+	Long:    "List recorded sessions of all workspaces, or of the given workspace. Recordings of removed workspaces can be listed by the workspace name",
+	Args:    cobra.RangeArgs(0, 1),
+	Aliases: []string{"ls"},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		err = apiclient_util.RequireCapability(server.CapabilitySessionRecording)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		request := apiClient.SessionRecordingAPI.ListSessionRecordings(ctx)
+
+		existingWorkspace := false
+		if len(args) == 1 {
+			workspace, err := apiclient_util.GetWorkspace(args[0])
+			if err == nil {
+				request = request.WorkspaceId(*workspace.Id)
+				existingWorkspace = true
+			}
+		}
+
+		recordings, res, err := request.Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		recordingList := recordings.Items
+		if len(args) == 1 && !existingWorkspace {
+			recordingList = []apiclient.SessionRecording{}
+			for _, recording := range recordings.Items {
+				if recording.WorkspaceName == args[0] || recording.WorkspaceId == args[0] {
+					recordingList = append(recordingList, recording)
+				}
+			}
+		}
+
+		if len(recordingList) == 0 {
+			views.RenderInfoMessageBold("No recorded sessions found")
+			views.RenderInfoMessage("Use 'daytona create --record-sessions' to record the SSH sessions of a workspace")
+			return
+		}
+
+		if output.FormatFlag != "" {
+			output.Output = recordingList
+			return
+		}
+
+		sessions_view.ListSessionRecordings(recordingList)
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
+
+var sessionsPlayCmd = &cobra.Command{
+	Use:   "play RECORDING_ID",
+	Short: "Replay a recorded session",
+	Long:  "Replay a recorded session in the terminal, or save it as an asciicast file that can also be replayed with asciinema",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		err = apiclient_util.RequireCapability(server.CapabilitySessionRecording)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		cast, res, err := apiClient.SessionRecordingAPI.GetSessionRecordingCast(ctx, args[0]).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		if playOutputFileFlag != "" {
+			err = os.WriteFile(playOutputFileFlag, []byte(cast), 0600)
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			views.RenderInfoMessage(fmt.Sprintf("Session saved to %s. Replay it with 'asciinema play %s'", playOutputFileFlag, playOutputFileFlag))
+			return
+		}
+
+		header, events, err := sessionrecording.ParseCast(strings.NewReader(cast))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Replaying a session recorded in a %dx%d terminal. Press Ctrl+C to stop", header.Width, header.Height))
+
+		err = sessionrecording.Play(os.Stdout, events, playSpeedFlag, playMaxIdleFlag)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		// Resets the terminal attributes left by the recorded session
+		fmt.Print("\x1b[0m\n")
+	},
+}
+
+var sessionsRemoveCmd = &cobra.Command{
+	Use:     "remove RECORDING_ID",
+	Short:   "Remove a recorded session",
+	Args:    cobra.ExactArgs(1),
+	Aliases: []string{"rm", "delete"},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		err = apiclient_util.RequireCapability(server.CapabilitySessionRecording)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		res, err := apiClient.SessionRecordingAPI.RemoveSessionRecording(ctx, args[0]).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		views.RenderInfoMessageBold(fmt.Sprintf("Session recording %s removed successfully", args[0]))
+	},
+}
+
+func init() {
+	sessionsPlayCmd.Flags().Float64Var(&playSpeedFlag, "speed", 1, "Playback speed multiplier (e.g. 2 plays the session twice as fast)")
+	sessionsPlayCmd.Flags().DurationVar(&playMaxIdleFlag, "max-idle", 2*time.Second, "Shorten pauses in the session to at most the given duration; 0 keeps the recorded pauses")
+	sessionsPlayCmd.Flags().StringVar(&playOutputFileFlag, "output-file", "", "Save the session as an asciicast file instead of replaying it")
+
+	SessionsCmd.AddCommand(sessionsListCmd)
+	SessionsCmd.AddCommand(sessionsPlayCmd)
+	SessionsCmd.AddCommand(sessionsRemoveCmd)
+}
@@ -0,0 +1,193 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"fmt"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	share_view "github.com/daytonaio/daytona/pkg/views/workspace/share"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var shareDurationFlag string
+
+var ShareCmd = &cobra.Command{
+	Use:   "share [WORKSPACE]",
+	Short: "Create an expiring link to a workspace",
+	Long:  "Create a link that grants access to the projects of the workspace until it expires. The link is opened with 'daytona connect'. Requires SSH certificate authentication to be enabled on the server",
+	Args:  cobra.RangeArgs(0, 1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		err = apiclient_util.RequireCapability(server.CapabilityWorkspaceShare)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		workspaceId, err := getShareWorkspaceId(args, "Share")
+		if err != nil {
+			log.Fatal(err)
+		}
+		if workspaceId == "" {
+			return
+		}
+
+		share, res, err := apiClient.WorkspaceAPI.ShareWorkspace(ctx, workspaceId).Share(apiclient.ShareWorkspace{
+			Duration: shareDurationFlag,
+		}).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		views.RenderContainerLayout(views.GetInfoMessage(fmt.Sprintf("Workspace '%s' is shared until %s\n\nAnyone with the link can connect to it by running:\n\ndaytona connect '%s'\n\nRevoke the link with 'daytona share revoke %s %s'", workspaceId, share.ExpiresAt, share.GetUrl(), workspaceId, share.Name)))
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
+
+var shareListCmd = &cobra.Command{
+	Use:     "list [WORKSPACE]",
+	Short:   "List the active links of a workspace",
+	Args:    cobra.RangeArgs(0, 1),
+	Aliases: []string{"ls"},
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		err = apiclient_util.RequireCapability(server.CapabilityWorkspaceShare)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		workspaceId, err := getShareWorkspaceId(args, "List shares of")
+		if err != nil {
+			log.Fatal(err)
+		}
+		if workspaceId == "" {
+			return
+		}
+
+		shares, res, err := apiClient.WorkspaceAPI.ListWorkspaceShares(ctx, workspaceId).Execute()
+		if err != nil {
+			log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+		}
+
+		if len(shares.Items) == 0 {
+			views.RenderInfoMessage("The workspace has no active links")
+			return
+		}
+
+		share_view.ListShares(shares.Items)
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
+
+var shareRevokeCmd = &cobra.Command{
+	Use:   "revoke WORKSPACE [SHARE]",
+	Short: "Revoke a link to a workspace",
+	Long:  "Revoke a link to a workspace. All links of the workspace are revoked if SHARE is omitted",
+	Args:  cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(nil)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		err = apiclient_util.RequireCapability(server.CapabilityWorkspaceShare)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		workspaceId := args[0]
+
+		shareNames := []string{}
+		if len(args) == 2 {
+			shareNames = append(shareNames, args[1])
+		} else {
+			shares, res, err := apiClient.WorkspaceAPI.ListWorkspaceShares(ctx, workspaceId).Execute()
+			if err != nil {
+				log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+			}
+
+			for _, share := range shares.Items {
+				shareNames = append(shareNames, share.Name)
+			}
+		}
+
+		for _, shareName := range shareNames {
+			res, err := apiClient.WorkspaceAPI.RevokeWorkspaceShare(ctx, workspaceId, shareName).Execute()
+			if err != nil {
+				log.Fatal(apiclient_util.HandleErrorResponse(res, err))
+			}
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Revoked %d link(s) to workspace '%s'", len(shareNames), workspaceId))
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
+
+func getShareWorkspaceId(args []string, actionVerb string) (string, error) {
+	if len(args) == 1 {
+		return args[0], nil
+	}
+
+	apiClient, err := apiclient_util.GetApiClient(nil)
+	if err != nil {
+		return "", err
+	}
+
+	workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(context.Background()).Execute()
+	if err != nil {
+		return "", apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	workspace := selection.GetWorkspaceFromPrompt(workspaceList.Items, actionVerb)
+	if workspace == nil {
+		return "", nil
+	}
+
+	return *workspace.Id, nil
+}
+
+func init() {
+	ShareCmd.Flags().StringVarP(&shareDurationFlag, "duration", "d", "2h", "How long the link stays valid (e.g. 30m, 2h, 24h)")
+
+	ShareCmd.AddCommand(shareListCmd)
+	ShareCmd.AddCommand(shareRevokeCmd)
+}
@@ -47,14 +47,21 @@ var SshProxyCmd = &cobra.Command{
 			}
 		}
 
-		tsConn, err := tailscale.GetConnection(&profile)
+		// Renews the certificate for the next connection, e.g. when an IDE reconnects.
+		// The current connection already uses the certificate loaded by the SSH client
+		err = apiclient.EnsureSshCertificate(profile, workspaceId, projectName)
+		if err != nil {
+			log.Debugf("Failed to renew the SSH certificate: %s", err)
+		}
+
+		dialer, err := tailscale.GetDialer(&profile, workspaceId)
 		if err != nil {
 			log.Fatal(err)
 		}
 
 		errChan := make(chan error)
 
-		dialConn, err := tsConn.Dial(context.Background(), "tcp", fmt.Sprintf("%s:%d", workspace.GetProjectHostname(workspaceId, projectName), ssh_config.SSH_PORT))
+		dialConn, err := dialer.Dial(context.Background(), "tcp", fmt.Sprintf("%s:%d", workspace.GetProjectHostname(workspaceId, projectName), ssh_config.SSH_PORT))
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -45,7 +45,7 @@ var SshCmd = &cobra.Command{
 				log.Fatal(apiclient.HandleErrorResponse(res, err))
 			}
 
-			workspace := selection.GetWorkspaceFromPrompt(workspaceList, "SSH Into")
+			workspace := selection.GetWorkspaceFromPrompt(workspaceList.Items, "SSH Into")
 			if workspace == nil {
 				return
 			}
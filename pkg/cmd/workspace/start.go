@@ -60,7 +60,7 @@ var StartCmd = &cobra.Command{
 				log.Fatal(apiclient.HandleErrorResponse(res, err))
 			}
 
-			workspace := selection.GetWorkspaceFromPrompt(workspaceList, "Start")
+			workspace := selection.GetWorkspaceFromPrompt(workspaceList.Items, "Start")
 			if workspace == nil {
 				return
 			}
@@ -116,7 +116,7 @@ func startAllWorkspaces() error {
 		return apiclient.HandleErrorResponse(res, err)
 	}
 
-	for _, workspace := range workspaceList {
+	for _, workspace := range workspaceList.Items {
 		res, err := apiClient.WorkspaceAPI.StartWorkspace(ctx, *workspace.Id).Execute()
 		if err != nil {
 			log.Errorf("Failed to start workspace %s: %v", *workspace.Name, apiclient.HandleErrorResponse(res, err))
@@ -160,7 +160,7 @@ func getWorkspaceNameCompletions() ([]string, cobra.ShellCompDirective) {
 	}
 
 	var choices []string
-	for _, v := range workspaceList {
+	for _, v := range workspaceList.Items {
 		choices = append(choices, *v.Name)
 	}
 
@@ -180,7 +180,7 @@ func getAllWorkspacesByState(state WorkspaceState) ([]string, cobra.ShellCompDir
 	}
 
 	var choices []string
-	for _, workspace := range workspaceList {
+	for _, workspace := range workspaceList.Items {
 		for _, project := range workspace.Info.Projects {
 			if state == WORKSPACE_STATUS_RUNNING && *project.IsRunning {
 				choices = append(choices, *workspace.Name)
@@ -52,7 +52,7 @@ var StopCmd = &cobra.Command{
 				log.Fatal(apiclient.HandleErrorResponse(res, err))
 			}
 
-			workspace := selection.GetWorkspaceFromPrompt(workspaceList, "Stop")
+			workspace := selection.GetWorkspaceFromPrompt(workspaceList.Items, "Stop")
 			if workspace == nil {
 				return
 			}
@@ -103,7 +103,7 @@ func stopAllWorkspaces() error {
 		return apiclient.HandleErrorResponse(res, err)
 	}
 
-	for _, workspace := range workspaceList {
+	for _, workspace := range workspaceList.Items {
 		res, err := apiClient.WorkspaceAPI.StopWorkspace(ctx, *workspace.Id).Execute()
 		if err != nil {
 			log.Errorf("Failed to stop workspace %s: %v", *workspace.Name, apiclient.HandleErrorResponse(res, err))
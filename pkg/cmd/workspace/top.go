@@ -0,0 +1,72 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/ide"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/views/workspace/top"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var TopCmd = &cobra.Command{
+	Use:   "top",
+	Short: "Show resource usage of running projects",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		c, err := config.GetConfig()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		activeProfile, err := c.GetActiveProfile()
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		ctx := context.Background()
+
+		apiClient, err := apiclient_util.GetApiClient(&activeProfile)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		err = apiclient_util.RequireCapability(server.CapabilityProjectStats)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		fetchStats := func() ([]apiclient.ProjectStats, error) {
+			stats, res, err := apiClient.WorkspaceAPI.ListProjectStats(ctx).Execute()
+			if err != nil {
+				return nil, apiclient_util.HandleErrorResponse(res, err)
+			}
+			return stats.Items, nil
+		}
+
+		stopWorkspace := func(workspaceId string) error {
+			res, err := apiClient.WorkspaceAPI.StopWorkspace(ctx, workspaceId).Execute()
+			if err != nil {
+				return apiclient_util.HandleErrorResponse(res, err)
+			}
+			return nil
+		}
+
+		selected := top.Render(fetchStats, stopWorkspace)
+		if selected == nil {
+			return
+		}
+
+		err = ide.OpenTerminalSsh(activeProfile, selected.WorkspaceId, selected.ProjectName)
+		if err != nil {
+			log.Fatal(err)
+		}
+	},
+}
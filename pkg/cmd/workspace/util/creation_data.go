@@ -4,6 +4,7 @@
 package util
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"path/filepath"
@@ -27,11 +28,12 @@ type CreateDataPromptConfig struct {
 func GetCreationDataFromPrompt(config CreateDataPromptConfig) (string, []apiclient.CreateWorkspaceRequestProject, error) {
 	var projectList []apiclient.CreateWorkspaceRequestProject
 	var providerRepo *apiclient.GitRepository
+	var gitProviderConfigId string
 	var err error
 	var workspaceName string
 
 	if !config.Manual && config.UserGitProviders != nil && len(config.UserGitProviders) > 0 {
-		providerRepo, err = getRepositoryFromWizard(config.UserGitProviders, 0)
+		providerRepo, gitProviderConfigId, err = getRepositoryFromWizard(config.UserGitProviders, 0)
 		if err != nil {
 			return "", nil, err
 		}
@@ -42,6 +44,11 @@ func GetCreationDataFromPrompt(config CreateDataPromptConfig) (string, []apiclie
 		if err != nil {
 			return "", nil, err
 		}
+
+		gitProviderConfigId, err = getGitProviderConfigIdFromPrompt(config.UserGitProviders, *providerRepo.Url, 0)
+		if err != nil {
+			return "", nil, err
+		}
 	}
 
 	providerRepoName, err := GetSanitizedProjectName(*providerRepo.Name)
@@ -49,15 +56,21 @@ func GetCreationDataFromPrompt(config CreateDataPromptConfig) (string, []apiclie
 		return "", nil, err
 	}
 
-	projectList = []apiclient.CreateWorkspaceRequestProject{newCreateProjectRequest(config, providerRepo, providerRepoName)}
+	project, err := newCreateProjectRequest(config, providerRepo, providerRepoName, gitProviderConfigId)
+	if err != nil {
+		return "", nil, err
+	}
+
+	projectList = []apiclient.CreateWorkspaceRequestProject{*project}
 
 	if config.MultiProject {
 		addMore := true
 		for i := 2; addMore; i++ {
 			var providerRepo *apiclient.GitRepository
+			var gitProviderConfigId string
 
 			if !config.Manual && config.UserGitProviders != nil && len(config.UserGitProviders) > 0 {
-				providerRepo, err = getRepositoryFromWizard(config.UserGitProviders, i)
+				providerRepo, gitProviderConfigId, err = getRepositoryFromWizard(config.UserGitProviders, i)
 				if err != nil {
 					return "", nil, err
 				}
@@ -68,6 +81,11 @@ func GetCreationDataFromPrompt(config CreateDataPromptConfig) (string, []apiclie
 				if err != nil {
 					return "", nil, err
 				}
+
+				gitProviderConfigId, err = getGitProviderConfigIdFromPrompt(config.UserGitProviders, *providerRepo.Url, i)
+				if err != nil {
+					return "", nil, err
+				}
 			} else {
 				addMore, err = create.RunAddMoreProjectsForm()
 				if err != nil {
@@ -80,7 +98,12 @@ func GetCreationDataFromPrompt(config CreateDataPromptConfig) (string, []apiclie
 				return "", nil, err
 			}
 
-			projectList = append(projectList, newCreateProjectRequest(config, providerRepo, providerRepoName))
+			project, err := newCreateProjectRequest(config, providerRepo, providerRepoName, gitProviderConfigId)
+			if err != nil {
+				return "", nil, err
+			}
+
+			projectList = append(projectList, *project)
 		}
 	}
 
@@ -94,7 +117,7 @@ func GetCreationDataFromPrompt(config CreateDataPromptConfig) (string, []apiclie
 	return workspaceName, projectList, nil
 }
 
-func newCreateProjectRequest(config CreateDataPromptConfig, providerRepo *apiclient.GitRepository, providerRepoName string) apiclient.CreateWorkspaceRequestProject {
+func newCreateProjectRequest(config CreateDataPromptConfig, providerRepo *apiclient.GitRepository, providerRepoName string, gitProviderConfigId string) (*apiclient.CreateWorkspaceRequestProject, error) {
 	project := apiclient.CreateWorkspaceRequestProject{
 		Name: providerRepoName,
 		Source: &apiclient.CreateWorkspaceRequestProjectSource{
@@ -107,8 +130,20 @@ func newCreateProjectRequest(config CreateDataPromptConfig, providerRepo *apicli
 		EnvVars:           &map[string]string{},
 	}
 
-	return project
+	if gitProviderConfigId != "" {
+		project.GitProviderConfigId = &gitProviderConfigId
+	}
+
+	// The repository defaults are shown in the summary so they can still be changed
+	repoConfig, err := GetRepositoryConfig(context.Background(), config.ApiClient, *providerRepo.Url)
+	if err != nil {
+		return nil, err
+	}
+	if repoConfig != nil {
+		ApplyRepositoryConfig(&project, repoConfig)
+	}
 
+	return &project, nil
 }
 
 func GetProjectNameFromRepo(repoUrl string) string {
@@ -0,0 +1,91 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	gitprovider_view "github.com/daytonaio/daytona/pkg/views/gitprovider"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+)
+
+// Returns the views of the supported configs.
+// Configs are labelled with their account if several configs of the same provider are registered
+func getGitProviderViews(userGitProviders []apiclient.GitProvider) []gitprovider_view.GitProviderView {
+	providerCount := map[string]int{}
+	for _, gitProvider := range userGitProviders {
+		providerCount[gitprovider_view.GetProviderId(gitProvider)]++
+	}
+
+	gitProviderViewList := []gitprovider_view.GitProviderView{}
+	for _, gitProvider := range userGitProviders {
+		providerId := gitprovider_view.GetProviderId(gitProvider)
+		for _, supportedProvider := range config.GetSupportedGitProviders() {
+			if providerId != supportedProvider.Id {
+				continue
+			}
+
+			name := supportedProvider.Name
+			if providerCount[providerId] > 1 {
+				name = gitprovider_view.GetConfigLabel(gitProvider)
+			}
+
+			gitProviderViewList = append(gitProviderViewList,
+				gitprovider_view.GitProviderView{
+					Id:         *gitProvider.Id,
+					ProviderId: providerId,
+					Name:       name,
+					Username:   *gitProvider.Username,
+					Alias:      gitProvider.GetAlias(),
+					IsDefault:  gitProvider.GetIsDefault(),
+				},
+			)
+		}
+	}
+
+	return gitProviderViewList
+}
+
+// Prompts for the config used to clone the repository if several registered configs match its URL.
+// Returns an empty string if the server can resolve the config on its own
+func getGitProviderConfigIdFromPrompt(userGitProviders []apiclient.GitProvider, repoUrl string, additionalProjectOrder int) (string, error) {
+	matches := []apiclient.GitProvider{}
+	for _, gitProvider := range userGitProviders {
+		if gitProviderMatchesUrl(gitProvider, repoUrl) {
+			matches = append(matches, gitProvider)
+		}
+	}
+
+	if len(matches) < 2 {
+		return "", nil
+	}
+
+	gitProviderConfigId := selection.GetGitProviderConfigIdFromPrompt(getGitProviderViews(matches), additionalProjectOrder)
+	if gitProviderConfigId == "" {
+		return "", fmt.Errorf("must select a git provider config for %s", repoUrl)
+	}
+
+	return gitProviderConfigId, nil
+}
+
+func gitProviderMatchesUrl(gitProvider apiclient.GitProvider, repoUrl string) bool {
+	if strings.Contains(repoUrl, fmt.Sprintf("%s.", gitprovider_view.GetProviderId(gitProvider))) {
+		return true
+	}
+
+	if gitProvider.GetBaseApiUrl() == "" {
+		return false
+	}
+
+	baseApiUrl, err := url.Parse(gitProvider.GetBaseApiUrl())
+	if err != nil || baseApiUrl.Hostname() == "" {
+		return false
+	}
+
+	return strings.Contains(repoUrl, strings.TrimPrefix(baseApiUrl.Hostname(), "www."))
+}
@@ -0,0 +1,84 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/views/workspace/create"
+)
+
+// GetRepositoryConfig returns the .daytona/config.yaml of the repository, or nil if the repository has none
+// or if the server can't read it
+func GetRepositoryConfig(ctx context.Context, apiClient *apiclient.APIClient, repoUrl string) (*apiclient.RepositoryConfig, error) {
+	if apiclient_util.RequireCapability(server.CapabilityRepositoryConfig) != nil {
+		return nil, nil
+	}
+
+	repoConfig, res, err := apiClient.GitProviderAPI.GetRepositoryConfig(ctx, url.QueryEscape(repoUrl)).Execute()
+	if err != nil {
+		if res != nil && (res.StatusCode == http.StatusNotFound || res.StatusCode == http.StatusNotImplemented) {
+			return nil, nil
+		}
+		return nil, apiclient_util.HandleErrorResponse(res, err)
+	}
+
+	return repoConfig, nil
+}
+
+// ApplyRepositoryConfig sets the repository defaults on the project. Settings passed through CLI flags must be applied afterwards so they take precedence
+func ApplyRepositoryConfig(project *apiclient.CreateWorkspaceRequestProject, repoConfig *apiclient.RepositoryConfig) {
+	repository := project.Source.Repository
+
+	// The branch only applies if the repository URL does not point to a branch, PR or ref
+	if repoConfig.Branch != nil && repository.Branch == nil && repository.PrNumber == nil && repository.Ref == nil {
+		repository.Branch = repoConfig.Branch
+		// The server resolves the last commit of the branch
+		repository.Sha = nil
+	}
+
+	switch repoConfig.GetBuilder() {
+	case apiclient.RepositoryConfigBuilderAuto:
+		project.Build = &apiclient.ProjectBuild{}
+	case apiclient.RepositoryConfigBuilderDevcontainer:
+		devcontainerFilePath := create.DEVCONTAINER_FILEPATH
+		if repoConfig.DevcontainerPath != nil {
+			devcontainerFilePath = *repoConfig.DevcontainerPath
+		}
+		project.Build = &apiclient.ProjectBuild{
+			Devcontainer: &apiclient.ProjectBuildDevcontainer{
+				DevContainerFilePath: &devcontainerFilePath,
+			},
+		}
+	case apiclient.RepositoryConfigBuilderCustomImage:
+		project.Build = nil
+		project.Image = repoConfig.Image
+		if repoConfig.ImageUser != nil {
+			project.User = repoConfig.ImageUser
+		}
+	case apiclient.RepositoryConfigBuilderNone:
+		project.Build = nil
+	}
+
+	if repoConfig.EnvVars != nil {
+		envVars := map[string]string{}
+		for k, v := range *repoConfig.EnvVars {
+			envVars[k] = v
+		}
+		if project.EnvVars != nil {
+			for k, v := range *project.EnvVars {
+				envVars[k] = v
+			}
+		}
+		project.EnvVars = &envVars
+	}
+
+	project.PortForwards = repoConfig.Ports
+	project.PostCreateCommands = repoConfig.PostCreateCommands
+}
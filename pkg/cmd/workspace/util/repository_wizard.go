@@ -6,45 +6,39 @@ package util
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 	"net/url"
+	"sync"
 
-	"github.com/daytonaio/daytona/cmd/daytona/config"
 	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/api/controllers"
 	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/server"
+	"github.com/daytonaio/daytona/pkg/views"
 	gitprovider_view "github.com/daytonaio/daytona/pkg/views/gitprovider"
 	views_util "github.com/daytonaio/daytona/pkg/views/util"
 	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
 )
 
-func getRepositoryFromWizard(userGitProviders []apiclient.GitProvider, additionalProjectOrder int) (*apiclient.GitRepository, error) {
+const commitsPerPage = 50
+
+// Number of requests made at once when listing the repositories of all git providers
+const maxConcurrentProviderRequests = 8
+
+// Returns the repository chosen from the repositories of a Git provider and the ID of the chosen Git provider config
+func getRepositoryFromWizard(userGitProviders []apiclient.GitProvider, additionalProjectOrder int) (*apiclient.GitRepository, string, error) {
 	var providerId string
 	var namespaceId string
 	var checkoutOptions []selection.CheckoutOption
 
-	supportedProviders := config.GetSupportedGitProviders()
-	var gitProviderViewList []gitprovider_view.GitProviderView
-
-	for _, gitProvider := range userGitProviders {
-		for _, supportedProvider := range supportedProviders {
-			if *gitProvider.Id == supportedProvider.Id {
-				gitProviderViewList = append(gitProviderViewList,
-					gitprovider_view.GitProviderView{
-						Id:       *gitProvider.Id,
-						Name:     supportedProvider.Name,
-						Username: *gitProvider.Username,
-					},
-				)
-			}
-		}
-	}
-	providerId = selection.GetProviderIdFromPrompt(gitProviderViewList, additionalProjectOrder)
+	providerId = selection.GetProviderIdFromPrompt(getGitProviderViews(userGitProviders), additionalProjectOrder)
 	if providerId == "" {
-		return nil, errors.New("must select a provider")
+		return nil, "", errors.New("must select a provider")
 	}
 
 	if providerId == selection.CustomRepoIdentifier {
-		return nil, nil
+		return nil, "", nil
 	}
 
 	ctx := context.Background()
@@ -54,103 +48,194 @@ func getRepositoryFromWizard(userGitProviders []apiclient.GitProvider, additiona
 		log.Fatal(err)
 	}
 
-	var namespaceList []apiclient.GitNamespace
+	var chosenRepo *apiclient.GitRepository
 
-	err = views_util.With(func() error {
-		namespaceList, _, err = apiClient.GitProviderAPI.GetNamespaces(ctx, providerId).Execute()
-		return err
-	})
-	if err != nil {
-		return nil, err
-	}
+	if providerId == selection.AllProvidersIdentifier {
+		providerRepo, err := getRepositoryFromAllProviders(apiClient, getGitProviderViews(userGitProviders), additionalProjectOrder)
+		if err != nil {
+			return nil, "", err
+		}
 
-	if len(namespaceList) == 1 {
-		namespaceId = *namespaceList[0].Id
+		providerId = providerRepo.GitProviderId
+		namespaceId = providerRepo.NamespaceId
+		chosenRepo = &providerRepo.Repository
 	} else {
-		namespaceId = selection.GetNamespaceIdFromPrompt(namespaceList, additionalProjectOrder)
-		if namespaceId == "" {
-			return nil, errors.New("namespace not found")
-		}
-	}
+		var providerRepos []apiclient.GitRepository
 
-	var providerRepos []apiclient.GitRepository
-	err = views_util.With(func() error {
-		providerRepos, _, err = apiClient.GitProviderAPI.GetRepositories(ctx, providerId, namespaceId).Execute()
-		return err
-	})
+		for {
+			var canChooseAnotherNamespace bool
+			var namespaceList []apiclient.GitNamespace
 
-	if err != nil {
-		return nil, err
-	}
+			err = views_util.With(func() error {
+				namespaces, res, err := apiClient.GitProviderAPI.GetNamespaces(ctx, providerId).Execute()
+				if err != nil {
+					return apiclient_util.HandleErrorResponse(res, err)
+				}
+				namespaceList = namespaces.Items
+				return nil
+			})
+			if err != nil {
+				return nil, "", err
+			}
 
-	chosenRepo := selection.GetRepositoryFromPrompt(providerRepos, additionalProjectOrder)
-	if chosenRepo == nil {
-		return nil, errors.New("must select a repository")
+			if len(namespaceList) == 1 {
+				namespaceId = *namespaceList[0].Id
+			} else {
+				namespaceId = selection.GetNamespaceIdFromPrompt(namespaceList, additionalProjectOrder)
+				if namespaceId == "" {
+					return nil, "", errors.New("namespace not found")
+				}
+				canChooseAnotherNamespace = true
+			}
+
+			err = views_util.With(func() error {
+				repositories, res, err := apiClient.GitProviderAPI.GetRepositories(ctx, providerId, namespaceId).Execute()
+				if err != nil {
+					return apiclient_util.HandleErrorResponse(res, err)
+				}
+				providerRepos = repositories.Items
+				return nil
+			})
+			if err == nil {
+				break
+			}
+
+			// The repositories of other namespaces can still be listed until the token is authorized
+			if !apiclient_util.IsErrorCode(err, controllers.ErrorCodeSsoAuthorizationRequired) || !canChooseAnotherNamespace {
+				return nil, "", err
+			}
+
+			views.RenderInfoMessage(err.Error())
+		}
+
+		chosenRepo = selection.GetRepositoryFromPrompt(providerRepos, additionalProjectOrder)
+		if chosenRepo == nil {
+			return nil, "", errors.New("must select a repository")
+		}
 	}
 
 	var branchList []apiclient.GitBranch
 	err = views_util.With(func() error {
-		branchList, _, err = apiClient.GitProviderAPI.GetRepoBranches(ctx, providerId, namespaceId, url.QueryEscape(*chosenRepo.Id)).Execute()
-		return err
+		branches, res, err := apiClient.GitProviderAPI.GetRepoBranches(ctx, providerId, namespaceId, url.QueryEscape(*chosenRepo.Id)).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+		branchList = branches.Items
+		return nil
 	})
 
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	if len(branchList) == 0 {
-		return nil, errors.New("no branches found")
-	}
-
-	if len(branchList) == 1 {
-		chosenRepo.Branch = branchList[0].Name
-		chosenRepo.Sha = branchList[0].Sha
-		return chosenRepo, nil
+		return nil, "", errors.New("no branches found")
 	}
 
+	// Change requests are listed even for repositories with a single branch since they are not necessarily available as branches
 	var prList []apiclient.GitPullRequest
 	err = views_util.With(func() error {
-		prList, _, err = apiClient.GitProviderAPI.GetRepoPRs(ctx, providerId, namespaceId, url.QueryEscape(*chosenRepo.Id)).Execute()
-		return err
+		pullRequests, res, err := apiClient.GitProviderAPI.GetRepoPRs(ctx, providerId, namespaceId, url.QueryEscape(*chosenRepo.Id)).Execute()
+		if err != nil {
+			return apiclient_util.HandleErrorResponse(res, err)
+		}
+		prList = pullRequests.Items
+		return nil
 	})
 
 	if err != nil {
-		return nil, err
-	}
-
-	var branch *apiclient.GitBranch
-	if len(prList) == 0 {
-		branch = selection.GetBranchFromPrompt(branchList, additionalProjectOrder)
-		if branch == nil {
-			return nil, errors.New("must select a branch")
-		}
-
-		chosenRepo.Branch = branch.Name
-		chosenRepo.Sha = branch.Sha
-
-		return chosenRepo, nil
+		return nil, "", err
 	}
 
 	checkoutOptions = append(checkoutOptions, selection.CheckoutDefault)
-	checkoutOptions = append(checkoutOptions, selection.CheckoutBranch)
-	checkoutOptions = append(checkoutOptions, selection.CheckoutPR)
+	if len(branchList) > 1 {
+		checkoutOptions = append(checkoutOptions, selection.CheckoutBranch)
+	}
+	checkoutOptions = append(checkoutOptions, selection.CheckoutNewBranch)
+	if len(prList) > 0 {
+		checkoutOptions = append(checkoutOptions, selection.CheckoutPR)
+	}
+	checkoutOptions = append(checkoutOptions, selection.CheckoutCommit)
 
 	chosenCheckoutOption := selection.GetCheckoutOptionFromPrompt(additionalProjectOrder, checkoutOptions)
 	if chosenCheckoutOption == selection.CheckoutDefault {
-		return chosenRepo, nil
+		if len(branchList) == 1 {
+			chosenRepo.Branch = branchList[0].Name
+			chosenRepo.Sha = branchList[0].Sha
+		}
+		return chosenRepo, providerId, nil
 	}
 
+	var branch *apiclient.GitBranch
 	if chosenCheckoutOption == selection.CheckoutBranch {
 		branch = selection.GetBranchFromPrompt(branchList, additionalProjectOrder)
 		if branch == nil {
-			return nil, errors.New("must select a branch")
+			return nil, "", errors.New("must select a branch")
+		}
+		chosenRepo.Branch = branch.Name
+		chosenRepo.Sha = branch.Sha
+	} else if chosenCheckoutOption == selection.CheckoutNewBranch {
+		newBranch, err := selection.GetNewBranchNameFromPrompt(branchList, additionalProjectOrder)
+		if err != nil {
+			return nil, "", err
+		}
+
+		branch = &branchList[0]
+		if len(branchList) > 1 {
+			branch = selection.GetBaseBranchFromPrompt(branchList, additionalProjectOrder)
+			if branch == nil {
+				return nil, "", errors.New("must select a base branch")
+			}
 		}
+
 		chosenRepo.Branch = branch.Name
 		chosenRepo.Sha = branch.Sha
+		chosenRepo.NewBranch = &newBranch
+	} else if chosenCheckoutOption == selection.CheckoutCommit {
+		err := apiclient_util.RequireCapability(server.CapabilityRepoCommits)
+		if err != nil {
+			return nil, "", err
+		}
+
+		branch = &branchList[0]
+		if len(branchList) > 1 {
+			branch = selection.GetBranchFromPrompt(branchList, additionalProjectOrder)
+			if branch == nil {
+				return nil, "", errors.New("must select a branch")
+			}
+		}
+
+		commit, err := selection.GetCommitFromPrompt(func(page int) ([]apiclient.GitCommit, bool, error) {
+			var commits []apiclient.GitCommit
+			var hasMore bool
+			err := views_util.With(func() error {
+				commitPage, res, err := apiClient.GitProviderAPI.GetRepoCommits(ctx, providerId, namespaceId, url.QueryEscape(*chosenRepo.Id)).
+					Branch(*branch.Name).Page(int32(page)).PerPage(commitsPerPage).Execute()
+				if err != nil {
+					return apiclient_util.HandleErrorResponse(res, err)
+				}
+				commits = commitPage.Items
+				hasMore = commitPage.HasMore
+				return nil
+			})
+			return commits, hasMore, err
+		}, *branch.Name, additionalProjectOrder)
+		if err != nil {
+			return nil, "", err
+		}
+		if commit == nil {
+			return nil, "", errors.New("must select a commit")
+		}
+
+		// The commit is checked out in detached HEAD mode
+		pinned := true
+		chosenRepo.Branch = branch.Name
+		chosenRepo.Sha = &commit.Sha
+		chosenRepo.Pinned = &pinned
 	} else if chosenCheckoutOption == selection.CheckoutPR {
 		chosenPullRequest := selection.GetPullRequestFromPrompt(prList, additionalProjectOrder)
 		if chosenPullRequest == nil {
-			return nil, errors.New("must select a pull request")
+			return nil, "", errors.New("must select a pull request")
 		}
 
 		chosenRepo.Branch = chosenPullRequest.Branch
@@ -159,7 +244,132 @@ func getRepositoryFromWizard(userGitProviders []apiclient.GitProvider, additiona
 		chosenRepo.Name = chosenPullRequest.SourceRepoName
 		chosenRepo.Owner = chosenPullRequest.SourceRepoOwner
 		chosenRepo.Url = chosenPullRequest.SourceRepoUrl
+		if chosenPullRequest.GetRef() != "" {
+			chosenRepo.Ref = chosenPullRequest.Ref
+		}
+	}
+
+	return chosenRepo, providerId, nil
+}
+
+// Lists the repositories of all git providers and returns the chosen one
+func getRepositoryFromAllProviders(apiClient *apiclient.APIClient, gitProviders []gitprovider_view.GitProviderView, additionalProjectOrder int) (*selection.ProviderRepository, error) {
+	ctx := context.Background()
+
+	listNamespaces := func(gitProviderId string) ([]apiclient.GitNamespace, error) {
+		namespaceList, res, err := apiClient.GitProviderAPI.GetNamespaces(ctx, gitProviderId).Execute()
+		if err != nil {
+			return nil, apiclient_util.HandleErrorResponse(res, err)
+		}
+		return namespaceList.Items, nil
+	}
+
+	listRepositories := func(gitProviderId, namespaceId string) ([]apiclient.GitRepository, error) {
+		repositoryList, res, err := apiClient.GitProviderAPI.GetRepositories(ctx, gitProviderId, namespaceId).Execute()
+		if err != nil {
+			return nil, apiclient_util.HandleErrorResponse(res, err)
+		}
+		return repositoryList.Items, nil
+	}
+
+	var repositories []selection.ProviderRepository
+	err := views_util.With(func() error {
+		var err error
+		repositories, err = listAllProviderRepositories(gitProviders, listNamespaces, listRepositories)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	chosenRepo := selection.GetProviderRepositoryFromPrompt(repositories, additionalProjectOrder)
+	if chosenRepo == nil {
+		return nil, errors.New("must select a repository")
 	}
 
 	return chosenRepo, nil
 }
+
+// Fetches the repositories of all namespaces of the git providers concurrently. The repositories are merged in the
+// order of the providers and namespaces so the list does not depend on the response times.
+// Providers and namespaces that fail to respond are left out unless all of them fail
+func listAllProviderRepositories(gitProviders []gitprovider_view.GitProviderView, listNamespaces func(gitProviderId string) ([]apiclient.GitNamespace, error), listRepositories func(gitProviderId, namespaceId string) ([]apiclient.GitRepository, error)) ([]selection.ProviderRepository, error) {
+	type providerNamespace struct {
+		gitProvider gitprovider_view.GitProviderView
+		namespace   apiclient.GitNamespace
+	}
+
+	var errs []error
+	var errsMutex sync.Mutex
+	addError := func(err error) {
+		errsMutex.Lock()
+		defer errsMutex.Unlock()
+		errs = append(errs, err)
+	}
+
+	semaphore := make(chan struct{}, maxConcurrentProviderRequests)
+	var wg sync.WaitGroup
+
+	namespaceLists := make([][]providerNamespace, len(gitProviders))
+	for i, gitProvider := range gitProviders {
+		wg.Add(1)
+		go func(i int, gitProvider gitprovider_view.GitProviderView) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			namespaces, err := listNamespaces(gitProvider.Id)
+			if err != nil {
+				addError(fmt.Errorf("%s: %w", gitProvider.Name, err))
+				return
+			}
+
+			for _, namespace := range namespaces {
+				namespaceLists[i] = append(namespaceLists[i], providerNamespace{gitProvider: gitProvider, namespace: namespace})
+			}
+		}(i, gitProvider)
+	}
+	wg.Wait()
+
+	namespaces := []providerNamespace{}
+	for _, namespaceList := range namespaceLists {
+		namespaces = append(namespaces, namespaceList...)
+	}
+
+	repositoryLists := make([][]selection.ProviderRepository, len(namespaces))
+	for i, namespace := range namespaces {
+		wg.Add(1)
+		go func(i int, namespace providerNamespace) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			repositories, err := listRepositories(namespace.gitProvider.Id, *namespace.namespace.Id)
+			if err != nil {
+				addError(fmt.Errorf("%s, %s: %w", namespace.gitProvider.Name, *namespace.namespace.Name, err))
+				return
+			}
+
+			for _, repository := range repositories {
+				repositoryLists[i] = append(repositoryLists[i], selection.ProviderRepository{
+					GitProviderId:   namespace.gitProvider.Id,
+					GitProviderName: namespace.gitProvider.Name,
+					NamespaceId:     *namespace.namespace.Id,
+					Repository:      repository,
+				})
+			}
+		}(i, namespace)
+	}
+	wg.Wait()
+
+	repositories := []selection.ProviderRepository{}
+	for _, repositoryList := range repositoryLists {
+		repositories = append(repositories, repositoryList...)
+	}
+
+	if len(repositories) == 0 {
+		return nil, errors.Join(append([]error{errors.New("no repositories found")}, errs...)...)
+	}
+
+	return repositories, nil
+}
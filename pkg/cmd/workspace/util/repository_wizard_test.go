@@ -0,0 +1,106 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	gitprovider_view "github.com/daytonaio/daytona/pkg/views/gitprovider"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	"github.com/stretchr/testify/require"
+)
+
+var testGitProviders = []gitprovider_view.GitProviderView{
+	{Id: "github", Name: "GitHub"},
+	{Id: "gitlab", Name: "GitLab"},
+}
+
+func testNamespace(id string) apiclient.GitNamespace {
+	return apiclient.GitNamespace{Id: &id, Name: &id}
+}
+
+func testRepository(name string) apiclient.GitRepository {
+	url := "https://example.com/" + name
+	return apiclient.GitRepository{Name: &name, Url: &url}
+}
+
+func TestListAllProviderRepositories(t *testing.T) {
+	namespaces := map[string][]apiclient.GitNamespace{
+		"github": {testNamespace("<PERSONAL>"), testNamespace("daytonaio")},
+		"gitlab": {testNamespace("<PERSONAL>")},
+	}
+
+	repositories := map[string][]apiclient.GitRepository{
+		"github/<PERSONAL>": {testRepository("dotfiles")},
+		"github/daytonaio":  {testRepository("daytona"), testRepository("docs")},
+		"gitlab/<PERSONAL>": {testRepository("dotfiles")},
+	}
+
+	listNamespaces := func(gitProviderId string) ([]apiclient.GitNamespace, error) {
+		// The first provider responds last
+		if gitProviderId == "github" {
+			time.Sleep(10 * time.Millisecond)
+		}
+		return namespaces[gitProviderId], nil
+	}
+
+	t.Run("merges the repositories of all providers", func(t *testing.T) {
+		result, err := listAllProviderRepositories(testGitProviders, listNamespaces, func(gitProviderId, namespaceId string) ([]apiclient.GitRepository, error) {
+			return repositories[gitProviderId+"/"+namespaceId], nil
+		})
+		require.Nil(t, err)
+
+		require.Equal(t, []selection.ProviderRepository{
+			{GitProviderId: "github", GitProviderName: "GitHub", NamespaceId: "<PERSONAL>", Repository: testRepository("dotfiles")},
+			{GitProviderId: "github", GitProviderName: "GitHub", NamespaceId: "daytonaio", Repository: testRepository("daytona")},
+			{GitProviderId: "github", GitProviderName: "GitHub", NamespaceId: "daytonaio", Repository: testRepository("docs")},
+			{GitProviderId: "gitlab", GitProviderName: "GitLab", NamespaceId: "<PERSONAL>", Repository: testRepository("dotfiles")},
+		}, result)
+	})
+
+	t.Run("leaves out failed namespaces", func(t *testing.T) {
+		result, err := listAllProviderRepositories(testGitProviders, listNamespaces, func(gitProviderId, namespaceId string) ([]apiclient.GitRepository, error) {
+			if gitProviderId == "github" && namespaceId == "daytonaio" {
+				return nil, errors.New("SSO authorization required")
+			}
+			return repositories[gitProviderId+"/"+namespaceId], nil
+		})
+		require.Nil(t, err)
+
+		require.Len(t, result, 2)
+		require.Equal(t, "github", result[0].GitProviderId)
+		require.Equal(t, "gitlab", result[1].GitProviderId)
+	})
+
+	t.Run("leaves out failed providers", func(t *testing.T) {
+		result, err := listAllProviderRepositories(testGitProviders, func(gitProviderId string) ([]apiclient.GitNamespace, error) {
+			if gitProviderId == "gitlab" {
+				return nil, errors.New("unauthorized")
+			}
+			return namespaces[gitProviderId], nil
+		}, func(gitProviderId, namespaceId string) ([]apiclient.GitRepository, error) {
+			return repositories[gitProviderId+"/"+namespaceId], nil
+		})
+		require.Nil(t, err)
+
+		require.Len(t, result, 3)
+		for _, repository := range result {
+			require.Equal(t, "github", repository.GitProviderId)
+		}
+	})
+
+	t.Run("fails if all providers fail", func(t *testing.T) {
+		_, err := listAllProviderRepositories(testGitProviders, func(gitProviderId string) ([]apiclient.GitNamespace, error) {
+			return nil, errors.New("unauthorized")
+		}, func(gitProviderId, namespaceId string) ([]apiclient.GitRepository, error) {
+			return nil, nil
+		})
+		require.ErrorContains(t, err, "no repositories found")
+		require.ErrorContains(t, err, "GitHub: unauthorized")
+		require.ErrorContains(t, err, "GitLab: unauthorized")
+	})
+}
@@ -0,0 +1,288 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/daytonaio/daytona/cmd/daytona/config"
+	apiclient_util "github.com/daytonaio/daytona/internal/util/apiclient"
+	"github.com/daytonaio/daytona/pkg/cmd/output"
+	"github.com/daytonaio/daytona/pkg/git"
+	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/daytonaio/daytona/pkg/views/workspace/selection"
+	worktree_view "github.com/daytonaio/daytona/pkg/views/workspace/worktree"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var forceRemoveWorktreeFlag bool
+
+var WorktreeCmd = &cobra.Command{
+	Use:   "worktree",
+	Short: "Manage the worktrees of a project in worktree mode",
+	Long:  "Manage the branches checked out as worktrees in projects of workspaces created with 'daytona create --worktrees'. Each worktree is a directory of the project that can be opened with 'daytona code --worktree BRANCH'",
+}
+
+var worktreeAddCmd = &cobra.Command{
+	Use:   "add BRANCH [WORKSPACE] [PROJECT]",
+	Short: "Check out a branch in a new worktree",
+	Long:  "Check out a branch in a new worktree. Remote branches are tracked and unknown branches are created from the default branch",
+	Args:  cobra.RangeArgs(1, 3),
+	Run: func(cmd *cobra.Command, args []string) {
+		project, err := selectWorktreeProject(args[1:], "Add a worktree to")
+		if err != nil {
+			log.Fatal(err)
+		}
+		if project == nil {
+			return
+		}
+
+		data, err := runAgentWorktreeCommand(project.hostname, "add", args[0])
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		worktree := git.Worktree{}
+		err = json.Unmarshal(data, &worktree)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Branch '%s' checked out at %s\n\nRun 'daytona code %s %s --worktree %s' to open it", worktree.Branch, worktree.Path, project.workspaceName, project.projectName, worktree.Branch))
+	},
+	ValidArgsFunction: getWorktreeArgsCompletions,
+}
+
+var worktreeListCmd = &cobra.Command{
+	Use:     "list [WORKSPACE] [PROJECT]",
+	Short:   "List the worktrees of a project",
+	Args:    cobra.RangeArgs(0, 2),
+	Aliases: []string{"ls"},
+	Run: func(cmd *cobra.Command, args []string) {
+		project, err := selectWorktreeProject(args, "List the worktrees of")
+		if err != nil {
+			log.Fatal(err)
+		}
+		if project == nil {
+			return
+		}
+
+		worktrees, err := listProjectWorktrees(project.hostname)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if output.FormatFlag != "" {
+			output.Output = worktrees
+			return
+		}
+
+		worktree_view.ListWorktrees(worktrees)
+	},
+	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) >= 2 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		if len(args) == 1 {
+			return getProjectNameCompletions(cmd, args, toComplete)
+		}
+
+		return getWorkspaceNameCompletions()
+	},
+}
+
+var worktreeRemoveCmd = &cobra.Command{
+	Use:     "remove BRANCH [WORKSPACE] [PROJECT]",
+	Short:   "Remove the worktree of a branch",
+	Long:    "Remove the worktree directory of a branch. The branch is kept in the repository",
+	Args:    cobra.RangeArgs(1, 3),
+	Aliases: []string{"rm"},
+	Run: func(cmd *cobra.Command, args []string) {
+		project, err := selectWorktreeProject(args[1:], "Remove a worktree from")
+		if err != nil {
+			log.Fatal(err)
+		}
+		if project == nil {
+			return
+		}
+
+		agentArgs := []string{"remove", args[0]}
+		if forceRemoveWorktreeFlag {
+			agentArgs = append(agentArgs, "--force")
+		}
+
+		_, err = runAgentWorktreeCommand(project.hostname, agentArgs...)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		views.RenderInfoMessage(fmt.Sprintf("Worktree of branch '%s' removed", args[0]))
+	},
+	ValidArgsFunction: getWorktreeArgsCompletions,
+}
+
+type worktreeProject struct {
+	workspaceName string
+	projectName   string
+	hostname      string
+}
+
+// Selects the workspace and the project from the arguments or prompts and prepares the SSH connection to the project.
+// Returns nil if a prompt is cancelled
+func selectWorktreeProject(args []string, actionVerb string) (*worktreeProject, error) {
+	c, err := config.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	activeProfile, err := c.GetActiveProfile()
+	if err != nil {
+		return nil, err
+	}
+
+	apiClient, err := apiclient_util.GetApiClient(&activeProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	var workspaceId, workspaceName, projectName string
+
+	if len(args) == 0 {
+		workspaceList, res, err := apiClient.WorkspaceAPI.ListWorkspaces(context.Background()).Execute()
+		if err != nil {
+			return nil, apiclient_util.HandleErrorResponse(res, err)
+		}
+
+		workspace := selection.GetWorkspaceFromPrompt(workspaceList.Items, actionVerb)
+		if workspace == nil {
+			return nil, nil
+		}
+		workspaceId, workspaceName = *workspace.Id, *workspace.Name
+	} else {
+		workspace, err := apiclient_util.GetWorkspace(args[0])
+		if err != nil {
+			return nil, err
+		}
+		workspaceId, workspaceName = *workspace.Id, *workspace.Name
+	}
+
+	if len(args) < 2 {
+		selectedProject, err := selectWorkspaceProject(workspaceId, &activeProfile)
+		if err != nil {
+			return nil, err
+		}
+		if selectedProject == nil {
+			return nil, nil
+		}
+		projectName = *selectedProject
+	} else {
+		projectName = args[1]
+	}
+
+	hostname, err := getProjectSshHostname(activeProfile, workspaceId, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &worktreeProject{
+		workspaceName: workspaceName,
+		projectName:   projectName,
+		hostname:      hostname,
+	}, nil
+}
+
+// Returns the path of the worktree of the branch inside the project
+func getWorktreePath(activeProfile config.Profile, workspaceId, projectName, branch string) (string, error) {
+	hostname, err := getProjectSshHostname(activeProfile, workspaceId, projectName)
+	if err != nil {
+		return "", err
+	}
+
+	worktrees, err := listProjectWorktrees(hostname)
+	if err != nil {
+		return "", err
+	}
+
+	for _, worktree := range worktrees {
+		if worktree.Branch == branch {
+			return worktree.Path, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %s. Run 'daytona worktree add %s' to check out the branch", git.ErrWorktreeNotFound, branch, branch)
+}
+
+func getProjectSshHostname(activeProfile config.Profile, workspaceId, projectName string) (string, error) {
+	err := config.EnsureSshConfigEntryAdded(activeProfile.Id, workspaceId, projectName)
+	if err != nil {
+		return "", err
+	}
+
+	err = apiclient_util.EnsureSshCertificate(activeProfile, workspaceId, projectName)
+	if err != nil {
+		return "", err
+	}
+
+	return config.GetProjectHostname(activeProfile.Id, workspaceId, projectName), nil
+}
+
+func listProjectWorktrees(projectHostname string) ([]git.Worktree, error) {
+	data, err := runAgentWorktreeCommand(projectHostname, "list")
+	if err != nil {
+		return nil, err
+	}
+
+	worktrees := []git.Worktree{}
+	err = json.Unmarshal(data, &worktrees)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the project worktrees: %w", err)
+	}
+
+	return worktrees, nil
+}
+
+// Worktrees are managed by the agent inside the project
+func runAgentWorktreeCommand(projectHostname string, args ...string) ([]byte, error) {
+	sshArgs := []string{projectHostname, "daytona", "agent", "worktree"}
+	for _, arg := range args {
+		sshArgs = append(sshArgs, fmt.Sprintf("'%s'", strings.ReplaceAll(arg, "'", `'\''`)))
+	}
+
+	data, err := exec.Command("ssh", sshArgs...).Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+			return nil, fmt.Errorf("failed to run the worktree command: %s", strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return nil, fmt.Errorf("failed to run the worktree command: %w", err)
+	}
+
+	return data, nil
+}
+
+func getWorktreeArgsCompletions(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) == 0 || len(args) >= 3 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	if len(args) == 2 {
+		return getProjectNameCompletions(cmd, args[1:], toComplete)
+	}
+
+	return getWorkspaceNameCompletions()
+}
+
+func init() {
+	worktreeRemoveCmd.Flags().BoolVarP(&forceRemoveWorktreeFlag, "force", "f", false, "Remove the worktree even if it has uncommitted changes")
+
+	WorktreeCmd.AddCommand(worktreeAddCmd)
+	WorktreeCmd.AddCommand(worktreeListCmd)
+	WorktreeCmd.AddCommand(worktreeRemoveCmd)
+}
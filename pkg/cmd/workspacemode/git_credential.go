@@ -0,0 +1,120 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspacemode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/spf13/cobra"
+)
+
+var gitCredentialSocketFlag string
+
+// Git runs the helper with the get, store or erase operation. Credentials are never stored,
+// the agent requests the credential from the server for every get
+var gitCredentialCmd = &cobra.Command{
+	Use:    "git-credential [get|store|erase]",
+	Short:  "Git credential helper that requests credentials from the Daytona agent",
+	Args:   cobra.ExactArgs(1),
+	Hidden: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		if args[0] != "get" {
+			return
+		}
+
+		input, err := parseCredentialInput(os.Stdin)
+		if err != nil || input["host"] == "" {
+			fmt.Fprintln(os.Stderr, "error parsing 'host' from stdin")
+			os.Exit(1)
+		}
+
+		protocol := input["protocol"]
+		if protocol == "" {
+			protocol = "https"
+		}
+
+		repoUrl := fmt.Sprintf("%s://%s", protocol, input["host"])
+		if input["path"] != "" {
+			repoUrl = fmt.Sprintf("%s/%s", repoUrl, strings.TrimPrefix(input["path"], "/"))
+		}
+
+		credential, err := getGitCredentialFromAgent(gitCredentialSocketFlag, repoUrl)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error getting git credential: %s\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Println("username=" + credential.Username)
+		fmt.Println("password=" + credential.Password)
+
+		expiresAt, err := time.Parse(time.RFC3339, credential.ExpiresAt)
+		if err == nil {
+			fmt.Printf("password_expiry_utc=%d\n", expiresAt.Unix())
+		}
+	},
+}
+
+func getGitCredentialFromAgent(socketPath string, repoUrl string) (*gitprovider.GitCredential, error) {
+	client := &http.Client{
+		Timeout: 30 * time.Second,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, "unix", socketPath)
+			},
+		},
+	}
+
+	res, err := client.Get("http://agent/credential?url=" + url.QueryEscape(repoUrl))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(res.Body)
+		return nil, fmt.Errorf("%s", strings.TrimSpace(string(body)))
+	}
+
+	var credential gitprovider.GitCredential
+	err = json.NewDecoder(res.Body).Decode(&credential)
+	if err != nil {
+		return nil, err
+	}
+
+	return &credential, nil
+}
+
+// Parses the attributes git writes to the helper, one key=value pair per line
+func parseCredentialInput(r io.Reader) (map[string]string, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string)
+	for _, line := range strings.Split(string(content), "\n") {
+		key, value, found := strings.Cut(strings.TrimSpace(line), "=")
+		if found {
+			result[key] = value
+		}
+	}
+
+	return result, nil
+}
+
+func init() {
+	defaultSocketPath := filepath.Join(os.Getenv("HOME"), ".daytona", "git-credential.sock")
+	gitCredentialCmd.Flags().StringVar(&gitCredentialSocketFlag, "socket", defaultSocketPath, "Path of the agent git credential socket")
+}
@@ -33,6 +33,7 @@ func Execute() {
 	cmd.SetupRootCommand(workspaceModeRootCmd)
 
 	workspaceModeRootCmd.AddCommand(gitCredCmd)
+	workspaceModeRootCmd.AddCommand(gitCredentialCmd)
 	workspaceModeRootCmd.AddCommand(AgentCmd)
 	workspaceModeRootCmd.AddCommand(startCmd)
 	workspaceModeRootCmd.AddCommand(stopCmd)
@@ -0,0 +1,60 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/daytonaio/daytona/pkg/builder"
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+)
+
+type BuildResultStore struct {
+	db *gorm.DB
+}
+
+func NewBuildResultStore(db *gorm.DB) (*BuildResultStore, error) {
+	err := db.AutoMigrate(&BuildResultDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &BuildResultStore{db: db}, nil
+}
+
+func (s *BuildResultStore) Find(hash string) (*builder.BuildResult, error) {
+	buildResultDTO := BuildResultDTO{}
+	tx := s.db.Where("hash = ?", hash).First(&buildResultDTO)
+	if tx.Error != nil {
+		if IsRecordNotFound(tx.Error) {
+			return nil, builder.ErrBuildResultNotFound
+		}
+		return nil, tx.Error
+	}
+
+	return ToBuildResult(buildResultDTO), nil
+}
+
+func (s *BuildResultStore) FindLatest(repositoryUrl, branch string) (*builder.BuildResult, error) {
+	buildResultDTO := BuildResultDTO{}
+	tx := s.db.Where("repository_url = ? AND branch = ?", repositoryUrl, branch).Order("updated_at desc").First(&buildResultDTO)
+	if tx.Error != nil {
+		if IsRecordNotFound(tx.Error) {
+			return nil, builder.ErrBuildResultNotFound
+		}
+		return nil, tx.Error
+	}
+
+	return ToBuildResult(buildResultDTO), nil
+}
+
+func (s *BuildResultStore) Save(hash string, result *builder.BuildResult) error {
+	buildResultDTO := ToBuildResultDTO(hash, result)
+	tx := s.db.Save(&buildResultDTO)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
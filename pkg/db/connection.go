@@ -9,6 +9,7 @@ import (
 
 	"github.com/glebarez/sqlite"
 	log "github.com/sirupsen/logrus"
+	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 )
@@ -22,12 +23,46 @@ func GetSQLiteConnection(dbPath string) *gorm.DB {
 
 	log.Debug(fmt.Sprintf("Opening db at %s", dbPath))
 
+	db, err := gorm.Open(sqlite.Open(dbPath), &gorm.Config{
+		Logger: getLogger(),
+	})
+	if err != nil {
+		panic("failed to connect database")
+	}
+
+	_db = db
+
+	return _db
+}
+
+// Connects to an external Postgres database. Unlike the embedded SQLite database,
+// it can be shared by multiple server instances.
+func GetPostgresConnection(dsn string) (*gorm.DB, error) {
+	if _db != nil {
+		return _db, nil
+	}
+
+	log.Debug("Connecting to postgres database")
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+		Logger: getLogger(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to postgres database: %w", err)
+	}
+
+	_db = db
+
+	return _db, nil
+}
+
+func getLogger() logger.Interface {
 	logLevel := log.ErrorLevel
 	if log.GetLevel() == log.DebugLevel {
 		logLevel = log.DebugLevel
 	}
 
-	newLogger := logger.New(
+	return logger.New(
 		log.New(),
 		logger.Config{
 			SlowThreshold:             time.Second,
@@ -37,15 +72,4 @@ func GetSQLiteConnection(dbPath string) *gorm.DB {
 			Colorful:                  true,
 		},
 	)
-
-	db, err := gorm.Open(sqlite.Open(dbPath), &gorm.Config{
-		Logger: newLogger,
-	})
-	if err != nil {
-		panic("failed to connect database")
-	}
-
-	_db = db
-
-	return _db
 }
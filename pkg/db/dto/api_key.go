@@ -8,23 +8,32 @@ import (
 )
 
 type ApiKeyDTO struct {
-	KeyHash string `gorm:"primaryKey"`
-	Type    apikey.ApiKeyType
-	Name    string `gorm:"uniqueIndex"`
+	KeyHash     string `gorm:"primaryKey"`
+	Type        apikey.ApiKeyType
+	Name        string `gorm:"uniqueIndex"`
+	WorkspaceId string
+	ExpiresAt   string
+	UserId      string
 }
 
 func ToApiKeyDTO(apiKey apikey.ApiKey) ApiKeyDTO {
 	return ApiKeyDTO{
-		KeyHash: apiKey.KeyHash,
-		Type:    apiKey.Type,
-		Name:    apiKey.Name,
+		KeyHash:     apiKey.KeyHash,
+		Type:        apiKey.Type,
+		Name:        apiKey.Name,
+		WorkspaceId: apiKey.WorkspaceId,
+		ExpiresAt:   apiKey.ExpiresAt,
+		UserId:      apiKey.UserId,
 	}
 }
 
 func ToApiKey(apiKeyDTO ApiKeyDTO) apikey.ApiKey {
 	return apikey.ApiKey{
-		KeyHash: apiKeyDTO.KeyHash,
-		Type:    apiKeyDTO.Type,
-		Name:    apiKeyDTO.Name,
+		KeyHash:     apiKeyDTO.KeyHash,
+		Type:        apiKeyDTO.Type,
+		Name:        apiKeyDTO.Name,
+		WorkspaceId: apiKeyDTO.WorkspaceId,
+		ExpiresAt:   apiKeyDTO.ExpiresAt,
+		UserId:      apiKeyDTO.UserId,
 	}
 }
@@ -0,0 +1,65 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/builder"
+)
+
+type BuildResultDTO struct {
+	Hash               string   `gorm:"primaryKey"`
+	BuildId            string   `json:"buildId"`
+	User               string   `json:"user"`
+	ImageName          string   `json:"imageName"`
+	ProjectVolumePath  string   `json:"projectVolumePath"`
+	PostCreateCommands []string `json:"postCreateCommands,omitempty" gorm:"serializer:json"`
+	PostStartCommands  []string `json:"postStartCommands,omitempty" gorm:"serializer:json"`
+	ExportedImage      string   `json:"exportedImage,omitempty"`
+	RepositoryUrl      string   `json:"repositoryUrl" gorm:"index:idx_build_result_branch"`
+	Branch             string   `json:"branch" gorm:"index:idx_build_result_branch"`
+	Sha                string   `json:"sha"`
+	CacheFrom          string   `json:"cacheFrom,omitempty"`
+	CacheHits          int      `json:"cacheHits"`
+	CacheMisses        int      `json:"cacheMisses"`
+	UpdatedAt          time.Time
+}
+
+func ToBuildResultDTO(hash string, result *builder.BuildResult) BuildResultDTO {
+	return BuildResultDTO{
+		Hash:               hash,
+		BuildId:            result.BuildId,
+		User:               result.User,
+		ImageName:          result.ImageName,
+		ProjectVolumePath:  result.ProjectVolumePath,
+		PostCreateCommands: result.PostCreateCommands,
+		PostStartCommands:  result.PostStartCommands,
+		ExportedImage:      result.ExportedImage,
+		RepositoryUrl:      result.RepositoryUrl,
+		Branch:             result.Branch,
+		Sha:                result.Sha,
+		CacheFrom:          result.CacheFrom,
+		CacheHits:          result.CacheHits,
+		CacheMisses:        result.CacheMisses,
+	}
+}
+
+func ToBuildResult(buildResultDTO BuildResultDTO) *builder.BuildResult {
+	return &builder.BuildResult{
+		BuildId:            buildResultDTO.BuildId,
+		User:               buildResultDTO.User,
+		ImageName:          buildResultDTO.ImageName,
+		ProjectVolumePath:  buildResultDTO.ProjectVolumePath,
+		PostCreateCommands: buildResultDTO.PostCreateCommands,
+		PostStartCommands:  buildResultDTO.PostStartCommands,
+		ExportedImage:      buildResultDTO.ExportedImage,
+		RepositoryUrl:      buildResultDTO.RepositoryUrl,
+		Branch:             buildResultDTO.Branch,
+		Sha:                buildResultDTO.Sha,
+		CacheFrom:          buildResultDTO.CacheFrom,
+		CacheHits:          buildResultDTO.CacheHits,
+		CacheMisses:        buildResultDTO.CacheMisses,
+	}
+}
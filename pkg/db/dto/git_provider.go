@@ -8,18 +8,28 @@ import (
 )
 
 type GitProviderConfigDTO struct {
-	Id         string  `gorm:"primaryKey"`
-	Username   string  `json:"username"`
-	Token      string  `json:"token"`
-	BaseApiUrl *string `json:"baseApiUrl,omitempty"`
+	Id         string                       `gorm:"primaryKey"`
+	ProviderId string                       `json:"providerId,omitempty"`
+	Username   string                       `json:"username"`
+	Token      string                       `json:"token"`
+	BaseApiUrl *string                      `json:"baseApiUrl,omitempty"`
+	GitHubApp  *gitprovider.GitHubAppConfig `json:"githubApp,omitempty" gorm:"serializer:json"`
+	Alias      string                       `json:"alias,omitempty"`
+	IsDefault  bool                         `json:"isDefault"`
+	OwnerId    string                       `json:"ownerId"`
 }
 
 func ToGitProviderConfigDTO(gitProvider gitprovider.GitProviderConfig) GitProviderConfigDTO {
 	gitProviderDTO := GitProviderConfigDTO{
 		Id:         gitProvider.Id,
+		ProviderId: gitProvider.ProviderId,
 		Username:   gitProvider.Username,
 		Token:      gitProvider.Token,
 		BaseApiUrl: gitProvider.BaseApiUrl,
+		GitHubApp:  gitProvider.GitHubApp,
+		Alias:      gitProvider.Alias,
+		IsDefault:  gitProvider.IsDefault,
+		OwnerId:    gitProvider.OwnerId,
 	}
 
 	return gitProviderDTO
@@ -28,8 +38,13 @@ func ToGitProviderConfigDTO(gitProvider gitprovider.GitProviderConfig) GitProvid
 func ToGitProviderConfig(gitProviderDTO GitProviderConfigDTO) gitprovider.GitProviderConfig {
 	return gitprovider.GitProviderConfig{
 		Id:         gitProviderDTO.Id,
+		ProviderId: gitProviderDTO.ProviderId,
 		Username:   gitProviderDTO.Username,
 		Token:      gitProviderDTO.Token,
 		BaseApiUrl: gitProviderDTO.BaseApiUrl,
+		GitHubApp:  gitProviderDTO.GitHubApp,
+		Alias:      gitProviderDTO.Alias,
+		IsDefault:  gitProviderDTO.IsDefault,
+		OwnerId:    gitProviderDTO.OwnerId,
 	}
 }
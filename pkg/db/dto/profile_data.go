@@ -5,22 +5,35 @@ package dto
 
 import "github.com/daytonaio/daytona/pkg/profiledata"
 
+// ID of the profile data without a user, which was the only profile data before users were introduced
 const ProfileDataId = "profile_data"
 
 type ProfileDataDTO struct {
-	Id      string            `gorm:"primaryKey"`
-	EnvVars map[string]string `gorm:"serializer:json"`
+	Id          string                   `gorm:"primaryKey"`
+	EnvVars     map[string]string        `gorm:"serializer:json"`
+	GitIdentity *profiledata.GitIdentity `gorm:"serializer:json"`
 }
 
-func ToProfileDataDTO(profileData *profiledata.ProfileData) ProfileDataDTO {
+// Returns the ID of the profile data of the user
+func ToProfileDataId(userId string) string {
+	if userId == "" {
+		return ProfileDataId
+	}
+
+	return userId
+}
+
+func ToProfileDataDTO(userId string, profileData *profiledata.ProfileData) ProfileDataDTO {
 	return ProfileDataDTO{
-		Id:      ProfileDataId,
-		EnvVars: profileData.EnvVars,
+		Id:          ToProfileDataId(userId),
+		EnvVars:     profileData.EnvVars,
+		GitIdentity: profileData.GitIdentity,
 	}
 }
 
 func ToProfileData(profileDataDTO ProfileDataDTO) *profiledata.ProfileData {
 	return &profiledata.ProfileData{
-		EnvVars: profileDataDTO.EnvVars,
+		EnvVars:     profileDataDTO.EnvVars,
+		GitIdentity: profileDataDTO.GitIdentity,
 	}
 }
@@ -9,15 +9,18 @@ import (
 )
 
 type RepositoryDTO struct {
-	Id       string  `json:"id"`
-	Url      string  `json:"url"`
-	Name     string  `json:"name"`
-	Owner    string  `json:"owner"`
-	Sha      string  `json:"sha"`
-	Source   string  `json:"source"`
-	Branch   *string `default:"main" json:"branch,omitempty"`
-	PrNumber *uint32 `json:"prNumber,omitempty"`
-	Path     *string `json:"path,omitempty"`
+	Id        string  `json:"id"`
+	Url       string  `json:"url"`
+	Name      string  `json:"name"`
+	Owner     string  `json:"owner"`
+	Sha       string  `json:"sha"`
+	Source    string  `json:"source"`
+	Branch    *string `default:"main" json:"branch,omitempty"`
+	PrNumber  *uint32 `json:"prNumber,omitempty"`
+	Path      *string `json:"path,omitempty"`
+	NewBranch *string `json:"newBranch,omitempty"`
+	Pinned    bool    `json:"pinned,omitempty"`
+	Ref       *string `json:"ref,omitempty"`
 }
 
 type FileStatusDTO struct {
@@ -29,64 +32,116 @@ type FileStatusDTO struct {
 
 type GitStatusDTO struct {
 	CurrentBranch string           `json:"currentBranch"`
+	HeadSha       string           `json:"headSha,omitempty"`
 	Files         []*FileStatusDTO `json:"fileStatus"`
 }
 
+type ProjectResourcesDTO struct {
+	CpuUsage      float64 `json:"cpuUsage"`
+	MemoryUsageMB uint64  `json:"memoryUsageMB"`
+	MemoryLimitMB uint64  `json:"memoryLimitMB"`
+	DiskUsageMB   uint64  `json:"diskUsageMB"`
+	DiskTotalMB   uint64  `json:"diskTotalMB"`
+}
+
 type ProjectStateDTO struct {
-	UpdatedAt string        `json:"updatedAt"`
-	Uptime    uint64        `json:"uptime"`
-	GitStatus *GitStatusDTO `json:"gitStatus"`
+	UpdatedAt string               `json:"updatedAt"`
+	Uptime    uint64               `json:"uptime"`
+	GitStatus *GitStatusDTO        `json:"gitStatus"`
+	Resources *ProjectResourcesDTO `json:"resources,omitempty"`
+	Welcome   string               `json:"welcome,omitempty"`
+	Language  string               `json:"language,omitempty"`
 }
 
 type ProjectBuildDevcontainerDTO struct {
 	DevContainerFilePath string `json:"devContainerFilePath"`
 }
 
+type ProjectBuildExportDTO struct {
+	Image string `json:"image"`
+}
+
 type ProjectBuildDTO struct {
 	Devcontainer *ProjectBuildDevcontainerDTO `json:"devcontainer"`
+	Export       *ProjectBuildExportDTO       `json:"export,omitempty"`
 }
 
 type ProjectDTO struct {
-	Name               string           `json:"name"`
-	Image              string           `json:"image"`
-	User               string           `json:"user"`
-	Build              *ProjectBuildDTO `json:"build,omitempty" gorm:"serializer:json"`
-	Repository         RepositoryDTO    `json:"repository"`
-	WorkspaceId        string           `json:"workspaceId"`
-	Target             string           `json:"target"`
-	ApiKey             string           `json:"apiKey"`
-	State              *ProjectStateDTO `json:"state,omitempty" gorm:"serializer:json"`
-	PostStartCommands  []string         `json:"postStartCommands,omitempty"`
-	PostCreateCommands []string         `json:"postCreateCommands,omitempty"`
+	Name                string           `json:"name"`
+	Image               string           `json:"image"`
+	User                string           `json:"user"`
+	Build               *ProjectBuildDTO `json:"build,omitempty" gorm:"serializer:json"`
+	Repository          RepositoryDTO    `json:"repository"`
+	WorkspaceId         string           `json:"workspaceId"`
+	Target              string           `json:"target"`
+	ApiKey              string           `json:"apiKey"`
+	State               *ProjectStateDTO `json:"state,omitempty" gorm:"serializer:json"`
+	PostStartCommands   []string         `json:"postStartCommands,omitempty"`
+	PostCreateCommands  []string         `json:"postCreateCommands,omitempty"`
+	ExportedImage       string           `json:"exportedImage,omitempty"`
+	GitProviderConfigId string           `json:"gitProviderConfigId,omitempty"`
+	Status              string           `json:"status,omitempty"`
+	StatusError         string           `json:"statusError,omitempty"`
+	StateLastVerifiedAt string           `json:"stateLastVerifiedAt,omitempty"`
+	PortForwards        []PortForwardDTO `json:"portForwards,omitempty"`
+	Prebuild            *PrebuildDTO     `json:"prebuild,omitempty"`
+	DependsOn           []string         `json:"dependsOn,omitempty"`
+	RecordSessions      bool             `json:"recordSessions,omitempty"`
+	WorktreeMode        bool             `json:"worktreeMode,omitempty"`
+}
+
+type PrebuildDTO struct {
+	Hit     bool   `json:"hit"`
+	BuildId string `json:"buildId,omitempty"`
+	Image   string `json:"image,omitempty"`
+	Sha     string `json:"sha,omitempty"`
+}
+
+type PortForwardDTO struct {
+	Port   uint16 `json:"port"`
+	Public bool   `json:"public"`
 }
 
 func ToProjectDTO(project *workspace.Project, workspace *workspace.Workspace) ProjectDTO {
 	return ProjectDTO{
-		Name:               project.Name,
-		Image:              project.Image,
-		User:               project.User,
-		Build:              ToProjectBuildDTO(project.Build),
-		Repository:         ToRepositoryDTO(project.Repository),
-		WorkspaceId:        project.WorkspaceId,
-		Target:             project.Target,
-		State:              ToProjectStateDTO(project.State),
-		PostStartCommands:  project.PostStartCommands,
-		PostCreateCommands: project.PostCreateCommands,
-		ApiKey:             workspace.ApiKey,
+		Name:                project.Name,
+		Image:               project.Image,
+		User:                project.User,
+		Build:               ToProjectBuildDTO(project.Build),
+		Repository:          ToRepositoryDTO(project.Repository),
+		WorkspaceId:         project.WorkspaceId,
+		Target:              project.Target,
+		State:               ToProjectStateDTO(project.State),
+		PostStartCommands:   project.PostStartCommands,
+		PostCreateCommands:  project.PostCreateCommands,
+		ApiKey:              workspace.ApiKey,
+		ExportedImage:       project.ExportedImage,
+		GitProviderConfigId: project.GitProviderConfigId,
+		Status:              string(project.Status),
+		StatusError:         project.StatusError,
+		StateLastVerifiedAt: project.StateLastVerifiedAt,
+		PortForwards:        ToPortForwardDTOs(project.PortForwards),
+		Prebuild:            ToPrebuildDTO(project.Prebuild),
+		DependsOn:           project.DependsOn,
+		RecordSessions:      project.RecordSessions,
+		WorktreeMode:        project.WorktreeMode,
 	}
 }
 
 func ToRepositoryDTO(repo *gitprovider.GitRepository) RepositoryDTO {
 	repoDTO := RepositoryDTO{
-		Url:      repo.Url,
-		Name:     repo.Name,
-		Id:       repo.Id,
-		Owner:    repo.Owner,
-		Sha:      repo.Sha,
-		Source:   repo.Source,
-		Branch:   repo.Branch,
-		PrNumber: repo.PrNumber,
-		Path:     repo.Path,
+		Url:       repo.Url,
+		Name:      repo.Name,
+		Id:        repo.Id,
+		Owner:     repo.Owner,
+		Sha:       repo.Sha,
+		Source:    repo.Source,
+		Branch:    repo.Branch,
+		PrNumber:  repo.PrNumber,
+		Path:      repo.Path,
+		NewBranch: repo.NewBranch,
+		Pinned:    repo.Pinned,
+		Ref:       repo.Ref,
 	}
 
 	return repoDTO
@@ -112,6 +167,7 @@ func ToGitStatusDTO(status *workspace.GitStatus) *GitStatusDTO {
 
 	statusDTO := &GitStatusDTO{
 		CurrentBranch: status.CurrentBranch,
+		HeadSha:       status.HeadSha,
 	}
 
 	for _, file := range status.Files {
@@ -130,6 +186,23 @@ func ToProjectStateDTO(state *workspace.ProjectState) *ProjectStateDTO {
 		UpdatedAt: state.UpdatedAt,
 		Uptime:    state.Uptime,
 		GitStatus: ToGitStatusDTO(state.GitStatus),
+		Resources: ToProjectResourcesDTO(state.Resources),
+		Welcome:   state.Welcome,
+		Language:  state.Language,
+	}
+}
+
+func ToProjectResourcesDTO(resources *workspace.ProjectResources) *ProjectResourcesDTO {
+	if resources == nil {
+		return nil
+	}
+
+	return &ProjectResourcesDTO{
+		CpuUsage:      resources.CpuUsage,
+		MemoryUsageMB: resources.MemoryUsageMB,
+		MemoryLimitMB: resources.MemoryLimitMB,
+		DiskUsageMB:   resources.DiskUsageMB,
+		DiskTotalMB:   resources.DiskTotalMB,
 	}
 }
 
@@ -138,30 +211,46 @@ func ToProjectBuildDTO(build *workspace.ProjectBuild) *ProjectBuildDTO {
 		return nil
 	}
 
-	if build.Devcontainer == nil {
-		return &ProjectBuildDTO{}
-	}
+	buildDTO := &ProjectBuildDTO{}
 
-	return &ProjectBuildDTO{
-		Devcontainer: &ProjectBuildDevcontainerDTO{
+	if build.Devcontainer != nil {
+		buildDTO.Devcontainer = &ProjectBuildDevcontainerDTO{
 			DevContainerFilePath: build.Devcontainer.DevContainerFilePath,
-		},
+		}
 	}
+
+	if build.Export != nil {
+		buildDTO.Export = &ProjectBuildExportDTO{
+			Image: build.Export.Image,
+		}
+	}
+
+	return buildDTO
 }
 
 func ToProject(projectDTO ProjectDTO) *workspace.Project {
 	return &workspace.Project{
-		Name:               projectDTO.Name,
-		Image:              projectDTO.Image,
-		User:               projectDTO.User,
-		Build:              ToProjectBuild(projectDTO.Build),
-		Repository:         ToRepository(projectDTO.Repository),
-		WorkspaceId:        projectDTO.WorkspaceId,
-		Target:             projectDTO.Target,
-		State:              ToProjectState(projectDTO.State),
-		PostStartCommands:  projectDTO.PostStartCommands,
-		PostCreateCommands: projectDTO.PostCreateCommands,
-		ApiKey:             projectDTO.ApiKey,
+		Name:                projectDTO.Name,
+		Image:               projectDTO.Image,
+		User:                projectDTO.User,
+		Build:               ToProjectBuild(projectDTO.Build),
+		Repository:          ToRepository(projectDTO.Repository),
+		WorkspaceId:         projectDTO.WorkspaceId,
+		Target:              projectDTO.Target,
+		State:               ToProjectState(projectDTO.State),
+		PostStartCommands:   projectDTO.PostStartCommands,
+		PostCreateCommands:  projectDTO.PostCreateCommands,
+		ApiKey:              projectDTO.ApiKey,
+		ExportedImage:       projectDTO.ExportedImage,
+		GitProviderConfigId: projectDTO.GitProviderConfigId,
+		Status:              workspace.ProjectStatus(projectDTO.Status),
+		StatusError:         projectDTO.StatusError,
+		StateLastVerifiedAt: projectDTO.StateLastVerifiedAt,
+		PortForwards:        ToPortForwards(projectDTO.PortForwards),
+		Prebuild:            ToPrebuild(projectDTO.Prebuild),
+		DependsOn:           projectDTO.DependsOn,
+		RecordSessions:      projectDTO.RecordSessions,
+		WorktreeMode:        projectDTO.WorktreeMode,
 	}
 }
 
@@ -185,6 +274,7 @@ func ToGitStatus(statusDTO *GitStatusDTO) *workspace.GitStatus {
 
 	status := &workspace.GitStatus{
 		CurrentBranch: statusDTO.CurrentBranch,
+		HeadSha:       statusDTO.HeadSha,
 	}
 
 	for _, file := range statusDTO.Files {
@@ -203,20 +293,40 @@ func ToProjectState(stateDTO *ProjectStateDTO) *workspace.ProjectState {
 		UpdatedAt: stateDTO.UpdatedAt,
 		Uptime:    stateDTO.Uptime,
 		GitStatus: ToGitStatus(stateDTO.GitStatus),
+		Resources: ToProjectResources(stateDTO.Resources),
+		Welcome:   stateDTO.Welcome,
+		Language:  stateDTO.Language,
+	}
+}
+
+func ToProjectResources(resourcesDTO *ProjectResourcesDTO) *workspace.ProjectResources {
+	if resourcesDTO == nil {
+		return nil
+	}
+
+	return &workspace.ProjectResources{
+		CpuUsage:      resourcesDTO.CpuUsage,
+		MemoryUsageMB: resourcesDTO.MemoryUsageMB,
+		MemoryLimitMB: resourcesDTO.MemoryLimitMB,
+		DiskUsageMB:   resourcesDTO.DiskUsageMB,
+		DiskTotalMB:   resourcesDTO.DiskTotalMB,
 	}
 }
 
 func ToRepository(repoDTO RepositoryDTO) *gitprovider.GitRepository {
 	repo := gitprovider.GitRepository{
-		Url:      repoDTO.Url,
-		Id:       repoDTO.Id,
-		Name:     repoDTO.Name,
-		Owner:    repoDTO.Owner,
-		Branch:   repoDTO.Branch,
-		Sha:      repoDTO.Sha,
-		PrNumber: repoDTO.PrNumber,
-		Source:   repoDTO.Source,
-		Path:     repoDTO.Path,
+		Url:       repoDTO.Url,
+		Id:        repoDTO.Id,
+		Name:      repoDTO.Name,
+		Owner:     repoDTO.Owner,
+		Branch:    repoDTO.Branch,
+		Sha:       repoDTO.Sha,
+		PrNumber:  repoDTO.PrNumber,
+		Source:    repoDTO.Source,
+		Path:      repoDTO.Path,
+		NewBranch: repoDTO.NewBranch,
+		Pinned:    repoDTO.Pinned,
+		Ref:       repoDTO.Ref,
 	}
 
 	return &repo
@@ -227,13 +337,69 @@ func ToProjectBuild(buildDTO *ProjectBuildDTO) *workspace.ProjectBuild {
 		return nil
 	}
 
-	if buildDTO.Devcontainer == nil {
-		return &workspace.ProjectBuild{}
-	}
+	build := &workspace.ProjectBuild{}
 
-	return &workspace.ProjectBuild{
-		Devcontainer: &workspace.ProjectBuildDevcontainer{
+	if buildDTO.Devcontainer != nil {
+		build.Devcontainer = &workspace.ProjectBuildDevcontainer{
 			DevContainerFilePath: buildDTO.Devcontainer.DevContainerFilePath,
-		},
+		}
+	}
+
+	if buildDTO.Export != nil {
+		build.Export = &workspace.ProjectBuildExport{
+			Image: buildDTO.Export.Image,
+		}
+	}
+
+	return build
+}
+
+func ToPortForwardDTOs(portForwards []workspace.ProjectPortForward) []PortForwardDTO {
+	var portForwardDTOs []PortForwardDTO
+	for _, portForward := range portForwards {
+		portForwardDTOs = append(portForwardDTOs, PortForwardDTO{
+			Port:   portForward.Port,
+			Public: portForward.Public,
+		})
+	}
+
+	return portForwardDTOs
+}
+
+func ToPortForwards(portForwardDTOs []PortForwardDTO) []workspace.ProjectPortForward {
+	var portForwards []workspace.ProjectPortForward
+	for _, portForwardDTO := range portForwardDTOs {
+		portForwards = append(portForwards, workspace.ProjectPortForward{
+			Port:   portForwardDTO.Port,
+			Public: portForwardDTO.Public,
+		})
+	}
+
+	return portForwards
+}
+
+func ToPrebuildDTO(prebuild *workspace.ProjectPrebuild) *PrebuildDTO {
+	if prebuild == nil {
+		return nil
+	}
+
+	return &PrebuildDTO{
+		Hit:     prebuild.Hit,
+		BuildId: prebuild.BuildId,
+		Image:   prebuild.Image,
+		Sha:     prebuild.Sha,
+	}
+}
+
+func ToPrebuild(prebuildDTO *PrebuildDTO) *workspace.ProjectPrebuild {
+	if prebuildDTO == nil {
+		return nil
+	}
+
+	return &workspace.ProjectPrebuild{
+		Hit:     prebuildDTO.Hit,
+		BuildId: prebuildDTO.BuildId,
+		Image:   prebuildDTO.Image,
+		Sha:     prebuildDTO.Sha,
 	}
 }
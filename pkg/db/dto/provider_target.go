@@ -6,10 +6,13 @@ package dto
 import "github.com/daytonaio/daytona/pkg/provider"
 
 type ProviderTargetDTO struct {
-	Name            string `json:"name" gorm:"primaryKey"`
-	ProviderName    string `json:"providerName"`
-	ProviderVersion string `json:"providerVersion"`
-	Options         string `json:"options"`
+	Name            string                  `json:"name" gorm:"primaryKey"`
+	ProviderName    string                  `json:"providerName"`
+	ProviderVersion string                  `json:"providerVersion"`
+	Options         string                  `json:"options"`
+	NetworkPolicy   *provider.NetworkPolicy `json:"networkPolicy,omitempty" gorm:"serializer:json"`
+	NetworkMode     *provider.NetworkMode   `json:"networkMode,omitempty"`
+	OwnerId         string                  `json:"ownerId"`
 }
 
 func ToProviderTargetDTO(providerTarget *provider.ProviderTarget) ProviderTargetDTO {
@@ -18,6 +21,9 @@ func ToProviderTargetDTO(providerTarget *provider.ProviderTarget) ProviderTarget
 		ProviderName:    providerTarget.ProviderInfo.Name,
 		ProviderVersion: providerTarget.ProviderInfo.Version,
 		Options:         providerTarget.Options,
+		NetworkPolicy:   providerTarget.NetworkPolicy,
+		NetworkMode:     providerTarget.NetworkMode,
+		OwnerId:         providerTarget.OwnerId,
 	}
 }
 
@@ -28,6 +34,9 @@ func ToProviderTarget(providerTargetDTO ProviderTargetDTO) *provider.ProviderTar
 			Name:    providerTargetDTO.ProviderName,
 			Version: providerTargetDTO.ProviderVersion,
 		},
-		Options: providerTargetDTO.Options,
+		Options:       providerTargetDTO.Options,
+		NetworkPolicy: providerTargetDTO.NetworkPolicy,
+		NetworkMode:   providerTargetDTO.NetworkMode,
+		OwnerId:       providerTargetDTO.OwnerId,
 	}
 }
@@ -0,0 +1,40 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import "github.com/daytonaio/daytona/pkg/sessionrecording"
+
+type SessionRecordingDTO struct {
+	Id            string  `json:"id" gorm:"primaryKey"`
+	WorkspaceId   string  `json:"workspaceId" gorm:"index"`
+	WorkspaceName string  `json:"workspaceName"`
+	ProjectName   string  `json:"projectName"`
+	StartedAt     string  `json:"startedAt"`
+	Duration      float64 `json:"duration"`
+	Size          int64   `json:"size"`
+}
+
+func ToSessionRecordingDTO(recording *sessionrecording.SessionRecording) SessionRecordingDTO {
+	return SessionRecordingDTO{
+		Id:            recording.Id,
+		WorkspaceId:   recording.WorkspaceId,
+		WorkspaceName: recording.WorkspaceName,
+		ProjectName:   recording.ProjectName,
+		StartedAt:     recording.StartedAt,
+		Duration:      recording.Duration,
+		Size:          recording.Size,
+	}
+}
+
+func ToSessionRecording(recordingDTO SessionRecordingDTO) *sessionrecording.SessionRecording {
+	return &sessionrecording.SessionRecording{
+		Id:            recordingDTO.Id,
+		WorkspaceId:   recordingDTO.WorkspaceId,
+		WorkspaceName: recordingDTO.WorkspaceName,
+		ProjectName:   recordingDTO.ProjectName,
+		StartedAt:     recordingDTO.StartedAt,
+		Duration:      recordingDTO.Duration,
+		Size:          recordingDTO.Size,
+	}
+}
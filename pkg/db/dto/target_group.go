@@ -0,0 +1,28 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import "github.com/daytonaio/daytona/pkg/provider"
+
+type TargetGroupDTO struct {
+	Name            string                       `json:"name" gorm:"primaryKey"`
+	Members         []provider.TargetGroupMember `json:"members" gorm:"serializer:json"`
+	PlacementPolicy string                       `json:"placementPolicy"`
+}
+
+func ToTargetGroupDTO(group *provider.TargetGroup) TargetGroupDTO {
+	return TargetGroupDTO{
+		Name:            group.Name,
+		Members:         group.Members,
+		PlacementPolicy: string(group.PlacementPolicy),
+	}
+}
+
+func ToTargetGroup(groupDTO TargetGroupDTO) *provider.TargetGroup {
+	return &provider.TargetGroup{
+		Name:            groupDTO.Name,
+		Members:         groupDTO.Members,
+		PlacementPolicy: provider.PlacementPolicy(groupDTO.PlacementPolicy),
+	}
+}
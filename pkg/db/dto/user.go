@@ -0,0 +1,34 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import "github.com/daytonaio/daytona/pkg/user"
+
+type UserDTO struct {
+	Id        string `json:"id" gorm:"primaryKey"`
+	Name      string `json:"name" gorm:"uniqueIndex"`
+	Role      string `json:"role"`
+	Disabled  bool   `json:"disabled"`
+	CreatedAt string `json:"createdAt"`
+}
+
+func ToUserDTO(u *user.User) UserDTO {
+	return UserDTO{
+		Id:        u.Id,
+		Name:      u.Name,
+		Role:      string(u.Role),
+		Disabled:  u.Disabled,
+		CreatedAt: u.CreatedAt,
+	}
+}
+
+func ToUser(userDTO UserDTO) *user.User {
+	return &user.User{
+		Id:        userDTO.Id,
+		Name:      userDTO.Name,
+		Role:      user.Role(userDTO.Role),
+		Disabled:  userDTO.Disabled,
+		CreatedAt: userDTO.CreatedAt,
+	}
+}
@@ -10,11 +10,17 @@ import (
 )
 
 type WorkspaceDTO struct {
-	Id       string       `gorm:"primaryKey"`
-	Name     string       `json:"name" gorm:"unique"`
-	Target   string       `json:"target"`
-	ApiKey   string       `json:"apiKey"`
-	Projects []ProjectDTO `gorm:"serializer:json"`
+	Id           string                       `gorm:"primaryKey"`
+	Name         string                       `json:"name" gorm:"unique"`
+	Target       string                       `json:"target"`
+	TargetGroup  string                       `json:"targetGroup"`
+	ApiKey       string                       `json:"apiKey"`
+	Projects     []ProjectDTO                 `gorm:"serializer:json"`
+	ExpiresAt    string                       `json:"expiresAt"`
+	ExpiryAction string                       `json:"expiryAction"`
+	Creation     *workspace.WorkspaceCreation `json:"creation,omitempty" gorm:"serializer:json"`
+	Cost         *workspace.WorkspaceCost     `json:"cost,omitempty" gorm:"serializer:json"`
+	OwnerId      string                       `json:"ownerId"`
 }
 
 func (w WorkspaceDTO) GetProject(name string) (*ProjectDTO, error) {
@@ -29,10 +35,16 @@ func (w WorkspaceDTO) GetProject(name string) (*ProjectDTO, error) {
 
 func ToWorkspaceDTO(workspace *workspace.Workspace) WorkspaceDTO {
 	workspaceDTO := WorkspaceDTO{
-		Id:     workspace.Id,
-		Name:   workspace.Name,
-		Target: workspace.Target,
-		ApiKey: workspace.ApiKey,
+		Id:           workspace.Id,
+		Name:         workspace.Name,
+		Target:       workspace.Target,
+		TargetGroup:  workspace.TargetGroup,
+		ApiKey:       workspace.ApiKey,
+		ExpiresAt:    workspace.ExpiresAt,
+		ExpiryAction: string(workspace.ExpiryAction),
+		Creation:     workspace.Creation,
+		Cost:         workspace.Cost,
+		OwnerId:      workspace.OwnerId,
 	}
 
 	for _, project := range workspace.Projects {
@@ -44,10 +56,16 @@ func ToWorkspaceDTO(workspace *workspace.Workspace) WorkspaceDTO {
 
 func ToWorkspace(workspaceDTO WorkspaceDTO) *workspace.Workspace {
 	workspace := workspace.Workspace{
-		Id:     workspaceDTO.Id,
-		Name:   workspaceDTO.Name,
-		Target: workspaceDTO.Target,
-		ApiKey: workspaceDTO.ApiKey,
+		Id:           workspaceDTO.Id,
+		Name:         workspaceDTO.Name,
+		Target:       workspaceDTO.Target,
+		TargetGroup:  workspaceDTO.TargetGroup,
+		ApiKey:       workspaceDTO.ApiKey,
+		ExpiresAt:    workspaceDTO.ExpiresAt,
+		ExpiryAction: workspace.ExpiryAction(workspaceDTO.ExpiryAction),
+		Creation:     workspaceDTO.Creation,
+		Cost:         workspaceDTO.Cost,
+		OwnerId:      workspaceDTO.OwnerId,
 	}
 
 	for _, projectDTO := range workspaceDTO.Projects {
@@ -0,0 +1,87 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"hash/fnv"
+	"sync"
+
+	"gorm.io/gorm"
+)
+
+// PostgresLeaderLock elects a single leader among the server instances sharing a Postgres database.
+// The leader holds a session-level advisory lock on a dedicated connection, so the lock is released by the database
+// if the leader loses its connection
+type PostgresLeaderLock struct {
+	db    *sql.DB
+	key   int64
+	conn  *sql.Conn
+	mutex sync.Mutex
+}
+
+func NewPostgresLeaderLock(db *gorm.DB, name string) (*PostgresLeaderLock, error) {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+
+	hash := fnv.New64a()
+	hash.Write([]byte(name))
+
+	return &PostgresLeaderLock{
+		db:  sqlDB,
+		key: int64(hash.Sum64()),
+	}, nil
+}
+
+func (l *PostgresLeaderLock) TryAcquire(ctx context.Context) (bool, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.conn != nil {
+		err := l.conn.PingContext(ctx)
+		if err == nil {
+			return true, nil
+		}
+
+		l.conn.Close()
+		l.conn = nil
+		return false, fmt.Errorf("lost the leader lock connection: %w", err)
+	}
+
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var acquired bool
+	err = conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", l.key).Scan(&acquired)
+	if err != nil || !acquired {
+		conn.Close()
+		return false, err
+	}
+
+	l.conn = conn
+
+	return true, nil
+}
+
+func (l *PostgresLeaderLock) Release() error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.conn == nil {
+		return nil
+	}
+
+	// The connection is returned to the pool on close, so the lock is released explicitly
+	_, err := l.conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", l.key)
+	l.conn.Close()
+	l.conn = nil
+
+	return err
+}
@@ -22,9 +22,9 @@ func NewProfileDataStore(db *gorm.DB) (*ProfileDataStore, error) {
 	return &ProfileDataStore{db: db}, nil
 }
 
-func (p *ProfileDataStore) Get() (*profiledata.ProfileData, error) {
+func (p *ProfileDataStore) Get(userId string) (*profiledata.ProfileData, error) {
 	profileDataDTO := ProfileDataDTO{}
-	tx := p.db.Where("id = ?", ProfileDataId).First(&profileDataDTO)
+	tx := p.db.Where("id = ?", ToProfileDataId(userId)).First(&profileDataDTO)
 	if tx.Error != nil {
 		if tx.Error == gorm.ErrRecordNotFound {
 			return nil, profiledata.ErrProfileDataNotFound
@@ -37,8 +37,8 @@ func (p *ProfileDataStore) Get() (*profiledata.ProfileData, error) {
 	return profileData, nil
 }
 
-func (p *ProfileDataStore) Save(profileData *profiledata.ProfileData) error {
-	profileDataDTO := ToProfileDataDTO(profileData)
+func (p *ProfileDataStore) Save(userId string, profileData *profiledata.ProfileData) error {
+	profileDataDTO := ToProfileDataDTO(userId, profileData)
 	tx := p.db.Save(&profileDataDTO)
 	if tx.Error != nil {
 		return tx.Error
@@ -47,8 +47,8 @@ func (p *ProfileDataStore) Save(profileData *profiledata.ProfileData) error {
 	return nil
 }
 
-func (p *ProfileDataStore) Delete() error {
-	tx := p.db.Where("id = ?", ProfileDataId).Delete(&ProfileDataDTO{})
+func (p *ProfileDataStore) Delete(userId string) error {
+	tx := p.db.Where("id = ?", ToProfileDataId(userId)).Delete(&ProfileDataDTO{})
 	if tx.Error != nil {
 		return tx.Error
 	}
@@ -0,0 +1,73 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/sessionrecording"
+)
+
+type SessionRecordingStore struct {
+	db *gorm.DB
+}
+
+func NewSessionRecordingStore(db *gorm.DB) (*SessionRecordingStore, error) {
+	err := db.AutoMigrate(&SessionRecordingDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SessionRecordingStore{db: db}, nil
+}
+
+func (s *SessionRecordingStore) List() ([]*sessionrecording.SessionRecording, error) {
+	recordingDTOs := []SessionRecordingDTO{}
+	tx := s.db.Order("started_at").Find(&recordingDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	recordings := []*sessionrecording.SessionRecording{}
+	for _, recordingDTO := range recordingDTOs {
+		recordings = append(recordings, ToSessionRecording(recordingDTO))
+	}
+
+	return recordings, nil
+}
+
+func (s *SessionRecordingStore) Find(id string) (*sessionrecording.SessionRecording, error) {
+	recordingDTO := SessionRecordingDTO{}
+	tx := s.db.Where("id = ?", id).First(&recordingDTO)
+	if tx.Error != nil {
+		if IsRecordNotFound(tx.Error) {
+			return nil, sessionrecording.ErrSessionRecordingNotFound
+		}
+		return nil, tx.Error
+	}
+
+	return ToSessionRecording(recordingDTO), nil
+}
+
+func (s *SessionRecordingStore) Save(recording *sessionrecording.SessionRecording) error {
+	tx := s.db.Save(ToSessionRecordingDTO(recording))
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (s *SessionRecordingStore) Delete(recording *sessionrecording.SessionRecording) error {
+	tx := s.db.Delete(ToSessionRecordingDTO(recording))
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return sessionrecording.ErrSessionRecordingNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/provider"
+)
+
+type TargetGroupStore struct {
+	db *gorm.DB
+}
+
+func NewTargetGroupStore(db *gorm.DB) (*TargetGroupStore, error) {
+	err := db.AutoMigrate(&TargetGroupDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &TargetGroupStore{db: db}, nil
+}
+
+func (s *TargetGroupStore) List() ([]*provider.TargetGroup, error) {
+	targetGroupDTOs := []TargetGroupDTO{}
+	tx := s.db.Find(&targetGroupDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	targetGroups := []*provider.TargetGroup{}
+	for _, targetGroupDTO := range targetGroupDTOs {
+		targetGroups = append(targetGroups, ToTargetGroup(targetGroupDTO))
+	}
+
+	return targetGroups, nil
+}
+
+func (s *TargetGroupStore) Find(groupName string) (*provider.TargetGroup, error) {
+	targetGroupDTO := TargetGroupDTO{}
+	tx := s.db.Where("name = ?", groupName).First(&targetGroupDTO)
+	if tx.Error != nil {
+		if IsRecordNotFound(tx.Error) {
+			return nil, provider.ErrTargetGroupNotFound
+		}
+		return nil, tx.Error
+	}
+
+	return ToTargetGroup(targetGroupDTO), nil
+}
+
+func (s *TargetGroupStore) Save(group *provider.TargetGroup) error {
+	tx := s.db.Save(ToTargetGroupDTO(group))
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
+
+func (s *TargetGroupStore) Delete(group *provider.TargetGroup) error {
+	tx := s.db.Delete(ToTargetGroupDTO(group))
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return provider.ErrTargetGroupNotFound
+	}
+
+	return nil
+}
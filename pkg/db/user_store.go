@@ -0,0 +1,75 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package db
+
+import (
+	"gorm.io/gorm"
+
+	. "github.com/daytonaio/daytona/pkg/db/dto"
+	"github.com/daytonaio/daytona/pkg/user"
+)
+
+type UserStore struct {
+	db *gorm.DB
+}
+
+func NewUserStore(db *gorm.DB) (*UserStore, error) {
+	err := db.AutoMigrate(&UserDTO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserStore{db: db}, nil
+}
+
+func (s *UserStore) List() ([]*user.User, error) {
+	userDTOs := []UserDTO{}
+	tx := s.db.Find(&userDTOs)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+
+	users := []*user.User{}
+	for _, userDTO := range userDTOs {
+		users = append(users, ToUser(userDTO))
+	}
+
+	return users, nil
+}
+
+func (s *UserStore) Find(id string) (*user.User, error) {
+	userDTO := UserDTO{}
+	tx := s.db.Where("id = ?", id).First(&userDTO)
+	if tx.Error != nil {
+		if IsRecordNotFound(tx.Error) {
+			return nil, user.ErrUserNotFound
+		}
+		return nil, tx.Error
+	}
+
+	return ToUser(userDTO), nil
+}
+
+func (s *UserStore) FindByName(name string) (*user.User, error) {
+	userDTO := UserDTO{}
+	tx := s.db.Where("name = ?", name).First(&userDTO)
+	if tx.Error != nil {
+		if IsRecordNotFound(tx.Error) {
+			return nil, user.ErrUserNotFound
+		}
+		return nil, tx.Error
+	}
+
+	return ToUser(userDTO), nil
+}
+
+func (s *UserStore) Save(u *user.User) error {
+	userDTO := ToUserDTO(u)
+	tx := s.db.Save(&userDTO)
+	if tx.Error != nil {
+		return tx.Error
+	}
+
+	return nil
+}
@@ -0,0 +1,241 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/objectstorage"
+)
+
+var ErrRemoteArchiveNotSupported = errors.New("archiving is only supported for projects on the local Docker host")
+
+// ArchiveProject uploads the project directory to the object storage and removes the project container and directory.
+// The container is only removed once the archive is uploaded so that a failed upload leaves the project intact
+func (d *DockerClient) ArchiveProject(opts *CreateProjectOptions, storage objectstorage.S3Config, archiveKey string) error {
+	if opts.SshSessionConfig != nil {
+		return ErrRemoteArchiveNotSupported
+	}
+
+	opts.LogWriter.Write([]byte(fmt.Sprintf("Archiving project %s\n", opts.Project.Name)))
+
+	archiveFile, err := os.CreateTemp("", "daytona-archive-*.tar.gz")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(archiveFile.Name())
+	defer archiveFile.Close()
+
+	err = writeArchive(opts.ProjectDir, archiveFile)
+	if err != nil {
+		return fmt.Errorf("failed to archive the project directory: %w", err)
+	}
+
+	info, err := archiveFile.Stat()
+	if err != nil {
+		return err
+	}
+
+	_, err = archiveFile.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	opts.LogWriter.Write([]byte(fmt.Sprintf("Uploading %d bytes to %s\n", info.Size(), archiveKey)))
+
+	err = objectstorage.NewS3Client(storage).Upload(context.Background(), archiveKey, archiveFile, info.Size())
+	if err != nil {
+		return fmt.Errorf("failed to upload the project archive: %w", err)
+	}
+
+	err = d.removeProjectContainer(opts.Project)
+	if err != nil {
+		return err
+	}
+
+	err = os.RemoveAll(opts.ProjectDir)
+	if err != nil {
+		return err
+	}
+
+	opts.LogWriter.Write([]byte(fmt.Sprintf("Project %s archived\n", opts.Project.Name)))
+
+	return nil
+}
+
+// UnarchiveProject restores the project directory from the object storage and recreates the project container
+func (d *DockerClient) UnarchiveProject(opts *CreateProjectOptions, storage objectstorage.S3Config, archiveKey string) error {
+	if opts.SshSessionConfig != nil {
+		return ErrRemoteArchiveNotSupported
+	}
+
+	opts.LogWriter.Write([]byte(fmt.Sprintf("Restoring project %s from %s\n", opts.Project.Name, archiveKey)))
+
+	ctx := context.Background()
+	storageClient := objectstorage.NewS3Client(storage)
+
+	archive, err := storageClient.Download(ctx, archiveKey)
+	if err != nil {
+		return fmt.Errorf("failed to download the project archive: %w", err)
+	}
+	defer archive.Close()
+
+	err = os.MkdirAll(opts.ProjectDir, 0755)
+	if err != nil {
+		return err
+	}
+
+	err = extractArchive(archive, opts.ProjectDir)
+	if err != nil {
+		return fmt.Errorf("failed to extract the project archive: %w", err)
+	}
+
+	// The restored repository is kept since the clone is skipped if it exists
+	err = d.CreateProject(opts)
+	if err != nil {
+		return err
+	}
+
+	err = storageClient.Delete(ctx, archiveKey)
+	if err != nil {
+		opts.LogWriter.Write([]byte(fmt.Sprintf("Failed to remove the project archive: %s\n", err.Error())))
+	}
+
+	opts.LogWriter.Write([]byte(fmt.Sprintf("Project %s restored\n", opts.Project.Name)))
+
+	return nil
+}
+
+func writeArchive(dir string, w io.Writer) error {
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(dir, path)
+		if err != nil || relPath == "." {
+			return err
+		}
+
+		link := ""
+		if info.Mode()&os.ModeSymlink != 0 {
+			link, err = os.Readlink(path)
+			if err != nil {
+				return err
+			}
+		}
+
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(relPath)
+
+		err = tarWriter.WriteHeader(header)
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		_, err = io.Copy(tarWriter, file)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	err = tarWriter.Close()
+	if err != nil {
+		return err
+	}
+
+	return gzipWriter.Close()
+}
+
+// Files keep the owner stored in the archive if the server runs as root. Otherwise they are owned by the server user
+// and rootless runtimes hand them over to the project user when the container is created
+func extractArchive(r io.Reader, dir string) error {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer gzipReader.Close()
+
+	tarReader := tar.NewReader(gzipReader)
+	restoreOwnership := os.Geteuid() == 0
+
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(dir, filepath.FromSlash(header.Name))
+		if !strings.HasPrefix(target, filepath.Clean(dir)+string(os.PathSeparator)) {
+			return fmt.Errorf("invalid path in archive: %s", header.Name)
+		}
+
+		mode := os.FileMode(header.Mode).Perm()
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			err = os.MkdirAll(target, mode)
+		case tar.TypeSymlink:
+			err = os.Symlink(header.Linkname, target)
+		case tar.TypeReg:
+			err = extractFile(tarReader, target, mode)
+		default:
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if restoreOwnership {
+			err = os.Lchown(target, header.Uid, header.Gid)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func extractFile(r io.Reader, target string, mode os.FileMode) error {
+	err := os.MkdirAll(filepath.Dir(target), 0755)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = io.Copy(file, r)
+	return err
+}
@@ -0,0 +1,43 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractArchiveRestoresOwnership(t *testing.T) {
+	if os.Geteuid() != 0 {
+		t.Skip("restoring the owner requires root")
+	}
+
+	projectDir := t.TempDir()
+	require.Nil(t, os.MkdirAll(filepath.Join(projectDir, "src"), 0755))
+	require.Nil(t, os.WriteFile(filepath.Join(projectDir, "src", "main.go"), []byte("package main"), 0644))
+	require.Nil(t, os.Lchown(filepath.Join(projectDir, "src"), 1000, 1000))
+	require.Nil(t, os.Lchown(filepath.Join(projectDir, "src", "main.go"), 1001, 1002))
+
+	var archive bytes.Buffer
+	require.Nil(t, writeArchive(projectDir, &archive))
+
+	restoredDir := t.TempDir()
+	require.Nil(t, extractArchive(&archive, restoredDir))
+
+	for path, owner := range map[string][2]uint32{
+		"src":         {1000, 1000},
+		"src/main.go": {1001, 1002},
+	} {
+		info, err := os.Lstat(filepath.Join(restoredDir, path))
+		require.Nil(t, err)
+
+		stat := info.Sys().(*syscall.Stat_t)
+		require.Equal(t, owner, [2]uint32{stat.Uid, stat.Gid}, path)
+	}
+}
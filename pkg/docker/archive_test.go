@@ -0,0 +1,126 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/daytonaio/daytona/pkg/objectstorage"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *DockerClientTestSuite) TestArchiveProject() {
+	var uploaded []byte
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(s.T(), http.MethodPut, r.Method)
+		require.Equal(s.T(), "/archives/daytona/123/test.tar.gz", r.URL.Path)
+		uploaded, _ = io.ReadAll(r.Body)
+	}))
+	defer storage.Close()
+
+	projectDir := filepath.Join(s.T().TempDir(), "123-test")
+	require.Nil(s.T(), os.MkdirAll(filepath.Join(projectDir, ".git"), 0755))
+	require.Nil(s.T(), os.WriteFile(filepath.Join(projectDir, "main.go"), []byte("package main"), 0644))
+
+	s.mockClient.On("ContainerList", mock.Anything, mock.Anything).Return([]types.Container{}, nil)
+
+	containerName := s.dockerClient.GetProjectContainerName(project1)
+
+	s.mockClient.On("ContainerInspect", mock.Anything, containerName).Return(types.ContainerJSON{
+		Config: &container.Config{},
+	}, nil)
+	s.mockClient.On("ContainerRemove", mock.Anything, containerName, container.RemoveOptions{
+		Force:         true,
+		RemoveVolumes: true,
+	}).Return(nil)
+	s.mockClient.On("VolumeRemove", mock.Anything, s.dockerClient.GetProjectVolumeName(project1), true).Return(nil)
+
+	err := s.dockerClient.ArchiveProject(&docker.CreateProjectOptions{
+		Project:    project1,
+		ProjectDir: projectDir,
+		LogWriter:  io.Discard,
+	}, objectstorage.S3Config{
+		Endpoint:        storage.URL,
+		Region:          "us-east-1",
+		Bucket:          "archives",
+		AccessKeyId:     "access-key",
+		SecretAccessKey: "secret-key",
+	}, "daytona/123/test.tar.gz")
+	require.Nil(s.T(), err)
+
+	_, err = os.Stat(projectDir)
+	require.True(s.T(), os.IsNotExist(err))
+
+	gzipReader, err := gzip.NewReader(bytes.NewReader(uploaded))
+	require.Nil(s.T(), err)
+
+	files := map[string]string{}
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.Nil(s.T(), err)
+
+		content, _ := io.ReadAll(tarReader)
+		files[header.Name] = string(content)
+	}
+
+	require.Equal(s.T(), map[string]string{".git": "", "main.go": "package main"}, files)
+}
+
+func (s *DockerClientTestSuite) TestArchiveProjectUploadFailure() {
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer storage.Close()
+
+	projectDir := filepath.Join(s.T().TempDir(), "123-test")
+	require.Nil(s.T(), os.MkdirAll(projectDir, 0755))
+	require.Nil(s.T(), os.WriteFile(filepath.Join(projectDir, "main.go"), []byte("package main"), 0644))
+
+	// The mock client is shared by the tests of the suite
+	countContainerRemoveCalls := func() int {
+		count := 0
+		for _, call := range s.mockClient.Calls {
+			if call.Method == "ContainerRemove" {
+				count++
+			}
+		}
+		return count
+	}
+	containerRemoveCalls := countContainerRemoveCalls()
+
+	err := s.dockerClient.ArchiveProject(&docker.CreateProjectOptions{
+		Project:    project1,
+		ProjectDir: projectDir,
+		LogWriter:  io.Discard,
+	}, objectstorage.S3Config{
+		Endpoint:        storage.URL,
+		Region:          "us-east-1",
+		Bucket:          "archives",
+		AccessKeyId:     "access-key",
+		SecretAccessKey: "secret-key",
+	}, "daytona/123/test.tar.gz")
+	require.NotNil(s.T(), err)
+
+	// The project is left intact if the archive can not be uploaded
+	require.Equal(s.T(), containerRemoveCalls, countContainerRemoveCalls())
+
+	content, err := os.ReadFile(filepath.Join(projectDir, "main.go"))
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), "package main", string(content))
+}
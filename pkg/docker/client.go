@@ -7,9 +7,12 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sync"
 
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/objectstorage"
+	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/ssh"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/docker/docker/api/types"
@@ -25,6 +28,9 @@ type CreateProjectOptions struct {
 	LogWriter        io.Writer
 	Gpc              *gitprovider.GitProviderConfig
 	SshSessionConfig *ssh.SessionConfig
+	NetworkPolicy    *provider.NetworkPolicy
+	// Optional. Receives the progress of long steps, e.g. pulling the project image
+	Progress provider.ProgressReporter
 }
 
 type IDockerClient interface {
@@ -45,21 +51,39 @@ type IDockerClient interface {
 	ExecSync(containerID string, config types.ExecConfig, outputWriter io.Writer) (*ExecResult, error)
 	GetContainerLogs(containerName string, logWriter io.Writer) error
 	PullImage(imageName string, cr *containerregistry.ContainerRegistry, logWriter io.Writer) error
-	PushImage(imageName string, cr *containerregistry.ContainerRegistry, logWriter io.Writer) error
+	PushImage(imageName string, cr *containerregistry.ContainerRegistry, logWriter io.Writer) (string, error)
+
+	ArchiveProject(opts *CreateProjectOptions, storage objectstorage.S3Config, archiveKey string) error
+	UnarchiveProject(opts *CreateProjectOptions, storage objectstorage.S3Config, archiveKey string) error
 }
 
 type DockerClientConfig struct {
 	ApiClient client.APIClient
+	// Defaults to a Docker daemon running as root
+	Runtime Runtime
+	// Host path of the socket the API client connects to. Detected from the runtime if empty
+	SocketPath string
 }
 
 func NewDockerClient(config DockerClientConfig) IDockerClient {
+	runtime := config.Runtime
+	if runtime == "" {
+		runtime = RuntimeDocker
+	}
+
 	return &DockerClient{
-		apiClient: config.ApiClient,
+		apiClient:  config.ApiClient,
+		runtime:    runtime,
+		socketPath: config.SocketPath,
 	}
 }
 
 type DockerClient struct {
-	apiClient client.APIClient
+	apiClient  client.APIClient
+	runtime    Runtime
+	socketPath string
+
+	runtimeOnce sync.Once
 }
 
 func (d *DockerClient) GetProjectContainerName(project *workspace.Project) string {
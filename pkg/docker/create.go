@@ -72,13 +72,13 @@ func (d *DockerClient) cloneProjectRepository(opts *CreateProjectOptions, sshCli
 		cloneUrl = fmt.Sprintf("https://%s:%s@%s", opts.Gpc.Username, opts.Gpc.Token, repoUrl)
 	}
 
-	cloneCmd := []string{"git", "clone", cloneUrl, fmt.Sprintf("/workdir/%s-%s", opts.Project.WorkspaceId, opts.Project.Name)}
+	clonePath := fmt.Sprintf("/workdir/%s-%s", opts.Project.WorkspaceId, opts.Project.Name)
 
-	c, err := d.apiClient.ContainerCreate(ctx, &container.Config{
-		Image:      "daytonaio/workspace-project",
-		Entrypoint: []string{"sleep"},
-		Cmd:        []string{"infinity"},
-	}, &container.HostConfig{
+	// The repository is kept when the project is recreated without wiping its data.
+	// The token is removed from the remote after cloning, git operations in the project use the agent credential helper.
+	cloneCmd := []string{"sh", "-c", fmt.Sprintf("[ -d %s/.git ] || (git clone '%s' %s && git -C %s remote set-url origin '%s')", clonePath, cloneUrl, clonePath, clonePath, opts.Project.Repository.Url)}
+
+	hostConfig := &container.HostConfig{
 		Mounts: []mount.Mount{
 			{
 				Type:   mount.TypeBind,
@@ -86,7 +86,14 @@ func (d *DockerClient) cloneProjectRepository(opts *CreateProjectOptions, sshCli
 				Target: "/workdir",
 			},
 		},
-	}, nil, nil, fmt.Sprintf("git-clone-%s-%s", opts.Project.WorkspaceId, opts.Project.Name))
+	}
+	d.applyRuntimeHostConfig(hostConfig)
+
+	c, err := d.apiClient.ContainerCreate(ctx, &container.Config{
+		Image:      "daytonaio/workspace-project",
+		Entrypoint: []string{"sleep"},
+		Cmd:        []string{"infinity"},
+	}, hostConfig, nil, nil, fmt.Sprintf("git-clone-%s-%s", opts.Project.WorkspaceId, opts.Project.Name))
 	if err != nil {
 		return err
 	}
@@ -125,7 +132,9 @@ func (d *DockerClient) cloneProjectRepository(opts *CreateProjectOptions, sshCli
 		}
 	}
 
-	if newUid == "0" && newGid == "0" {
+	// The root user of a rootless Docker container is the host user, so the repository is cloned as root
+	// and handed over to the project user when the project starts
+	if (newUid == "0" && newGid == "0") || d.getRuntime() == RuntimeDockerRootless {
 		containerUser = "root"
 	}
 
@@ -106,6 +106,8 @@ func (d *DockerClient) createProjectFromDevcontainer(opts *CreateProjectOptions,
 	}
 	devcontainerConfig["workspaceMount"] = fmt.Sprintf("source=%s,target=%s,type=bind", opts.ProjectDir, workspaceFolder)
 
+	d.applyRuntimeDevcontainerConfig(devcontainerConfig)
+
 	delete(devcontainerConfig, "initializeCommand")
 
 	if _, ok := devcontainerConfig["dockerComposeFile"]; ok {
@@ -174,6 +176,15 @@ func (d *DockerClient) createProjectFromDevcontainer(opts *CreateProjectOptions,
 		devcontainerConfig["dockerComposeFile"] = path.Join(overridesTarget, "daytona-compose-override.yml")
 	}
 
+	// appPort is not supported for compose based configurations
+	if _, ok := devcontainerConfig["dockerComposeFile"]; !ok {
+		// Ports without a host port are published on host ports picked by Docker
+		appPorts := getAllowedInboundPortSpecs(opts.NetworkPolicy)
+		if len(appPorts) > 0 {
+			devcontainerConfig["appPort"] = appPorts
+		}
+	}
+
 	envVars["DAYTONA_PROJECT_DIR"] = workspaceFolder
 
 	devcontainerConfig["containerEnv"] = envVars
@@ -204,6 +215,7 @@ func (d *DockerClient) createProjectFromDevcontainer(opts *CreateProjectOptions,
 		"--override-config=" + path.Join(overridesTarget, "devcontainer.json"),
 		"--id-label=daytona.workspace.id=" + opts.Project.WorkspaceId,
 		"--id-label=daytona.project.name=" + opts.Project.Name,
+		"--remove-existing-container",
 	}
 
 	if prebuild {
@@ -217,15 +229,7 @@ func (d *DockerClient) createProjectFromDevcontainer(opts *CreateProjectOptions,
 		return "", err
 	}
 
-	c, err := d.apiClient.ContainerCreate(ctx, &container.Config{
-		Image:        "daytonaio/workspace-project",
-		Entrypoint:   []string{"sh"},
-		Env:          []string{"DOCKER_HOST=tcp://localhost:2375"},
-		Cmd:          cmd,
-		AttachStdout: true,
-		AttachStderr: true,
-		Tty:          true,
-	}, &container.HostConfig{
+	hostConfig := &container.HostConfig{
 		Privileged:  true,
 		NetworkMode: container.NetworkMode(fmt.Sprintf("container:%s", socketForwardId)),
 		Mounts: []mount.Mount{
@@ -240,7 +244,18 @@ func (d *DockerClient) createProjectFromDevcontainer(opts *CreateProjectOptions,
 				Target: overridesTarget,
 			},
 		},
-	}, nil, nil, uuid.NewString())
+	}
+	d.applyRuntimeHostConfig(hostConfig)
+
+	c, err := d.apiClient.ContainerCreate(ctx, &container.Config{
+		Image:        "daytonaio/workspace-project",
+		Entrypoint:   []string{"sh"},
+		Env:          []string{"DOCKER_HOST=tcp://localhost:2375"},
+		Cmd:          cmd,
+		AttachStdout: true,
+		AttachStderr: true,
+		Tty:          true,
+	}, hostConfig, nil, nil, uuid.NewString())
 	if err != nil {
 		return "", err
 	}
@@ -279,6 +294,13 @@ func (d *DockerClient) createProjectFromDevcontainer(opts *CreateProjectOptions,
 		}
 	}
 
+	if !prebuild {
+		err = d.fixProjectDirOwnership(d.GetProjectContainerName(opts.Project), remoteUser, workspaceFolder, opts.LogWriter)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	return RemoteUser(remoteUser), nil
 }
 
@@ -322,7 +344,7 @@ func (d *DockerClient) ensureDockerSockForward(logWriter io.Writer) (string, err
 		Mounts: []mount.Mount{
 			{
 				Type:   mount.TypeBind,
-				Source: "/var/run/docker.sock",
+				Source: d.getSocketPath(),
 				Target: "/var/run/docker.sock",
 			},
 		},
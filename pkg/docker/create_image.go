@@ -7,36 +7,55 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/mount"
 )
 
 func (d *DockerClient) createProjectFromImage(opts *CreateProjectOptions) error {
-	err := d.PullImage(opts.Project.Image, opts.Cr, opts.LogWriter)
+	err := d.pullImage(opts.Project.Image, opts.Cr, opts.LogWriter, opts.Progress)
 	if err != nil {
 		return err
 	}
 
-	return d.initProjectContainer(opts.Project, opts.ProjectDir)
+	return d.initProjectContainer(opts.Project, opts.ProjectDir, opts.NetworkPolicy)
 }
 
-func (d *DockerClient) initProjectContainer(project *workspace.Project, projectDir string) error {
+func (d *DockerClient) initProjectContainer(project *workspace.Project, projectDir string, networkPolicy *provider.NetworkPolicy) error {
 	ctx := context.Background()
 
-	_, err := d.apiClient.ContainerCreate(ctx, GetContainerCreateConfig(project), &container.HostConfig{
-		Privileged: true,
+	exposedPorts, portBindings, err := getInboundPortBindings(networkPolicy)
+	if err != nil {
+		return err
+	}
+
+	// Remove the previous container if the project is being recreated
+	err = d.removeContainer(d.GetProjectContainerName(project))
+	if err != nil {
+		return err
+	}
+
+	containerConfig := GetContainerCreateConfig(project)
+	containerConfig.ExposedPorts = exposedPorts
+
+	hostConfig := &container.HostConfig{
+		Privileged:   true,
+		PortBindings: portBindings,
 		Mounts: []mount.Mount{
 			{
 				Type:   mount.TypeBind,
 				Source: projectDir,
-				Target: fmt.Sprintf("/home/%s/%s", project.User, project.Name),
+				Target: getImageProjectPath(project),
 			},
 		},
 		ExtraHosts: []string{
 			"host.docker.internal:host-gateway",
 		},
-	}, nil, nil, d.GetProjectContainerName(project))
+	}
+	d.applyRuntimeHostConfig(hostConfig)
+
+	_, err = d.apiClient.ContainerCreate(ctx, containerConfig, hostConfig, nil, nil, d.GetProjectContainerName(project))
 	if err != nil {
 		return err
 	}
@@ -44,6 +63,10 @@ func (d *DockerClient) initProjectContainer(project *workspace.Project, projectD
 	return nil
 }
 
+func getImageProjectPath(project *workspace.Project) string {
+	return fmt.Sprintf("/home/%s/%s", project.User, project.Name)
+}
+
 func GetContainerCreateConfig(project *workspace.Project) *container.Config {
 	envVars := []string{}
 
@@ -6,12 +6,15 @@ package docker_test
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"net"
 	"os"
 	"path/filepath"
+	"strings"
 
 	t_docker "github.com/daytonaio/daytona/internal/testing/docker"
 	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/filters"
@@ -44,7 +47,14 @@ func (s *DockerClientTestSuite) TestCreateProject() {
 		},
 	).Return([]image.Summary{}, nil)
 
-	s.mockClient.On("ImagePull", mock.Anything, project1.Image, mock.Anything).Return(t_docker.NewPipeReader(""), nil)
+	s.mockClient.On("ImagePull", mock.Anything, project1.Image, mock.Anything).Return(io.NopCloser(strings.NewReader(strings.Join([]string{
+		`{"status":"Pulling fs layer","id":"layer1"}`,
+		`{"status":"Pulling fs layer","id":"layer2"}`,
+		`{"status":"Downloading","progressDetail":{"current":50,"total":100},"id":"layer1"}`,
+		`{"status":"Downloading","progressDetail":{"current":100,"total":300},"id":"layer2"}`,
+		`{"status":"Download complete","id":"layer1"}`,
+		`{"status":"Pull complete","id":"layer2"}`,
+	}, "\n"))), nil)
 	s.mockClient.On("ImagePull", mock.Anything, "daytonaio/workspace-project", mock.Anything).Return(t_docker.NewPipeReader(""), nil)
 
 	s.mockClient.On("ContainerRemove", mock.Anything, mock.Anything, container.RemoveOptions{RemoveVolumes: true, Force: true}).Return(nil)
@@ -92,6 +102,8 @@ func (s *DockerClientTestSuite) TestCreateProject() {
 		containerName,
 	).Return(container.CreateResponse{ID: "123"}, nil)
 
+	progressEvents := []provider.ProgressEvent{}
+
 	err := s.dockerClient.CreateProject(&docker.CreateProjectOptions{
 		Project:          project1,
 		ProjectDir:       projectDir,
@@ -99,6 +111,17 @@ func (s *DockerClientTestSuite) TestCreateProject() {
 		LogWriter:        nil,
 		Gpc:              nil,
 		SshSessionConfig: nil,
+		Progress: provider.ProgressReporterFunc(func(event provider.ProgressEvent) {
+			progressEvents = append(progressEvents, event)
+		}),
 	})
 	require.Nil(s.T(), err)
+
+	percents := []int{}
+	for _, event := range progressEvents {
+		require.Equal(s.T(), "Pulling image", event.Phase)
+		require.Equal(s.T(), project1.Image, event.Message)
+		percents = append(percents, event.Percent)
+	}
+	require.Equal(s.T(), []int{50, 37, 50, 100}, percents)
 }
@@ -16,7 +16,12 @@ func (d *DockerClient) DestroyWorkspace(workspace *workspace.Workspace) error {
 }
 
 func (d *DockerClient) DestroyProject(project *workspace.Project) error {
-	return d.removeProjectContainer(project)
+	err := d.removeProjectContainer(project)
+	if err != nil {
+		return err
+	}
+
+	return d.removeEgressPolicy(project)
 }
 
 func (d *DockerClient) removeProjectContainer(project *workspace.Project) error {
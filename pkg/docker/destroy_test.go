@@ -4,8 +4,11 @@
 package docker_test
 
 import (
+	"errors"
+
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/errdefs"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -33,6 +36,8 @@ func (s *DockerClientTestSuite) TestDestroyProject() {
 
 	s.mockClient.On("VolumeRemove", mock.Anything, s.dockerClient.GetProjectVolumeName(project1), true).Return(nil)
 
+	s.mockClient.On("NetworkInspect", mock.Anything, "123-test-egress", types.NetworkInspectOptions{}).Return(types.NetworkResource{}, errdefs.NotFound(errors.New("network not found")))
+
 	err := s.dockerClient.DestroyProject(project1)
 	require.Nil(s.T(), err)
 }
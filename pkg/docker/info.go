@@ -62,7 +62,15 @@ func (d *DockerClient) GetProjectInfo(project *workspace.Project) (*workspace.Pr
 	}
 
 	if info.Config != nil && info.Config.Labels != nil {
-		metadata, err := json.Marshal(info.Config.Labels)
+		labels := map[string]string{}
+		for key, value := range info.Config.Labels {
+			labels[key] = value
+		}
+		for port, hostPort := range getPublishedPorts(info) {
+			labels[publishedPortLabelPrefix+port] = hostPort
+		}
+
+		metadata, err := json.Marshal(labels)
 		if err != nil {
 			return nil, err
 		}
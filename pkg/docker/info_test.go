@@ -10,6 +10,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/go-connections/nat"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -31,8 +32,15 @@ func (s *DockerClientTestSuite) TestGetProjectInfo() {
 				"test": "label",
 			},
 		},
+		NetworkSettings: &types.NetworkSettings{
+			NetworkSettingsBase: types.NetworkSettingsBase{
+				Ports: nat.PortMap{
+					"3000/tcp": []nat.PortBinding{{HostIP: "0.0.0.0", HostPort: "49153"}},
+				},
+			},
+		},
 	}
-	metadata := `{"test":"label"}`
+	metadata := `{"daytona.published-port.3000/tcp":"0.0.0.0:49153","test":"label"}`
 
 	s.mockClient.On("ContainerInspect", mock.Anything, containerName).Return(inspectResult, nil)
 
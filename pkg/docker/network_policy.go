@@ -0,0 +1,373 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+	"github.com/docker/go-connections/nat"
+)
+
+// Image of the short-lived container which manages the egress rules in the host network namespace
+const egressPolicyImage = "daytonaio/workspace-project"
+
+const publishedPortLabelPrefix = "daytona.published-port."
+
+var ErrEgressPolicyNotSupported = errors.New("denying egress is only supported on Docker daemons running as root")
+
+// Hostnames and IPv4 addresses which can be passed to iptables as is
+var egressHostPattern = regexp.MustCompile(`^[A-Za-z0-9.-]+$`)
+
+// Returns the port specs ("<start>-<end>") of the inbound port ranges allowed by the policy
+func getAllowedInboundPortSpecs(networkPolicy *provider.NetworkPolicy) []string {
+	if networkPolicy == nil {
+		return nil
+	}
+
+	portSpecs := []string{}
+	for _, portRange := range networkPolicy.AllowedInboundPorts {
+		if portRange.End <= portRange.Start {
+			portSpecs = append(portSpecs, fmt.Sprintf("%d", portRange.Start))
+			continue
+		}
+		portSpecs = append(portSpecs, fmt.Sprintf("%d-%d", portRange.Start, portRange.End))
+	}
+
+	return portSpecs
+}
+
+// Publishes the allowed inbound port ranges on host ports picked by Docker so that projects on the same
+// host never conflict. The picked ports are reported by getPublishedPorts
+func getInboundPortBindings(networkPolicy *provider.NetworkPolicy) (nat.PortSet, nat.PortMap, error) {
+	portSpecs := getAllowedInboundPortSpecs(networkPolicy)
+	if len(portSpecs) == 0 {
+		return nil, nil, nil
+	}
+
+	return nat.ParsePortSpecs(portSpecs)
+}
+
+// Returns the host ports of the published container ports, e.g. "8080/tcp" -> "0.0.0.0:49153"
+func getPublishedPorts(info *types.ContainerJSON) map[string]string {
+	publishedPorts := map[string]string{}
+	if info == nil || info.NetworkSettings == nil {
+		return publishedPorts
+	}
+
+	for port, bindings := range info.NetworkSettings.Ports {
+		if len(bindings) == 0 {
+			continue
+		}
+		publishedPorts[string(port)] = fmt.Sprintf("%s:%s", bindings[0].HostIP, bindings[0].HostPort)
+	}
+
+	return publishedPorts
+}
+
+func (d *DockerClient) logPublishedPorts(project *workspace.Project, logWriter io.Writer) error {
+	if logWriter == nil {
+		return nil
+	}
+
+	info, err := d.apiClient.ContainerInspect(context.Background(), d.GetProjectContainerName(project))
+	if err != nil {
+		return err
+	}
+
+	publishedPorts := getPublishedPorts(&info)
+
+	ports := []string{}
+	for port := range publishedPorts {
+		ports = append(ports, port)
+	}
+	sort.Strings(ports)
+
+	for _, port := range ports {
+		logWriter.Write([]byte(fmt.Sprintf("Port %s is published on %s\n", port, publishedPorts[port])))
+	}
+
+	return nil
+}
+
+// Name of the Docker network projects that deny egress are connected to
+func getEgressNetworkName(project *workspace.Project) string {
+	return fmt.Sprintf("%s-%s-egress", project.WorkspaceId, project.Name)
+}
+
+// Interface names are limited to 15 characters and chain names to 28 so both are derived from a hash
+func getEgressPolicyId(project *workspace.Project) string {
+	hash := sha256.Sum256([]byte(project.WorkspaceId + "/" + project.Name))
+	return hex.EncodeToString(hash[:])[:11]
+}
+
+func getEgressBridgeName(project *workspace.Project) string {
+	return "dtn-" + getEgressPolicyId(project)
+}
+
+func getEgressChainName(project *workspace.Project) string {
+	return "DAYTONA-" + getEgressPolicyId(project)
+}
+
+// Blocks all outbound traffic of the project container except the connection to the Daytona server.
+// The rules can not be enforced inside the container since the project user can change them in a privileged
+// container. Instead the container is moved to a bridge of its own and the traffic leaving that bridge is
+// filtered in the DOCKER-USER chain of the Docker host. The rules are reapplied on each project start since
+// they do not survive a restart of the host.
+func (d *DockerClient) applyEgressPolicy(project *workspace.Project, networkPolicy *provider.NetworkPolicy, daytonaDownloadUrl string, logWriter io.Writer) error {
+	ctx := context.Background()
+
+	if networkPolicy == nil || !networkPolicy.DenyAllEgress {
+		// Lift the rules of projects whose target no longer denies egress
+		_, err := d.apiClient.NetworkInspect(ctx, getEgressNetworkName(project), types.NetworkInspectOptions{})
+		if err != nil {
+			if client.IsErrNotFound(err) {
+				return nil
+			}
+			return err
+		}
+
+		return d.runHostNetworkScript(getRemoveEgressRulesScript(getEgressChainName(project)), logWriter)
+	}
+
+	if d.isRootless() {
+		return ErrEgressPolicyNotSupported
+	}
+
+	if logWriter != nil {
+		logWriter.Write([]byte("Applying egress network policy\n"))
+	}
+
+	networkName := getEgressNetworkName(project)
+
+	_, err := d.apiClient.NetworkInspect(ctx, networkName, types.NetworkInspectOptions{})
+	if err != nil {
+		if !client.IsErrNotFound(err) {
+			return err
+		}
+
+		_, err = d.apiClient.NetworkCreate(ctx, networkName, types.NetworkCreate{
+			Driver: "bridge",
+			Options: map[string]string{
+				"com.docker.network.bridge.name": getEgressBridgeName(project),
+			},
+			Labels: map[string]string{
+				"daytona.workspace.id":           project.WorkspaceId,
+				"daytona.workspace.project.name": project.Name,
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	containerName := d.GetProjectContainerName(project)
+
+	info, err := d.apiClient.ContainerInspect(ctx, containerName)
+	if err != nil {
+		return err
+	}
+
+	bridges := []string{getEgressBridgeName(project)}
+	if info.NetworkSettings != nil {
+		// Other networks are those of Docker Compose projects, their traffic is restricted as well
+		for otherNetworkName := range info.NetworkSettings.Networks {
+			if otherNetworkName == networkName || otherNetworkName == "bridge" {
+				continue
+			}
+
+			otherNetwork, err := d.apiClient.NetworkInspect(ctx, otherNetworkName, types.NetworkInspectOptions{})
+			if err != nil {
+				return err
+			}
+
+			if otherNetwork.Driver != "bridge" {
+				return fmt.Errorf("denying egress is not supported for containers on %s networks", otherNetwork.Driver)
+			}
+
+			bridges = append(bridges, getBridgeName(otherNetwork))
+		}
+		sort.Strings(bridges[1:])
+	}
+
+	// The rules are in place before the container is connected so there is no window without them
+	script := getEgressRulesScript(getEgressChainName(project), bridges, getAllowedEgressHosts(project, daytonaDownloadUrl))
+	err = d.runHostNetworkScript(script, logWriter)
+	if err != nil {
+		return fmt.Errorf("failed to apply egress network policy: %w", err)
+	}
+
+	if info.NetworkSettings == nil || info.NetworkSettings.Networks[networkName] == nil {
+		err = d.apiClient.NetworkConnect(ctx, networkName, containerName, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	// The default bridge is shared by all containers of the host so its traffic can not be told apart
+	if info.NetworkSettings != nil && info.NetworkSettings.Networks["bridge"] != nil {
+		return d.apiClient.NetworkDisconnect(ctx, "bridge", containerName, true)
+	}
+
+	return nil
+}
+
+// Returns the name of the host interface of a bridge network
+func getBridgeName(network types.NetworkResource) string {
+	if name, ok := network.Options["com.docker.network.bridge.name"]; ok {
+		return name
+	}
+
+	return "br-" + network.ID[:min(len(network.ID), 12)]
+}
+
+// Removes the egress network of the project and its rules
+func (d *DockerClient) removeEgressPolicy(project *workspace.Project) error {
+	ctx := context.Background()
+
+	networkName := getEgressNetworkName(project)
+
+	_, err := d.apiClient.NetworkInspect(ctx, networkName, types.NetworkInspectOptions{})
+	if err != nil {
+		if client.IsErrNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	err = d.runHostNetworkScript(getRemoveEgressRulesScript(getEgressChainName(project)), nil)
+	if err != nil {
+		return err
+	}
+
+	err = d.apiClient.NetworkRemove(ctx, networkName)
+	if err != nil && !client.IsErrNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+// Returns the script which restricts the traffic leaving the bridges to the allowed hosts and the DNS resolvers
+// of the Docker host. Replies to inbound connections and traffic between containers on the same bridge are
+// not affected. Traffic to the Docker host itself does not pass the DOCKER-USER chain and stays allowed.
+func getEgressRulesScript(chain string, bridges []string, hosts []string) string {
+	rules := []string{
+		"set -e",
+		fmt.Sprintf("iptables -N %[1]s 2>/dev/null || iptables -F %[1]s", chain),
+		fmt.Sprintf("iptables -A %s -m conntrack --ctstate ESTABLISHED,RELATED -j RETURN", chain),
+		fmt.Sprintf(`for ns in $(awk '/^nameserver/ && $2 !~ /:/ {print $2}' /etc/resolv.conf); do iptables -A %[1]s -d "$ns" -p udp --dport 53 -j RETURN; iptables -A %[1]s -d "$ns" -p tcp --dport 53 -j RETURN; done`, chain),
+	}
+
+	for _, host := range hosts {
+		rules = append(rules, fmt.Sprintf("iptables -A %s -d %s -j RETURN", chain, host))
+	}
+
+	rules = append(rules, fmt.Sprintf("iptables -A %s -j DROP", chain))
+
+	for _, bridge := range bridges {
+		hook := fmt.Sprintf("DOCKER-USER -i %[1]s ! -o %[1]s -j %[2]s", bridge, chain)
+		rules = append(rules, fmt.Sprintf("iptables -C %[1]s 2>/dev/null || iptables -I %[1]s", hook))
+	}
+
+	return strings.Join(rules, "\n")
+}
+
+// Returns the script which removes the chain and every DOCKER-USER rule jumping to it
+func getRemoveEgressRulesScript(chain string) string {
+	return strings.Join([]string{
+		fmt.Sprintf(`iptables -S DOCKER-USER | grep -- "-j %s$" | sed 's/^-A /-D /' | while read -r rule; do iptables $rule; done`, chain),
+		fmt.Sprintf("iptables -F %s 2>/dev/null || true", chain),
+		fmt.Sprintf("iptables -X %s 2>/dev/null || true", chain),
+	}, "\n")
+}
+
+// Runs the script as root in the network namespace of the Docker host
+func (d *DockerClient) runHostNetworkScript(script string, logWriter io.Writer) error {
+	ctx := context.Background()
+
+	err := d.PullImage(egressPolicyImage, nil, logWriter)
+	if err != nil {
+		return err
+	}
+
+	c, err := d.apiClient.ContainerCreate(ctx, &container.Config{
+		Image:      egressPolicyImage,
+		User:       "root",
+		Entrypoint: []string{"sleep"},
+		Cmd:        []string{"infinity"},
+	}, &container.HostConfig{
+		NetworkMode: "host",
+		CapAdd:      []string{"NET_ADMIN"},
+	}, nil, nil, "")
+	if err != nil {
+		return err
+	}
+
+	defer d.removeContainer(c.ID) // nolint:errcheck
+
+	err = d.apiClient.ContainerStart(ctx, c.ID, container.StartOptions{})
+	if err != nil {
+		return err
+	}
+
+	result, err := d.ExecSync(c.ID, types.ExecConfig{
+		Cmd:  []string{"sh", "-c", script},
+		User: "root",
+	}, logWriter)
+	if err != nil {
+		return err
+	}
+
+	if result.ExitCode != 0 {
+		return errors.New(result.StdErr)
+	}
+
+	return nil
+}
+
+// Returns the hosts of the Daytona server the project connects to
+func getAllowedEgressHosts(project *workspace.Project, daytonaDownloadUrl string) []string {
+	hosts := []string{}
+
+	urls := []string{daytonaDownloadUrl}
+	if project.EnvVars != nil {
+		urls = append(urls, project.EnvVars["DAYTONA_SERVER_URL"], project.EnvVars["DAYTONA_SERVER_API_URL"])
+	}
+
+	for _, u := range urls {
+		if u == "" {
+			continue
+		}
+
+		parsedUrl, err := url.Parse(u)
+		if err != nil || parsedUrl.Hostname() == "" {
+			continue
+		}
+
+		hostname := parsedUrl.Hostname()
+		// Hosts of the Docker host are reached without passing the egress rules
+		if !egressHostPattern.MatchString(hostname) || hostname == "localhost" || hostname == "host.docker.internal" || slices.Contains(hosts, hostname) {
+			continue
+		}
+
+		hosts = append(hosts, hostname)
+	}
+
+	return hosts
+}
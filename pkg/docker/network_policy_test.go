@@ -0,0 +1,98 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/docker/go-connections/nat"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetInboundPortBindings(t *testing.T) {
+	exposedPorts, portBindings, err := getInboundPortBindings(nil)
+	require.Nil(t, err)
+	require.Nil(t, exposedPorts)
+	require.Nil(t, portBindings)
+
+	exposedPorts, portBindings, err = getInboundPortBindings(&provider.NetworkPolicy{
+		AllowedInboundPorts: []provider.PortRange{
+			{Start: 3000, End: 3000},
+			{Start: 8000, End: 8001},
+		},
+	})
+	require.Nil(t, err)
+
+	require.Equal(t, nat.PortSet{"3000/tcp": {}, "8000/tcp": {}, "8001/tcp": {}}, exposedPorts)
+
+	// Host ports are left to Docker so projects on the same host do not conflict
+	require.Equal(t, nat.PortMap{
+		"3000/tcp": {{HostIP: "", HostPort: ""}},
+		"8000/tcp": {{HostIP: "", HostPort: ""}},
+		"8001/tcp": {{HostIP: "", HostPort: ""}},
+	}, portBindings)
+}
+
+func TestGetEgressRulesScript(t *testing.T) {
+	script := getEgressRulesScript("DAYTONA-abc", []string{"dtn-abc", "br-123"}, []string{"daytona.example.com", "10.0.0.1"})
+	rules := strings.Split(script, "\n")
+
+	require.Equal(t, []string{
+		"set -e",
+		"iptables -N DAYTONA-abc 2>/dev/null || iptables -F DAYTONA-abc",
+		"iptables -A DAYTONA-abc -m conntrack --ctstate ESTABLISHED,RELATED -j RETURN",
+	}, rules[:3])
+
+	// DNS is only allowed to the resolvers of the Docker host
+	require.Contains(t, rules[3], "/etc/resolv.conf")
+	require.Contains(t, rules[3], `iptables -A DAYTONA-abc -d "$ns" -p udp --dport 53 -j RETURN`)
+	for _, rule := range rules {
+		if strings.Contains(rule, "--dport 53") {
+			require.Contains(t, rule, `-d "$ns"`)
+		}
+	}
+
+	require.Equal(t, []string{
+		"iptables -A DAYTONA-abc -d daytona.example.com -j RETURN",
+		"iptables -A DAYTONA-abc -d 10.0.0.1 -j RETURN",
+		"iptables -A DAYTONA-abc -j DROP",
+		"iptables -C DOCKER-USER -i dtn-abc ! -o dtn-abc -j DAYTONA-abc 2>/dev/null || iptables -I DOCKER-USER -i dtn-abc ! -o dtn-abc -j DAYTONA-abc",
+		"iptables -C DOCKER-USER -i br-123 ! -o br-123 -j DAYTONA-abc 2>/dev/null || iptables -I DOCKER-USER -i br-123 ! -o br-123 -j DAYTONA-abc",
+	}, rules[4:])
+}
+
+func TestGetRemoveEgressRulesScript(t *testing.T) {
+	rules := strings.Split(getRemoveEgressRulesScript("DAYTONA-abc"), "\n")
+
+	require.Contains(t, rules[0], `grep -- "-j DAYTONA-abc$"`)
+	require.Equal(t, "iptables -X DAYTONA-abc 2>/dev/null || true", rules[len(rules)-1])
+}
+
+func TestGetAllowedEgressHosts(t *testing.T) {
+	project := &workspace.Project{
+		EnvVars: map[string]string{
+			"DAYTONA_SERVER_URL":     "https://abc.try-eu.daytona.app",
+			"DAYTONA_SERVER_API_URL": "http://localhost:3986",
+		},
+	}
+
+	require.Equal(t, []string{"download.daytona.io", "abc.try-eu.daytona.app"}, getAllowedEgressHosts(project, "https://download.daytona.io/daytona"))
+
+	// Hosts which can not be passed to iptables safely are skipped
+	require.Empty(t, getAllowedEgressHosts(&workspace.Project{}, "https://a;b"))
+}
+
+func TestGetEgressNames(t *testing.T) {
+	project := &workspace.Project{WorkspaceId: "a-very-long-workspace-id", Name: "a-very-long-project-name"}
+
+	require.Equal(t, "a-very-long-workspace-id-a-very-long-project-name-egress", getEgressNetworkName(project))
+
+	// Linux limits interface names to 15 characters and iptables limits chain names to 28
+	require.LessOrEqual(t, len(getEgressBridgeName(project)), 15)
+	require.LessOrEqual(t, len(getEgressChainName(project)), 28)
+	require.NotEqual(t, getEgressBridgeName(project), getEgressBridgeName(&workspace.Project{WorkspaceId: "a-very-long-workspace-id", Name: "other"}))
+}
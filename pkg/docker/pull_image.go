@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/api/types/image"
 	"github.com/docker/docker/api/types/registry"
@@ -18,6 +19,11 @@ import (
 )
 
 func (d *DockerClient) PullImage(imageName string, cr *containerregistry.ContainerRegistry, logWriter io.Writer) error {
+	return d.pullImage(imageName, cr, logWriter, nil)
+}
+
+// Same as PullImage but also reports the overall download progress of the image layers
+func (d *DockerClient) pullImage(imageName string, cr *containerregistry.ContainerRegistry, logWriter io.Writer, progress provider.ProgressReporter) error {
 	ctx := context.Background()
 
 	tag := "latest"
@@ -63,7 +69,29 @@ func (d *DockerClient) PullImage(imageName string, cr *containerregistry.Contain
 	}
 	defer responseBody.Close()
 
-	err = jsonmessage.DisplayJSONMessagesStream(responseBody, logWriter, 0, true, nil)
+	var pullStream io.Reader = responseBody
+	if progress != nil {
+		progressReader, progressWriter := io.Pipe()
+		done := make(chan struct{})
+		go func() {
+			reportPullProgress(imageName, progressReader, progress)
+			close(done)
+		}()
+
+		pullStream = io.TeeReader(responseBody, progressWriter)
+		defer func() {
+			progressWriter.Close()
+			<-done
+		}()
+	}
+
+	// Pull messages can't be displayed to a nil writer
+	out := logWriter
+	if out == nil {
+		out = io.Discard
+	}
+
+	err = jsonmessage.DisplayJSONMessagesStream(pullStream, out, 0, true, nil)
 	if err != nil {
 		return err
 	}
@@ -74,6 +102,72 @@ func (d *DockerClient) PullImage(imageName string, cr *containerregistry.Contain
 	return nil
 }
 
+type layerProgress struct {
+	current int64
+	total   int64
+}
+
+// Reads the pull messages and reports the percentage of the image layer bytes downloaded so far
+func reportPullProgress(imageName string, pullStream io.Reader, progress provider.ProgressReporter) {
+	// The stream must be drained so the pull is never blocked by the reporter
+	defer io.Copy(io.Discard, pullStream) // nolint:errcheck
+
+	layers := map[string]*layerProgress{}
+	lastPercent := -1
+
+	decoder := json.NewDecoder(pullStream)
+	for {
+		var msg jsonmessage.JSONMessage
+		err := decoder.Decode(&msg)
+		if err != nil {
+			return
+		}
+
+		if msg.ID == "" {
+			continue
+		}
+
+		layer, ok := layers[msg.ID]
+		if !ok {
+			layer = &layerProgress{}
+			layers[msg.ID] = layer
+		}
+
+		switch msg.Status {
+		case "Downloading":
+			if msg.Progress != nil && msg.Progress.Total > 0 {
+				layer.current = msg.Progress.Current
+				layer.total = msg.Progress.Total
+			}
+		case "Download complete", "Pull complete", "Already exists":
+			layer.current = layer.total
+		default:
+			continue
+		}
+
+		var current, total int64
+		for _, l := range layers {
+			current += l.current
+			total += l.total
+		}
+		if total == 0 {
+			continue
+		}
+
+		percent := int(current * 100 / total)
+		if percent == lastPercent {
+			continue
+		}
+		lastPercent = percent
+
+		progress.ReportProgress(provider.ProgressEvent{
+			Phase:   "Pulling image",
+			Percent: percent,
+			Message: imageName,
+		})
+	}
+}
+
 func getRegistryAuth(cr *containerregistry.ContainerRegistry) string {
 	if cr == nil {
 		// Sometimes registry auth fails if "" is sent, so sending "empty" instead
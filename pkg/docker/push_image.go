@@ -5,6 +5,7 @@ package docker
 
 import (
 	"context"
+	"encoding/json"
 	"io"
 
 	"github.com/daytonaio/daytona/pkg/containerregistry"
@@ -12,7 +13,12 @@ import (
 	"github.com/docker/docker/pkg/jsonmessage"
 )
 
-func (d *DockerClient) PushImage(imageName string, cr *containerregistry.ContainerRegistry, logWriter io.Writer) error {
+type pushResult struct {
+	Digest string `json:"Digest"`
+}
+
+// Pushes the image and returns the digest reported by the registry
+func (d *DockerClient) PushImage(imageName string, cr *containerregistry.ContainerRegistry, logWriter io.Writer) (string, error) {
 	ctx := context.Background()
 
 	if logWriter != nil {
@@ -22,18 +28,28 @@ func (d *DockerClient) PushImage(imageName string, cr *containerregistry.Contain
 		RegistryAuth: getRegistryAuth(cr),
 	})
 	if err != nil {
-		return err
+		return "", err
 	}
 	defer responseBody.Close()
 
-	err = jsonmessage.DisplayJSONMessagesStream(responseBody, logWriter, 0, true, nil)
+	digest := ""
+	err = jsonmessage.DisplayJSONMessagesStream(responseBody, logWriter, 0, true, func(msg jsonmessage.JSONMessage) {
+		if msg.Aux == nil {
+			return
+		}
+
+		var result pushResult
+		if json.Unmarshal(*msg.Aux, &result) == nil && result.Digest != "" {
+			digest = result.Digest
+		}
+	})
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	if logWriter != nil {
 		logWriter.Write([]byte("Image pushed successfully\n"))
 	}
 
-	return nil
+	return digest, nil
 }
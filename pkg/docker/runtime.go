@@ -0,0 +1,200 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
+)
+
+// Runtime is the container engine serving the Docker API the client connects to
+type Runtime string
+
+const (
+	// Detected from the daemon the client connects to
+	RuntimeAuto Runtime = "auto"
+	// Docker daemon running as root
+	RuntimeDocker Runtime = "docker"
+	// Docker daemon running in the user namespace of an unprivileged user
+	RuntimeDockerRootless Runtime = "docker-rootless"
+	// Podman Docker-compatible socket of an unprivileged user
+	RuntimePodman Runtime = "podman"
+)
+
+// Runtimes can be offered as choices of a provider target option
+var Runtimes = []Runtime{RuntimeAuto, RuntimeDocker, RuntimeDockerRootless, RuntimePodman}
+
+const defaultSocketPath = "/var/run/docker.sock"
+
+var ErrSocketNotFound = errors.New("no Docker or Podman socket found")
+
+// Returns the socket paths used by the runtime on this host, most common first
+func GetSocketCandidates(runtime Runtime) []string {
+	runtimeDir := os.Getenv("XDG_RUNTIME_DIR")
+	if runtimeDir == "" {
+		runtimeDir = fmt.Sprintf("/run/user/%d", os.Getuid())
+	}
+
+	rootlessDocker := []string{filepath.Join(runtimeDir, "docker.sock")}
+	podman := []string{filepath.Join(runtimeDir, "podman", "podman.sock"), "/run/podman/podman.sock"}
+
+	switch runtime {
+	case RuntimeDocker:
+		return []string{defaultSocketPath}
+	case RuntimeDockerRootless:
+		return rootlessDocker
+	case RuntimePodman:
+		return podman
+	}
+
+	candidates := []string{}
+	if host := os.Getenv("DOCKER_HOST"); strings.HasPrefix(host, "unix://") {
+		candidates = append(candidates, strings.TrimPrefix(host, "unix://"))
+	}
+	candidates = append(candidates, defaultSocketPath)
+	candidates = append(candidates, rootlessDocker...)
+
+	return append(candidates, podman...)
+}
+
+// FindSocket returns the first socket of the runtime that exists on this host
+func FindSocket(runtime Runtime) (string, error) {
+	for _, socketPath := range GetSocketCandidates(runtime) {
+		info, err := os.Stat(socketPath)
+		if err == nil && info.Mode()&os.ModeSocket != 0 {
+			return socketPath, nil
+		}
+	}
+
+	return "", ErrSocketNotFound
+}
+
+// DetectRuntime asks the daemon whether it is Podman and whether it runs rootless
+func DetectRuntime(apiClient client.APIClient) (Runtime, error) {
+	ctx := context.Background()
+
+	version, err := apiClient.ServerVersion(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, component := range version.Components {
+		if strings.Contains(strings.ToLower(component.Name), "podman") {
+			return RuntimePodman, nil
+		}
+	}
+
+	info, err := apiClient.Info(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, option := range info.SecurityOptions {
+		if strings.Contains(option, "name=rootless") {
+			return RuntimeDockerRootless, nil
+		}
+	}
+
+	return RuntimeDocker, nil
+}
+
+// Resolves the runtime on first use so creating the client does not require a connection to the daemon
+func (d *DockerClient) getRuntime() Runtime {
+	d.runtimeOnce.Do(func() {
+		if d.runtime != RuntimeAuto {
+			return
+		}
+
+		runtime, err := DetectRuntime(d.apiClient)
+		if err != nil {
+			d.runtime = RuntimeDocker
+			return
+		}
+		d.runtime = runtime
+	})
+
+	return d.runtime
+}
+
+func (d *DockerClient) isRootless() bool {
+	runtime := d.getRuntime()
+	return runtime == RuntimeDockerRootless || runtime == RuntimePodman
+}
+
+// Returns the host path of the socket mounted into containers that use the Docker API
+func (d *DockerClient) getSocketPath() string {
+	if d.socketPath != "" {
+		return d.socketPath
+	}
+
+	if d.getRuntime() == RuntimeDocker {
+		return defaultSocketPath
+	}
+
+	socketPath, err := FindSocket(d.getRuntime())
+	if err != nil {
+		return defaultSocketPath
+	}
+
+	return socketPath
+}
+
+// Adjusts the host config of containers with bind-mounted project files to the runtime.
+// Podman keeps the host user ID inside the container so bind-mounted files keep their owner, and
+// rootless runtimes get a private cgroup namespace since the host cgroup hierarchy is not writable.
+func (d *DockerClient) applyRuntimeHostConfig(hostConfig *container.HostConfig) {
+	if d.getRuntime() == RuntimePodman {
+		hostConfig.UsernsMode = "keep-id"
+	}
+
+	if d.isRootless() {
+		hostConfig.CgroupnsMode = container.CgroupnsModePrivate
+	}
+}
+
+// Files cloned into a rootless Docker project are owned by the container root user, which is the host user.
+// They are handed over to the project user so it can modify them.
+func (d *DockerClient) fixProjectDirOwnership(containerName, containerUser, projectPath string, logWriter io.Writer) error {
+	if d.getRuntime() != RuntimeDockerRootless || containerUser == "" || containerUser == "root" {
+		return nil
+	}
+
+	result, err := d.ExecSync(containerName, types.ExecConfig{
+		User: "root",
+		Cmd:  []string{"sh", "-c", fmt.Sprintf(`[ "$(stat -c %%u '%[1]s')" = "$(id -u %[2]s)" ] || chown -R "$(id -u %[2]s):$(id -g %[2]s)" '%[1]s'`, projectPath, containerUser)},
+	}, logWriter)
+	if err != nil {
+		return err
+	}
+
+	if result.ExitCode != 0 {
+		return fmt.Errorf("failed to change the owner of %s to %s: %s", projectPath, containerUser, result.StdErr)
+	}
+
+	return nil
+}
+
+// The devcontainer CLI syncs the remote user ID with the host user, which maps to an unprivileged
+// subordinate ID in rootless runtimes. Podman maps the host user into the container instead.
+func (d *DockerClient) applyRuntimeDevcontainerConfig(devcontainerConfig map[string]interface{}) {
+	if !d.isRootless() {
+		return
+	}
+
+	devcontainerConfig["updateRemoteUserUID"] = false
+
+	if d.getRuntime() == RuntimePodman {
+		runArgs, _ := devcontainerConfig["runArgs"].([]interface{})
+		devcontainerConfig["runArgs"] = append(runArgs, "--userns=keep-id")
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package docker_test
+
+import (
+	"net"
+	"path/filepath"
+
+	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/system"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *DockerClientTestSuite) TestDetectRuntime() {
+	s.mockClient.On("ServerVersion", mock.Anything).Return(types.Version{
+		Components: []types.ComponentVersion{{Name: "Engine"}},
+	}, nil).Once()
+	s.mockClient.On("Info", mock.Anything).Return(system.Info{
+		SecurityOptions: []string{"name=seccomp,profile=builtin", "name=rootless"},
+	}, nil).Once()
+
+	runtime, err := docker.DetectRuntime(s.mockClient)
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), docker.RuntimeDockerRootless, runtime)
+
+	s.mockClient.On("ServerVersion", mock.Anything).Return(types.Version{
+		Components: []types.ComponentVersion{{Name: "Podman Engine"}},
+	}, nil).Once()
+
+	runtime, err = docker.DetectRuntime(s.mockClient)
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), docker.RuntimePodman, runtime)
+}
+
+func (s *DockerClientTestSuite) TestFindSocket() {
+	runtimeDir := s.T().TempDir()
+	s.T().Setenv("XDG_RUNTIME_DIR", runtimeDir)
+	s.T().Setenv("DOCKER_HOST", "")
+
+	_, err := docker.FindSocket(docker.RuntimeDockerRootless)
+	require.ErrorIs(s.T(), err, docker.ErrSocketNotFound)
+
+	socketPath := filepath.Join(runtimeDir, "docker.sock")
+	listener, err := net.Listen("unix", socketPath)
+	require.Nil(s.T(), err)
+	defer listener.Close()
+
+	found, err := docker.FindSocket(docker.RuntimeDockerRootless)
+	require.Nil(s.T(), err)
+	require.Equal(s.T(), socketPath, found)
+}
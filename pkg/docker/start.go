@@ -49,6 +49,16 @@ func (d *DockerClient) StartProject(opts *CreateProjectOptions, daytonaDownloadU
 		return err
 	}
 
+	err = d.applyEgressPolicy(opts.Project, opts.NetworkPolicy, daytonaDownloadUrl, opts.LogWriter)
+	if err != nil {
+		return err
+	}
+
+	err = d.logPublishedPorts(opts.Project, opts.LogWriter)
+	if err != nil {
+		return err
+	}
+
 	return d.startDaytonaAgent(opts.Project, containerUser, daytonaDownloadUrl, opts.LogWriter)
 }
 
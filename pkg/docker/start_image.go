@@ -67,5 +67,5 @@ func (d *DockerClient) startImageProject(opts *CreateProjectOptions) error {
 		time.Sleep(1 * time.Second)
 	}
 
-	return nil
+	return d.fixProjectDirOwnership(containerName, opts.Project.User, getImageProjectPath(opts.Project), opts.LogWriter)
 }
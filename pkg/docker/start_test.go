@@ -4,10 +4,17 @@
 package docker_test
 
 import (
+	"errors"
+	"strings"
+
+	t_docker "github.com/daytonaio/daytona/internal/testing/docker"
 	"github.com/daytonaio/daytona/pkg/docker"
+	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/provider/util"
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/errdefs"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -39,6 +46,8 @@ func (s *DockerClientTestSuite) TestStartProject() {
 		},
 	}, nil)
 
+	s.mockClient.On("NetworkInspect", mock.Anything, "123-test-egress", types.NetworkInspectOptions{}).Return(types.NetworkResource{}, errdefs.NotFound(errors.New("network not found")))
+
 	s.setupExecTest([]string{"bash", "-c", util.GetProjectStartScript("", project1.ApiKey)}, containerName, project1.User, []string{})
 
 	err := s.dockerClient.StartProject(&docker.CreateProjectOptions{
@@ -46,3 +55,52 @@ func (s *DockerClientTestSuite) TestStartProject() {
 	}, "")
 	require.Nil(s.T(), err)
 }
+
+func (s *DockerClientTestSuite) TestStartProjectDenyingEgress() {
+	s.mockClient.On("ContainerList", mock.Anything, mock.Anything).Return([]types.Container{}, nil)
+
+	containerName := s.dockerClient.GetProjectContainerName(project1)
+
+	s.mockClient.On("ContainerInspect", mock.Anything, containerName).Return(types.ContainerJSON{
+		ContainerJSONBase: &types.ContainerJSONBase{
+			State: &types.ContainerState{
+				Running: true,
+			},
+		},
+		Config: &container.Config{
+			Labels: map[string]string{},
+		},
+		NetworkSettings: &types.NetworkSettings{
+			Networks: map[string]*network.EndpointSettings{
+				"bridge": {},
+			},
+		},
+	}, nil)
+
+	s.mockClient.On("NetworkInspect", mock.Anything, "123-test-egress", types.NetworkInspectOptions{}).Return(types.NetworkResource{}, errdefs.NotFound(errors.New("network not found")))
+	s.mockClient.On("NetworkCreate", mock.Anything, "123-test-egress", mock.MatchedBy(func(options types.NetworkCreate) bool {
+		return options.Driver == "bridge" && strings.HasPrefix(options.Options["com.docker.network.bridge.name"], "dtn-")
+	})).Return(types.NetworkCreateResponse{}, nil)
+
+	// The rules are applied by a container in the host network namespace, not in the project container
+	s.mockClient.On("ImagePull", mock.Anything, "daytonaio/workspace-project", mock.Anything).Return(t_docker.NewPipeReader(""), nil)
+	s.mockClient.On("ContainerCreate", mock.Anything, mock.Anything, mock.MatchedBy(func(hostConfig *container.HostConfig) bool {
+		return hostConfig.NetworkMode == "host" && !hostConfig.Privileged
+	}), mock.Anything, mock.Anything, "").Return(container.CreateResponse{ID: "egress-policy"}, nil)
+	s.mockClient.On("ContainerStart", mock.Anything, "egress-policy", container.StartOptions{}).Return(nil)
+	s.mockClient.On("ContainerExecCreate", mock.Anything, "egress-policy", mock.MatchedBy(func(config types.ExecConfig) bool {
+		return config.User == "root" && strings.Contains(config.Cmd[2], "iptables -A DAYTONA-") && strings.Contains(config.Cmd[2], "-d daytona.example.com -j RETURN")
+	})).Return(types.IDResponse{ID: "123"}, nil)
+	s.mockClient.On("ContainerRemove", mock.Anything, "egress-policy", container.RemoveOptions{Force: true, RemoveVolumes: true}).Return(nil)
+
+	s.mockClient.On("NetworkConnect", mock.Anything, "123-test-egress", containerName, (*network.EndpointSettings)(nil)).Return(nil)
+	s.mockClient.On("NetworkDisconnect", mock.Anything, "bridge", containerName, true).Return(nil)
+
+	s.setupExecTest([]string{"bash", "-c", util.GetProjectStartScript("https://daytona.example.com/download", project1.ApiKey)}, containerName, project1.User, []string{})
+
+	err := s.dockerClient.StartProject(&docker.CreateProjectOptions{
+		Project:       project1,
+		NetworkPolicy: &provider.NetworkPolicy{DenyAllEgress: true},
+	}, "https://daytona.example.com/download")
+	require.Nil(s.T(), err)
+}
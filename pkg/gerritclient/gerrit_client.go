@@ -0,0 +1,176 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gerritclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Gerrit prefixes JSON responses with a magic line to prevent XSSI
+var xssiPrefix = []byte(")]}'")
+
+type GerritClient struct {
+	username string
+	token    string
+	BaseURL  *url.URL
+}
+
+// The token is the HTTP password generated in the Gerrit user settings
+func NewGerritClient(username string, token string, baseUrl *url.URL) *GerritClient {
+	return &GerritClient{
+		username: username,
+		token:    token,
+		BaseURL:  baseUrl,
+	}
+}
+
+// Returns the authenticated clone URL of the project
+func GetCloneUrl(baseUrl *url.URL, project string) string {
+	return strings.TrimSuffix(baseUrl.String(), "/") + "/a/" + project
+}
+
+func (g *GerritClient) performRequest(path string, query url.Values, response any) error {
+	// Authenticated REST endpoints are served under /a/
+	apiUrl := g.BaseURL.JoinPath("a", path)
+	apiUrl.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(context.Background(), "GET", apiUrl.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(g.username, g.token)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("request to %s failed with status %d: %s", apiUrl.Path, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	body = bytes.TrimPrefix(body, xssiPrefix)
+
+	return json.Unmarshal(body, response)
+}
+
+func (g *GerritClient) GetUser() (*Account, error) {
+	var account Account
+	err := g.performRequest("accounts/self", nil, &account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+func (g *GerritClient) GetProjects() ([]*Project, error) {
+	var response map[string]*Project
+	err := g.performRequest("projects/", url.Values{"type": []string{"CODE"}}, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	projects := []*Project{}
+	for name, project := range response {
+		// Read-only projects can still be cloned, hidden projects can not
+		if project.State == "HIDDEN" {
+			continue
+		}
+		project.Name = name
+		projects = append(projects, project)
+	}
+
+	sort.Slice(projects, func(i, j int) bool {
+		return projects[i].Name < projects[j].Name
+	})
+
+	return projects, nil
+}
+
+// Returns the branches of the project without refs/meta/config and the symbolic HEAD
+func (g *GerritClient) GetBranches(project string) ([]*Branch, error) {
+	var branches []*Branch
+	err := g.performRequest(fmt.Sprintf("projects/%s/branches/", url.PathEscape(project)), nil, &branches)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*Branch{}
+	for _, branch := range branches {
+		if !strings.HasPrefix(branch.Ref, "refs/heads/") {
+			continue
+		}
+		branch.Ref = strings.TrimPrefix(branch.Ref, "refs/heads/")
+		result = append(result, branch)
+	}
+
+	return result, nil
+}
+
+func (g *GerritClient) GetBranch(project string, branch string) (*Branch, error) {
+	var result Branch
+	err := g.performRequest(fmt.Sprintf("projects/%s/branches/%s", url.PathEscape(project), url.PathEscape(branch)), nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// Returns the branch HEAD of the project points to
+func (g *GerritClient) GetHeadBranch(project string) (string, error) {
+	var head string
+	err := g.performRequest(fmt.Sprintf("projects/%s/HEAD", url.PathEscape(project)), nil, &head)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimPrefix(head, "refs/heads/"), nil
+}
+
+func (g *GerritClient) GetOpenChanges(project string) ([]*Change, error) {
+	query := url.Values{
+		"q": []string{fmt.Sprintf("status:open project:%s", project)},
+		"o": []string{"CURRENT_REVISION", "DETAILED_ACCOUNTS"},
+	}
+
+	var changes []*Change
+	err := g.performRequest("changes/", query, &changes)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+func (g *GerritClient) GetChange(changeNumber uint32) (*Change, error) {
+	query := url.Values{
+		"o": []string{"CURRENT_REVISION", "DETAILED_ACCOUNTS"},
+	}
+
+	var change Change
+	err := g.performRequest(fmt.Sprintf("changes/%d", changeNumber), query, &change)
+	if err != nil {
+		return nil, err
+	}
+
+	return &change, nil
+}
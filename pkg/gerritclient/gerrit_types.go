@@ -0,0 +1,43 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gerritclient
+
+type Account struct {
+	AccountId int    `json:"_account_id"`
+	Name      string `json:"name"`
+	Username  string `json:"username"`
+	Email     string `json:"email"`
+}
+
+type Project struct {
+	Id    string `json:"id"`
+	Name  string `json:"name"`
+	State string `json:"state"`
+}
+
+type Branch struct {
+	Ref      string `json:"ref"`
+	Revision string `json:"revision"`
+}
+
+type Revision struct {
+	Number int    `json:"_number"`
+	Ref    string `json:"ref"`
+}
+
+type Change struct {
+	Id              string              `json:"id"`
+	Project         string              `json:"project"`
+	Branch          string              `json:"branch"`
+	Subject         string              `json:"subject"`
+	Number          int                 `json:"_number"`
+	CurrentRevision string              `json:"current_revision"`
+	Revisions       map[string]Revision `json:"revisions"`
+	Owner           Account             `json:"owner"`
+}
+
+// Returns the ref of the current patch set, e.g. refs/changes/45/12345/2
+func (c *Change) CurrentRef() string {
+	return c.Revisions[c.CurrentRevision].Ref
+}
@@ -5,13 +5,17 @@ package git
 
 import (
 	"bytes"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/profiledata"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/protocol/packp/capability"
 	"github.com/go-git/go-git/v5/plumbing/transport"
@@ -33,15 +37,17 @@ var MapStatus map[git.StatusCode]workspace.Status = map[git.StatusCode]workspace
 type IGitService interface {
 	CloneRepository(project *workspace.Project, auth *http.BasicAuth) error
 	RepositoryExists(project *workspace.Project) (bool, error)
-	SetGitConfig(userData *gitprovider.GitUser) error
+	SetGitConfig(userData *gitprovider.GitUser, identity *profiledata.GitIdentity) error
 	GetGitStatus() (*workspace.GitStatus, error)
 }
 
 type Service struct {
 	ProjectDir        string
 	GitConfigFileName string
-	LogWriter         io.Writer
-	OpenRepository    *git.Repository
+	// Path of the git credential helper. The daytona git-cred command is used if empty
+	CredentialHelper string
+	LogWriter        io.Writer
+	OpenRepository   *git.Repository
 }
 
 func (s *Service) CloneRepository(project *workspace.Project, auth *http.BasicAuth) error {
@@ -67,6 +73,15 @@ func (s *Service) CloneRepository(project *workspace.Project, auth *http.BasicAu
 
 	if s.shouldCloneBranch(project) {
 		cloneOptions.ReferenceName = plumbing.ReferenceName("refs/heads/" + *project.Repository.Branch)
+	} else if project.Repository.Pinned {
+		// The pinned commit is not necessarily reachable from the default branch
+		cloneOptions.SingleBranch = false
+	}
+
+	if project.WorktreeMode {
+		// All branches are fetched so that worktrees can be added for them
+		cloneOptions.SingleBranch = false
+		return s.cloneBareRepository(project, cloneOptions)
 	}
 
 	_, err := git.PlainClone(s.ProjectDir, false, cloneOptions)
@@ -93,9 +108,105 @@ func (s *Service) CloneRepository(project *workspace.Project, auth *http.BasicAu
 		}
 	}
 
+	if project.Repository.Ref != nil && *project.Repository.Ref != "" {
+		err = s.checkoutRef(*project.Repository.Ref, auth)
+		if err != nil {
+			return err
+		}
+	}
+
+	if project.Repository.Pinned {
+		err = s.checkoutPinnedSha(project.Repository.Sha)
+		if err != nil {
+			return err
+		}
+	}
+
+	if project.Repository.NewBranch != nil && *project.Repository.NewBranch != "" {
+		return s.createBranch(*project.Repository.NewBranch)
+	}
+
 	return err
 }
 
+// Checks out the pinned commit in detached HEAD mode. Abbreviated SHAs are resolved against the cloned repository
+func (s *Service) checkoutPinnedSha(sha string) error {
+	repo, err := git.PlainOpen(s.ProjectDir)
+	if err != nil {
+		return err
+	}
+
+	hash, err := repo.ResolveRevision(plumbing.Revision(sha))
+	if err != nil {
+		return fmt.Errorf("failed to resolve pinned commit %s: %w", sha, err)
+	}
+
+	w, err := repo.Worktree()
+	if err != nil {
+		return err
+	}
+
+	return w.Checkout(&git.CheckoutOptions{
+		Hash: *hash,
+	})
+}
+
+// Fetches a ref that is not fetched by the clone (e.g. a Gerrit change) and checks it out in detached HEAD mode
+func (s *Service) checkoutRef(ref string, auth *http.BasicAuth) error {
+	repo, err := git.PlainOpen(s.ProjectDir)
+	if err != nil {
+		return err
+	}
+
+	fetchOptions := &git.FetchOptions{
+		RemoteName:      "origin",
+		RefSpecs:        []config.RefSpec{config.RefSpec(fmt.Sprintf("+%s:%s", ref, ref))},
+		InsecureSkipTLS: true,
+		Auth:            auth,
+	}
+
+	if s.LogWriter != nil {
+		fetchOptions.Progress = s.LogWriter
+	}
+
+	err = repo.Fetch(fetchOptions)
+	if err != nil && err != git.NoErrAlreadyUpToDate {
+		return fmt.Errorf("failed to fetch %s: %w", ref, err)
+	}
+
+	reference, err := repo.Reference(plumbing.ReferenceName(ref), true)
+	if err != nil {
+		return err
+	}
+
+	w, err := repo.Worktree()
+	if err != nil {
+		return err
+	}
+
+	return w.Checkout(&git.CheckoutOptions{
+		Hash: reference.Hash(),
+	})
+}
+
+// Creates a new branch from the current HEAD and checks it out
+func (s *Service) createBranch(name string) error {
+	repo, err := git.PlainOpen(s.ProjectDir)
+	if err != nil {
+		return err
+	}
+
+	w, err := repo.Worktree()
+	if err != nil {
+		return err
+	}
+
+	return w.Checkout(&git.CheckoutOptions{
+		Branch: plumbing.NewBranchReferenceName(name),
+		Create: true,
+	})
+}
+
 func (s *Service) RepositoryExists(project *workspace.Project) (bool, error) {
 	_, err := os.Stat(filepath.Join(s.ProjectDir, ".git"))
 	if os.IsNotExist(err) {
@@ -107,7 +218,8 @@ func (s *Service) RepositoryExists(project *workspace.Project) (bool, error) {
 	return true, nil
 }
 
-func (s *Service) SetGitConfig(userData *gitprovider.GitUser) error {
+// Writes the credential helper and the user to the git config. The identity takes precedence over the git provider user
+func (s *Service) SetGitConfig(userData *gitprovider.GitUser, identity *profiledata.GitIdentity) error {
 	gitConfigFileName := s.GitConfigFileName
 
 	var gitConfigContent []byte
@@ -128,25 +240,47 @@ func (s *Service) SetGitConfig(userData *gitprovider.GitUser) error {
 		}
 	}
 
-	_, err = cfg.Section("credential").NewKey("helper", "/usr/local/bin/daytona git-cred")
+	credentialHelper := s.CredentialHelper
+	if credentialHelper == "" {
+		credentialHelper = "/usr/local/bin/daytona git-cred"
+	}
+
+	_, err = cfg.Section("credential").NewKey("helper", credentialHelper)
+	if err != nil {
+		return err
+	}
+
+	// The repository path selects the git provider config for repositories owned by an aliased account
+	_, err = cfg.Section("credential").NewKey("useHttpPath", "true")
 	if err != nil {
 		return err
 	}
 
+	var name, email string
 	if userData != nil {
-		if !cfg.HasSection("user") {
-			_, err := cfg.NewSection("user")
-			if err != nil {
-				return err
-			}
+		name, email = userData.Name, userData.Email
+	}
+	if identity != nil && identity.Name != "" {
+		name = identity.Name
+	}
+	if identity != nil && identity.Email != "" {
+		email = identity.Email
+	}
+
+	if userData != nil || name != "" || email != "" {
+		_, err := cfg.Section("user").NewKey("name", name)
+		if err != nil {
+			return err
 		}
 
-		_, err := cfg.Section("user").NewKey("name", userData.Name)
+		_, err = cfg.Section("user").NewKey("email", email)
 		if err != nil {
 			return err
 		}
+	}
 
-		_, err = cfg.Section("user").NewKey("email", userData.Email)
+	if identity != nil && identity.SigningKey != "" {
+		err = setGitSigningConfig(cfg, identity)
 		if err != nil {
 			return err
 		}
@@ -166,8 +300,51 @@ func (s *Service) SetGitConfig(userData *gitprovider.GitUser) error {
 	return nil
 }
 
+// Signs all commits and tags with the signing key of the identity
+func setGitSigningConfig(cfg *ini.File, identity *profiledata.GitIdentity) error {
+	format := identity.SigningFormat
+	if format == "" {
+		format = profiledata.GitSigningFormatSsh
+	}
+
+	signingKey := identity.SigningKey
+	// Literal SSH public keys must be prefixed so git does not treat them as a path to a key file
+	if format == profiledata.GitSigningFormatSsh && !strings.HasPrefix(signingKey, "key::") && strings.Contains(signingKey, " ") {
+		signingKey = "key::" + signingKey
+	}
+
+	values := []struct {
+		section string
+		key     string
+		value   string
+	}{
+		{"user", "signingkey", signingKey},
+		{"gpg", "format", string(format)},
+		{"commit", "gpgsign", "true"},
+		{"tag", "gpgsign", "true"},
+	}
+
+	for _, v := range values {
+		_, err := cfg.Section(v.section).NewKey(v.key, v.value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (s *Service) GetGitStatus() (*workspace.GitStatus, error) {
-	repo, err := git.PlainOpen(s.ProjectDir)
+	repoDir := s.ProjectDir
+	if s.IsWorktreeMode() {
+		var err error
+		repoDir, err = s.getStatusWorktreeDir()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	repo, err := git.PlainOpenWithOptions(repoDir, &git.PlainOpenOptions{EnableDotGitCommonDir: true})
 	if err != nil {
 		return nil, err
 	}
@@ -199,6 +376,7 @@ func (s *Service) GetGitStatus() (*workspace.GitStatus, error) {
 
 	return &workspace.GitStatus{
 		CurrentBranch: ref.Name().Short(),
+		HeadSha:       ref.Hash().String(),
 		Files:         files,
 	}, nil
 }
@@ -216,7 +394,7 @@ func (s *Service) shouldCloneBranch(project *workspace.Project) bool {
 }
 
 func (s *Service) shouldCheckoutSha(project *workspace.Project) bool {
-	if project.Repository.Sha == "" {
+	if project.Repository.Sha == "" || project.Repository.Pinned {
 		return false
 	}
 
@@ -0,0 +1,250 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// Directory of the bare repository inside the project directory of projects in worktree mode
+const BareRepositoryDir = ".bare"
+
+var (
+	ErrNotWorktreeMode   = errors.New("the project repository is not cloned in worktree mode. Create the workspace with 'daytona create --worktrees'")
+	ErrWorktreeExists    = errors.New("a worktree already exists for the branch")
+	ErrWorktreeNotFound  = errors.New("worktree not found")
+	ErrInvalidBranchName = errors.New("invalid branch name")
+)
+
+// Worktree is a branch checked out in its own directory of a project in worktree mode
+type Worktree struct {
+	// Empty if the worktree is in detached HEAD mode
+	Branch string `json:"branch"`
+	// Absolute path of the worktree directory
+	Path string `json:"path"`
+	Sha  string `json:"sha"`
+}
+
+// Worktrees are created in the project directory. Slashes are replaced so that branches like feature/login do not nest worktrees
+func GetWorktreeDirName(branch string) string {
+	return strings.ReplaceAll(branch, "/", "-")
+}
+
+func (s *Service) IsWorktreeMode() bool {
+	stat, err := os.Stat(filepath.Join(s.ProjectDir, BareRepositoryDir))
+	return err == nil && stat.IsDir()
+}
+
+// Clones a bare repository and checks out the cloned branch, or the new branch of the project, as the first worktree.
+// The .git file in the project directory points git commands run in the project directory to the bare repository
+func (s *Service) cloneBareRepository(project *workspace.Project, cloneOptions *git.CloneOptions) error {
+	repo, err := git.PlainClone(filepath.Join(s.ProjectDir, BareRepositoryDir), true, cloneOptions)
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(filepath.Join(s.ProjectDir, ".git"), []byte(fmt.Sprintf("gitdir: ./%s\n", BareRepositoryDir)), 0644)
+	if err != nil {
+		return err
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return err
+	}
+	branch := head.Name().Short()
+
+	if project.Repository.NewBranch != nil && *project.Repository.NewBranch != "" {
+		newBranch := *project.Repository.NewBranch
+		_, err = s.git("worktree", "add", "-b", newBranch, filepath.Join(s.ProjectDir, GetWorktreeDirName(newBranch)), branch)
+		return err
+	}
+
+	// Repository URLs of a commit are checked out in detached HEAD mode
+	if s.shouldCheckoutSha(project) {
+		sha := project.Repository.Sha
+		_, err = s.git("worktree", "add", "--detach", filepath.Join(s.ProjectDir, sha[:min(len(sha), 7)]), sha)
+		return err
+	}
+
+	_, err = s.git("worktree", "add", filepath.Join(s.ProjectDir, GetWorktreeDirName(branch)), branch)
+	return err
+}
+
+// Checks out the branch in a new worktree. Remote branches are tracked and unknown branches are created from the default branch
+func (s *Service) AddWorktree(branch string) (*Worktree, error) {
+	if !s.IsWorktreeMode() {
+		return nil, ErrNotWorktreeMode
+	}
+
+	_, err := s.git("check-ref-format", "--branch", branch)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidBranchName, branch)
+	}
+
+	_, err = s.findWorktree(branch)
+	if err == nil {
+		return nil, ErrWorktreeExists
+	}
+	if !errors.Is(err, ErrWorktreeNotFound) {
+		return nil, err
+	}
+
+	// Branches pushed after the clone are only known after a fetch. Local branches can still be checked out if the remote is unreachable
+	_, err = s.git("fetch", "origin")
+	if err != nil && s.LogWriter != nil {
+		fmt.Fprintf(s.LogWriter, "Failed to fetch the remote branches: %s\n", err)
+	}
+
+	worktreeDir := filepath.Join(s.ProjectDir, GetWorktreeDirName(branch))
+
+	switch {
+	case s.refExists(plumbing.NewBranchReferenceName(branch)):
+		_, err = s.git("worktree", "add", worktreeDir, branch)
+	case s.refExists(plumbing.NewRemoteReferenceName("origin", branch)):
+		_, err = s.git("worktree", "add", "--track", "-b", branch, worktreeDir, "origin/"+branch)
+	default:
+		_, err = s.git("worktree", "add", "-b", branch, worktreeDir)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.findWorktree(branch)
+}
+
+func (s *Service) ListWorktrees() ([]Worktree, error) {
+	if !s.IsWorktreeMode() {
+		return nil, ErrNotWorktreeMode
+	}
+
+	output, err := s.git("worktree", "list", "--porcelain")
+	if err != nil {
+		return nil, err
+	}
+
+	return parseWorktreeList(output), nil
+}
+
+// Removes the worktree directory of the branch. The branch itself is kept
+func (s *Service) RemoveWorktree(branch string, force bool) error {
+	worktree, err := s.findWorktree(branch)
+	if err != nil {
+		return err
+	}
+
+	args := []string{"worktree", "remove"}
+	if force {
+		args = append(args, "--force")
+	}
+
+	_, err = s.git(append(args, worktree.Path)...)
+	return err
+}
+
+func (s *Service) findWorktree(branch string) (*Worktree, error) {
+	worktrees, err := s.ListWorktrees()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, worktree := range worktrees {
+		if worktree.Branch == branch {
+			return &worktree, nil
+		}
+	}
+
+	return nil, ErrWorktreeNotFound
+}
+
+// The git status of projects in worktree mode is reported for the worktree of the default branch, or the first worktree if it was removed
+func (s *Service) getStatusWorktreeDir() (string, error) {
+	repo, err := git.PlainOpen(filepath.Join(s.ProjectDir, BareRepositoryDir))
+	if err != nil {
+		return "", err
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return "", err
+	}
+
+	worktrees, err := s.ListWorktrees()
+	if err != nil {
+		return "", err
+	}
+
+	if len(worktrees) == 0 {
+		return "", ErrWorktreeNotFound
+	}
+
+	for _, worktree := range worktrees {
+		if worktree.Branch == head.Name().Short() {
+			return worktree.Path, nil
+		}
+	}
+
+	return worktrees[0].Path, nil
+}
+
+func (s *Service) refExists(name plumbing.ReferenceName) bool {
+	_, err := s.git("show-ref", "--verify", "--quiet", name.String())
+	return err == nil
+}
+
+// Worktrees are not supported by go-git so they are managed with the git CLI
+func (s *Service) git(args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = s.ProjectDir
+
+	output, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+			return "", fmt.Errorf("git %s failed: %s", args[0], strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return "", fmt.Errorf("git %s failed: %w", args[0], err)
+	}
+
+	return string(output), nil
+}
+
+// Parses the output of git worktree list --porcelain. The bare repository is not a worktree and is skipped
+func parseWorktreeList(output string) []Worktree {
+	worktrees := []Worktree{}
+
+	for _, entry := range strings.Split(strings.TrimSpace(output), "\n\n") {
+		worktree := Worktree{}
+		bare := false
+
+		for _, line := range strings.Split(entry, "\n") {
+			key, value, _ := strings.Cut(strings.TrimSpace(line), " ")
+			switch key {
+			case "worktree":
+				worktree.Path = value
+			case "HEAD":
+				worktree.Sha = value
+			case "branch":
+				worktree.Branch = plumbing.ReferenceName(value).Short()
+			case "bare":
+				bare = true
+			}
+		}
+
+		if worktree.Path != "" && !bare {
+			worktrees = append(worktrees, worktree)
+		}
+	}
+
+	return worktrees
+}
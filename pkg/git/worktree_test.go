@@ -0,0 +1,108 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package git_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/git"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorktreeMode(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	remoteDir := createRemoteRepository(t)
+	projectDir := filepath.Join(t.TempDir(), "project")
+
+	service := &git.Service{ProjectDir: projectDir}
+
+	err := service.CloneRepository(&workspace.Project{
+		Repository:   &gitprovider.GitRepository{Url: remoteDir},
+		WorktreeMode: true,
+	}, nil)
+	require.NoError(t, err)
+
+	require.True(t, service.IsWorktreeMode())
+	exists, err := service.RepositoryExists(nil)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	worktrees, err := service.ListWorktrees()
+	require.NoError(t, err)
+	require.Len(t, worktrees, 1)
+	require.Equal(t, "main", worktrees[0].Branch)
+	require.Equal(t, filepath.Join(projectDir, "main"), worktrees[0].Path)
+
+	status, err := service.GetGitStatus()
+	require.NoError(t, err)
+	require.Equal(t, "main", status.CurrentBranch)
+
+	t.Run("AddWorktree tracks remote branches", func(t *testing.T) {
+		worktree, err := service.AddWorktree("feature/login")
+		require.NoError(t, err)
+		require.Equal(t, "feature/login", worktree.Branch)
+		require.Equal(t, filepath.Join(projectDir, "feature-login"), worktree.Path)
+		require.FileExists(t, filepath.Join(worktree.Path, "login.txt"))
+	})
+
+	t.Run("AddWorktree creates unknown branches", func(t *testing.T) {
+		worktree, err := service.AddWorktree("experiment")
+		require.NoError(t, err)
+		require.Equal(t, worktrees[0].Sha, worktree.Sha)
+	})
+
+	t.Run("AddWorktree fails for checked out branches", func(t *testing.T) {
+		_, err := service.AddWorktree("main")
+		require.ErrorIs(t, err, git.ErrWorktreeExists)
+	})
+
+	t.Run("AddWorktree fails for invalid branch names", func(t *testing.T) {
+		_, err := service.AddWorktree("bad..name")
+		require.ErrorIs(t, err, git.ErrInvalidBranchName)
+	})
+
+	t.Run("RemoveWorktree", func(t *testing.T) {
+		require.NoError(t, service.RemoveWorktree("experiment", false))
+		require.ErrorIs(t, service.RemoveWorktree("experiment", false), git.ErrWorktreeNotFound)
+
+		worktrees, err := service.ListWorktrees()
+		require.NoError(t, err)
+		require.Len(t, worktrees, 2)
+	})
+
+	t.Run("ListWorktrees fails outside of worktree mode", func(t *testing.T) {
+		_, err := (&git.Service{ProjectDir: remoteDir}).ListWorktrees()
+		require.ErrorIs(t, err, git.ErrNotWorktreeMode)
+	})
+}
+
+// Creates a repository with a main and a feature/login branch
+func createRemoteRepository(t *testing.T) string {
+	dir := t.TempDir()
+
+	runGit := func(args ...string) {
+		cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@daytona.io"}, args...)...)
+		cmd.Dir = dir
+		output, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(output))
+	}
+
+	runGit("init", "-b", "main")
+	runGit("commit", "--allow-empty", "-m", "initial commit")
+	runGit("checkout", "-b", "feature/login")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "login.txt"), []byte("login"), 0644))
+	runGit("add", "login.txt")
+	runGit("commit", "-m", "login")
+	runGit("checkout", "main")
+
+	return dir
+}
@@ -0,0 +1,309 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitprovider
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	gerritclient "github.com/daytonaio/daytona/pkg/gerritclient"
+)
+
+// Projects created by Gerrit to store its own configuration
+var gerritInternalProjects = []string{"All-Projects", "All-Users"}
+
+var gerritShaRegex = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+type GerritGitProvider struct {
+	*AbstractGitProvider
+	username   string
+	token      string
+	baseApiUrl *string
+}
+
+func NewGerritGitProvider(username string, token string, baseApiUrl *string) *GerritGitProvider {
+	gitProvider := &GerritGitProvider{
+		username:            username,
+		token:               token,
+		baseApiUrl:          baseApiUrl,
+		AbstractGitProvider: &AbstractGitProvider{},
+	}
+	gitProvider.AbstractGitProvider.GitProvider = gitProvider
+
+	return gitProvider
+}
+
+func (g *GerritGitProvider) getApiClient() (*gerritclient.GerritClient, error) {
+	if g.baseApiUrl == nil {
+		return nil, errors.New("Gerrit base URL is not set")
+	}
+
+	baseUrl, err := url.Parse(*g.baseApiUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	return gerritclient.NewGerritClient(g.username, g.token, baseUrl), nil
+}
+
+// Gerrit has no namespaces so all projects are listed under a single one
+func (g *GerritGitProvider) GetNamespaces() ([]*GitNamespace, error) {
+	return []*GitNamespace{{Id: personalNamespaceId, Name: "All projects"}}, nil
+}
+
+func (g *GerritGitProvider) GetRepositories(namespace string) ([]*GitRepository, error) {
+	client, err := g.getApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := client.GetProjects()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch Repositories : %w", err)
+	}
+
+	var repos []*GitRepository
+	for _, project := range projects {
+		if slices.Contains(gerritInternalProjects, project.Name) {
+			continue
+		}
+
+		repos = append(repos, g.getRepository(client, project.Name))
+	}
+
+	return repos, nil
+}
+
+func (g *GerritGitProvider) GetRepoBranches(repositoryId string, namespaceId string) ([]*GitBranch, error) {
+	client, err := g.getApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.GetBranches(repositoryId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch Branches: %w", err)
+	}
+
+	var branches []*GitBranch
+	for _, branch := range response {
+		branches = append(branches, &GitBranch{
+			Name: branch.Ref,
+			Sha:  branch.Revision,
+		})
+	}
+
+	return branches, nil
+}
+
+// Returns the open changes of the project. Changes are not available as branches so they are checked out from their patch set ref
+func (g *GerritGitProvider) GetRepoPRs(repositoryId string, namespaceId string) ([]*GitPullRequest, error) {
+	client, err := g.getApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := client.GetOpenChanges(repositoryId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch Pull Request : %w", err)
+	}
+
+	repo := g.getRepository(client, repositoryId)
+
+	var pullRequests []*GitPullRequest
+	for _, change := range changes {
+		pullRequests = append(pullRequests, &GitPullRequest{
+			Name:            fmt.Sprintf("%d: %s", change.Number, change.Subject),
+			Branch:          change.Branch,
+			Sha:             change.CurrentRevision,
+			Ref:             change.CurrentRef(),
+			SourceRepoId:    repo.Id,
+			SourceRepoUrl:   repo.Url,
+			SourceRepoOwner: repo.Owner,
+			SourceRepoName:  repo.Name,
+		})
+	}
+
+	return pullRequests, nil
+}
+
+func (g *GerritGitProvider) GetUser() (*GitUser, error) {
+	client, err := g.getApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := client.GetUser()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch User : %w", err)
+	}
+
+	return &GitUser{
+		Id:       strconv.Itoa(account.AccountId),
+		Username: account.Username,
+		Name:     account.Name,
+		Email:    account.Email,
+	}, nil
+}
+
+func (g *GerritGitProvider) GetLastCommitSha(staticContext *StaticGitContext) (string, error) {
+	// Changes and commits are pinned to a revision
+	if staticContext.Sha != nil && *staticContext.Sha != "" {
+		if staticContext.Ref != nil || (staticContext.Branch != nil && *staticContext.Branch == *staticContext.Sha) {
+			return *staticContext.Sha, nil
+		}
+	}
+
+	client, err := g.getApiClient()
+	if err != nil {
+		return "", err
+	}
+
+	var branchName string
+	if staticContext.Branch != nil {
+		branchName = *staticContext.Branch
+	} else {
+		branchName, err = client.GetHeadBranch(staticContext.Id)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	branch, err := client.GetBranch(staticContext.Id, branchName)
+	if err != nil {
+		return "", err
+	}
+
+	return branch.Revision, nil
+}
+
+func (g *GerritGitProvider) getPrContext(staticContext *StaticGitContext) (*StaticGitContext, error) {
+	if staticContext.PrNumber == nil {
+		return staticContext, nil
+	}
+
+	client, err := g.getApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	change, err := client.GetChange(*staticContext.PrNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	ref := change.CurrentRef()
+
+	repo := *staticContext
+	repo.Branch = &change.Branch
+	repo.Sha = &change.CurrentRevision
+	repo.Ref = &ref
+
+	return &repo, nil
+}
+
+// Parses change (/c/<project>/+/<number>), Gitiles (/plugins/gitiles/<project>/+/<revision>), admin (/admin/repos/<project>) and clone URLs
+func (g *GerritGitProvider) parseStaticGitContext(repoUrl string) (*StaticGitContext, error) {
+	if strings.HasPrefix(repoUrl, "ssh://") {
+		// Gerrit serves SSH on a separate port, e.g. ssh://user@gerrit-host:29418/project
+		sshUrl, err := url.Parse(repoUrl)
+		if err != nil {
+			return nil, err
+		}
+		repoUrl = fmt.Sprintf("https://%s%s", sshUrl.Hostname(), sshUrl.Path)
+	}
+
+	parsedUrl, err := url.Parse(repoUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	if parsedUrl.Host == "" {
+		return nil, errors.New("cannot parse git URL: " + repoUrl)
+	}
+
+	baseUrl := &url.URL{Scheme: parsedUrl.Scheme, Host: parsedUrl.Host}
+	if g.baseApiUrl != nil {
+		configuredUrl, err := url.Parse(*g.baseApiUrl)
+		if err == nil && configuredUrl.Host == parsedUrl.Host {
+			baseUrl.Path = strings.TrimSuffix(configuredUrl.Path, "/")
+		}
+	}
+
+	urlPath := strings.TrimPrefix(parsedUrl.Path, baseUrl.Path)
+	urlPath = strings.Trim(urlPath, "/")
+	urlPath = strings.TrimSuffix(urlPath, ".git")
+	urlPath = strings.TrimPrefix(urlPath, "a/")
+
+	staticContext := &StaticGitContext{}
+
+	project := urlPath
+	switch {
+	case strings.HasPrefix(urlPath, "c/"):
+		parts := strings.SplitN(strings.TrimPrefix(urlPath, "c/"), "/+/", 2)
+		project = parts[0]
+		if len(parts) == 2 {
+			number, err := strconv.Atoi(strings.Split(parts[1], "/")[0])
+			if err != nil {
+				return nil, fmt.Errorf("invalid change number: %w", err)
+			}
+			prNumber := uint32(number)
+			staticContext.PrNumber = &prNumber
+		}
+	case strings.HasPrefix(urlPath, "plugins/gitiles/"):
+		parts := strings.SplitN(strings.TrimPrefix(urlPath, "plugins/gitiles/"), "/+/", 2)
+		project = parts[0]
+		if len(parts) == 2 {
+			revision := parts[1]
+			if strings.HasPrefix(revision, "refs/heads/") {
+				branch := strings.TrimPrefix(revision, "refs/heads/")
+				staticContext.Branch = &branch
+			} else if sha := strings.Split(revision, "/")[0]; gerritShaRegex.MatchString(sha) {
+				staticContext.Sha = &sha
+				staticContext.Branch = &sha
+			}
+		}
+	case strings.HasPrefix(urlPath, "admin/repos/"):
+		// Admin URLs can contain a section after the project name, e.g. /admin/repos/project,branches
+		project = strings.Split(strings.TrimPrefix(urlPath, "admin/repos/"), ",")[0]
+	}
+
+	if project == "" {
+		return nil, errors.New("cannot parse git URL: " + repoUrl)
+	}
+
+	staticContext.Id = project
+	staticContext.Name = path.Base(project)
+	staticContext.Owner = getGerritProjectOwner(project)
+	staticContext.Source = parsedUrl.Host
+	staticContext.Url = gerritclient.GetCloneUrl(baseUrl, project)
+
+	return staticContext, nil
+}
+
+func (g *GerritGitProvider) getRepository(client *gerritclient.GerritClient, project string) *GitRepository {
+	return &GitRepository{
+		Id:     project,
+		Name:   path.Base(project),
+		Url:    gerritclient.GetCloneUrl(client.BaseURL, project),
+		Owner:  getGerritProjectOwner(project),
+		Source: client.BaseURL.Host,
+	}
+}
+
+// Gerrit projects are organized hierarchically, e.g. platform/build. The parent path is used as the owner
+func getGerritProjectOwner(project string) string {
+	owner := path.Dir(project)
+	if owner == "." {
+		return ""
+	}
+
+	return owner
+}
@@ -0,0 +1,119 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitprovider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type GerritGitProviderTestSuite struct {
+	gitProvider *GerritGitProvider
+	suite.Suite
+}
+
+func NewGerritGitProviderTestSuite() *GerritGitProviderTestSuite {
+	baseApiUrl := "https://gerrit.example.com/r"
+	return &GerritGitProviderTestSuite{
+		gitProvider: NewGerritGitProvider("username", "token", &baseApiUrl),
+	}
+}
+
+func (g *GerritGitProviderTestSuite) TestParseStaticGitContext_Change() {
+	changeUrl := "https://gerrit.example.com/r/c/platform/build/+/12345/2"
+	changeContext := &StaticGitContext{
+		Id:       "platform/build",
+		Name:     "build",
+		Owner:    "platform",
+		Url:      "https://gerrit.example.com/r/a/platform/build",
+		Source:   "gerrit.example.com",
+		Branch:   nil,
+		Sha:      nil,
+		PrNumber: &[]uint32{12345}[0],
+		Path:     nil,
+	}
+
+	require := g.Require()
+
+	httpContext, err := g.gitProvider.parseStaticGitContext(changeUrl)
+
+	require.Nil(err)
+	require.Equal(changeContext, httpContext)
+}
+
+func (g *GerritGitProviderTestSuite) TestParseStaticGitContext_Branch() {
+	branchUrl := "https://gerrit.example.com/r/plugins/gitiles/platform/build/+/refs/heads/release/1.0"
+	branchContext := &StaticGitContext{
+		Id:       "platform/build",
+		Name:     "build",
+		Owner:    "platform",
+		Url:      "https://gerrit.example.com/r/a/platform/build",
+		Source:   "gerrit.example.com",
+		Branch:   &[]string{"release/1.0"}[0],
+		Sha:      nil,
+		PrNumber: nil,
+		Path:     nil,
+	}
+
+	require := g.Require()
+
+	httpContext, err := g.gitProvider.parseStaticGitContext(branchUrl)
+
+	require.Nil(err)
+	require.Equal(branchContext, httpContext)
+}
+
+func (g *GerritGitProviderTestSuite) TestParseStaticGitContext_Commit() {
+	commitUrl := "https://gerrit.example.com/r/plugins/gitiles/project/+/2d9c8f8f0c3b5a1e7d6a4b3c2e1f0a9b8c7d6e5f"
+	commitContext := &StaticGitContext{
+		Id:       "project",
+		Name:     "project",
+		Owner:    "",
+		Url:      "https://gerrit.example.com/r/a/project",
+		Source:   "gerrit.example.com",
+		Branch:   &[]string{"2d9c8f8f0c3b5a1e7d6a4b3c2e1f0a9b8c7d6e5f"}[0],
+		Sha:      &[]string{"2d9c8f8f0c3b5a1e7d6a4b3c2e1f0a9b8c7d6e5f"}[0],
+		PrNumber: nil,
+		Path:     nil,
+	}
+
+	require := g.Require()
+
+	httpContext, err := g.gitProvider.parseStaticGitContext(commitUrl)
+
+	require.Nil(err)
+	require.Equal(commitContext, httpContext)
+}
+
+func (g *GerritGitProviderTestSuite) TestParseStaticGitContext_Clone() {
+	cloneContext := &StaticGitContext{
+		Id:       "platform/build",
+		Name:     "build",
+		Owner:    "platform",
+		Url:      "https://gerrit.example.com/r/a/platform/build",
+		Source:   "gerrit.example.com",
+		Branch:   nil,
+		Sha:      nil,
+		PrNumber: nil,
+		Path:     nil,
+	}
+
+	require := g.Require()
+
+	for _, cloneUrl := range []string{
+		"https://gerrit.example.com/r/a/platform/build",
+		"https://gerrit.example.com/r/platform/build.git",
+		"ssh://username@gerrit.example.com:29418/platform/build",
+	} {
+		httpContext, err := g.gitProvider.parseStaticGitContext(cloneUrl)
+
+		require.Nil(err)
+		require.Equal(cloneContext, httpContext)
+	}
+}
+
+func TestGerritGitProvider(t *testing.T) {
+	suite.Run(t, NewGerritGitProviderTestSuite())
+}
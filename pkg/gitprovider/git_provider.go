@@ -23,6 +23,7 @@ type StaticGitContext struct {
 	PrNumber *uint32 `json:"prNumber,omitempty"`
 	Source   string  `json:"source"`
 	Path     *string `json:"path,omitempty"`
+	Ref      *string `json:"ref,omitempty"`
 } // @name StaticGitContext
 
 type GitProvider interface {
@@ -38,6 +39,66 @@ type GitProvider interface {
 	parseStaticGitContext(repoUrl string) (*StaticGitContext, error)
 }
 
+// GitCommitLister is implemented by the git providers that can list the commit history of a repository
+type GitCommitLister interface {
+	// Lists the commits of the branch, newest first. Pages start at 1
+	GetRepoCommits(repositoryId string, namespaceId string, branch string, page int, perPage int) ([]*GitCommit, error)
+}
+
+var ErrCommitListingNotSupported = errors.New("listing commits is not supported by the git provider")
+
+// SsoAuthorizationRequiredError is returned if the token must be authorized for the SAML SSO of an organization
+// before the organization resources are accessible. Git providers may return empty lists instead of an error otherwise
+type SsoAuthorizationRequiredError struct {
+	Organization string
+	// Empty if the git provider does not report where the token can be authorized
+	AuthorizationUrl string
+}
+
+func (e *SsoAuthorizationRequiredError) Error() string {
+	message := "the git provider token is not authorized for the SSO of the organization"
+	if e.Organization != "" {
+		message = fmt.Sprintf("the git provider token is not authorized for the SSO of the %s organization", e.Organization)
+	}
+
+	if e.AuthorizationUrl == "" {
+		return message
+	}
+
+	return fmt.Sprintf("%s. Authorize it at %s and try again", message, e.AuthorizationUrl)
+}
+
+func IsSsoAuthorizationRequired(err error) bool {
+	var ssoErr *SsoAuthorizationRequiredError
+	return errors.As(err, &ssoErr)
+}
+
+// GitBranchComparer is implemented by the git providers that can compare a branch with another branch of a repository
+type GitBranchComparer interface {
+	GetDefaultBranch(repositoryId string, namespaceId string) (string, error)
+	CompareBranches(repositoryId string, namespaceId string, base string, head string) (*GitBranchComparison, error)
+}
+
+// GitFileReader is implemented by the git providers that can read a file of a repository without cloning it
+type GitFileReader interface {
+	// Reads the file from the default branch if branch is empty. Returns ErrRepoFileNotFound if the file does not exist
+	GetRepoFile(repositoryId string, namespaceId string, branch string, filePath string) ([]byte, error)
+}
+
+var (
+	ErrFileReadingNotSupported = errors.New("reading repository files is not supported by the git provider")
+	ErrRepoFileNotFound        = errors.New("repository file not found")
+)
+
+type GitBranchComparison struct {
+	// Commits on the head branch that are not on the base branch
+	Ahead int
+	// Commits on the base branch that are not on the head branch
+	Behind int
+	// Last commit of the head branch, if returned by the comparison
+	HeadCommit *GitCommit
+}
+
 type AbstractGitProvider struct {
 	GitProvider
 }
@@ -70,6 +131,7 @@ func (a *AbstractGitProvider) GetRepositoryFromUrl(repositoryUrl string) (*GitRe
 		PrNumber: staticContext.PrNumber,
 		Source:   staticContext.Source,
 		Path:     staticContext.Path,
+		Ref:      staticContext.Ref,
 	}, nil
 }
 
@@ -136,3 +198,7 @@ func getCloneUrl(source, owner, repo string, isHttps bool) string {
 	}
 	return fmt.Sprintf("%s://%s/%s/%s.git", scheme, source, owner, repo)
 }
+
+func getCommitTitle(message string) string {
+	return strings.TrimSpace(strings.SplitN(message, "\n", 2)[0])
+}
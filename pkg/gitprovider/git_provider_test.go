@@ -84,6 +84,14 @@ func (a *AbstractGitProviderTestSuite) TestParseGitContext_HTTPWithPath() {
 	require.Equal(httpContext, contextWithPath)
 }
 
+func (a *AbstractGitProviderTestSuite) TestGetCommitTitle() {
+	require := a.Require()
+
+	require.Equal("Fix the build", getCommitTitle("Fix the build\n\nThe linker flags were missing"))
+	require.Equal("Fix the build", getCommitTitle("  Fix the build  "))
+	require.Equal("", getCommitTitle(""))
+}
+
 func TestAbstractGitProvider(t *testing.T) {
 	suite.Run(t, NewAbstractGitProviderTestSuite())
 }
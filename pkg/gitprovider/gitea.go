@@ -5,9 +5,11 @@ package gitprovider
 
 import (
 	"context"
+	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"code.gitea.io/sdk/gitea"
 )
@@ -148,6 +150,12 @@ func (g *GiteaGitProvider) GetRepoBranches(repositoryId string, namespaceId stri
 		}
 		if branch.Commit != nil {
 			responseBranch.Sha = branch.Commit.ID
+			if branch.Commit.Author != nil {
+				responseBranch.Author = branch.Commit.Author.Name
+			}
+			if !branch.Commit.Timestamp.IsZero() {
+				responseBranch.Date = branch.Commit.Timestamp.Format(time.RFC3339)
+			}
 		}
 		response = append(response, responseBranch)
 	}
@@ -155,6 +163,85 @@ func (g *GiteaGitProvider) GetRepoBranches(repositoryId string, namespaceId stri
 	return response, nil
 }
 
+func (g *GiteaGitProvider) GetRepoCommits(repositoryId string, namespaceId string, branch string, page int, perPage int) ([]*GitCommit, error) {
+	client, err := g.getApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	if namespaceId == personalNamespaceId {
+		user, err := g.GetUser()
+		if err != nil {
+			return nil, err
+		}
+		namespaceId = user.Username
+	}
+
+	commits, _, err := client.ListRepoCommits(namespaceId, repositoryId, gitea.ListCommitOptions{
+		ListOptions: gitea.ListOptions{
+			Page:     page,
+			PageSize: perPage,
+		},
+		SHA: branch,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := []*GitCommit{}
+
+	for _, commit := range commits {
+		responseCommit := &GitCommit{}
+		if commit.CommitMeta != nil {
+			responseCommit.Sha = commit.SHA
+		}
+		if commit.RepoCommit != nil {
+			responseCommit.Message = getCommitTitle(commit.RepoCommit.Message)
+			if commit.RepoCommit.Author != nil {
+				responseCommit.Author = commit.RepoCommit.Author.Name
+				responseCommit.Date = commit.RepoCommit.Author.Date
+			}
+		}
+		response = append(response, responseCommit)
+	}
+
+	return response, nil
+}
+
+func (g *GiteaGitProvider) GetRepoFile(repositoryId string, namespaceId string, branch string, filePath string) ([]byte, error) {
+	client, err := g.getApiClient()
+	if err != nil {
+		return nil, err
+	}
+
+	if namespaceId == personalNamespaceId {
+		user, err := g.GetUser()
+		if err != nil {
+			return nil, err
+		}
+		namespaceId = user.Username
+	}
+
+	// The raw file endpoint requires a ref
+	if branch == "" {
+		repo, _, err := client.GetRepo(namespaceId, repositoryId)
+		if err != nil {
+			return nil, err
+		}
+		branch = repo.DefaultBranch
+	}
+
+	content, res, err := client.GetFile(namespaceId, repositoryId, branch, filePath)
+	if err != nil {
+		if res != nil && res.StatusCode == http.StatusNotFound {
+			return nil, ErrRepoFileNotFound
+		}
+		return nil, err
+	}
+
+	return content, nil
+}
+
 func (g *GiteaGitProvider) GetRepoPRs(repositoryId string, namespaceId string) ([]*GitPullRequest, error) {
 	client, err := g.getApiClient()
 	if err != nil {
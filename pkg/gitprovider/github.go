@@ -5,9 +5,13 @@ package gitprovider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/go-github/github"
 	"golang.org/x/oauth2"
@@ -18,6 +22,7 @@ type GitHubGitProvider struct {
 
 	token      string
 	baseApiUrl *string
+	app        *GitHubAppConfig
 }
 
 func NewGitHubGitProvider(token string, baseApiUrl *string) *GitHubGitProvider {
@@ -31,6 +36,35 @@ func NewGitHubGitProvider(token string, baseApiUrl *string) *GitHubGitProvider {
 	return gitProvider
 }
 
+func NewGitHubAppGitProvider(app *GitHubAppConfig, baseApiUrl *string) *GitHubGitProvider {
+	gitProvider := NewGitHubGitProvider("", baseApiUrl)
+	gitProvider.app = app
+
+	return gitProvider
+}
+
+// GetToken returns the token used for git operations.
+// For GitHub App installations, a cached installation token is returned and renewed once it expires.
+func (g *GitHubGitProvider) GetToken() (string, error) {
+	token, _, err := g.GetTokenWithExpiry()
+	return token, err
+}
+
+// GetTokenWithExpiry is the same as GetToken but also returns when the installation token expires.
+// The expiry is zero for personal access tokens
+func (g *GitHubGitProvider) GetTokenWithExpiry() (string, time.Time, error) {
+	if g.app == nil {
+		return g.token, time.Time{}, nil
+	}
+
+	token, err := getGitHubAppTokenSource(g.app, g.baseApiUrl).Token()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return token.AccessToken, token.Expiry, nil
+}
+
 func (g *GitHubGitProvider) GetNamespaces() ([]*GitNamespace, error) {
 	client := g.getApiClient()
 	user, err := g.GetUser()
@@ -79,7 +113,7 @@ func (g *GitHubGitProvider) GetRepositories(namespace string) ([]*GitRepository,
 		query += "org:" + namespace
 	}
 
-	repoList, _, err := client.Search.Repositories(context.Background(), query, &github.SearchOptions{
+	repoList, res, err := client.Search.Repositories(context.Background(), query, &github.SearchOptions{
 		ListOptions: github.ListOptions{
 			PerPage: 100,
 			Page:    1,
@@ -87,7 +121,15 @@ func (g *GitHubGitProvider) GetRepositories(namespace string) ([]*GitRepository,
 	})
 
 	if err != nil {
-		return nil, err
+		return nil, g.getSsoError(err, namespace)
+	}
+
+	// Repositories of organizations that require SSO authorization are omitted from search results
+	if namespace != personalNamespaceId && len(repoList.Repositories) == 0 && isSsoPartialResponse(res) {
+		return nil, &SsoAuthorizationRequiredError{
+			Organization:     namespace,
+			AuthorizationUrl: g.getSsoAuthorizationUrl(namespace),
+		}
 	}
 
 	for _, repo := range repoList.Repositories {
@@ -123,7 +165,7 @@ func (g *GitHubGitProvider) GetRepoBranches(repositoryId string, namespaceId str
 
 	repoBranches, _, err := client.Repositories.ListBranches(context.Background(), namespaceId, repositoryId, &github.ListOptions{})
 	if err != nil {
-		return nil, err
+		return nil, g.getSsoError(err, namespaceId)
 	}
 
 	for _, branch := range repoBranches {
@@ -139,6 +181,146 @@ func (g *GitHubGitProvider) GetRepoBranches(repositoryId string, namespaceId str
 	return response, nil
 }
 
+func (g *GitHubGitProvider) GetRepoCommits(repositoryId string, namespaceId string, branch string, page int, perPage int) ([]*GitCommit, error) {
+	client := g.getApiClient()
+
+	if namespaceId == personalNamespaceId {
+		user, err := g.GetUser()
+		if err != nil {
+			return nil, err
+		}
+		namespaceId = user.Username
+	}
+
+	commits, _, err := client.Repositories.ListCommits(context.Background(), namespaceId, repositoryId, &github.CommitsListOptions{
+		SHA: branch,
+		ListOptions: github.ListOptions{
+			Page:    page,
+			PerPage: perPage,
+		},
+	})
+	if err != nil {
+		return nil, g.getSsoError(err, namespaceId)
+	}
+
+	response := []*GitCommit{}
+
+	for _, commit := range commits {
+		responseCommit := &GitCommit{
+			Sha: commit.GetSHA(),
+		}
+		if commit.Commit != nil {
+			responseCommit.Message = getCommitTitle(commit.Commit.GetMessage())
+			if commit.Commit.Author != nil {
+				responseCommit.Author = commit.Commit.Author.GetName()
+				if commit.Commit.Author.Date != nil {
+					responseCommit.Date = commit.Commit.Author.Date.Format(time.RFC3339)
+				}
+			}
+		}
+		response = append(response, responseCommit)
+	}
+
+	return response, nil
+}
+
+func (g *GitHubGitProvider) GetRepoFile(repositoryId string, namespaceId string, branch string, filePath string) ([]byte, error) {
+	client := g.getApiClient()
+
+	if namespaceId == personalNamespaceId {
+		user, err := g.GetUser()
+		if err != nil {
+			return nil, err
+		}
+		namespaceId = user.Username
+	}
+
+	fileContent, _, _, err := client.Repositories.GetContents(context.Background(), namespaceId, repositoryId, filePath, &github.RepositoryContentGetOptions{
+		Ref: branch,
+	})
+	if err != nil {
+		var errResponse *github.ErrorResponse
+		if errors.As(err, &errResponse) && errResponse.Response != nil && errResponse.Response.StatusCode == http.StatusNotFound {
+			return nil, ErrRepoFileNotFound
+		}
+		return nil, g.getSsoError(err, namespaceId)
+	}
+
+	// Directories are returned as a directory listing instead of the file content
+	if fileContent == nil {
+		return nil, ErrRepoFileNotFound
+	}
+
+	content, err := fileContent.GetContent()
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(content), nil
+}
+
+func (g *GitHubGitProvider) GetDefaultBranch(repositoryId string, namespaceId string) (string, error) {
+	client := g.getApiClient()
+
+	if namespaceId == personalNamespaceId {
+		user, err := g.GetUser()
+		if err != nil {
+			return "", err
+		}
+		namespaceId = user.Username
+	}
+
+	repo, _, err := client.Repositories.Get(context.Background(), namespaceId, repositoryId)
+	if err != nil {
+		return "", err
+	}
+
+	return repo.GetDefaultBranch(), nil
+}
+
+func (g *GitHubGitProvider) CompareBranches(repositoryId string, namespaceId string, base string, head string) (*GitBranchComparison, error) {
+	client := g.getApiClient()
+
+	if namespaceId == personalNamespaceId {
+		user, err := g.GetUser()
+		if err != nil {
+			return nil, err
+		}
+		namespaceId = user.Username
+	}
+
+	comparison, _, err := client.Repositories.CompareCommits(context.Background(), namespaceId, repositoryId, base, head)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GitBranchComparison{
+		Ahead:  comparison.GetAheadBy(),
+		Behind: comparison.GetBehindBy(),
+	}
+
+	// The head is the merge base if it has no commits of its own
+	headCommit := comparison.MergeBaseCommit
+	if len(comparison.Commits) > 0 {
+		headCommit = &comparison.Commits[len(comparison.Commits)-1]
+	}
+
+	if headCommit != nil && headCommit.Commit != nil {
+		response.HeadCommit = &GitCommit{
+			Sha:     headCommit.GetSHA(),
+			Message: getCommitTitle(headCommit.Commit.GetMessage()),
+		}
+		if headCommit.Commit.Author != nil {
+			response.HeadCommit.Author = headCommit.Commit.Author.GetName()
+			if headCommit.Commit.Author.Date != nil {
+				response.HeadCommit.Date = headCommit.Commit.Author.Date.Format(time.RFC3339)
+			}
+		}
+	}
+
+	return response, nil
+}
+
 func (g *GitHubGitProvider) GetRepoPRs(repositoryId string, namespaceId string) ([]*GitPullRequest, error) {
 	client := g.getApiClient()
 
@@ -156,7 +338,7 @@ func (g *GitHubGitProvider) GetRepoPRs(repositoryId string, namespaceId string)
 		State: "open",
 	})
 	if err != nil {
-		return nil, err
+		return nil, g.getSsoError(err, namespaceId)
 	}
 
 	for _, pr := range prList {
@@ -175,6 +357,10 @@ func (g *GitHubGitProvider) GetRepoPRs(repositoryId string, namespaceId string)
 }
 
 func (g *GitHubGitProvider) GetUser() (*GitUser, error) {
+	if g.app != nil {
+		return g.getAppInstallationUser()
+	}
+
 	client := g.getApiClient()
 
 	user, _, err := client.Users.Get(context.Background(), "")
@@ -232,21 +418,54 @@ func (g *GitHubGitProvider) GetLastCommitSha(staticContext *StaticGitContext) (s
 	return *commits[0].SHA, nil
 }
 
+// Installation tokens can not access the authenticated user endpoint,
+// so the account the app is installed on is used instead
+func (g *GitHubGitProvider) getAppInstallationUser() (*GitUser, error) {
+	client, err := newGitHubAppJWTClient(g.app, g.baseApiUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	installation, _, err := client.Apps.GetInstallation(context.Background(), g.app.InstallationId)
+	if err != nil {
+		return nil, err
+	}
+
+	account := installation.GetAccount()
+	if account == nil {
+		return nil, errors.New("GitHub App installation has no account")
+	}
+
+	return &GitUser{
+		Id:       strconv.FormatInt(account.GetID(), 10),
+		Username: account.GetLogin(),
+		Name:     account.GetName(),
+		Email:    account.GetEmail(),
+	}, nil
+}
+
 func (g *GitHubGitProvider) getApiClient() *github.Client {
 	ctx := context.Background()
 	ts := oauth2.StaticTokenSource(
 		&oauth2.Token{AccessToken: g.token},
 	)
+	if g.app != nil {
+		ts = getGitHubAppTokenSource(g.app, g.baseApiUrl)
+	}
 	tc := oauth2.NewClient(ctx, ts)
 
-	if g.token == "" {
+	if g.token == "" && g.app == nil {
 		tc = nil
 	}
 
+	return newGitHubClient(tc, g.baseApiUrl)
+}
+
+func newGitHubClient(tc *http.Client, baseApiUrl *string) *github.Client {
 	client := github.NewClient(tc)
 
-	if g.baseApiUrl != nil {
-		trimmedUrl := strings.TrimPrefix(*g.baseApiUrl, "https://")
+	if baseApiUrl != nil {
+		trimmedUrl := strings.TrimPrefix(*baseApiUrl, "https://")
 		trimmedUrl = strings.TrimSuffix(trimmedUrl, "api/v3/")
 		trimmedUrl = strings.TrimSuffix(trimmedUrl, "/")
 
@@ -318,3 +537,47 @@ func (g *GitHubGitProvider) parseStaticGitContext(repoUrl string) (*StaticGitCon
 
 	return staticContext, nil
 }
+
+const ssoHeader = "X-GitHub-SSO"
+
+// Converts forbidden responses of organizations that require SSO authorization of the token. The header of these
+// responses has the form "required; url=<authorization URL>"
+func (g *GitHubGitProvider) getSsoError(err error, organization string) error {
+	var errResponse *github.ErrorResponse
+	if !errors.As(err, &errResponse) || errResponse.Response == nil || errResponse.Response.StatusCode != http.StatusForbidden {
+		return err
+	}
+
+	header := errResponse.Response.Header.Get(ssoHeader)
+	if !strings.HasPrefix(header, "required") {
+		return err
+	}
+
+	ssoErr := &SsoAuthorizationRequiredError{
+		Organization:     organization,
+		AuthorizationUrl: g.getSsoAuthorizationUrl(organization),
+	}
+
+	for _, part := range strings.Split(header, ";") {
+		if authorizationUrl, ok := strings.CutPrefix(strings.TrimSpace(part), "url="); ok {
+			ssoErr.AuthorizationUrl = authorizationUrl
+		}
+	}
+
+	return ssoErr
+}
+
+// List responses have the "partial-results; organizations=<ids>" header if results of organizations that require SSO
+// authorization of the token are omitted
+func isSsoPartialResponse(res *github.Response) bool {
+	return res != nil && res.Response != nil && strings.HasPrefix(res.Header.Get(ssoHeader), "partial-results")
+}
+
+func (g *GitHubGitProvider) getSsoAuthorizationUrl(organization string) string {
+	// GitHub Enterprise Server does not have a common authorization page
+	if g.baseApiUrl != nil {
+		return ""
+	}
+
+	return fmt.Sprintf("https://github.com/orgs/%s/sso", organization)
+}
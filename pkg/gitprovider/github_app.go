@@ -0,0 +1,152 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitprovider
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/github"
+	"golang.org/x/oauth2"
+)
+
+// Installation tokens are cached per app installation so they are reused across
+// git provider instances until they expire
+var gitHubAppTokenSources = map[gitHubAppTokenSourceKey]oauth2.TokenSource{}
+var gitHubAppTokenSourcesMutex sync.Mutex
+
+type gitHubAppTokenSourceKey struct {
+	app        GitHubAppConfig
+	baseApiUrl string
+}
+
+type gitHubAppTokenSource struct {
+	app        GitHubAppConfig
+	baseApiUrl *string
+}
+
+func getGitHubAppTokenSource(app *GitHubAppConfig, baseApiUrl *string) oauth2.TokenSource {
+	key := gitHubAppTokenSourceKey{app: *app}
+	if baseApiUrl != nil {
+		key.baseApiUrl = *baseApiUrl
+	}
+
+	gitHubAppTokenSourcesMutex.Lock()
+	defer gitHubAppTokenSourcesMutex.Unlock()
+
+	if ts, ok := gitHubAppTokenSources[key]; ok {
+		return ts
+	}
+
+	ts := oauth2.ReuseTokenSource(nil, &gitHubAppTokenSource{
+		app:        *app,
+		baseApiUrl: baseApiUrl,
+	})
+	gitHubAppTokenSources[key] = ts
+
+	return ts
+}
+
+// Token mints a new installation token using the app JWT
+func (s *gitHubAppTokenSource) Token() (*oauth2.Token, error) {
+	client, err := newGitHubAppJWTClient(&s.app, s.baseApiUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	installationToken, _, err := client.Apps.CreateInstallationToken(context.Background(), s.app.InstallationId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create installation token: %w", err)
+	}
+
+	return &oauth2.Token{
+		AccessToken: installationToken.GetToken(),
+		Expiry:      installationToken.GetExpiresAt(),
+	}, nil
+}
+
+func newGitHubAppJWTClient(app *GitHubAppConfig, baseApiUrl *string) (*github.Client, error) {
+	jwt, err := createGitHubAppJWT(app)
+	if err != nil {
+		return nil, err
+	}
+
+	ts := oauth2.StaticTokenSource(
+		&oauth2.Token{AccessToken: jwt},
+	)
+
+	return newGitHubClient(oauth2.NewClient(context.Background(), ts), baseApiUrl), nil
+}
+
+func createGitHubAppJWT(app *GitHubAppConfig) (string, error) {
+	privateKey, err := parseGitHubAppPrivateKey(app.PrivateKey)
+	if err != nil {
+		return "", err
+	}
+
+	header, err := json.Marshal(map[string]string{
+		"alg": "RS256",
+		"typ": "JWT",
+	})
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	claims, err := json.Marshal(map[string]interface{}{
+		// Issued in the past to allow for clock drift
+		"iat": now.Add(-time.Minute).Unix(),
+		"exp": now.Add(9 * time.Minute).Unix(),
+		"iss": strconv.FormatInt(app.AppId, 10),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+
+	hash := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, privateKey, crypto.SHA256, hash[:])
+	if err != nil {
+		return "", err
+	}
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func parseGitHubAppPrivateKey(privateKey string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(privateKey))
+	if block == nil {
+		return nil, errors.New("invalid GitHub App private key: PEM block not found")
+	}
+
+	key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err == nil {
+		return key, nil
+	}
+
+	parsedKey, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid GitHub App private key: %w", err)
+	}
+
+	rsaKey, ok := parsedKey.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("invalid GitHub App private key: not an RSA key")
+	}
+
+	return rsaKey, nil
+}
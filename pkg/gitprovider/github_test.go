@@ -4,8 +4,20 @@
 package gitprovider
 
 import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
 	"testing"
 
+	"github.com/google/go-github/github"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -130,6 +142,83 @@ func (g *GitHubGitProviderTestSuite) TestParseStaticGitContext_Commit() {
 	require.Equal(httpContext, commitContext)
 }
 
+func (g *GitHubGitProviderTestSuite) TestCreateGitHubAppJWT() {
+	require := g.Require()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.Nil(err)
+
+	app := &GitHubAppConfig{
+		AppId:          1234,
+		InstallationId: 5678,
+		PrivateKey: string(pem.EncodeToMemory(&pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
+		})),
+	}
+
+	jwt, err := createGitHubAppJWT(app)
+	require.Nil(err)
+
+	parts := strings.Split(jwt, ".")
+	require.Len(parts, 3)
+
+	claims, err := base64.RawURLEncoding.DecodeString(parts[1])
+	require.Nil(err)
+	require.Contains(string(claims), `"iss":"1234"`)
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	require.Nil(err)
+
+	hash := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	require.Nil(rsa.VerifyPKCS1v15(&privateKey.PublicKey, crypto.SHA256, hash[:], signature))
+}
+
+func (g *GitHubGitProviderTestSuite) TestCreateGitHubAppJWT_InvalidKey() {
+	_, err := createGitHubAppJWT(&GitHubAppConfig{PrivateKey: "invalid"})
+	g.Require().NotNil(err)
+}
+
+func (g *GitHubGitProviderTestSuite) TestGetSsoError() {
+	require := g.Require()
+
+	err := g.gitProvider.getSsoError(&github.ErrorResponse{
+		Response: &http.Response{
+			StatusCode: http.StatusForbidden,
+			Header:     http.Header{"X-Github-Sso": []string{"required; url=https://github.com/orgs/daytonaio/sso?authorization_request=abc"}},
+		},
+	}, "daytonaio")
+
+	require.True(IsSsoAuthorizationRequired(fmt.Errorf("failed to get repositories: %w", err)))
+	require.Equal(&SsoAuthorizationRequiredError{
+		Organization:     "daytonaio",
+		AuthorizationUrl: "https://github.com/orgs/daytonaio/sso?authorization_request=abc",
+	}, err)
+	require.Equal("the git provider token is not authorized for the SSO of the daytonaio organization. Authorize it at https://github.com/orgs/daytonaio/sso?authorization_request=abc and try again", err.Error())
+}
+
+func (g *GitHubGitProviderTestSuite) TestGetSsoError_NotRequired() {
+	require := g.Require()
+
+	forbiddenErr := &github.ErrorResponse{
+		Response: &http.Response{StatusCode: http.StatusForbidden, Header: http.Header{}},
+	}
+	require.Equal(forbiddenErr, g.gitProvider.getSsoError(forbiddenErr, "daytonaio"))
+
+	otherErr := errors.New("connection refused")
+	require.Equal(otherErr, g.gitProvider.getSsoError(otherErr, "daytonaio"))
+}
+
+func (g *GitHubGitProviderTestSuite) TestIsSsoPartialResponse() {
+	require := g.Require()
+
+	require.True(isSsoPartialResponse(&github.Response{
+		Response: &http.Response{Header: http.Header{"X-Github-Sso": []string{"partial-results; organizations=21955855,20582480"}}},
+	}))
+	require.False(isSsoPartialResponse(&github.Response{Response: &http.Response{Header: http.Header{}}}))
+	require.False(isSsoPartialResponse(nil))
+}
+
 func TestGitHubGitProvider(t *testing.T) {
 	suite.Run(t, NewGitHubGitProviderTestSuite())
 }
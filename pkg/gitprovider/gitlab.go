@@ -6,9 +6,11 @@ package gitprovider
 import (
 	"fmt"
 	"log"
+	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/xanzy/go-gitlab"
 )
@@ -124,6 +126,10 @@ func (g *GitLabGitProvider) GetRepoBranches(repositoryId string, namespaceId str
 		}
 		if branch.Commit != nil {
 			responseBranch.Sha = branch.Commit.ID
+			responseBranch.Author = branch.Commit.AuthorName
+			if branch.Commit.AuthoredDate != nil {
+				responseBranch.Date = branch.Commit.AuthoredDate.Format(time.RFC3339)
+			}
 		}
 		response = append(response, responseBranch)
 	}
@@ -131,6 +137,91 @@ func (g *GitLabGitProvider) GetRepoBranches(repositoryId string, namespaceId str
 	return response, nil
 }
 
+func (g *GitLabGitProvider) GetRepoCommits(repositoryId string, namespaceId string, branch string, page int, perPage int) ([]*GitCommit, error) {
+	client := g.getApiClient()
+
+	options := &gitlab.ListCommitsOptions{
+		ListOptions: gitlab.ListOptions{
+			Page:    page,
+			PerPage: perPage,
+		},
+	}
+	if branch != "" {
+		options.RefName = &branch
+	}
+
+	commits, _, err := client.Commits.ListCommits(repositoryId, options)
+	if err != nil {
+		return nil, err
+	}
+
+	response := []*GitCommit{}
+
+	for _, commit := range commits {
+		responseCommit := &GitCommit{
+			Sha:     commit.ID,
+			Message: getCommitTitle(commit.Message),
+			Author:  commit.AuthorName,
+		}
+		if commit.AuthoredDate != nil {
+			responseCommit.Date = commit.AuthoredDate.Format(time.RFC3339)
+		}
+		response = append(response, responseCommit)
+	}
+
+	return response, nil
+}
+
+func (g *GitLabGitProvider) GetRepoFile(repositoryId string, namespaceId string, branch string, filePath string) ([]byte, error) {
+	client := g.getApiClient()
+
+	options := &gitlab.GetRawFileOptions{}
+	if branch != "" {
+		options.Ref = &branch
+	}
+
+	content, res, err := client.RepositoryFiles.GetRawFile(repositoryId, filePath, options)
+	if err != nil {
+		if res != nil && res.StatusCode == http.StatusNotFound {
+			return nil, ErrRepoFileNotFound
+		}
+		return nil, err
+	}
+
+	return content, nil
+}
+
+func (g *GitLabGitProvider) GetDefaultBranch(repositoryId string, namespaceId string) (string, error) {
+	client := g.getApiClient()
+
+	project, _, err := client.Projects.GetProject(repositoryId, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return project.DefaultBranch, nil
+}
+
+func (g *GitLabGitProvider) CompareBranches(repositoryId string, namespaceId string, base string, head string) (*GitBranchComparison, error) {
+	client := g.getApiClient()
+
+	// Comparisons are made from the merge base so each direction lists the commits missing on the other branch
+	ahead, _, err := client.Repositories.Compare(repositoryId, &gitlab.CompareOptions{From: &base, To: &head})
+	if err != nil {
+		return nil, err
+	}
+
+	behind, _, err := client.Repositories.Compare(repositoryId, &gitlab.CompareOptions{From: &head, To: &base})
+	if err != nil {
+		return nil, err
+	}
+
+	return &GitBranchComparison{
+		Ahead:  len(ahead.Commits),
+		Behind: len(behind.Commits),
+	}, nil
+}
+
 func (g *GitLabGitProvider) GetRepoPRs(repositoryId string, namespaceId string) ([]*GitPullRequest, error) {
 	client := g.getApiClient()
 	var response []*GitPullRequest
@@ -209,6 +300,29 @@ func (g *GitLabGitProvider) GetLastCommitSha(staticContext *StaticGitContext) (s
 	return commits[0].ID, nil
 }
 
+// CreateProjectToken creates a project access token with read and write access to the repository.
+// GitLab only accepts an expiry date, the token expires at midnight UTC at the start of that date
+func (g *GitLabGitProvider) CreateProjectToken(repoUrl string, expiresOn time.Time) (string, time.Time, error) {
+	staticContext, err := g.parseStaticGitContext(repoUrl)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	expiresAt := gitlab.ISOTime(expiresOn.UTC().Truncate(24 * time.Hour))
+
+	token, _, err := g.getApiClient().ProjectAccessTokens.CreateProjectAccessToken(staticContext.Id, &gitlab.CreateProjectAccessTokenOptions{
+		Name:        gitlab.Ptr("daytona-git-credential"),
+		Scopes:      &[]string{"read_repository", "write_repository"},
+		AccessLevel: gitlab.Ptr(gitlab.DeveloperPermissions),
+		ExpiresAt:   &expiresAt,
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return token.Token, time.Time(expiresAt), nil
+}
+
 func (g *GitLabGitProvider) getApiClient() *gitlab.Client {
 	var client *gitlab.Client
 	var err error
@@ -109,7 +109,7 @@ func (g *GitnessGitProvider) GetRepoPRs(repositoryId string, namespaceId string)
 			Name:            pr.Title,
 			Branch:          pr.SourceBranch,
 			Sha:             pr.SourceSha,
-			SourceRepoId:    fmt.Sprintf("%d", pr.SourceRepoId),
+			SourceRepoId:    repositoryId,
 			SourceRepoUrl:   gitnessclient.GetCloneUrl(client.BaseURL.Scheme, client.BaseURL.Host, namespaceId, repositoryId),
 			SourceRepoOwner: pr.Author.DisplayName,
 			SourceRepoName:  repositoryId,
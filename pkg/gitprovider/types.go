@@ -4,12 +4,64 @@
 package gitprovider
 
 type GitProviderConfig struct {
-	Id         string  `json:"id"`
-	Username   string  `json:"username"`
-	Token      string  `json:"token"`
-	BaseApiUrl *string `json:"baseApiUrl,omitempty"`
+	Id string `json:"id"`
+	// Git provider type (e.g. github, gitlab). Empty for configs registered before multiple configs per provider were supported, in which case the ID is the provider type
+	ProviderId string           `json:"providerId,omitempty"`
+	Username   string           `json:"username"`
+	Token      string           `json:"token"`
+	BaseApiUrl *string          `json:"baseApiUrl,omitempty"`
+	GitHubApp  *GitHubAppConfig `json:"githubApp,omitempty"`
+	// Selects the config for repositories owned by the user or organization with the same name
+	Alias string `json:"alias,omitempty"`
+	// Used when several configs match a repository URL and none of them is selected by the alias
+	IsDefault bool `json:"isDefault"`
+	// Set by the server when the repository listing cache is warmed. Not persisted
+	CacheStatus *GitProviderCacheStatus `json:"cacheStatus,omitempty"`
+	// ID of the user who registered the config. Empty for configs registered before users were introduced
+	OwnerId string `json:"ownerId,omitempty"`
 } // @name GitProvider
 
+type GitProviderCacheState string // @name GitProviderCacheState
+
+const (
+	GitProviderCacheStateWarming GitProviderCacheState = "warming"
+	GitProviderCacheStateReady   GitProviderCacheState = "ready"
+	GitProviderCacheStateFailed  GitProviderCacheState = "failed"
+)
+
+// GitProviderCacheStatus reports the progress of fetching the namespace and repository lists in the background
+type GitProviderCacheStatus struct {
+	State GitProviderCacheState `json:"state" validate:"required"`
+	// RFC3339 time the cache was last filled
+	UpdatedAt string `json:"updatedAt,omitempty"`
+	Error     string `json:"error,omitempty"`
+} // @name GitProviderCacheStatus
+
+func (c *GitProviderConfig) GetProviderId() string {
+	if c.ProviderId != "" {
+		return c.ProviderId
+	}
+
+	return c.Id
+}
+
+// GitHubAppConfig holds the credentials of a GitHub App installation.
+// When set, short-lived installation tokens are used instead of a personal access token.
+type GitHubAppConfig struct {
+	AppId          int64  `json:"appId" validate:"required"`
+	InstallationId int64  `json:"installationId" validate:"required"`
+	PrivateKey     string `json:"privateKey" validate:"required"`
+} // @name GitHubAppConfig
+
+// GitCredential is issued to the git credential helper inside projects
+type GitCredential struct {
+	Username string `json:"username" validate:"required"`
+	Password string `json:"password" validate:"required"`
+	// RFC3339 time at which the token expires and git requests the credential again.
+	// Empty for personal access tokens, which do not expire on their own
+	ExpiresAt string `json:"expiresAt,omitempty"`
+} // @name GitCredential
+
 type GitUser struct {
 	Id       string `json:"id"`
 	Username string `json:"username"`
@@ -27,6 +79,12 @@ type GitRepository struct {
 	PrNumber *uint32 `json:"prNumber,omitempty"`
 	Source   string  `json:"source"`
 	Path     *string `json:"path,omitempty"`
+	// Branch created from Branch after the repository is cloned
+	NewBranch *string `json:"newBranch,omitempty"`
+	// Check out exactly Sha instead of the branch head
+	Pinned bool `json:"pinned,omitempty"`
+	// Fetched and checked out after the repository is cloned. Set for change requests that are not available as a branch
+	Ref *string `json:"ref,omitempty"`
 } // @name GitRepository
 
 type GitNamespace struct {
@@ -36,9 +94,28 @@ type GitNamespace struct {
 
 type GitBranch struct {
 	Name string `json:"name"`
-	Sha  string `json:"sha"`
+	// SHA of the last commit
+	Sha string `json:"sha"`
+	// Author of the last commit
+	Author string `json:"author,omitempty"`
+	// RFC3339 authoring date of the last commit
+	Date string `json:"date,omitempty"`
+	// Commits on the branch that are not on the default branch. Not set if the git provider can not compare branches
+	Ahead *int `json:"ahead,omitempty"`
+	// Commits on the default branch that are not on the branch
+	Behind *int `json:"behind,omitempty"`
 } // @name GitBranch
 
+type GitCommit struct {
+	Sha string `json:"sha" validate:"required"`
+	// First line of the commit message
+	Message string `json:"message" validate:"required"`
+	Author  string `json:"author" validate:"required"`
+	// RFC3339 authoring date
+	Date string `json:"date" validate:"required"`
+} // @name GitCommit
+
+// GitPullRequest is a change request of a repository, e.g. a pull request, a merge request or a Gerrit change
 type GitPullRequest struct {
 	Name            string `json:"name"`
 	Branch          string `json:"branch"`
@@ -47,4 +124,6 @@ type GitPullRequest struct {
 	SourceRepoUrl   string `json:"sourceRepoUrl"`
 	SourceRepoOwner string `json:"sourceRepoOwner"`
 	SourceRepoName  string `json:"sourceRepoName"`
+	// Ref the change request is checked out from when it is not available as a branch, e.g. refs/changes/45/12345/2
+	Ref string `json:"ref,omitempty"`
 } // @name GitPullRequest
@@ -13,6 +13,7 @@ import (
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/internal/cmd/tailscale"
 	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/internal/util/apiclient"
 	"github.com/daytonaio/daytona/pkg/ports"
 	"github.com/daytonaio/daytona/pkg/views"
 
@@ -22,13 +23,19 @@ import (
 
 const startVSCodeServerCommand = "$HOME/vscode-server/bin/openvscode-server --start-server --port=63000 --host=0.0.0.0 --without-connection-token --disable-workspace-trust --default-folder="
 
-func OpenBrowserIDE(activeProfile config.Profile, workspaceId string, projectName string) error {
+// The project directory is opened if projectDir is empty
+func OpenBrowserIDE(activeProfile config.Profile, workspaceId string, projectName string, projectDir string) error {
 	// Download and start IDE
 	err := config.EnsureSshConfigEntryAdded(activeProfile.Id, workspaceId, projectName)
 	if err != nil {
 		return err
 	}
 
+	err = apiclient.EnsureSshCertificate(activeProfile, workspaceId, projectName)
+	if err != nil {
+		return err
+	}
+
 	views.RenderInfoMessageBold("Downloading OpenVSCode Server...")
 	projectHostname := config.GetProjectHostname(activeProfile.Id, workspaceId, projectName)
 
@@ -41,9 +48,11 @@ func OpenBrowserIDE(activeProfile config.Profile, workspaceId string, projectNam
 		return err
 	}
 
-	projectDir, err := util.GetProjectDir(activeProfile, workspaceId, projectName)
-	if err != nil {
-		return err
+	if projectDir == "" {
+		projectDir, err = util.GetProjectDir(activeProfile, workspaceId, projectName)
+		if err != nil {
+			return err
+		}
 	}
 
 	views.RenderInfoMessageBold("Starting OpenVSCode Server...")
@@ -16,18 +16,27 @@ import (
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/internal/jetbrains"
 	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/internal/util/apiclient"
 	ssh_config "github.com/daytonaio/daytona/pkg/agent/ssh/config"
 	ospkg "github.com/daytonaio/daytona/pkg/os"
 	"github.com/daytonaio/daytona/pkg/views"
 	"github.com/pkg/browser"
 )
 
-func OpenJetbrainsIDE(activeProfile config.Profile, ide, workspaceId, projectName string) error {
-	projectDir, err := util.GetProjectDir(activeProfile, workspaceId, projectName)
+// The project directory is opened if projectDir is empty
+func OpenJetbrainsIDE(activeProfile config.Profile, ide, workspaceId, projectName, projectDir string) error {
+	err := apiclient.EnsureSshCertificate(activeProfile, workspaceId, projectName)
 	if err != nil {
 		return err
 	}
 
+	if projectDir == "" {
+		projectDir, err = util.GetProjectDir(activeProfile, workspaceId, projectName)
+		if err != nil {
+			return err
+		}
+	}
+
 	projectHostname := config.GetProjectHostname(activeProfile.Id, workspaceId, projectName)
 
 	jbIde, ok := jetbrains.GetIdes()[jetbrains.Id(ide)]
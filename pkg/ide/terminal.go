@@ -8,6 +8,7 @@ import (
 	"os/exec"
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
+	"github.com/daytonaio/daytona/internal/util/apiclient"
 )
 
 func OpenTerminalSsh(activeProfile config.Profile, workspaceId string, projectName string) error {
@@ -16,6 +17,11 @@ func OpenTerminalSsh(activeProfile config.Profile, workspaceId string, projectNa
 		return err
 	}
 
+	err = apiclient.EnsureSshCertificate(activeProfile, workspaceId, projectName)
+	if err != nil {
+		return err
+	}
+
 	projectHostname := config.GetProjectHostname(activeProfile.Id, workspaceId, projectName)
 
 	sshCommand := exec.Command("ssh", projectHostname)
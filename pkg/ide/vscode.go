@@ -9,20 +9,29 @@ import (
 
 	"github.com/daytonaio/daytona/cmd/daytona/config"
 	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/internal/util/apiclient"
 
 	log "github.com/sirupsen/logrus"
 )
 
-func OpenVSCode(activeProfile config.Profile, workspaceId string, projectName string) error {
+// The project directory is opened if projectDir is empty
+func OpenVSCode(activeProfile config.Profile, workspaceId string, projectName string, projectDir string) error {
 	checkAndAlertVSCodeInstalled()
 
 	projectHostname := config.GetProjectHostname(activeProfile.Id, workspaceId, projectName)
 
-	projectDir, err := util.GetProjectDir(activeProfile, workspaceId, projectName)
+	err := apiclient.EnsureSshCertificate(activeProfile, workspaceId, projectName)
 	if err != nil {
 		return err
 	}
 
+	if projectDir == "" {
+		projectDir, err = util.GetProjectDir(activeProfile, workspaceId, projectName)
+		if err != nil {
+			return err
+		}
+	}
+
 	commandArgument := fmt.Sprintf("vscode-remote://ssh-remote+%s/%s", projectHostname, projectDir)
 
 	var vscCommand *exec.Cmd = exec.Command("code", "--folder-uri", commandArgument, "--disable-extension", "ms-vscode-remote.remote-containers")
@@ -0,0 +1,156 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+var ErrInvalidLogFilter = errors.New("invalid log filter")
+
+// Level reported by logrus in the logfmt (level=error) and the text (ERRO[0001]) formats
+var (
+	logfmtLevelRegex = regexp.MustCompile(`\blevel="?([a-zA-Z]+)`)
+	textLevelRegex   = regexp.MustCompile(`^(PANI|FATA|ERRO|WARN|INFO|DEBU|TRAC)\[`)
+	ansiRegex        = regexp.MustCompile("\x1b\\[[0-9;]*m")
+)
+
+var textLevels = map[string]logrus.Level{
+	"PANI": logrus.PanicLevel,
+	"FATA": logrus.FatalLevel,
+	"ERRO": logrus.ErrorLevel,
+	"WARN": logrus.WarnLevel,
+	"INFO": logrus.InfoLevel,
+	"DEBU": logrus.DebugLevel,
+	"TRAC": logrus.TraceLevel,
+}
+
+// LogFilter selects the log entries returned by the log readers
+type LogFilter struct {
+	Pattern *regexp.Regexp
+	// Entries without a severity are always matched
+	MinLevel *logrus.Level
+	// Entries without a timestamp are always matched
+	Since *time.Time
+	Until *time.Time
+}
+
+// ParseLogFilter returns nil if no filter is set. since and until are either RFC3339 timestamps or durations before now (e.g. 1h)
+func ParseLogFilter(pattern, minLevel, since, until string) (*LogFilter, error) {
+	if pattern == "" && minLevel == "" && since == "" && until == "" {
+		return nil, nil
+	}
+
+	filter := &LogFilter{}
+
+	if pattern != "" {
+		regex, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidLogFilter, err)
+		}
+		filter.Pattern = regex
+	}
+
+	if minLevel != "" {
+		level, err := logrus.ParseLevel(minLevel)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidLogFilter, err)
+		}
+		filter.MinLevel = &level
+	}
+
+	now := time.Now()
+
+	var err error
+	filter.Since, err = parseLogFilterTime(since, now)
+	if err != nil {
+		return nil, err
+	}
+
+	filter.Until, err = parseLogFilterTime(until, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return filter, nil
+}
+
+func (f *LogFilter) Match(entry LogEntry) bool {
+	if f.Pattern != nil && !f.Pattern.MatchString(entry.Msg) {
+		return false
+	}
+
+	if f.MinLevel != nil {
+		levelName := entry.Level
+		if levelName == "" {
+			levelName = getLineLevel(entry.Msg)
+		}
+
+		level, err := logrus.ParseLevel(levelName)
+		// Lower logrus levels are more severe
+		if err == nil && level > *f.MinLevel {
+			return false
+		}
+	}
+
+	if entry.Time != "" && (f.Since != nil || f.Until != nil) {
+		entryTime, err := time.Parse(time.RFC3339, entry.Time)
+		if err == nil {
+			if f.Since != nil && entryTime.Before(*f.Since) {
+				return false
+			}
+			if f.Until != nil && entryTime.After(*f.Until) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// MatchLine matches a line of the plain text server log. Server log lines have no timestamp so the time range is ignored
+func (f *LogFilter) MatchLine(line string) bool {
+	return f.Match(LogEntry{Msg: line})
+}
+
+func getLineLevel(line string) string {
+	line = ansiRegex.ReplaceAllString(line, "")
+
+	match := logfmtLevelRegex.FindStringSubmatch(line)
+	if match != nil {
+		return match[1]
+	}
+
+	match = textLevelRegex.FindStringSubmatch(strings.TrimSpace(line))
+	if match != nil {
+		return textLevels[match[1]].String()
+	}
+
+	return ""
+}
+
+func parseLogFilterTime(value string, now time.Time) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err == nil {
+		return &t, nil
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration < 0 {
+		return nil, fmt.Errorf("%w: %s is neither an RFC3339 timestamp nor a positive duration", ErrInvalidLogFilter, value)
+	}
+
+	t = now.Add(-duration)
+	return &t, nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogFilter(t *testing.T) {
+	filter, err := ParseLogFilter("", "", "", "")
+	require.NoError(t, err)
+	require.Nil(t, filter)
+
+	filter, err = ParseLogFilter("pull (failed|timeout)", "", "", "")
+	require.NoError(t, err)
+	require.True(t, filter.Match(LogEntry{Msg: "image pull failed\n"}))
+	require.False(t, filter.Match(LogEntry{Msg: "image pulled\n"}))
+
+	filter, err = ParseLogFilter("", "warn", "", "")
+	require.NoError(t, err)
+	require.True(t, filter.Match(LogEntry{Msg: "disk almost full", Level: "error"}))
+	require.False(t, filter.Match(LogEntry{Msg: "project started", Level: "info"}))
+	require.True(t, filter.Match(LogEntry{Msg: `time="2024-06-01T12:00:00Z" level=warning msg="slow clone"`}))
+	require.False(t, filter.Match(LogEntry{Msg: `time="2024-06-01T12:00:00Z" level=debug msg="cloning"`}))
+	require.True(t, filter.MatchLine("\x1b[31mERRO\x1b[0m[0012] failed to start project"))
+	require.False(t, filter.MatchLine("\x1b[36mINFO\x1b[0m[0012] project started"))
+	// Entries without a severity are not filtered out
+	require.True(t, filter.Match(LogEntry{Msg: "Cloning into 'daytona'..."}))
+
+	filter, err = ParseLogFilter("", "", "2024-06-01T12:00:00Z", "2024-06-01T13:00:00Z")
+	require.NoError(t, err)
+	require.True(t, filter.Match(LogEntry{Msg: "in range", Time: "2024-06-01T12:30:00Z"}))
+	require.False(t, filter.Match(LogEntry{Msg: "before", Time: "2024-06-01T11:59:59Z"}))
+	require.False(t, filter.Match(LogEntry{Msg: "after", Time: "2024-06-01T13:00:01Z"}))
+	require.True(t, filter.Match(LogEntry{Msg: "no time"}))
+
+	filter, err = ParseLogFilter("", "", "1h", "")
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().Add(-time.Hour), *filter.Since, time.Minute)
+	require.Nil(t, filter.Until)
+
+	_, err = ParseLogFilter("(", "", "", "")
+	require.ErrorIs(t, err, ErrInvalidLogFilter)
+
+	_, err = ParseLogFilter("", "loud", "", "")
+	require.ErrorIs(t, err, ErrInvalidLogFilter)
+
+	_, err = ParseLogFilter("", "", "yesterday", "")
+	require.ErrorIs(t, err, ErrInvalidLogFilter)
+}
@@ -11,6 +11,8 @@ var LogDelimiter = "!-#_^*|\n"
 
 type Logger interface {
 	io.WriteCloser
+	// WriteProgress writes an entry that clients can render as a progress bar
+	WriteProgress(progress LogProgress, msg string) error
 	Cleanup() error
 }
 
@@ -29,6 +31,15 @@ type LogEntry struct {
 	Msg         string `json:"msg"`
 	Level       string `json:"level"`
 	Time        string `json:"time"`
+	// Set on entries reporting the progress of a long operation. Msg holds a plain text
+	// version of the progress for clients that do not render progress bars
+	Progress *LogProgress `json:"progress,omitempty"`
+}
+
+type LogProgress struct {
+	Phase string `json:"phase"`
+	// Between 0 and 100. Negative if the progress of the phase is unknown
+	Percent int `json:"percent"`
 }
 
 type LoggerFactory interface {
@@ -39,9 +50,15 @@ type LoggerFactory interface {
 }
 
 type loggerFactoryImpl struct {
-	logsDir string
+	logsDir   string
+	retention *RetentionConfig
 }
 
 func NewLoggerFactory(logsDir string) LoggerFactory {
 	return &loggerFactoryImpl{logsDir: logsDir}
 }
+
+// NewLoggerFactoryWithRetention creates a logger factory that rotates the log files according to the retention config
+func NewLoggerFactoryWithRetention(logsDir string, retention RetentionConfig) LoggerFactory {
+	return &loggerFactoryImpl{logsDir: logsDir, retention: &retention}
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/sirupsen/logrus"
 )
@@ -16,46 +17,51 @@ type projectLogger struct {
 	logsDir     string
 	workspaceId string
 	projectName string
-	logFile     *os.File
+	logFile     *rotatingFile
+	retention   *RetentionConfig
 	logger      *logrus.Logger
 	source      LogSource
 }
 
 func (pl *projectLogger) Write(p []byte) (n int, err error) {
-	if pl.logFile == nil {
-		filePath := filepath.Join(pl.logsDir, pl.workspaceId, pl.projectName, "log")
-		err = os.MkdirAll(filepath.Dir(filePath), 0755)
-		if err != nil {
-			return len(p), err
-		}
+	return len(p), pl.writeEntry(string(p), nil)
+}
+
+func (pl *projectLogger) WriteProgress(progress LogProgress, msg string) error {
+	return pl.writeEntry(msg, &progress)
+}
 
-		logFile, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+func (pl *projectLogger) writeEntry(msg string, progress *LogProgress) error {
+	if pl.logFile == nil {
+		logFile, err := openRotatingFile(filepath.Join(pl.logsDir, pl.workspaceId, pl.projectName, "log"), pl.retention)
 		if err != nil {
-			return len(p), err
+			return err
 		}
 		pl.logFile = logFile
 		pl.logger.SetOutput(pl.logFile)
 	}
 
 	var entry LogEntry
-	entry.Msg = string(p)
+	entry.Msg = msg
+	entry.Progress = progress
+	entry.Time = time.Now().Format(time.RFC3339)
 	entry.Source = string(pl.source)
 	entry.WorkspaceId = pl.workspaceId
 	entry.ProjectName = pl.projectName
 
 	b, err := json.Marshal(entry)
 	if err != nil {
-		return len(p), err
+		return err
 	}
 
 	b = append(b, []byte(LogDelimiter)...)
 
 	_, err = pl.logFile.Write(b)
 	if err != nil {
-		return len(p), err
+		return err
 	}
 
-	return len(p), nil
+	return nil
 }
 
 func (pl *projectLogger) Close() error {
@@ -86,6 +92,7 @@ func (l *loggerFactoryImpl) CreateProjectLogger(workspaceId, projectName string,
 	return &projectLogger{
 		workspaceId: workspaceId,
 		logsDir:     l.logsDir,
+		retention:   l.retention,
 		projectName: projectName,
 		logger:      logger,
 		source:      source,
@@ -94,5 +101,5 @@ func (l *loggerFactoryImpl) CreateProjectLogger(workspaceId, projectName string,
 
 func (l *loggerFactoryImpl) CreateProjectLogReader(workspaceId, projectName string) (io.Reader, error) {
 	filePath := filepath.Join(l.logsDir, workspaceId, projectName, "log")
-	return os.Open(filePath)
+	return newSegmentReader(filePath)
 }
@@ -0,0 +1,311 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sync"
+	"time"
+)
+
+// RetentionConfig limits the disk space used by workspace, project and build logs.
+// Zero values disable the corresponding limit.
+type RetentionConfig struct {
+	// Size after which a log file is rotated into a segment
+	MaxFileSizeMB uint32
+	// Number of rotated segments kept for each log file
+	MaxBackups uint32
+	// Rotated segments older than this are removed by CleanupLogs
+	MaxAgeDays uint32
+}
+
+// Serializes writes and rotations of all log files written by this process
+var rotationMutex sync.Mutex
+
+// rotatingFile appends to the log file at path and rotates it when it exceeds the configured size.
+// Writers that still hold a rotated file reopen the path before the next write.
+type rotatingFile struct {
+	path      string
+	file      *os.File
+	retention *RetentionConfig
+}
+
+func openRotatingFile(path string, retention *RetentionConfig) (*rotatingFile, error) {
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &rotatingFile{path: path, retention: retention}
+	err = f.open()
+	if err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (f *rotatingFile) Write(p []byte) (int, error) {
+	rotationMutex.Lock()
+	defer rotationMutex.Unlock()
+
+	info, err := os.Stat(f.path)
+	if err != nil || !f.isCurrent(info) {
+		err = f.open()
+		if err != nil {
+			return 0, err
+		}
+		info, err = f.file.Stat()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if f.retention != nil && f.retention.MaxFileSizeMB > 0 {
+		maxSize := int64(f.retention.MaxFileSizeMB) * 1024 * 1024
+		if info.Size() > 0 && info.Size()+int64(len(p)) > maxSize {
+			err = f.rotate()
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return f.file.Write(p)
+}
+
+func (f *rotatingFile) Close() error {
+	if f.file == nil {
+		return nil
+	}
+
+	err := f.file.Close()
+	f.file = nil
+	return err
+}
+
+func (f *rotatingFile) open() error {
+	if f.file != nil {
+		f.file.Close()
+	}
+
+	file, err := os.OpenFile(f.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	f.file = file
+	return nil
+}
+
+func (f *rotatingFile) isCurrent(info os.FileInfo) bool {
+	if f.file == nil {
+		return false
+	}
+
+	fileInfo, err := f.file.Stat()
+	if err != nil {
+		return false
+	}
+
+	return os.SameFile(info, fileInfo)
+}
+
+func (f *rotatingFile) rotate() error {
+	err := os.Rename(f.path, fmt.Sprintf("%s.%d", f.path, time.Now().UnixNano()))
+	if err != nil {
+		return err
+	}
+
+	err = f.open()
+	if err != nil {
+		return err
+	}
+
+	if f.retention.MaxBackups == 0 {
+		return nil
+	}
+
+	segments, err := getSegments(f.path)
+	if err != nil {
+		return err
+	}
+
+	for len(segments) > int(f.retention.MaxBackups) {
+		err = os.Remove(segments[0])
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		segments = segments[1:]
+	}
+
+	return nil
+}
+
+// Returns the rotated segments of the log file at path, oldest first
+func getSegments(path string) ([]string, error) {
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// Rotated segments are named "<log file>.<unix nano timestamp>"
+	segmentRegex := regexp.MustCompile(`^` + regexp.QuoteMeta(filepath.Base(path)) + `\.\d+$`)
+
+	segments := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() || !segmentRegex.MatchString(entry.Name()) {
+			continue
+		}
+		segments = append(segments, filepath.Join(filepath.Dir(path), entry.Name()))
+	}
+
+	// Timestamps have the same number of digits so the lexical order is the age order
+	slices.Sort(segments)
+
+	return segments, nil
+}
+
+// segmentReader reads the rotated segments of a log file followed by the log file itself.
+// When the log file is rotated while following it, the reader continues with the new file.
+type segmentReader struct {
+	path     string
+	segments []string
+	file     *os.File
+	// Set when the open file was rotated and the reader should switch to the new file after draining it
+	rotated bool
+}
+
+func newSegmentReader(path string) (io.Reader, error) {
+	segments, err := getSegments(path)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &segmentReader{path: path, segments: segments}
+
+	if len(segments) == 0 {
+		r.file, err = os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+func (r *segmentReader) Read(p []byte) (int, error) {
+	for {
+		if r.file == nil {
+			err := r.openNext()
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		n, err := r.file.Read(p)
+		if n > 0 || (err != nil && err != io.EOF) {
+			return n, err
+		}
+
+		if len(r.segments) > 0 || r.rotated {
+			r.file.Close()
+			r.file = nil
+			r.rotated = false
+			continue
+		}
+
+		info, statErr := os.Stat(r.path)
+		fileInfo, fileStatErr := r.file.Stat()
+		if statErr == nil && fileStatErr == nil && !os.SameFile(info, fileInfo) {
+			// Data written before the rotation still has to be read from the open file
+			r.rotated = true
+			continue
+		}
+
+		return 0, io.EOF
+	}
+}
+
+func (r *segmentReader) openNext() error {
+	for len(r.segments) > 0 {
+		segment := r.segments[0]
+		r.segments = r.segments[1:]
+
+		file, err := os.Open(segment)
+		if err == nil {
+			r.file = file
+			return nil
+		}
+
+		// The segment was removed by the retention cleanup in the meantime
+		if !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	file, err := os.Open(r.path)
+	if err != nil {
+		return err
+	}
+
+	r.file = file
+	return nil
+}
+
+// CleanupLogs removes the rotated segments in logsDir that exceed the retention limits
+func CleanupLogs(logsDir string, retention RetentionConfig) error {
+	if retention.MaxAgeDays == 0 && retention.MaxBackups == 0 {
+		return nil
+	}
+
+	maxAge := time.Duration(retention.MaxAgeDays) * 24 * time.Hour
+
+	return filepath.WalkDir(logsDir, func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		if entry.IsDir() || entry.Name() != "log" {
+			return nil
+		}
+
+		segments, err := getSegments(path)
+		if err != nil {
+			return err
+		}
+
+		for i, segment := range segments {
+			remove := retention.MaxBackups > 0 && len(segments)-i > int(retention.MaxBackups)
+
+			if !remove && maxAge > 0 {
+				info, err := os.Stat(segment)
+				if err != nil {
+					continue
+				}
+				remove = time.Since(info.ModTime()) > maxAge
+			}
+
+			if remove {
+				err = os.Remove(segment)
+				if err != nil && !os.IsNotExist(err) {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,106 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package logs
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotatingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "log")
+
+	file, err := openRotatingFile(path, &RetentionConfig{MaxFileSizeMB: 1, MaxBackups: 2})
+	require.NoError(t, err)
+	defer file.Close()
+
+	chunk := strings.Repeat("a", 600*1024)
+	for i := 0; i < 4; i++ {
+		_, err = file.Write([]byte(chunk))
+		require.NoError(t, err)
+	}
+
+	segments, err := getSegments(path)
+	require.NoError(t, err)
+	require.Len(t, segments, 2)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, int64(len(chunk)), info.Size())
+}
+
+func TestSegmentReader(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "log")
+
+	require.NoError(t, os.WriteFile(path+".1", []byte("first "), 0644))
+	require.NoError(t, os.WriteFile(path+".2", []byte("second "), 0644))
+	require.NoError(t, os.WriteFile(path, []byte("current"), 0644))
+
+	reader, err := newSegmentReader(path)
+	require.NoError(t, err)
+
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, "first second current", string(content))
+}
+
+func TestSegmentReaderFollowsRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "log")
+
+	file, err := openRotatingFile(path, &RetentionConfig{MaxFileSizeMB: 1})
+	require.NoError(t, err)
+	defer file.Close()
+
+	_, err = file.Write([]byte("before"))
+	require.NoError(t, err)
+
+	reader, err := newSegmentReader(path)
+	require.NoError(t, err)
+
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, "before", string(content))
+
+	// Written to the old file before it is rotated and then to the new file
+	_, err = file.Write([]byte(" pending"))
+	require.NoError(t, err)
+	err = file.rotate()
+	require.NoError(t, err)
+	_, err = file.Write([]byte(" after"))
+	require.NoError(t, err)
+
+	content, err = io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, " pending after", string(content))
+}
+
+func TestCleanupLogs(t *testing.T) {
+	logsDir := t.TempDir()
+	path := filepath.Join(logsDir, "workspace", "project", "log")
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+
+	require.NoError(t, os.WriteFile(path, []byte{}, 0644))
+	require.NoError(t, os.WriteFile(path+".1", []byte{}, 0644))
+	require.NoError(t, os.WriteFile(path+".2", []byte{}, 0644))
+	require.NoError(t, os.WriteFile(path+".3", []byte{}, 0644))
+
+	old := time.Now().Add(-48 * time.Hour)
+	require.NoError(t, os.Chtimes(path+".3", old, old))
+
+	err := CleanupLogs(logsDir, RetentionConfig{MaxBackups: 2, MaxAgeDays: 1})
+	require.NoError(t, err)
+
+	segments, err := getSegments(path)
+	require.NoError(t, err)
+	require.Equal(t, []string{path + ".2"}, segments)
+
+	_, err = os.Stat(path)
+	require.NoError(t, err)
+}
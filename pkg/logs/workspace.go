@@ -8,6 +8,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/sirupsen/logrus"
 )
@@ -15,44 +16,50 @@ import (
 type workspaceLogger struct {
 	logsDir     string
 	workspaceId string
-	logFile     *os.File
+	logFile     *rotatingFile
+	retention   *RetentionConfig
 	logger      *logrus.Logger
 	source      LogSource
 }
 
 func (w *workspaceLogger) Write(p []byte) (n int, err error) {
+	return len(p), w.writeEntry(string(p), nil)
+}
+
+func (w *workspaceLogger) WriteProgress(progress LogProgress, msg string) error {
+	return w.writeEntry(msg, &progress)
+}
+
+func (w *workspaceLogger) writeEntry(msg string, progress *LogProgress) error {
 	if w.logFile == nil {
-		filePath := filepath.Join(w.logsDir, w.workspaceId, "log")
-		err = os.MkdirAll(filepath.Dir(filePath), 0755)
+		logFile, err := openRotatingFile(filepath.Join(w.logsDir, w.workspaceId, "log"), w.retention)
 		if err != nil {
-			return len(p), err
-		}
-		logFile, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
-		if err != nil {
-			return len(p), err
+			return err
 		}
 		w.logFile = logFile
 		w.logger.SetOutput(w.logFile)
 	}
 
 	var entry LogEntry
-	entry.Msg = string(p)
+	entry.Msg = msg
+	entry.Progress = progress
+	entry.Time = time.Now().Format(time.RFC3339)
 	entry.Source = string(w.source)
 	entry.WorkspaceId = w.workspaceId
 
 	b, err := json.Marshal(entry)
 	if err != nil {
-		return len(p), err
+		return err
 	}
 
 	b = append(b, []byte(LogDelimiter)...)
 
 	_, err = w.logFile.Write(b)
 	if err != nil {
-		return len(p), err
+		return err
 	}
 
-	return len(p), nil
+	return nil
 }
 
 func (w *workspaceLogger) Close() error {
@@ -83,6 +90,7 @@ func (l *loggerFactoryImpl) CreateWorkspaceLogger(workspaceId string, source Log
 	return &workspaceLogger{
 		workspaceId: workspaceId,
 		logsDir:     l.logsDir,
+		retention:   l.retention,
 		logger:      logger,
 		source:      source,
 	}
@@ -90,5 +98,5 @@ func (l *loggerFactoryImpl) CreateWorkspaceLogger(workspaceId string, source Log
 
 func (l *loggerFactoryImpl) CreateWorkspaceLogReader(workspaceId string) (io.Reader, error) {
 	filePath := filepath.Join(l.logsDir, workspaceId, "log")
-	return os.Open(filePath)
+	return newSegmentReader(filePath)
 }
@@ -0,0 +1,146 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package objectstorage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+)
+
+const unsignedPayload = "UNSIGNED-PAYLOAD"
+
+// S3Config holds the location and credentials of an S3-compatible bucket, e.g. AWS S3, MinIO or Cloudflare R2
+type S3Config struct {
+	// Base URL of the storage API, e.g. https://s3.eu-central-1.amazonaws.com
+	Endpoint        string `json:"endpoint" validate:"required"`
+	Region          string `json:"region" validate:"required"`
+	Bucket          string `json:"bucket" validate:"required"`
+	AccessKeyId     string `json:"accessKeyId" validate:"required"`
+	SecretAccessKey string `json:"secretAccessKey" validate:"required"`
+} // @name S3Config
+
+var ErrObjectNotFound = errors.New("object not found")
+
+func IsObjectNotFound(err error) bool {
+	return err.Error() == ErrObjectNotFound.Error()
+}
+
+// S3Client stores objects in a bucket using path-style requests signed with AWS Signature Version 4
+type S3Client struct {
+	config     S3Config
+	httpClient *http.Client
+	signer     *v4.Signer
+}
+
+func NewS3Client(config S3Config) *S3Client {
+	return &S3Client{
+		config:     config,
+		httpClient: http.DefaultClient,
+		signer: v4.NewSigner(func(options *v4.SignerOptions) {
+			// S3 object keys are signed as they are sent
+			options.DisableURIPathEscaping = true
+		}),
+	}
+}
+
+// Upload stores the content under the key. The size of the content must be known in advance
+func (c *S3Client) Upload(ctx context.Context, key string, content io.Reader, size int64) error {
+	req, err := c.newRequest(ctx, http.MethodPut, key, content)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+
+	res, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	return nil
+}
+
+// Download returns the content stored under the key. The caller must close the returned reader
+func (c *S3Client) Download(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Body, nil
+}
+
+func (c *S3Client) Delete(ctx context.Context, key string) error {
+	req, err := c.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	return nil
+}
+
+func (c *S3Client) newRequest(ctx context.Context, method, key string, body io.Reader) (*http.Request, error) {
+	endpoint, err := url.Parse(strings.TrimSuffix(c.config.Endpoint, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid storage endpoint: %w", err)
+	}
+
+	endpoint.Path = fmt.Sprintf("%s/%s/%s", endpoint.Path, c.config.Bucket, strings.TrimPrefix(key, "/"))
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Amz-Content-Sha256", unsignedPayload)
+
+	return req, nil
+}
+
+func (c *S3Client) do(req *http.Request) (*http.Response, error) {
+	err := c.signer.SignHTTP(req.Context(), aws.Credentials{
+		AccessKeyID:     c.config.AccessKeyId,
+		SecretAccessKey: c.config.SecretAccessKey,
+	}, req, unsignedPayload, "s3", c.config.Region, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		res.Body.Close()
+		return nil, ErrObjectNotFound
+	}
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		defer res.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return nil, fmt.Errorf("storage request failed with status %d: %s", res.StatusCode, string(body))
+	}
+
+	return res, nil
+}
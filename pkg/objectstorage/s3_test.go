@@ -0,0 +1,75 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package objectstorage_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/objectstorage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestS3Client(t *testing.T) {
+	var mutex sync.Mutex
+	objects := map[string][]byte{}
+
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access-key/") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		switch r.Method {
+		case http.MethodPut:
+			content, _ := io.ReadAll(r.Body)
+			objects[r.URL.Path] = content
+		case http.MethodGet:
+			content, ok := objects[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Write(content)
+		case http.MethodDelete:
+			delete(objects, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer storage.Close()
+
+	client := objectstorage.NewS3Client(objectstorage.S3Config{
+		Endpoint:        storage.URL,
+		Region:          "us-east-1",
+		Bucket:          "archives",
+		AccessKeyId:     "access-key",
+		SecretAccessKey: "secret-key",
+	})
+	ctx := context.Background()
+
+	err := client.Upload(ctx, "workspace/project.tar.gz", strings.NewReader("content"), int64(len("content")))
+	require.Nil(t, err)
+	require.Contains(t, objects, "/archives/workspace/project.tar.gz")
+
+	reader, err := client.Download(ctx, "workspace/project.tar.gz")
+	require.Nil(t, err)
+	content, err := io.ReadAll(reader)
+	reader.Close()
+	require.Nil(t, err)
+	require.Equal(t, "content", string(content))
+
+	err = client.Delete(ctx, "workspace/project.tar.gz")
+	require.Nil(t, err)
+
+	_, err = client.Download(ctx, "workspace/project.tar.gz")
+	require.True(t, objectstorage.IsObjectNotFound(err))
+}
@@ -4,5 +4,23 @@
 package profiledata
 
 type ProfileData struct {
-	EnvVars map[string]string `json:"envVars"`
+	EnvVars     map[string]string `json:"envVars"`
+	GitIdentity *GitIdentity      `json:"gitIdentity,omitempty"`
 } // @name ProfileData
+
+type GitSigningFormat string // @name GitSigningFormat
+
+const (
+	GitSigningFormatSsh     GitSigningFormat = "ssh"
+	GitSigningFormatOpenPgp GitSigningFormat = "openpgp"
+)
+
+// GitIdentity is written to the git config of new projects. It takes precedence over the git provider user
+type GitIdentity struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	// Public SSH key or GPG key ID used to sign commits. The private key is never sent to the server,
+	// SSH keys are used through the SSH agent forwarded by 'daytona ssh' and the IDEs
+	SigningKey    string           `json:"signingKey,omitempty"`
+	SigningFormat GitSigningFormat `json:"signingFormat,omitempty"`
+} // @name GitIdentity
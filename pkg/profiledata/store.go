@@ -5,10 +5,11 @@ package profiledata
 
 import "errors"
 
+// Profile data is stored per user. Servers without users and keys created before users were introduced use an empty user ID
 type Store interface {
-	Get() (*ProfileData, error)
-	Save(profileData *ProfileData) error
-	Delete() error
+	Get(userId string) (*ProfileData, error)
+	Save(userId string, profileData *ProfileData) error
+	Delete(userId string) error
 }
 
 var (
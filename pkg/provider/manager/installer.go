@@ -4,6 +4,8 @@
 package manager
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -11,6 +13,7 @@ import (
 	goos "os"
 	"path/filepath"
 	"runtime"
+	"strings"
 
 	"github.com/daytonaio/daytona/pkg/os"
 	log "github.com/sirupsen/logrus"
@@ -40,7 +43,7 @@ func (m *ProviderManager) GetProvidersManifest() (*ProvidersManifest, error) {
 	return &manifest, nil
 }
 
-func (m *ProviderManager) DownloadProvider(downloadUrls map[os.OperatingSystem]string, providerName string, throwIfPresent bool) (string, error) {
+func (m *ProviderManager) DownloadProvider(downloadUrls map[os.OperatingSystem]string, checksums map[os.OperatingSystem]string, providerName string, throwIfPresent bool) (string, error) {
 	downloadPath := filepath.Join(m.baseDir, providerName, providerName)
 	if runtime.GOOS == "windows" {
 		downloadPath += ".exe"
@@ -65,5 +68,34 @@ func (m *ProviderManager) DownloadProvider(downloadUrls map[os.OperatingSystem]s
 		return "", err
 	}
 
+	if checksum, ok := checksums[*operatingSystem]; ok && checksum != "" {
+		err = verifyChecksum(downloadPath, checksum)
+		if err != nil {
+			goos.RemoveAll(filepath.Dir(downloadPath))
+			return "", fmt.Errorf("failed to verify provider %s: %w", providerName, err)
+		}
+	}
+
 	return downloadPath, nil
 }
+
+func verifyChecksum(path string, expectedChecksum string) error {
+	file, err := goos.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	_, err = io.Copy(hash, file)
+	if err != nil {
+		return err
+	}
+
+	checksum := hex.EncodeToString(hash.Sum(nil))
+	if !strings.EqualFold(checksum, expectedChecksum) {
+		return fmt.Errorf("checksum mismatch: expected %s, got %s", expectedChecksum, checksum)
+	}
+
+	return nil
+}
@@ -35,10 +35,12 @@ var ProviderHandshakeConfig = plugin.HandshakeConfig{
 }
 
 type IProviderManager interface {
-	DownloadProvider(downloadUrls map[os_util.OperatingSystem]string, providerName string, throwIfPresent bool) (string, error)
+	DownloadProvider(downloadUrls map[os_util.OperatingSystem]string, checksums map[os_util.OperatingSystem]string, providerName string, throwIfPresent bool) (string, error)
 	GetProvider(name string) (*Provider, error)
 	GetProviders() map[string]Provider
 	GetProvidersManifest() (*ProvidersManifest, error)
+	GetOfflineInstall(providerName string) (*OfflineInstall, error)
+	InstallProviderFromFile(path string, manifest OfflineManifest) (string, error)
 	RegisterProvider(pluginPath string) error
 	TerminateProviderProcesses(providersBasePath string) error
 	UninstallProvider(name string) error
@@ -10,6 +10,8 @@ import (
 
 type Version struct {
 	DownloadUrls map[os.OperatingSystem]string `json:"downloadUrls"`
+	// SHA256 checksums of the provider binaries
+	Checksums map[os.OperatingSystem]string `json:"checksums,omitempty"`
 }
 
 type ProvidersManifest map[string]ProviderManifest
@@ -40,6 +42,18 @@ func (p *ProviderManifest) FindLatestVersion() (string, *Version) {
 	return latestVersion, &version
 }
 
+// Returns the version tagged as "latest" if present, otherwise the highest semver version
+func (p *ProviderManifest) GetLatestVersion() (string, *Version) {
+	latestVersionName, latestVersion := p.FindLatestVersion()
+
+	version, ok := p.Versions["latest"]
+	if ok {
+		return latestVersionName, &version
+	}
+
+	return latestVersionName, latestVersion
+}
+
 func (p *ProvidersManifest) GetDefaultProviders() map[string]*Version {
 	defaultProviders := make(map[string]*Version)
 	for providerName, providerManifest := range *p {
@@ -0,0 +1,316 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package manager
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	goos "os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/os"
+)
+
+const (
+	offlineManifestFileName = "manifest.json"
+	offlineInstallFileName  = "offline-install.json"
+)
+
+var ErrInvalidProviderFile = errors.New("invalid provider file")
+
+// OfflineManifest describes a provider installed from a file. Provider tarballs contain it as manifest.json
+type OfflineManifest struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	// Paths of the provider binaries inside the tarball
+	Binaries map[os.OperatingSystem]string `json:"binaries,omitempty"`
+	// SHA256 checksums of the provider binaries
+	Checksums map[os.OperatingSystem]string `json:"checksums"`
+}
+
+// OfflineInstall is recorded in the directory of providers installed from a file
+type OfflineInstall struct {
+	Version string `json:"version"`
+	// Path of the file the provider was installed from
+	Source      string `json:"source"`
+	Checksum    string `json:"checksum"`
+	InstalledAt string `json:"installedAt"`
+}
+
+// ReadOfflineManifest returns the manifest of the provider tarball at path. Provider binaries are described by the
+// manifest at manifestPath instead
+func ReadOfflineManifest(path, manifestPath string) (*OfflineManifest, error) {
+	var manifest *OfflineManifest
+	var err error
+
+	if isTarball(path) {
+		manifest, err = readTarballManifest(path)
+	} else {
+		if manifestPath == "" {
+			return nil, fmt.Errorf("%w: a manifest is required to install a provider binary", ErrInvalidProviderFile)
+		}
+		manifest, err = readManifestFile(manifestPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if manifest.Name == "" || manifest.Name != filepath.Base(manifest.Name) {
+		return nil, fmt.Errorf("%w: invalid provider name %q", ErrInvalidProviderFile, manifest.Name)
+	}
+
+	if manifest.Version == "" {
+		return nil, fmt.Errorf("%w: the manifest has no version", ErrInvalidProviderFile)
+	}
+
+	return manifest, nil
+}
+
+// InstallProviderFromFile copies the provider binary for the server operating system from the file at path to the
+// providers directory. The binary is verified before the installed provider of the same name is uninstalled and
+// replaced. Returns the path of the installed binary
+func (m *ProviderManager) InstallProviderFromFile(path string, manifest OfflineManifest) (string, error) {
+	operatingSystem, err := os.GetOperatingSystem()
+	if err != nil {
+		return "", err
+	}
+
+	// Files are not downloaded from a trusted registry so the checksum is required
+	checksum := manifest.Checksums[*operatingSystem]
+	if checksum == "" {
+		return "", fmt.Errorf("%w: the manifest has no checksum for %s", ErrInvalidProviderFile, *operatingSystem)
+	}
+
+	binaryName := manifest.Name
+	if runtime.GOOS == "windows" {
+		binaryName += ".exe"
+	}
+
+	err = goos.MkdirAll(m.baseDir, 0755)
+	if err != nil {
+		return "", err
+	}
+
+	// The provider is staged next to the providers so it can be moved in place. Hidden directories are not
+	// registered as providers
+	stagingDir, err := goos.MkdirTemp(m.baseDir, "."+manifest.Name+"-")
+	if err != nil {
+		return "", err
+	}
+	defer goos.RemoveAll(stagingDir)
+
+	err = goos.Chmod(stagingDir, 0755)
+	if err != nil {
+		return "", err
+	}
+
+	stagingPath := filepath.Join(stagingDir, binaryName)
+
+	if isTarball(path) {
+		binaryPath := manifest.Binaries[*operatingSystem]
+		if binaryPath == "" {
+			return "", fmt.Errorf("%w: the tarball has no binary for %s", ErrInvalidProviderFile, *operatingSystem)
+		}
+		err = extractTarballFile(path, binaryPath, stagingPath)
+	} else {
+		err = copyFile(path, stagingPath)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	err = verifyChecksum(stagingPath, checksum)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrInvalidProviderFile, err)
+	}
+
+	offlineInstall, err := json.MarshalIndent(OfflineInstall{
+		Version:     manifest.Version,
+		Source:      path,
+		Checksum:    checksum,
+		InstalledAt: time.Now().Format(time.RFC3339),
+	}, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	err = goos.WriteFile(filepath.Join(stagingDir, offlineInstallFileName), offlineInstall, 0644)
+	if err != nil {
+		return "", err
+	}
+
+	providerDir := filepath.Join(m.baseDir, manifest.Name)
+
+	if _, ok := m.pluginRefs[manifest.Name]; ok {
+		err = m.UninstallProvider(manifest.Name)
+		if err != nil {
+			return "", fmt.Errorf("failed to uninstall current provider: %w", err)
+		}
+	} else {
+		// Leftovers of a provider that failed to register
+		err = goos.RemoveAll(providerDir)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	err = goos.Rename(stagingDir, providerDir)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(providerDir, binaryName), nil
+}
+
+// GetOfflineInstall returns nil if the provider was not installed from a file
+func (m *ProviderManager) GetOfflineInstall(providerName string) (*OfflineInstall, error) {
+	content, err := goos.ReadFile(filepath.Join(m.baseDir, providerName, offlineInstallFileName))
+	if err != nil {
+		if goos.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var offlineInstall OfflineInstall
+	err = json.Unmarshal(content, &offlineInstall)
+	if err != nil {
+		return nil, err
+	}
+
+	return &offlineInstall, nil
+}
+
+func isTarball(path string) bool {
+	return strings.HasSuffix(path, ".tar.gz") || strings.HasSuffix(path, ".tgz")
+}
+
+func readManifestFile(manifestPath string) (*OfflineManifest, error) {
+	file, err := goos.Open(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return decodeOfflineManifest(file)
+}
+
+func decodeOfflineManifest(reader io.Reader) (*OfflineManifest, error) {
+	var manifest OfflineManifest
+	err := json.NewDecoder(reader).Decode(&manifest)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to parse the manifest: %s", ErrInvalidProviderFile, err)
+	}
+
+	return &manifest, nil
+}
+
+func readTarballManifest(path string) (*OfflineManifest, error) {
+	var manifest *OfflineManifest
+
+	err := walkTarball(path, func(name string, reader io.Reader) (bool, error) {
+		if name != offlineManifestFileName {
+			return false, nil
+		}
+
+		var err error
+		manifest, err = decodeOfflineManifest(reader)
+		return true, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if manifest == nil {
+		return nil, fmt.Errorf("%w: %s not found in the tarball", ErrInvalidProviderFile, offlineManifestFileName)
+	}
+
+	return manifest, nil
+}
+
+func extractTarballFile(path, name, destPath string) error {
+	found := false
+
+	err := walkTarball(path, func(entryName string, reader io.Reader) (bool, error) {
+		if entryName != strings.TrimPrefix(name, "./") {
+			return false, nil
+		}
+
+		found = true
+		return true, writeFile(reader, destPath)
+	})
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("%w: %s not found in the tarball", ErrInvalidProviderFile, name)
+	}
+
+	return nil
+}
+
+// Calls fn with the regular files of the tarball until it returns true
+func walkTarball(path string, fn func(name string, reader io.Reader) (bool, error)) error {
+	file, err := goos.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	gzipReader, err := gzip.NewReader(file)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidProviderFile, err)
+	}
+	defer gzipReader.Close()
+
+	tarReader := tar.NewReader(gzipReader)
+
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidProviderFile, err)
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		done, err := fn(strings.TrimPrefix(header.Name, "./"), tarReader)
+		if err != nil || done {
+			return err
+		}
+	}
+}
+
+func copyFile(src, dest string) error {
+	file, err := goos.Open(src)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return writeFile(file, dest)
+}
+
+func writeFile(reader io.Reader, dest string) error {
+	file, err := goos.OpenFile(dest, goos.O_CREATE|goos.O_TRUNC|goos.O_WRONLY, 0755)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = io.Copy(file, reader)
+	return err
+}
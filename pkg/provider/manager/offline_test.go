@@ -0,0 +1,164 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package manager_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	os_util "github.com/daytonaio/daytona/pkg/os"
+	"github.com/daytonaio/daytona/pkg/provider/manager"
+	"github.com/stretchr/testify/require"
+)
+
+var providerBinary = []byte("#!/bin/sh\necho provider\n")
+
+func TestInstallProviderFromTarball(t *testing.T) {
+	operatingSystem, err := os_util.GetOperatingSystem()
+	require.Nil(t, err)
+
+	checksum := sha256.Sum256(providerBinary)
+	manifest := manager.OfflineManifest{
+		Name:      "test-provider",
+		Version:   "v0.1.0",
+		Binaries:  map[os_util.OperatingSystem]string{*operatingSystem: string(*operatingSystem) + "/test-provider"},
+		Checksums: map[os_util.OperatingSystem]string{*operatingSystem: hex.EncodeToString(checksum[:])},
+	}
+
+	tarballPath := writeTarball(t, manifest, map[string][]byte{string(*operatingSystem) + "/test-provider": providerBinary})
+
+	readManifest, err := manager.ReadOfflineManifest(tarballPath, "")
+	require.Nil(t, err)
+	require.Equal(t, manifest, *readManifest)
+
+	baseDir := t.TempDir()
+	providerManager := manager.NewProviderManager(manager.ProviderManagerConfig{BaseDir: baseDir})
+
+	pluginPath, err := providerManager.InstallProviderFromFile(tarballPath, *readManifest)
+	require.Nil(t, err)
+	require.Equal(t, filepath.Join(baseDir, "test-provider", "test-provider"), pluginPath)
+
+	content, err := os.ReadFile(pluginPath)
+	require.Nil(t, err)
+	require.Equal(t, providerBinary, content)
+
+	offlineInstall, err := providerManager.GetOfflineInstall("test-provider")
+	require.Nil(t, err)
+	require.Equal(t, "v0.1.0", offlineInstall.Version)
+	require.Equal(t, tarballPath, offlineInstall.Source)
+
+	offlineInstall, err = providerManager.GetOfflineInstall("other-provider")
+	require.Nil(t, err)
+	require.Nil(t, offlineInstall)
+}
+
+func TestInstallProviderFromFileFailsChecksumValidation(t *testing.T) {
+	operatingSystem, err := os_util.GetOperatingSystem()
+	require.Nil(t, err)
+
+	binaryPath := filepath.Join(t.TempDir(), "test-provider")
+	err = os.WriteFile(binaryPath, providerBinary, 0755)
+	require.Nil(t, err)
+
+	_, err = manager.ReadOfflineManifest(binaryPath, "")
+	require.ErrorIs(t, err, manager.ErrInvalidProviderFile)
+
+	manifest := manager.OfflineManifest{
+		Name:      "test-provider",
+		Version:   "v0.1.0",
+		Checksums: map[os_util.OperatingSystem]string{*operatingSystem: "invalid"},
+	}
+
+	baseDir := t.TempDir()
+	providerManager := manager.NewProviderManager(manager.ProviderManagerConfig{BaseDir: baseDir})
+
+	_, err = providerManager.InstallProviderFromFile(binaryPath, manifest)
+	require.ErrorIs(t, err, manager.ErrInvalidProviderFile)
+
+	entries, err := os.ReadDir(baseDir)
+	require.Nil(t, err)
+	require.Empty(t, entries)
+}
+
+func TestInstallProviderFromFileKeepsCurrentProvider(t *testing.T) {
+	operatingSystem, err := os_util.GetOperatingSystem()
+	require.Nil(t, err)
+
+	baseDir := t.TempDir()
+	installedPath := filepath.Join(baseDir, "test-provider", "test-provider")
+	err = os.MkdirAll(filepath.Dir(installedPath), 0755)
+	require.Nil(t, err)
+	err = os.WriteFile(installedPath, []byte("current"), 0755)
+	require.Nil(t, err)
+
+	binaryPath := filepath.Join(t.TempDir(), "test-provider")
+	err = os.WriteFile(binaryPath, providerBinary, 0755)
+	require.Nil(t, err)
+
+	providerManager := manager.NewProviderManager(manager.ProviderManagerConfig{BaseDir: baseDir})
+
+	_, err = providerManager.InstallProviderFromFile(binaryPath, manager.OfflineManifest{
+		Name:      "test-provider",
+		Version:   "v0.2.0",
+		Checksums: map[os_util.OperatingSystem]string{*operatingSystem: "invalid"},
+	})
+	require.ErrorIs(t, err, manager.ErrInvalidProviderFile)
+
+	content, err := os.ReadFile(installedPath)
+	require.Nil(t, err)
+	require.Equal(t, []byte("current"), content)
+
+	entries, err := os.ReadDir(baseDir)
+	require.Nil(t, err)
+	require.Len(t, entries, 1)
+
+	checksum := sha256.Sum256(providerBinary)
+	pluginPath, err := providerManager.InstallProviderFromFile(binaryPath, manager.OfflineManifest{
+		Name:      "test-provider",
+		Version:   "v0.2.0",
+		Checksums: map[os_util.OperatingSystem]string{*operatingSystem: hex.EncodeToString(checksum[:])},
+	})
+	require.Nil(t, err)
+	require.Equal(t, installedPath, pluginPath)
+
+	content, err = os.ReadFile(installedPath)
+	require.Nil(t, err)
+	require.Equal(t, providerBinary, content)
+
+	entries, err = os.ReadDir(baseDir)
+	require.Nil(t, err)
+	require.Len(t, entries, 1)
+}
+
+func writeTarball(t *testing.T, manifest manager.OfflineManifest, files map[string][]byte) string {
+	manifestJson, err := json.Marshal(manifest)
+	require.Nil(t, err)
+
+	path := filepath.Join(t.TempDir(), "test-provider.tar.gz")
+	file, err := os.Create(path)
+	require.Nil(t, err)
+	defer file.Close()
+
+	gzipWriter := gzip.NewWriter(file)
+	defer gzipWriter.Close()
+
+	tarWriter := tar.NewWriter(gzipWriter)
+	defer tarWriter.Close()
+
+	files["manifest.json"] = manifestJson
+	for name, content := range files {
+		err = tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0755, Size: int64(len(content)), Typeflag: tar.TypeReg})
+		require.Nil(t, err)
+		_, err = tarWriter.Write(content)
+		require.Nil(t, err)
+	}
+
+	return path
+}
@@ -0,0 +1,118 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"net/rpc"
+
+	"github.com/daytonaio/daytona/pkg/provider/util"
+	"github.com/hashicorp/go-plugin"
+)
+
+// ProgressEvent reports the progress of a long provider operation, e.g. pulling the project image
+type ProgressEvent struct {
+	// Step of the operation the progress refers to, e.g. "Pulling image"
+	Phase string
+	// Between 0 and 100. Negative if the progress of the phase is unknown
+	Percent int
+	Message string
+}
+
+// ProgressReporter receives the progress events of a provider operation
+type ProgressReporter interface {
+	ReportProgress(event ProgressEvent)
+}
+
+// ProgressReporterFunc adapts a function to the ProgressReporter interface
+type ProgressReporterFunc func(event ProgressEvent)
+
+func (f ProgressReporterFunc) ReportProgress(event ProgressEvent) {
+	f(event)
+}
+
+// ProgressStream is embedded in the requests of long operations. Providers report progress on the request
+// and the events are streamed to the Daytona Server while the operation runs
+type ProgressStream struct {
+	// ID of the plugin broker connection the events are sent over. Zero if progress is not reported
+	ProgressStreamId uint32
+	// Unexported so that it is not sent to the plugin
+	reporter ProgressReporter
+}
+
+// SetProgressReporter sets the reporter receiving the progress events of the operation
+func (s *ProgressStream) SetProgressReporter(reporter ProgressReporter) {
+	s.reporter = reporter
+}
+
+// ReportProgress is a no-op if the caller did not ask for progress or the server does not support it
+func (s *ProgressStream) ReportProgress(event ProgressEvent) {
+	if s.reporter != nil {
+		s.reporter.ReportProgress(event)
+	}
+}
+
+// Runs on the Daytona Server and receives the events sent by the provider
+type progressRPCServer struct {
+	reporter ProgressReporter
+}
+
+func (s *progressRPCServer) ReportProgress(arg ProgressEvent, resp *util.Empty) error {
+	s.reporter.ReportProgress(arg)
+	return nil
+}
+
+type progressRPCClient struct {
+	client *rpc.Client
+}
+
+func (c *progressRPCClient) ReportProgress(event ProgressEvent) {
+	// Progress is informational so failures must not fail the operation
+	_ = c.client.Call("Plugin.ReportProgress", event, new(util.Empty))
+}
+
+// Opens the progress stream of the request on the server side. The stream is closed by the provider
+// before the operation returns, so all events are received by then
+func openProgressStream(broker *plugin.MuxBroker, stream *ProgressStream) {
+	if broker == nil || stream.reporter == nil {
+		return
+	}
+
+	stream.ProgressStreamId = broker.NextId()
+
+	go func() {
+		// Providers built before progress streaming was added never connect and the accept times out
+		conn, err := broker.Accept(stream.ProgressStreamId)
+		if err != nil {
+			return
+		}
+
+		server := rpc.NewServer()
+		err = server.RegisterName("Plugin", &progressRPCServer{reporter: stream.reporter})
+		if err != nil {
+			conn.Close()
+			return
+		}
+
+		server.ServeConn(conn)
+	}()
+}
+
+// Connects to the progress stream of the request on the provider side. The returned function closes the stream
+func connectProgressStream(broker *plugin.MuxBroker, stream *ProgressStream) func() {
+	if broker == nil || stream.ProgressStreamId == 0 {
+		return func() {}
+	}
+
+	conn, err := broker.Dial(stream.ProgressStreamId)
+	if err != nil {
+		return func() {}
+	}
+
+	client := rpc.NewClient(conn)
+	stream.reporter = &progressRPCClient{client: client}
+
+	return func() {
+		client.Close()
+	}
+}
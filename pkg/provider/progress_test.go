@@ -0,0 +1,73 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider_test
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/provider/util"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/require"
+)
+
+type progressTestProvider struct {
+	provider.Provider
+}
+
+func (p *progressTestProvider) CreateProject(req *provider.ProjectRequest) (*util.Empty, error) {
+	for _, percent := range []int{0, 50, 100} {
+		req.ReportProgress(provider.ProgressEvent{
+			Phase:   "Pulling image",
+			Percent: percent,
+			Message: req.Project.Image,
+		})
+	}
+
+	return new(util.Empty), nil
+}
+
+func TestProgressStream(t *testing.T) {
+	client, _ := plugin.TestPluginRPCConn(t, map[string]plugin.Plugin{
+		"provider": &provider.ProviderPlugin{Impl: &progressTestProvider{}},
+	}, nil)
+	defer client.Close()
+
+	raw, err := client.Dispense("provider")
+	require.Nil(t, err)
+
+	events := []provider.ProgressEvent{}
+
+	req := &provider.ProjectRequest{
+		Project: &workspace.Project{Image: "test-image"},
+	}
+	req.SetProgressReporter(provider.ProgressReporterFunc(func(event provider.ProgressEvent) {
+		events = append(events, event)
+	}))
+
+	_, err = raw.(provider.Provider).CreateProject(req)
+	require.Nil(t, err)
+
+	require.Equal(t, []provider.ProgressEvent{
+		{Phase: "Pulling image", Percent: 0, Message: "test-image"},
+		{Phase: "Pulling image", Percent: 50, Message: "test-image"},
+		{Phase: "Pulling image", Percent: 100, Message: "test-image"},
+	}, events)
+}
+
+func TestProgressStreamWithoutReporter(t *testing.T) {
+	client, _ := plugin.TestPluginRPCConn(t, map[string]plugin.Plugin{
+		"provider": &provider.ProviderPlugin{Impl: &progressTestProvider{}},
+	}, nil)
+	defer client.Close()
+
+	raw, err := client.Dispense("provider")
+	require.Nil(t, err)
+
+	_, err = raw.(provider.Provider).CreateProject(&provider.ProjectRequest{
+		Project: &workspace.Project{Image: "test-image"},
+	})
+	require.Nil(t, err)
+}
@@ -17,28 +17,39 @@ type Provider interface {
 
 	GetTargetManifest() (*ProviderTargetManifest, error)
 	GetDefaultTargets() (*[]ProviderTarget, error)
+	// Checks the JSON encoded target options, e.g. credentials, quotas and region/instance type availability,
+	// and returns the detected problems. An empty result means the options are valid
+	ValidateTargetOptions(targetOptions string) (*[]TargetOptionValidationError, error)
 
+	// Long operations can report their progress with the ReportProgress method of the request
 	CreateWorkspace(*WorkspaceRequest) (*util.Empty, error)
 	StartWorkspace(*WorkspaceRequest) (*util.Empty, error)
 	StopWorkspace(*WorkspaceRequest) (*util.Empty, error)
 	DestroyWorkspace(*WorkspaceRequest) (*util.Empty, error)
 	GetWorkspaceInfo(*WorkspaceRequest) (*workspace.WorkspaceInfo, error)
+	// Returns the hourly cost of the resources provisioned for the workspace, e.g. the instance type and disks.
+	// Providers of targets with no cost return an estimate without items
+	GetWorkspaceCostEstimate(*WorkspaceRequest) (*workspace.CostEstimate, error)
 
 	CreateProject(*ProjectRequest) (*util.Empty, error)
 	StartProject(*ProjectRequest) (*util.Empty, error)
 	StopProject(*ProjectRequest) (*util.Empty, error)
 	DestroyProject(*ProjectRequest) (*util.Empty, error)
 	GetProjectInfo(*ProjectRequest) (*workspace.ProjectInfo, error)
+	// Exports the project data to the object storage and removes the project resources from the target
+	ArchiveProject(*ProjectArchiveRequest) (*util.Empty, error)
+	// Restores the project data from the object storage and recreates the project resources
+	UnarchiveProject(*ProjectArchiveRequest) (*util.Empty, error)
 }
 
 type ProviderPlugin struct {
 	Impl Provider
 }
 
-func (p *ProviderPlugin) Server(*plugin.MuxBroker) (interface{}, error) {
-	return &ProviderRPCServer{Impl: p.Impl}, nil
+func (p *ProviderPlugin) Server(b *plugin.MuxBroker) (interface{}, error) {
+	return &ProviderRPCServer{Impl: p.Impl, broker: b}, nil
 }
 
 func (p *ProviderPlugin) Client(b *plugin.MuxBroker, c *rpc.Client) (interface{}, error) {
-	return &ProviderRPCClient{client: c}, nil
+	return &ProviderRPCClient{client: c, broker: b}, nil
 }
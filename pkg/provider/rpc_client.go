@@ -4,14 +4,21 @@
 package provider
 
 import (
+	"errors"
 	"net/rpc"
+	"strings"
 
 	"github.com/daytonaio/daytona/pkg/provider/util"
 	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/hashicorp/go-plugin"
 )
 
+var ErrArchiveNotSupported = errors.New("the provider does not support archiving projects")
+var ErrCostEstimateNotSupported = errors.New("the provider does not support cost estimates")
+
 type ProviderRPCClient struct {
 	client *rpc.Client
+	broker *plugin.MuxBroker
 }
 
 func (m *ProviderRPCClient) Initialize(req InitializeProviderRequest) (*util.Empty, error) {
@@ -38,12 +45,26 @@ func (m *ProviderRPCClient) GetDefaultTargets() (*[]ProviderTarget, error) {
 	return &resp, err
 }
 
+func (m *ProviderRPCClient) ValidateTargetOptions(targetOptions string) (*[]TargetOptionValidationError, error) {
+	var resp []TargetOptionValidationError
+	err := m.client.Call("Plugin.ValidateTargetOptions", &targetOptions, &resp)
+	// Providers built before target validation was added accept any options
+	if err != nil && strings.HasPrefix(err.Error(), "rpc: can't find method") {
+		return &[]TargetOptionValidationError{}, nil
+	}
+	return &resp, err
+}
+
 func (m *ProviderRPCClient) CreateWorkspace(workspaceReq *WorkspaceRequest) (*util.Empty, error) {
+	openProgressStream(m.broker, &workspaceReq.ProgressStream)
+
 	err := m.client.Call("Plugin.CreateWorkspace", workspaceReq, new(util.Empty))
 	return new(util.Empty), err
 }
 
 func (m *ProviderRPCClient) StartWorkspace(workspaceReq *WorkspaceRequest) (*util.Empty, error) {
+	openProgressStream(m.broker, &workspaceReq.ProgressStream)
+
 	err := m.client.Call("Plugin.StartWorkspace", workspaceReq, new(util.Empty))
 	return new(util.Empty), err
 }
@@ -54,6 +75,8 @@ func (m *ProviderRPCClient) StopWorkspace(workspaceReq *WorkspaceRequest) (*util
 }
 
 func (m *ProviderRPCClient) DestroyWorkspace(workspaceReq *WorkspaceRequest) (*util.Empty, error) {
+	openProgressStream(m.broker, &workspaceReq.ProgressStream)
+
 	err := m.client.Call("Plugin.DestroyWorkspace", workspaceReq, new(util.Empty))
 	return new(util.Empty), err
 }
@@ -64,12 +87,25 @@ func (m *ProviderRPCClient) GetWorkspaceInfo(workspaceReq *WorkspaceRequest) (*w
 	return &response, err
 }
 
+func (m *ProviderRPCClient) GetWorkspaceCostEstimate(workspaceReq *WorkspaceRequest) (*workspace.CostEstimate, error) {
+	var resp workspace.CostEstimate
+	err := m.client.Call("Plugin.GetWorkspaceCostEstimate", workspaceReq, &resp)
+	if err != nil && strings.HasPrefix(err.Error(), "rpc: can't find method") {
+		return nil, ErrCostEstimateNotSupported
+	}
+	return &resp, err
+}
+
 func (m *ProviderRPCClient) CreateProject(projectReq *ProjectRequest) (*util.Empty, error) {
+	openProgressStream(m.broker, &projectReq.ProgressStream)
+
 	err := m.client.Call("Plugin.CreateProject", projectReq, new(util.Empty))
 	return new(util.Empty), err
 }
 
 func (m *ProviderRPCClient) StartProject(projectReq *ProjectRequest) (*util.Empty, error) {
+	openProgressStream(m.broker, &projectReq.ProgressStream)
+
 	err := m.client.Call("Plugin.StartProject", projectReq, new(util.Empty))
 	return new(util.Empty), err
 }
@@ -80,6 +116,8 @@ func (m *ProviderRPCClient) StopProject(projectReq *ProjectRequest) (*util.Empty
 }
 
 func (m *ProviderRPCClient) DestroyProject(projectReq *ProjectRequest) (*util.Empty, error) {
+	openProgressStream(m.broker, &projectReq.ProgressStream)
+
 	err := m.client.Call("Plugin.DestroyProject", projectReq, new(util.Empty))
 	return new(util.Empty), err
 }
@@ -89,3 +127,19 @@ func (m *ProviderRPCClient) GetProjectInfo(projectReq *ProjectRequest) (*workspa
 	err := m.client.Call("Plugin.GetProjectInfo", projectReq, &resp)
 	return &resp, err
 }
+
+func (m *ProviderRPCClient) ArchiveProject(archiveReq *ProjectArchiveRequest) (*util.Empty, error) {
+	err := m.client.Call("Plugin.ArchiveProject", archiveReq, new(util.Empty))
+	if err != nil && strings.HasPrefix(err.Error(), "rpc: can't find method") {
+		return nil, ErrArchiveNotSupported
+	}
+	return new(util.Empty), err
+}
+
+func (m *ProviderRPCClient) UnarchiveProject(archiveReq *ProjectArchiveRequest) (*util.Empty, error) {
+	err := m.client.Call("Plugin.UnarchiveProject", archiveReq, new(util.Empty))
+	if err != nil && strings.HasPrefix(err.Error(), "rpc: can't find method") {
+		return nil, ErrArchiveNotSupported
+	}
+	return new(util.Empty), err
+}
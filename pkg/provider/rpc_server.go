@@ -6,10 +6,12 @@ package provider
 import (
 	"github.com/daytonaio/daytona/pkg/provider/util"
 	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/hashicorp/go-plugin"
 )
 
 type ProviderRPCServer struct {
-	Impl Provider
+	Impl   Provider
+	broker *plugin.MuxBroker
 }
 
 func (m *ProviderRPCServer) Initialize(arg InitializeProviderRequest, resp *util.Empty) error {
@@ -47,12 +49,26 @@ func (m *ProviderRPCServer) GetDefaultTargets(arg interface{}, resp *[]ProviderT
 	return nil
 }
 
+func (m *ProviderRPCServer) ValidateTargetOptions(arg *string, resp *[]TargetOptionValidationError) error {
+	validationErrors, err := m.Impl.ValidateTargetOptions(*arg)
+	if err != nil {
+		return err
+	}
+
+	*resp = *validationErrors
+	return nil
+}
+
 func (m *ProviderRPCServer) CreateWorkspace(arg *WorkspaceRequest, resp *util.Empty) error {
+	defer connectProgressStream(m.broker, &arg.ProgressStream)()
+
 	_, err := m.Impl.CreateWorkspace(arg)
 	return err
 }
 
 func (m *ProviderRPCServer) StartWorkspace(arg *WorkspaceRequest, resp *util.Empty) error {
+	defer connectProgressStream(m.broker, &arg.ProgressStream)()
+
 	_, err := m.Impl.StartWorkspace(arg)
 	return err
 }
@@ -63,6 +79,8 @@ func (m *ProviderRPCServer) StopWorkspace(arg *WorkspaceRequest, resp *util.Empt
 }
 
 func (m *ProviderRPCServer) DestroyWorkspace(arg *WorkspaceRequest, resp *util.Empty) error {
+	defer connectProgressStream(m.broker, &arg.ProgressStream)()
+
 	_, err := m.Impl.DestroyWorkspace(arg)
 	return err
 }
@@ -77,12 +95,26 @@ func (m *ProviderRPCServer) GetWorkspaceInfo(arg *WorkspaceRequest, resp *worksp
 	return nil
 }
 
+func (m *ProviderRPCServer) GetWorkspaceCostEstimate(arg *WorkspaceRequest, resp *workspace.CostEstimate) error {
+	estimate, err := m.Impl.GetWorkspaceCostEstimate(arg)
+	if err != nil {
+		return err
+	}
+
+	*resp = *estimate
+	return nil
+}
+
 func (m *ProviderRPCServer) CreateProject(arg *ProjectRequest, resp *util.Empty) error {
+	defer connectProgressStream(m.broker, &arg.ProgressStream)()
+
 	_, err := m.Impl.CreateProject(arg)
 	return err
 }
 
 func (m *ProviderRPCServer) StartProject(arg *ProjectRequest, resp *util.Empty) error {
+	defer connectProgressStream(m.broker, &arg.ProgressStream)()
+
 	_, err := m.Impl.StartProject(arg)
 	return err
 }
@@ -93,6 +125,8 @@ func (m *ProviderRPCServer) StopProject(arg *ProjectRequest, resp *util.Empty) e
 }
 
 func (m *ProviderRPCServer) DestroyProject(arg *ProjectRequest, resp *util.Empty) error {
+	defer connectProgressStream(m.broker, &arg.ProgressStream)()
+
 	_, err := m.Impl.DestroyProject(arg)
 	return err
 }
@@ -106,3 +140,13 @@ func (m *ProviderRPCServer) GetProjectInfo(arg *ProjectRequest, resp *workspace.
 	*resp = *info
 	return nil
 }
+
+func (m *ProviderRPCServer) ArchiveProject(arg *ProjectArchiveRequest, resp *util.Empty) error {
+	_, err := m.Impl.ArchiveProject(arg)
+	return err
+}
+
+func (m *ProviderRPCServer) UnarchiveProject(arg *ProjectArchiveRequest, resp *util.Empty) error {
+	_, err := m.Impl.UnarchiveProject(arg)
+	return err
+}
@@ -0,0 +1,44 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import "errors"
+
+// PlacementPolicy determines the member target of a target group new workspaces are created on
+type PlacementPolicy string // @name PlacementPolicy
+
+const (
+	// Places the workspace on the member with the fewest workspaces
+	PlacementPolicySpread PlacementPolicy = "spread"
+	// Places the workspace on the first member in the group order, e.g. to fail over to the other members
+	PlacementPolicyOrdered PlacementPolicy = "ordered"
+)
+
+// TargetGroup aggregates targets, e.g. the hosts of an environment or a region, that workspaces can be placed on
+type TargetGroup struct {
+	Name            string              `json:"name" validate:"required"`
+	Members         []TargetGroupMember `json:"members" validate:"required"`
+	PlacementPolicy PlacementPolicy     `json:"placementPolicy" validate:"required"`
+} // @name TargetGroup
+
+type TargetGroupMember struct {
+	Target string `json:"target" validate:"required"`
+	// Drained members keep their workspaces but no new workspaces are placed on them
+	Drained bool `json:"drained" validate:"required"`
+} // @name TargetGroupMember
+
+type TargetGroupStore interface {
+	List() ([]*TargetGroup, error)
+	Find(groupName string) (*TargetGroup, error)
+	Save(group *TargetGroup) error
+	Delete(group *TargetGroup) error
+}
+
+var (
+	ErrTargetGroupNotFound = errors.New("target group not found")
+)
+
+func IsTargetGroupNotFound(err error) bool {
+	return err.Error() == ErrTargetGroupNotFound.Error()
+}
@@ -6,6 +6,7 @@ package provider
 import (
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/objectstorage"
 	"github.com/daytonaio/daytona/pkg/workspace"
 )
 
@@ -30,24 +31,71 @@ type InitializeProviderRequest struct {
 }
 
 type WorkspaceRequest struct {
+	ProgressStream
 	TargetOptions string
 	Workspace     *workspace.Workspace
 }
 
 type ProjectRequest struct {
+	ProgressStream
 	TargetOptions     string
 	ContainerRegistry *containerregistry.ContainerRegistry
 	Project           *workspace.Project
 	GitProviderConfig *gitprovider.GitProviderConfig
+	NetworkPolicy     *NetworkPolicy
+}
+
+type ProjectArchiveRequest struct {
+	ProjectRequest
+	Storage *objectstorage.S3Config
+	// Object key the project data is stored under
+	ArchiveKey string
 }
 
 type ProviderTarget struct {
 	Name         string       `json:"name"`
 	ProviderInfo ProviderInfo `json:"providerInfo"`
 	// JSON encoded map of options
-	Options string `json:"options"`
+	Options       string         `json:"options"`
+	NetworkPolicy *NetworkPolicy `json:"networkPolicy,omitempty"`
+	// Overrides the server network mode for projects created on the target
+	NetworkMode *NetworkMode `json:"networkMode,omitempty"`
+	// ID of the user who set the target. Targets without an owner are shared with all users and managed by admins
+	OwnerId string `json:"ownerId,omitempty"`
 } // @name ProviderTarget
 
+// NetworkMode determines how clients connect to projects
+type NetworkMode string // @name NetworkMode
+
+const (
+	// Projects join the network of the embedded Daytona control server (headscale)
+	NetworkModeDaytona NetworkMode = "daytona"
+	// Projects join an external tailnet and are reached directly over WireGuard
+	NetworkModeTailnet NetworkMode = "tailnet"
+)
+
+type PortRange struct {
+	Start uint32 `json:"start" validate:"required"`
+	End   uint32 `json:"end" validate:"required"`
+} // @name PortRange
+
+// NetworkPolicy holds the firewall rules applied to all projects created on a target.
+// Enforcing the policy is up to the provider.
+type NetworkPolicy struct {
+	// Port ranges which are exposed on the target host
+	AllowedInboundPorts []PortRange `json:"allowedInboundPorts"`
+	// Blocks all outbound traffic except to the Daytona server
+	DenyAllEgress bool `json:"denyAllEgress"`
+} // @name NetworkPolicy
+
+// TargetOptionValidationError is a problem with the target options detected by the provider,
+// e.g. invalid credentials or an instance type that is not available in the selected region
+type TargetOptionValidationError struct {
+	// Name of the invalid target option. Empty if the error is not related to a single option
+	Option  string `json:"option"`
+	Message string `json:"message"`
+} // @name TargetOptionValidationError
+
 type ProviderTargetManifest map[string]ProviderTargetProperty // @name ProviderTargetManifest
 
 type ProviderTargetPropertyType string
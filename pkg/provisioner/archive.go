@@ -0,0 +1,57 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provisioner
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/objectstorage"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+func (p *Provisioner) ArchiveProject(project *workspace.Project, target *provider.ProviderTarget, storage *objectstorage.S3Config) error {
+	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+	if err != nil {
+		return err
+	}
+
+	_, err = (*targetProvider).ArchiveProject(&provider.ProjectArchiveRequest{
+		ProjectRequest: provider.ProjectRequest{
+			TargetOptions: target.Options,
+			Project:       project,
+		},
+		Storage:    storage,
+		ArchiveKey: GetProjectArchiveKey(project),
+	})
+
+	return err
+}
+
+func (p *Provisioner) UnarchiveProject(project *workspace.Project, target *provider.ProviderTarget, cr *containerregistry.ContainerRegistry, gc *gitprovider.GitProviderConfig, storage *objectstorage.S3Config) error {
+	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+	if err != nil {
+		return err
+	}
+
+	_, err = (*targetProvider).UnarchiveProject(&provider.ProjectArchiveRequest{
+		ProjectRequest: provider.ProjectRequest{
+			TargetOptions:     target.Options,
+			Project:           project,
+			ContainerRegistry: cr,
+			GitProviderConfig: gc,
+			NetworkPolicy:     target.NetworkPolicy,
+		},
+		Storage:    storage,
+		ArchiveKey: GetProjectArchiveKey(project),
+	})
+
+	return err
+}
+
+func GetProjectArchiveKey(project *workspace.Project) string {
+	return fmt.Sprintf("daytona/%s/%s.tar.gz", project.WorkspaceId, project.Name)
+}
@@ -0,0 +1,21 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provisioner
+
+import (
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+func (p *Provisioner) GetWorkspaceCostEstimate(workspace *workspace.Workspace, target *provider.ProviderTarget) (*workspace.CostEstimate, error) {
+	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return (*targetProvider).GetWorkspaceCostEstimate(&provider.WorkspaceRequest{
+		TargetOptions: target.Options,
+		Workspace:     workspace,
+	})
+}
@@ -10,32 +10,39 @@ import (
 	"github.com/daytonaio/daytona/pkg/workspace"
 )
 
-func (p *Provisioner) CreateWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error {
+func (p *Provisioner) CreateWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget, progress provider.ProgressReporter) error {
 	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
 	if err != nil {
 		return err
 	}
 
-	_, err = (*targetProvider).CreateWorkspace(&provider.WorkspaceRequest{
+	workspaceReq := &provider.WorkspaceRequest{
 		TargetOptions: target.Options,
 		Workspace:     workspace,
-	})
+	}
+	workspaceReq.SetProgressReporter(progress)
+
+	_, err = (*targetProvider).CreateWorkspace(workspaceReq)
 
 	return err
 }
 
-func (p *Provisioner) CreateProject(project *workspace.Project, target *provider.ProviderTarget, cr *containerregistry.ContainerRegistry, gc *gitprovider.GitProviderConfig) error {
+func (p *Provisioner) CreateProject(project *workspace.Project, target *provider.ProviderTarget, cr *containerregistry.ContainerRegistry, gc *gitprovider.GitProviderConfig, progress provider.ProgressReporter) error {
 	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
 	if err != nil {
 		return err
 	}
 
-	_, err = (*targetProvider).CreateProject(&provider.ProjectRequest{
+	projectReq := &provider.ProjectRequest{
 		TargetOptions:     target.Options,
 		Project:           project,
 		ContainerRegistry: cr,
 		GitProviderConfig: gc,
-	})
+		NetworkPolicy:     target.NetworkPolicy,
+	}
+	projectReq.SetProgressReporter(progress)
+
+	_, err = (*targetProvider).CreateProject(projectReq)
 
 	return err
 }
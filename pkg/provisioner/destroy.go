@@ -8,30 +8,36 @@ import (
 	"github.com/daytonaio/daytona/pkg/workspace"
 )
 
-func (p *Provisioner) DestroyWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error {
+func (p *Provisioner) DestroyWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget, progress provider.ProgressReporter) error {
 	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
 	if err != nil {
 		return err
 	}
 
-	_, err = (*targetProvider).DestroyWorkspace(&provider.WorkspaceRequest{
+	workspaceReq := &provider.WorkspaceRequest{
 		TargetOptions: target.Options,
 		Workspace:     workspace,
-	})
+	}
+	workspaceReq.SetProgressReporter(progress)
+
+	_, err = (*targetProvider).DestroyWorkspace(workspaceReq)
 
 	return err
 }
 
-func (p *Provisioner) DestroyProject(project *workspace.Project, target *provider.ProviderTarget) error {
+func (p *Provisioner) DestroyProject(project *workspace.Project, target *provider.ProviderTarget, progress provider.ProgressReporter) error {
 	targetProvider, err := p.providerManager.GetProvider(target.ProviderInfo.Name)
 	if err != nil {
 		return err
 	}
 
-	_, err = (*targetProvider).DestroyProject(&provider.ProjectRequest{
+	projectReq := &provider.ProjectRequest{
 		TargetOptions: target.Options,
 		Project:       project,
-	})
+	}
+	projectReq.SetProgressReporter(progress)
+
+	_, err = (*targetProvider).DestroyProject(projectReq)
 
 	return err
 }
@@ -6,21 +6,25 @@ package provisioner
 import (
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/objectstorage"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/provider/manager"
 	"github.com/daytonaio/daytona/pkg/workspace"
 )
 
 type IProvisioner interface {
-	CreateProject(project *workspace.Project, target *provider.ProviderTarget, cr *containerregistry.ContainerRegistry, gc *gitprovider.GitProviderConfig) error
-	CreateWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error
-	DestroyProject(project *workspace.Project, target *provider.ProviderTarget) error
-	DestroyWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error
+	ArchiveProject(project *workspace.Project, target *provider.ProviderTarget, storage *objectstorage.S3Config) error
+	CreateProject(project *workspace.Project, target *provider.ProviderTarget, cr *containerregistry.ContainerRegistry, gc *gitprovider.GitProviderConfig, progress provider.ProgressReporter) error
+	CreateWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget, progress provider.ProgressReporter) error
+	DestroyProject(project *workspace.Project, target *provider.ProviderTarget, progress provider.ProgressReporter) error
+	DestroyWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget, progress provider.ProgressReporter) error
+	GetWorkspaceCostEstimate(workspace *workspace.Workspace, target *provider.ProviderTarget) (*workspace.CostEstimate, error)
 	GetWorkspaceInfo(workspace *workspace.Workspace, target *provider.ProviderTarget) (*workspace.WorkspaceInfo, error)
 	StartProject(project *workspace.Project, target *provider.ProviderTarget) error
 	StartWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error
 	StopProject(project *workspace.Project, target *provider.ProviderTarget) error
 	StopWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget) error
+	UnarchiveProject(project *workspace.Project, target *provider.ProviderTarget, cr *containerregistry.ContainerRegistry, gc *gitprovider.GitProviderConfig, storage *objectstorage.S3Config) error
 }
 
 type ProvisionerConfig struct {
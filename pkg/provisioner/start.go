@@ -31,6 +31,7 @@ func (p *Provisioner) StartProject(project *workspace.Project, target *provider.
 	_, err = (*targetProvider).StartProject(&provider.ProjectRequest{
 		TargetOptions: target.Options,
 		Project:       project,
+		NetworkPolicy: target.NetworkPolicy,
 	})
 
 	return err
@@ -0,0 +1,279 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package selfupdate
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	os_util "github.com/daytonaio/daytona/pkg/os"
+	"golang.org/x/mod/semver"
+)
+
+type Channel string
+
+const (
+	ChannelStable Channel = "stable"
+	ChannelBeta   Channel = "beta"
+)
+
+var Channels = []Channel{ChannelStable, ChannelBeta}
+
+// Releases are published as GitHub releases. Prereleases are only installed from the beta channel
+const DefaultReleasesUrl = "https://api.github.com/repos/daytonaio/daytona/releases"
+
+const (
+	// Lists the SHA256 checksums of the release binaries in the sha256sum format
+	ChecksumsAssetName = "checksums.txt"
+	// Base64 encoded Ed25519 signature of the checksums file
+	SignatureAssetName = "checksums.txt.sig"
+)
+
+// Release describes the assets of a Daytona version published on a channel
+type Release struct {
+	Version    string `json:"tag_name"`
+	Draft      bool   `json:"draft"`
+	Prerelease bool   `json:"prerelease"`
+	Assets     []struct {
+		Name        string `json:"name"`
+		DownloadUrl string `json:"browser_download_url"`
+	} `json:"assets"`
+}
+
+var (
+	ErrUnsupportedOperatingSystem = errors.New("no release binary for the operating system")
+	ErrMissingPublicKey           = errors.New("the binary was not built with a release public key, self-update is not supported")
+)
+
+// GetLatestRelease fetches the newest release published on the channel
+func GetLatestRelease(releasesUrl string, channel Channel) (*Release, error) {
+	res, err := http.Get(releasesUrl)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get the latest %s release: %s", channel, res.Status)
+	}
+
+	var releases []Release
+	err = json.NewDecoder(res.Body).Decode(&releases)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the releases: %w", err)
+	}
+
+	// Releases are listed newest first
+	for _, release := range releases {
+		if release.Draft || (release.Prerelease && channel != ChannelBeta) {
+			continue
+		}
+
+		if !semver.IsValid(release.Version) {
+			return nil, fmt.Errorf("invalid release version: %s", release.Version)
+		}
+
+		return &release, nil
+	}
+
+	return nil, fmt.Errorf("no %s release found", channel)
+}
+
+// IsNewerThan returns true if the release version is higher than the given version. Development builds are always outdated
+func (r *Release) IsNewerThan(version string) bool {
+	return semver.Compare(r.Version, version) > 0
+}
+
+// Apply downloads the release binary for the operating system, verifies it and atomically replaces the executable at
+// executablePath. The checksums of the release must be signed with the private key matching publicKey
+func (r *Release) Apply(operatingSystem os_util.OperatingSystem, executablePath string, publicKey ed25519.PublicKey) error {
+	if publicKey == nil {
+		return ErrMissingPublicKey
+	}
+
+	binaryName := GetBinaryName(operatingSystem)
+	downloadUrl := r.getAssetUrl(binaryName)
+	if downloadUrl == "" {
+		return fmt.Errorf("%w: %s", ErrUnsupportedOperatingSystem, operatingSystem)
+	}
+
+	expectedChecksum, err := r.getChecksum(binaryName, publicKey)
+	if err != nil {
+		return err
+	}
+
+	// Downloaded next to the executable so that the rename does not cross file systems
+	tmpFile, err := os.CreateTemp(filepath.Dir(executablePath), ".daytona-update-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	hash := sha256.New()
+
+	err = download(downloadUrl, io.MultiWriter(tmpFile, hash))
+	closeErr := tmpFile.Close()
+	if err != nil {
+		return err
+	}
+	if closeErr != nil {
+		return closeErr
+	}
+
+	checksum := hex.EncodeToString(hash.Sum(nil))
+	if !strings.EqualFold(checksum, expectedChecksum) {
+		return fmt.Errorf("checksum mismatch: expected %s, got %s", expectedChecksum, checksum)
+	}
+
+	err = os.Chmod(tmpFile.Name(), 0755)
+	if err != nil {
+		return err
+	}
+
+	return replaceExecutable(tmpFile.Name(), executablePath)
+}
+
+// Release binaries are named after the operating system, e.g. daytona-linux-amd64
+func GetBinaryName(operatingSystem os_util.OperatingSystem) string {
+	name := fmt.Sprintf("daytona-%s", operatingSystem)
+	if strings.HasPrefix(string(operatingSystem), "windows") {
+		name += ".exe"
+	}
+
+	return name
+}
+
+func (r *Release) getAssetUrl(name string) string {
+	for _, asset := range r.Assets {
+		if asset.Name == name {
+			return asset.DownloadUrl
+		}
+	}
+
+	return ""
+}
+
+// Returns the checksum of the binary from the checksums file after verifying the signature of the file
+func (r *Release) getChecksum(binaryName string, publicKey ed25519.PublicKey) (string, error) {
+	checksumsUrl := r.getAssetUrl(ChecksumsAssetName)
+	if checksumsUrl == "" {
+		return "", errors.New("the release has no checksums")
+	}
+
+	signatureUrl := r.getAssetUrl(SignatureAssetName)
+	if signatureUrl == "" {
+		return "", errors.New("the release checksums are not signed")
+	}
+
+	var checksums, signature strings.Builder
+
+	err := download(checksumsUrl, &checksums)
+	if err != nil {
+		return "", err
+	}
+
+	err = download(signatureUrl, &signature)
+	if err != nil {
+		return "", err
+	}
+
+	err = verifySignature(publicKey, []byte(checksums.String()), strings.TrimSpace(signature.String()))
+	if err != nil {
+		return "", err
+	}
+
+	for _, line := range strings.Split(checksums.String(), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && strings.TrimPrefix(fields[1], "*") == binaryName {
+			return fields[0], nil
+		}
+	}
+
+	return "", fmt.Errorf("the release has no checksum for %s", binaryName)
+}
+
+func verifySignature(publicKey ed25519.PublicKey, message []byte, signature string) error {
+	if signature == "" {
+		return errors.New("the release checksums are not signed")
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+
+	if !ed25519.Verify(publicKey, message, decoded) {
+		return errors.New("invalid signature of the release checksums")
+	}
+
+	return nil
+}
+
+func download(url string, w io.Writer) error {
+	res, err := http.Get(url)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to download %s: %s", url, res.Status)
+	}
+
+	_, err = io.Copy(w, res.Body)
+	return err
+}
+
+// A running executable can not be overwritten on Windows, but it can be renamed
+func replaceExecutable(newPath, executablePath string) error {
+	if runtime.GOOS != "windows" {
+		return os.Rename(newPath, executablePath)
+	}
+
+	oldPath := executablePath + ".old"
+	os.Remove(oldPath)
+
+	err := os.Rename(executablePath, oldPath)
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(newPath, executablePath)
+	if err != nil {
+		// Restores the current executable
+		os.Rename(oldPath, executablePath)
+		return err
+	}
+
+	return nil
+}
+
+// ParsePublicKey decodes a base64 encoded Ed25519 public key. Builds without a release public key can not self-update
+func ParsePublicKey(key string) (ed25519.PublicKey, error) {
+	if key == "" {
+		return nil, ErrMissingPublicKey
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(decoded) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid public key size: %d", len(decoded))
+	}
+
+	return ed25519.PublicKey(decoded), nil
+}
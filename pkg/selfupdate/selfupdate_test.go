@@ -0,0 +1,135 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package selfupdate_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	os_util "github.com/daytonaio/daytona/pkg/os"
+	"github.com/daytonaio/daytona/pkg/selfupdate"
+	"github.com/stretchr/testify/require"
+)
+
+const binary = "#!/bin/sh\necho v0.2.0\n"
+
+func TestSelfUpdate(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.Nil(t, err)
+
+	checksum := sha256.Sum256([]byte(binary))
+	checksums := fmt.Sprintf("%s  daytona-linux-amd64\n%s  daytona-darwin-arm64\n", hex.EncodeToString(checksum[:]), hex.EncodeToString(make([]byte, sha256.Size)))
+	signature := base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, []byte(checksums)))
+
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/releases":
+			// Newest first, like the GitHub releases API
+			fmt.Fprintf(w, `[
+				{"tag_name": "v0.3.0-beta.1", "prerelease": true, "assets": []},
+				{"tag_name": "v0.2.0", "assets": [
+					{"name": "daytona-linux-amd64", "browser_download_url": "%[1]s/v0.2.0/daytona-linux-amd64"},
+					{"name": "daytona-darwin-arm64", "browser_download_url": "%[1]s/v0.2.0/daytona-darwin-arm64"},
+					{"name": "checksums.txt", "browser_download_url": "%[1]s/v0.2.0/checksums.txt"},
+					{"name": "checksums.txt.sig", "browser_download_url": "%[1]s/v0.2.0/checksums.txt.sig"}
+				]}
+			]`, server.URL)
+		case "/v0.2.0/daytona-linux-amd64", "/v0.2.0/daytona-darwin-arm64":
+			w.Write([]byte(binary))
+		case "/v0.2.0/checksums.txt":
+			w.Write([]byte(checksums))
+		case "/v0.2.0/checksums.txt.sig":
+			w.Write([]byte(signature + "\n"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	release, err := selfupdate.GetLatestRelease(server.URL+"/releases", selfupdate.ChannelStable)
+	require.Nil(t, err)
+	require.Equal(t, "v0.2.0", release.Version)
+	require.True(t, release.IsNewerThan("v0.1.0"))
+	require.True(t, release.IsNewerThan("v0.0.0-dev"))
+	require.False(t, release.IsNewerThan("v0.2.0"))
+
+	betaRelease, err := selfupdate.GetLatestRelease(server.URL+"/releases", selfupdate.ChannelBeta)
+	require.Nil(t, err)
+	require.Equal(t, "v0.3.0-beta.1", betaRelease.Version)
+
+	executablePath := filepath.Join(t.TempDir(), "daytona")
+	require.Nil(t, os.WriteFile(executablePath, []byte("old"), 0755))
+
+	requireNotReplaced := func(t *testing.T) {
+		content, err := os.ReadFile(executablePath)
+		require.Nil(t, err)
+		require.Equal(t, "old", string(content))
+	}
+
+	t.Run("rejects an invalid signature", func(t *testing.T) {
+		otherPublicKey, _, err := ed25519.GenerateKey(rand.Reader)
+		require.Nil(t, err)
+
+		err = release.Apply(os_util.Linux_64_86, executablePath, otherPublicKey)
+		require.ErrorContains(t, err, "invalid signature")
+		requireNotReplaced(t)
+	})
+
+	t.Run("requires a public key", func(t *testing.T) {
+		err := release.Apply(os_util.Linux_64_86, executablePath, nil)
+		require.ErrorIs(t, err, selfupdate.ErrMissingPublicKey)
+		requireNotReplaced(t)
+
+		_, err = selfupdate.ParsePublicKey("")
+		require.ErrorIs(t, err, selfupdate.ErrMissingPublicKey)
+	})
+
+	t.Run("requires a signature", func(t *testing.T) {
+		unsigned := *release
+		unsigned.Assets = release.Assets[:len(release.Assets)-1]
+
+		err := unsigned.Apply(os_util.Linux_64_86, executablePath, publicKey)
+		require.ErrorContains(t, err, "not signed")
+		requireNotReplaced(t)
+	})
+
+	t.Run("rejects a checksum mismatch", func(t *testing.T) {
+		err := release.Apply(os_util.Darwin_arm64, executablePath, publicKey)
+		require.ErrorContains(t, err, "checksum mismatch")
+		requireNotReplaced(t)
+	})
+
+	t.Run("fails for an unsupported operating system", func(t *testing.T) {
+		err := release.Apply(os_util.Windows_arm64, executablePath, publicKey)
+		require.ErrorIs(t, err, selfupdate.ErrUnsupportedOperatingSystem)
+	})
+
+	t.Run("replaces the executable", func(t *testing.T) {
+		err := release.Apply(os_util.Linux_64_86, executablePath, publicKey)
+		require.Nil(t, err)
+
+		content, err := os.ReadFile(executablePath)
+		require.Nil(t, err)
+		require.Equal(t, binary, string(content))
+
+		info, err := os.Stat(executablePath)
+		require.Nil(t, err)
+		require.Equal(t, os.FileMode(0755), info.Mode().Perm())
+
+		// The temporary download is not left behind
+		entries, err := os.ReadDir(filepath.Dir(executablePath))
+		require.Nil(t, err)
+		require.Len(t, entries, 1)
+	})
+}
@@ -4,10 +4,17 @@
 package apikeys
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
 	"github.com/daytonaio/daytona/internal/apikeys"
 	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/docker/docker/pkg/stringid"
 )
 
+var ErrApiKeyAlreadyExists = errors.New("api key already exists")
+
 func (s *ApiKeyService) ListClientKeys() ([]*apikey.ApiKey, error) {
 	keys, err := s.apiKeyStore.List()
 	if err != nil {
@@ -25,8 +32,38 @@ func (s *ApiKeyService) ListClientKeys() ([]*apikey.ApiKey, error) {
 	return clientKeys, nil
 }
 
+func (s *ApiKeyService) ListShareKeys(workspaceId string) ([]*apikey.ApiKey, error) {
+	keys, err := s.apiKeyStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	shareKeys := []*apikey.ApiKey{}
+
+	for _, key := range keys {
+		if key.Type == apikey.ApiKeyTypeShare && key.WorkspaceId == workspaceId {
+			shareKeys = append(shareKeys, key)
+		}
+	}
+
+	return shareKeys, nil
+}
+
+// Revokes the workspace, project or share key with the name. Client keys are revoked with RevokeClientKey
 func (s *ApiKeyService) Revoke(name string) error {
-	apiKey, err := s.apiKeyStore.FindByName(name)
+	apiKey, err := s.find(func(key *apikey.ApiKey) bool {
+		return key.Type != apikey.ApiKeyTypeClient && key.Name == name
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.apiKeyStore.Delete(apiKey)
+}
+
+// Revokes the client key of the user with the name. Keys created before users were introduced have an empty user ID
+func (s *ApiKeyService) RevokeClientKey(userId, name string) error {
+	apiKey, err := s.findClientKey(userId, name)
 	if err != nil {
 		return err
 	}
@@ -35,6 +72,13 @@ func (s *ApiKeyService) Revoke(name string) error {
 }
 
 func (s *ApiKeyService) Generate(keyType apikey.ApiKeyType, name string) (string, error) {
+	if keyType == apikey.ApiKeyTypeClient {
+		err := s.checkClientKeyName("", name)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	key := apikeys.GenerateRandomKey()
 
 	apiKey := &apikey.ApiKey{
@@ -50,3 +94,81 @@ func (s *ApiKeyService) Generate(keyType apikey.ApiKeyType, name string) (string
 
 	return key, nil
 }
+
+// Generates a client key that authenticates as the user
+func (s *ApiKeyService) GenerateUserKey(userId, name string) (string, error) {
+	err := s.checkClientKeyName(userId, name)
+	if err != nil {
+		return "", err
+	}
+
+	key := apikeys.GenerateRandomKey()
+
+	apiKey := &apikey.ApiKey{
+		KeyHash: apikeys.HashKey(key),
+		Type:    apikey.ApiKeyTypeClient,
+		Name:    name,
+		UserId:  userId,
+	}
+
+	err = s.apiKeyStore.Save(apiKey)
+	if err != nil {
+		return "", err
+	}
+
+	return key, nil
+}
+
+// Generates a key granting access to a single workspace until expiresAt. Returns the key and its stored record
+func (s *ApiKeyService) GenerateShareKey(workspaceId string, expiresAt time.Time) (*apikey.ApiKey, string, error) {
+	key := apikeys.GenerateRandomKey()
+
+	apiKey := &apikey.ApiKey{
+		KeyHash:     apikeys.HashKey(key),
+		Type:        apikey.ApiKeyTypeShare,
+		Name:        fmt.Sprintf("share-%s", stringid.TruncateID(stringid.GenerateRandomID())),
+		WorkspaceId: workspaceId,
+		ExpiresAt:   expiresAt.Format(time.RFC3339),
+	}
+
+	err := s.apiKeyStore.Save(apiKey)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return apiKey, key, nil
+}
+
+// Client key names are unique per user
+func (s *ApiKeyService) checkClientKeyName(userId, name string) error {
+	_, err := s.findClientKey(userId, name)
+	if err == nil {
+		return ErrApiKeyAlreadyExists
+	}
+	if !apikey.IsApiKeyNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *ApiKeyService) findClientKey(userId, name string) (*apikey.ApiKey, error) {
+	return s.find(func(key *apikey.ApiKey) bool {
+		return key.Type == apikey.ApiKeyTypeClient && key.UserId == userId && key.Name == name
+	})
+}
+
+func (s *ApiKeyService) find(match func(key *apikey.ApiKey) bool) (*apikey.ApiKey, error) {
+	keys, err := s.apiKeyStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range keys {
+		if match(key) {
+			return key, nil
+		}
+	}
+
+	return nil, apikey.ErrApiKeyNotFound
+}
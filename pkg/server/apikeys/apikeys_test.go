@@ -3,7 +3,10 @@
 
 package apikeys_test
 
-import "github.com/daytonaio/daytona/pkg/apikey"
+import (
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/server/apikeys"
+)
 
 func (s *ApiKeyServiceTestSuite) TestListClientKeys() {
 	expectedKeys := []*apikey.ApiKey{}
@@ -36,7 +39,7 @@ func (s *ApiKeyServiceTestSuite) TestRevoke() {
 
 	require := s.Require()
 
-	err := s.apiKeyService.Revoke(clientKeyNames[0])
+	err := s.apiKeyService.RevokeClientKey("", clientKeyNames[0])
 	require.Nil(err)
 
 	keys, err := s.apiKeyStore.List()
@@ -44,6 +47,52 @@ func (s *ApiKeyServiceTestSuite) TestRevoke() {
 	require.ElementsMatch(expectedKeys, keys)
 }
 
+func (s *ApiKeyServiceTestSuite) TestRevokeClientKeyOfUser() {
+	require := s.Require()
+
+	_, err := s.apiKeyService.GenerateUserKey("user1", clientKeyNames[0])
+	require.Nil(err)
+
+	// Keys of other users with the same name are not revoked
+	err = s.apiKeyService.RevokeClientKey("user2", clientKeyNames[0])
+	require.True(apikey.IsApiKeyNotFound(err))
+
+	err = s.apiKeyService.RevokeClientKey("user1", clientKeyNames[0])
+	require.Nil(err)
+
+	keys, err := s.apiKeyService.ListClientKeys()
+	require.Nil(err)
+	require.Len(keys, len(clientKeyNames))
+	for _, key := range keys {
+		require.Empty(key.UserId)
+	}
+}
+
+func (s *ApiKeyServiceTestSuite) TestRevokeIgnoresClientKeys() {
+	require := s.Require()
+
+	err := s.apiKeyService.Revoke(clientKeyNames[0])
+	require.True(apikey.IsApiKeyNotFound(err))
+
+	keys, err := s.apiKeyService.ListClientKeys()
+	require.Nil(err)
+	require.Len(keys, len(clientKeyNames))
+}
+
+func (s *ApiKeyServiceTestSuite) TestGenerateUniqueName() {
+	require := s.Require()
+
+	_, err := s.apiKeyService.Generate(apikey.ApiKeyTypeClient, clientKeyNames[0])
+	require.ErrorIs(err, apikeys.ErrApiKeyAlreadyExists)
+
+	// Names are unique per user
+	_, err = s.apiKeyService.GenerateUserKey("user1", clientKeyNames[0])
+	require.Nil(err)
+
+	_, err = s.apiKeyService.GenerateUserKey("user1", clientKeyNames[0])
+	require.ErrorIs(err, apikeys.ErrApiKeyAlreadyExists)
+}
+
 func (s *ApiKeyServiceTestSuite) TestGenerate() {
 	expectedKeys := []*apikey.ApiKey{}
 	keyNames := []string{}
@@ -3,15 +3,25 @@
 
 package apikeys
 
-import "github.com/daytonaio/daytona/pkg/apikey"
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+)
 
 type IApiKeyService interface {
 	Generate(keyType apikey.ApiKeyType, name string) (string, error)
+	GenerateShareKey(workspaceId string, expiresAt time.Time) (*apikey.ApiKey, string, error)
+	GenerateUserKey(userId, name string) (string, error)
+	GetApiKey(apiKey string) (*apikey.ApiKey, error)
+	GetSharedWorkspaceId(apiKey string) (string, bool)
 	IsProjectApiKey(apiKey string) bool
 	IsWorkspaceApiKey(apiKey string) bool
 	IsValidApiKey(apiKey string) bool
 	ListClientKeys() ([]*apikey.ApiKey, error)
+	ListShareKeys(workspaceId string) ([]*apikey.ApiKey, error)
 	Revoke(name string) error
+	RevokeClientKey(userId, name string) error
 }
 
 type ApiKeyServiceConfig struct {
@@ -11,8 +11,18 @@ import (
 func (s *ApiKeyService) IsValidApiKey(apiKey string) bool {
 	keyHash := apikeys.HashKey(apiKey)
 
-	_, err := s.apiKeyStore.Find(keyHash)
-	return err == nil
+	key, err := s.apiKeyStore.Find(keyHash)
+	if err != nil {
+		return false
+	}
+
+	// Share keys are only valid for the shared workspace routes
+	return key.Type != apikey.ApiKeyTypeShare && !key.IsExpired()
+}
+
+// Returns the stored record of the key
+func (s *ApiKeyService) GetApiKey(apiKey string) (*apikey.ApiKey, error) {
+	return s.apiKeyStore.Find(apikeys.HashKey(apiKey))
 }
 
 func (s *ApiKeyService) IsProjectApiKey(apiKey string) bool {
@@ -44,3 +54,19 @@ func (s *ApiKeyService) IsWorkspaceApiKey(apiKey string) bool {
 
 	return true
 }
+
+// Returns the workspace the share key grants access to. Returns false if the key is not a valid share key
+func (s *ApiKeyService) GetSharedWorkspaceId(apiKey string) (string, bool) {
+	keyHash := apikeys.HashKey(apiKey)
+
+	key, err := s.apiKeyStore.Find(keyHash)
+	if err != nil {
+		return "", false
+	}
+
+	if key.Type != apikey.ApiKeyTypeShare || key.IsExpired() {
+		return "", false
+	}
+
+	return key.WorkspaceId, true
+}
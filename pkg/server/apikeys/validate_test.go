@@ -3,7 +3,11 @@
 
 package apikeys_test
 
-import "github.com/daytonaio/daytona/pkg/apikey"
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+)
 
 func (s *ApiKeyServiceTestSuite) TestIsValidKey_True() {
 	keyName := "api-key"
@@ -73,3 +77,38 @@ func (s *ApiKeyServiceTestSuite) TestIsWorkspaceApiKey_False() {
 	res := s.apiKeyService.IsWorkspaceApiKey(apiKey)
 	require.False(res)
 }
+
+func (s *ApiKeyServiceTestSuite) TestGetSharedWorkspaceId() {
+	require := s.Require()
+
+	shareKey, key, err := s.apiKeyService.GenerateShareKey("workspace1", time.Now().Add(time.Hour))
+	require.Nil(err)
+	require.Equal(apikey.ApiKeyTypeShare, shareKey.Type)
+
+	workspaceId, ok := s.apiKeyService.GetSharedWorkspaceId(key)
+	require.True(ok)
+	require.Equal("workspace1", workspaceId)
+
+	// Share keys are not valid for the rest of the API
+	require.False(s.apiKeyService.IsValidApiKey(key))
+}
+
+func (s *ApiKeyServiceTestSuite) TestGetSharedWorkspaceId_Expired() {
+	require := s.Require()
+
+	_, key, err := s.apiKeyService.GenerateShareKey("workspace1", time.Now().Add(-time.Minute))
+	require.Nil(err)
+
+	_, ok := s.apiKeyService.GetSharedWorkspaceId(key)
+	require.False(ok)
+}
+
+func (s *ApiKeyServiceTestSuite) TestGetSharedWorkspaceId_NotShareKey() {
+	require := s.Require()
+
+	key, err := s.apiKeyService.Generate(apikey.ApiKeyTypeClient, "client")
+	require.Nil(err)
+
+	_, ok := s.apiKeyService.GetSharedWorkspaceId(key)
+	require.False(ok)
+}
@@ -0,0 +1,242 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const (
+	manifestFileName = "manifest.json"
+	configDirName    = "server"
+	dbFileName       = "db"
+)
+
+// SQLite databases in the server config dir that are snapshotted instead of copied
+var configDirDatabases = []string{filepath.Join("headscale", "headscale.db")}
+
+// Paths in the server config dir that are not part of the backup
+var excludedConfigPaths = []string{
+	"registry",
+	"builds",
+	filepath.Join("headscale", "headscale.sock"),
+	filepath.Join("headscale", "headscale.db-wal"),
+	filepath.Join("headscale", "headscale.db-shm"),
+}
+
+var ErrInvalidArchive = errors.New("invalid backup archive")
+
+func IsInvalidArchive(err error) bool {
+	return errors.Is(err, ErrInvalidArchive)
+}
+
+type Manifest struct {
+	// Version of the Daytona server that created the backup
+	Version   string    `json:"version"`
+	ServerId  string    `json:"serverId"`
+	CreatedAt time.Time `json:"createdAt"`
+	// Server config dir of the machine the backup was created on
+	ConfigDir string `json:"configDir"`
+	// Set when the server database is included in the backup.
+	// External databases have to be backed up separately.
+	IncludesDatabase bool `json:"includesDatabase"`
+	IncludesLogs     bool `json:"includesLogs"`
+}
+
+type BackupConfig struct {
+	Version   string
+	ServerId  string
+	ConfigDir string
+	// Path of the SQLite server database. Empty when the server uses an external database
+	DbPath      string
+	ExcludeLogs bool
+}
+
+// Create writes a gzipped tar archive of the server config dir and database to w.
+// Databases are snapshotted so the backup is consistent while the server is running.
+func Create(config BackupConfig, w io.Writer) (*Manifest, error) {
+	tmpDir, err := os.MkdirTemp("", "daytona-backup-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	manifest := &Manifest{
+		Version:      config.Version,
+		ServerId:     config.ServerId,
+		CreatedAt:    time.Now(),
+		ConfigDir:    config.ConfigDir,
+		IncludesLogs: !config.ExcludeLogs,
+	}
+
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	if config.DbPath != "" {
+		_, err = os.Stat(config.DbPath)
+		if err == nil {
+			snapshotPath := filepath.Join(tmpDir, dbFileName)
+			err = snapshotDatabase(config.DbPath, snapshotPath)
+			if err != nil {
+				return nil, err
+			}
+
+			err = addFile(tarWriter, snapshotPath, dbFileName)
+			if err != nil {
+				return nil, err
+			}
+			manifest.IncludesDatabase = true
+		} else if !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+
+	excluded := append([]string{}, excludedConfigPaths...)
+	excluded = append(excluded, configDirDatabases...)
+	if config.ExcludeLogs {
+		excluded = append(excluded, "logs")
+	}
+
+	err = addDir(tarWriter, config.ConfigDir, configDirName, excluded)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, db := range configDirDatabases {
+		dbPath := filepath.Join(config.ConfigDir, db)
+		_, err = os.Stat(dbPath)
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		snapshotPath := filepath.Join(tmpDir, filepath.Base(db))
+		err = snapshotDatabase(dbPath, snapshotPath)
+		if err != nil {
+			return nil, err
+		}
+
+		err = addFile(tarWriter, snapshotPath, filepath.ToSlash(filepath.Join(configDirName, db)))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	manifestContent, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	err = tarWriter.WriteHeader(&tar.Header{
+		Name:    manifestFileName,
+		Mode:    0600,
+		Size:    int64(len(manifestContent)),
+		ModTime: manifest.CreatedAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tarWriter.Write(manifestContent)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tarWriter.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest, gzipWriter.Close()
+}
+
+// Writes a consistent copy of the SQLite database at dbPath to destPath
+func snapshotDatabase(dbPath, destPath string) error {
+	db, err := gorm.Open(sqlite.Open(dbPath), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		return err
+	}
+
+	sqlDb, err := db.DB()
+	if err != nil {
+		return err
+	}
+	defer sqlDb.Close()
+
+	return db.Exec("VACUUM INTO ?", destPath).Error
+}
+
+func addDir(tarWriter *tar.Writer, dir, archiveDir string, excluded []string) error {
+	return filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && path == dir {
+				return nil
+			}
+			return err
+		}
+
+		relPath, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		for _, e := range excluded {
+			if relPath == e {
+				if entry.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+		}
+
+		if relPath == "." || entry.IsDir() || !entry.Type().IsRegular() {
+			return nil
+		}
+
+		return addFile(tarWriter, path, filepath.ToSlash(filepath.Join(archiveDir, relPath)))
+	})
+}
+
+func addFile(tarWriter *tar.Writer, path, name string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	header, err := tar.FileInfoHeader(info, "")
+	if err != nil {
+		return err
+	}
+	header.Name = name
+
+	err = tarWriter.WriteHeader(header)
+	if err != nil {
+		return err
+	}
+
+	// Files that are still written to (e.g. logs) are copied up to their size at the time of the header
+	_, err = io.CopyN(tarWriter, file, info.Size())
+	return err
+}
@@ -0,0 +1,136 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package backup
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type record struct {
+	Id   string `gorm:"primaryKey"`
+	Name string
+}
+
+func createDatabase(t *testing.T, path string) {
+	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{})
+	require.NoError(t, err)
+
+	require.NoError(t, db.AutoMigrate(&record{}))
+	require.NoError(t, db.Create(&record{Id: "1", Name: "target"}).Error)
+
+	sqlDb, err := db.DB()
+	require.NoError(t, err)
+	require.NoError(t, sqlDb.Close())
+}
+
+func TestBackupAndRestore(t *testing.T) {
+	sourceDir := t.TempDir()
+	sourceConfigDir := filepath.Join(sourceDir, "daytona", "server")
+	sourceDbPath := filepath.Join(sourceDir, "daytona", "db")
+
+	require.NoError(t, os.MkdirAll(filepath.Join(sourceConfigDir, "headscale"), 0700))
+	require.NoError(t, os.MkdirAll(filepath.Join(sourceConfigDir, "logs", "workspace"), 0755))
+	require.NoError(t, os.MkdirAll(filepath.Join(sourceConfigDir, "registry"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(sourceConfigDir, "config.json"), []byte(`{"id":"server"}`), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(sourceConfigDir, "headscale", "noise_private.key"), []byte("key"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(sourceConfigDir, "logs", "workspace", "log"), []byte("log"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(sourceConfigDir, "registry", "blob"), []byte("blob"), 0644))
+	createDatabase(t, sourceDbPath)
+	createDatabase(t, filepath.Join(sourceConfigDir, "headscale", "headscale.db"))
+
+	var archive bytes.Buffer
+	_, err := Create(BackupConfig{
+		Version:     "v0.30.0",
+		ServerId:    "server",
+		ConfigDir:   sourceConfigDir,
+		DbPath:      sourceDbPath,
+		ExcludeLogs: true,
+	}, &archive)
+	require.NoError(t, err)
+
+	archivePath := filepath.Join(t.TempDir(), "backup.tar.gz")
+	require.NoError(t, os.WriteFile(archivePath, archive.Bytes(), 0600))
+
+	manifest, err := ReadManifest(archivePath)
+	require.NoError(t, err)
+	require.Equal(t, "server", manifest.ServerId)
+	require.Equal(t, sourceConfigDir, manifest.ConfigDir)
+	require.True(t, manifest.IncludesDatabase)
+	require.False(t, manifest.IncludesLogs)
+
+	destDir := t.TempDir()
+	destConfigDir := filepath.Join(destDir, "daytona", "server")
+	destDbPath := filepath.Join(destDir, "daytona", "db")
+	require.NoError(t, os.MkdirAll(destConfigDir, 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(destConfigDir, "config.json"), []byte(`{"id":"previous"}`), 0600))
+
+	result, err := Restore(RestoreConfig{
+		Version:   "v0.30.0",
+		ConfigDir: destConfigDir,
+		DbPath:    destDbPath,
+	}, archivePath)
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(filepath.Join(destConfigDir, "config.json"))
+	require.NoError(t, err)
+	require.Equal(t, `{"id":"server"}`, string(content))
+
+	content, err = os.ReadFile(filepath.Join(result.PreviousConfigDir, "config.json"))
+	require.NoError(t, err)
+	require.Equal(t, `{"id":"previous"}`, string(content))
+	require.Empty(t, result.PreviousDbPath)
+
+	require.FileExists(t, filepath.Join(destConfigDir, "headscale", "noise_private.key"))
+	require.FileExists(t, filepath.Join(destConfigDir, "headscale", "headscale.db"))
+	require.NoDirExists(t, filepath.Join(destConfigDir, "logs"))
+	require.NoDirExists(t, filepath.Join(destConfigDir, "registry"))
+
+	db, err := gorm.Open(sqlite.Open(destDbPath), &gorm.Config{})
+	require.NoError(t, err)
+	var restored record
+	require.NoError(t, db.First(&restored, "id = ?", "1").Error)
+	require.Equal(t, "target", restored.Name)
+}
+
+func TestRestoreNewerVersion(t *testing.T) {
+	configDir := filepath.Join(t.TempDir(), "server")
+
+	var archive bytes.Buffer
+	_, err := Create(BackupConfig{
+		Version:   "v0.31.0",
+		ServerId:  "server",
+		ConfigDir: configDir,
+	}, &archive)
+	require.NoError(t, err)
+
+	archivePath := filepath.Join(t.TempDir(), "backup.tar.gz")
+	require.NoError(t, os.WriteFile(archivePath, archive.Bytes(), 0600))
+
+	_, err = Restore(RestoreConfig{
+		Version:   "v0.30.0",
+		ConfigDir: configDir,
+	}, archivePath)
+	require.True(t, IsNewerVersion(err))
+
+	_, err = Restore(RestoreConfig{
+		Version:   "v0.0.0-dev",
+		ConfigDir: configDir,
+	}, archivePath)
+	require.NoError(t, err)
+}
+
+func TestReadManifestInvalidArchive(t *testing.T) {
+	archivePath := filepath.Join(t.TempDir(), "backup.tar.gz")
+	require.NoError(t, os.WriteFile(archivePath, []byte("not an archive"), 0600))
+
+	_, err := ReadManifest(archivePath)
+	require.True(t, IsInvalidArchive(err))
+}
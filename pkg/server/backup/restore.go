@@ -0,0 +1,237 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/mod/semver"
+)
+
+var ErrNewerVersion = errors.New("the backup was created by a newer version of Daytona")
+
+func IsNewerVersion(err error) bool {
+	return errors.Is(err, ErrNewerVersion)
+}
+
+type RestoreConfig struct {
+	Version   string
+	ConfigDir string
+	DbPath    string
+	// Restore backups created by a newer version
+	Force bool
+}
+
+type RestoreResult struct {
+	Manifest *Manifest
+	// Locations the replaced server config dir and database were moved to. Empty if there was nothing to replace
+	PreviousConfigDir string
+	PreviousDbPath    string
+}
+
+// ReadManifest returns the manifest of the backup archive without extracting it
+func ReadManifest(archivePath string) (*Manifest, error) {
+	file, err := os.Open(archivePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	tarReader, err := newTarReader(file)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil, fmt.Errorf("%w: %s not found", ErrInvalidArchive, manifestFileName)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidArchive, err)
+		}
+
+		if header.Name == manifestFileName {
+			return decodeManifest(tarReader)
+		}
+	}
+}
+
+// Restore replaces the server config dir and database with the contents of the backup archive.
+// The replaced data is kept next to the original location.
+func Restore(config RestoreConfig, archivePath string) (*RestoreResult, error) {
+	manifest, err := ReadManifest(archivePath)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkVersion(manifest.Version, config.Version)
+	if err != nil && !config.Force {
+		return nil, err
+	}
+
+	err = os.MkdirAll(filepath.Dir(config.ConfigDir), 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extracted next to the config dir so that the restored data can be moved in place
+	tmpDir, err := os.MkdirTemp(filepath.Dir(config.ConfigDir), ".daytona-restore-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	err = extract(archivePath, tmpDir)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &RestoreResult{Manifest: manifest}
+	suffix := fmt.Sprintf(".bak-%s", time.Now().Format("20060102150405"))
+
+	result.PreviousConfigDir, err = replace(filepath.Join(tmpDir, configDirName), config.ConfigDir, suffix)
+	if err != nil {
+		return nil, err
+	}
+
+	if manifest.IncludesDatabase {
+		err = os.MkdirAll(filepath.Dir(config.DbPath), 0755)
+		if err != nil {
+			return nil, err
+		}
+
+		result.PreviousDbPath, err = replace(filepath.Join(tmpDir, dbFileName), config.DbPath, suffix)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// Returns an error if the backup was created by a newer version. Development builds restore any backup
+func checkVersion(backupVersion, currentVersion string) error {
+	if !semver.IsValid(backupVersion) || !semver.IsValid(currentVersion) || semver.Prerelease(currentVersion) == "-dev" {
+		return nil
+	}
+
+	if semver.Compare(backupVersion, currentVersion) > 0 {
+		return fmt.Errorf("%w (%s, current version is %s)", ErrNewerVersion, backupVersion, currentVersion)
+	}
+
+	return nil
+}
+
+// Moves src to dest. An existing dest is moved to dest+suffix whose path is returned
+func replace(src, dest, suffix string) (string, error) {
+	_, err := os.Stat(src)
+	if os.IsNotExist(err) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	previous := ""
+	_, err = os.Stat(dest)
+	if err == nil {
+		previous = dest + suffix
+		err = os.Rename(dest, previous)
+		if err != nil {
+			return "", err
+		}
+	} else if !os.IsNotExist(err) {
+		return "", err
+	}
+
+	return previous, os.Rename(src, dest)
+}
+
+func extract(archivePath, destDir string) error {
+	file, err := os.Open(archivePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	tarReader, err := newTarReader(file)
+	if err != nil {
+		return err
+	}
+
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidArchive, err)
+		}
+
+		if header.Typeflag != tar.TypeReg || header.Name == manifestFileName {
+			continue
+		}
+
+		name := filepath.FromSlash(header.Name)
+		if filepath.IsAbs(name) || name != filepath.Clean(name) || strings.HasPrefix(name, "..") {
+			return fmt.Errorf("%w: invalid path %s", ErrInvalidArchive, header.Name)
+		}
+
+		path := filepath.Join(destDir, name)
+		err = os.MkdirAll(filepath.Dir(path), 0700)
+		if err != nil {
+			return err
+		}
+
+		err = extractFile(tarReader, path, os.FileMode(header.Mode).Perm())
+		if err != nil {
+			return err
+		}
+
+		_ = os.Chtimes(path, header.ModTime, header.ModTime)
+	}
+}
+
+func extractFile(reader io.Reader, path string, mode os.FileMode) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = io.Copy(file, reader)
+	return err
+}
+
+func newTarReader(reader io.Reader) (*tar.Reader, error) {
+	gzipReader, err := gzip.NewReader(reader)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidArchive, err)
+	}
+
+	return tar.NewReader(gzipReader), nil
+}
+
+func decodeManifest(reader io.Reader) (*Manifest, error) {
+	var manifest Manifest
+	err := json.NewDecoder(reader).Decode(&manifest)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidArchive, err)
+	}
+
+	if manifest.Version == "" || manifest.ServerId == "" {
+		return nil, fmt.Errorf("%w: incomplete manifest", ErrInvalidArchive)
+	}
+
+	return &manifest, nil
+}
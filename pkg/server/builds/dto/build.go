@@ -0,0 +1,18 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import "github.com/daytonaio/daytona/pkg/gitprovider"
+
+type CreateBuildRequest struct {
+	Repository gitprovider.GitRepository `json:"repository" validate:"required"`
+	// Path of the devcontainer configuration relative to the repository root
+	DevcontainerFilePath string `json:"devcontainerFilePath" validate:"required"`
+	// Push the build image to an external registry under the given name
+	ExportImage *string `json:"exportImage,omitempty"`
+	// Git provider config used to clone the repository. Resolved from the repository URL if not set
+	GitProviderConfigId *string `json:"gitProviderConfigId,omitempty"`
+	// Set by the server to the user creating the build
+	OwnerId string `json:"-" swaggerignore:"true"`
+} //	@name	CreateBuildRequest
@@ -0,0 +1,287 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package builds
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/builder"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/server/builds/dto"
+	"github.com/daytonaio/daytona/pkg/server/events"
+	"github.com/daytonaio/daytona/pkg/server/gitproviders"
+	"github.com/daytonaio/daytona/pkg/server/users"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/docker/docker/pkg/stringid"
+)
+
+type IBuildService interface {
+	Create(req dto.CreateBuildRequest) (*builder.Build, error)
+	Find(id string) (*builder.Build, error)
+	GetBuildOwner(id string) (string, error)
+	List() ([]*builder.Build, error)
+	GetBuildLogReader(id string) (io.Reader, error)
+}
+
+type BuildServiceConfig struct {
+	BuilderFactory     builder.IBuilderFactory
+	GitProviderService gitproviders.IGitProviderService
+	LoggerFactory      logs.LoggerFactory
+	EventService       events.IEventService
+	// Used to restrict the git provider configs of builds created by members. All configs are used if not set
+	UserService users.IUserService
+}
+
+func NewBuildService(config BuildServiceConfig) IBuildService {
+	return &BuildService{
+		builderFactory:     config.BuilderFactory,
+		gitProviderService: config.GitProviderService,
+		loggerFactory:      config.LoggerFactory,
+		eventService:       config.EventService,
+		userService:        config.UserService,
+		builds:             map[string]*builder.Build{},
+	}
+}
+
+// BuildService runs one-off builds. Builds are kept in memory and their logs are
+// written with the build ID in place of the workspace ID.
+type BuildService struct {
+	builderFactory     builder.IBuilderFactory
+	gitProviderService gitproviders.IGitProviderService
+	loggerFactory      logs.LoggerFactory
+	eventService       events.IEventService
+	userService        users.IUserService
+
+	builds map[string]*builder.Build
+	mutex  sync.RWMutex
+}
+
+var ErrBuildNotFound = errors.New("build not found")
+
+func IsBuildNotFound(err error) bool {
+	return err.Error() == ErrBuildNotFound.Error()
+}
+
+func (s *BuildService) Create(req dto.CreateBuildRequest) (*builder.Build, error) {
+	repository := req.Repository
+	if repository.Url == "" {
+		return nil, errors.New("repository url is required")
+	}
+
+	if repository.Sha == "" {
+		sha, err := s.getGitProviderService(req.OwnerId).GetLastCommitSha(&repository)
+		if err != nil {
+			return nil, err
+		}
+		repository.Sha = sha
+	}
+
+	id := stringid.TruncateID(stringid.GenerateRandomID())
+
+	projectName := repository.Name
+	if projectName == "" {
+		projectName = id
+	}
+
+	project := workspace.Project{
+		Name:        projectName,
+		WorkspaceId: id,
+		Repository:  &repository,
+		Build: &workspace.ProjectBuild{
+			Devcontainer: &workspace.ProjectBuildDevcontainer{
+				DevContainerFilePath: req.DevcontainerFilePath,
+			},
+		},
+	}
+
+	if req.ExportImage != nil && *req.ExportImage != "" {
+		project.Build.Export = &workspace.ProjectBuildExport{
+			Image: *req.ExportImage,
+		}
+	}
+
+	if req.GitProviderConfigId != nil {
+		project.GitProviderConfigId = *req.GitProviderConfigId
+	}
+
+	now := time.Now().Format(time.RFC1123)
+	build := &builder.Build{
+		Id:                   id,
+		State:                builder.BuildStatePending,
+		ProjectName:          projectName,
+		Repository:           &repository,
+		DevcontainerFilePath: req.DevcontainerFilePath,
+		OwnerId:              req.OwnerId,
+		CreatedAt:            now,
+		UpdatedAt:            now,
+	}
+
+	s.mutex.Lock()
+	s.builds[id] = build
+	s.mutex.Unlock()
+
+	go s.runBuild(project, req.OwnerId)
+
+	return s.Find(id)
+}
+
+func (s *BuildService) Find(id string) (*builder.Build, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	build, ok := s.builds[id]
+	if !ok {
+		return nil, ErrBuildNotFound
+	}
+
+	// Return a copy so the caller does not observe concurrent updates
+	result := *build
+	return &result, nil
+}
+
+func (s *BuildService) GetBuildOwner(id string) (string, error) {
+	build, err := s.Find(id)
+	if err != nil {
+		return "", err
+	}
+
+	return build.OwnerId, nil
+}
+
+func (s *BuildService) List() ([]*builder.Build, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	builds := []*builder.Build{}
+	for _, build := range s.builds {
+		result := *build
+		builds = append(builds, &result)
+	}
+
+	sort.Slice(builds, func(i, j int) bool {
+		return builds[i].Id < builds[j].Id
+	})
+
+	return builds, nil
+}
+
+func (s *BuildService) GetBuildLogReader(id string) (io.Reader, error) {
+	build, err := s.Find(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.loggerFactory.CreateProjectLogReader(build.Id, build.ProjectName)
+}
+
+func (s *BuildService) runBuild(project workspace.Project, ownerId string) {
+	buildLogger := s.loggerFactory.CreateProjectLogger(project.WorkspaceId, project.Name, logs.LogSourceServer)
+	defer buildLogger.Close()
+
+	buildLogger.Write([]byte(fmt.Sprintf("Building %s (%s)\n", project.Repository.Url, project.Repository.Sha)))
+	s.update(project.WorkspaceId, func(b *builder.Build) {
+		b.State = builder.BuildStateRunning
+	})
+
+	result, err := s.build(project, ownerId)
+	if err != nil {
+		buildLogger.Write([]byte(fmt.Sprintf("Build failed: %s\n", err.Error())))
+		s.update(project.WorkspaceId, func(b *builder.Build) {
+			b.State = builder.BuildStateError
+			b.Error = err.Error()
+		})
+		s.emitEvent(events.Event{
+			Type:        events.EventTypeBuildFailed,
+			ProjectName: project.Name,
+			BuildId:     project.WorkspaceId,
+			Message:     fmt.Sprintf("Build of %s failed: %s", project.Repository.Url, err.Error()),
+		})
+		return
+	}
+
+	buildLogger.Write([]byte(fmt.Sprintf("Build image: %s\n", result.ImageName)))
+	s.update(project.WorkspaceId, func(b *builder.Build) {
+		b.State = builder.BuildStateSuccess
+		b.Image = result.ImageName
+		b.User = result.User
+		b.ExportedImage = result.ExportedImage
+		b.CacheFrom = result.CacheFrom
+		b.CacheHits = result.CacheHits
+		b.CacheMisses = result.CacheMisses
+	})
+	s.emitEvent(events.Event{
+		Type:        events.EventTypePrebuildReady,
+		ProjectName: project.Name,
+		BuildId:     project.WorkspaceId,
+		Message:     fmt.Sprintf("Build of %s (%s) is ready: %s", project.Repository.Url, project.Repository.Sha, result.ImageName),
+	})
+}
+
+// Builds and publishes the image. The result is not stored so following workspaces are not affected
+func (s *BuildService) build(project workspace.Project, ownerId string) (*builder.BuildResult, error) {
+	gc, err := s.getGitProviderService(ownerId).ResolveConfig(project.Repository.Url, project.GitProviderConfigId)
+	if err != nil && !gitprovider.IsGitProviderNotFound(err) {
+		return nil, err
+	}
+
+	b, err := s.builderFactory.Create(project, gc)
+	if err != nil {
+		return nil, err
+	}
+
+	if b == nil {
+		return nil, errors.New("no builder found for the project")
+	}
+
+	defer func() {
+		cleanUpErr := b.CleanUp()
+		if cleanUpErr != nil {
+			s.logError(project, fmt.Errorf("error cleaning up build: %w", cleanUpErr))
+		}
+	}()
+
+	result, err := b.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.Publish(result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *BuildService) update(id string, update func(b *builder.Build)) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	build, ok := s.builds[id]
+	if !ok {
+		return
+	}
+
+	update(build)
+	build.UpdatedAt = time.Now().Format(time.RFC1123)
+}
+
+func (s *BuildService) emitEvent(event events.Event) {
+	if s.eventService != nil {
+		s.eventService.Emit(event)
+	}
+}
+
+func (s *BuildService) logError(project workspace.Project, err error) {
+	buildLogger := s.loggerFactory.CreateProjectLogger(project.WorkspaceId, project.Name, logs.LogSourceServer)
+	defer buildLogger.Close()
+
+	buildLogger.Write([]byte(err.Error() + "\n"))
+}
@@ -0,0 +1,167 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package builds_test
+
+import (
+	"testing"
+	"time"
+
+	t_apikeys "github.com/daytonaio/daytona/internal/testing/server/apikeys"
+	t_users "github.com/daytonaio/daytona/internal/testing/server/users"
+	"github.com/daytonaio/daytona/internal/testing/server/workspaces/mocks"
+	"github.com/daytonaio/daytona/pkg/builder"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	"github.com/daytonaio/daytona/pkg/server/builds"
+	"github.com/daytonaio/daytona/pkg/server/builds/dto"
+	"github.com/daytonaio/daytona/pkg/server/gitproviders"
+	"github.com/daytonaio/daytona/pkg/server/users"
+	"github.com/daytonaio/daytona/pkg/user"
+	"github.com/stretchr/testify/require"
+)
+
+var repository = gitprovider.GitRepository{
+	Id:   "123",
+	Url:  "https://github.com/daytonaio/daytona",
+	Name: "daytona",
+}
+
+func TestBuildService(t *testing.T) {
+	gitProviderService := mocks.NewMockGitProviderService()
+
+	buildService := builds.NewBuildService(builds.BuildServiceConfig{
+		BuilderFactory:     &mocks.MockBuilderFactory{},
+		GitProviderService: gitProviderService,
+		LoggerFactory:      logs.NewLoggerFactory(t.TempDir()),
+	})
+
+	gitProviderService.On("GetLastCommitSha", &repository).Return("abc", nil)
+	gitProviderService.On("ResolveConfig", repository.Url, "").Return(&gitprovider.GitProviderConfig{Id: "github"}, nil)
+
+	t.Run("CreateBuild", func(t *testing.T) {
+		build, err := buildService.Create(dto.CreateBuildRequest{
+			Repository:           repository,
+			DevcontainerFilePath: ".devcontainer/devcontainer.json",
+		})
+		require.NoError(t, err)
+		require.Equal(t, repository.Name, build.ProjectName)
+		require.Equal(t, "abc", build.Repository.Sha)
+
+		require.Eventually(t, func() bool {
+			b, err := buildService.Find(build.Id)
+			return err == nil && b.IsFinished()
+		}, 5*time.Second, 10*time.Millisecond)
+
+		build, err = buildService.Find(build.Id)
+		require.NoError(t, err)
+		require.Equal(t, builder.BuildStateSuccess, build.State)
+		require.Equal(t, mocks.MockBuildResults.ImageName, build.Image)
+		require.Equal(t, mocks.MockBuildResults.User, build.User)
+
+		buildList, err := buildService.List()
+		require.NoError(t, err)
+		require.Len(t, buildList, 1)
+
+		_, err = buildService.GetBuildLogReader(build.Id)
+		require.NoError(t, err)
+	})
+
+	t.Run("FindBuildNotFound", func(t *testing.T) {
+		_, err := buildService.Find("not-found")
+		require.True(t, builds.IsBuildNotFound(err))
+	})
+}
+
+// Returns a separate git provider service for each owner
+type ownerGitProviderService struct {
+	gitproviders.IGitProviderService
+	owners map[string]gitproviders.IGitProviderService
+}
+
+func (s *ownerGitProviderService) ForOwner(ownerId string) gitproviders.IGitProviderService {
+	return s.owners[ownerId]
+}
+
+func TestBuildServiceOwner(t *testing.T) {
+	userService := users.NewUserService(users.UserServiceConfig{
+		UserStore: t_users.NewInMemoryUserStore(),
+		ApiKeyService: apikeys.NewApiKeyService(apikeys.ApiKeyServiceConfig{
+			ApiKeyStore: t_apikeys.NewInMemoryApiKeyStore(),
+		}),
+	})
+
+	admin, _, err := userService.Create("admin", user.RoleAdmin)
+	require.NoError(t, err)
+	member, _, err := userService.Create("member", user.RoleMember)
+	require.NoError(t, err)
+
+	gitProviderService := mocks.NewMockGitProviderService()
+	memberGitProviderService := mocks.NewMockGitProviderService()
+
+	buildService := builds.NewBuildService(builds.BuildServiceConfig{
+		BuilderFactory: &mocks.MockBuilderFactory{},
+		GitProviderService: &ownerGitProviderService{
+			IGitProviderService: gitProviderService,
+			owners: map[string]gitproviders.IGitProviderService{
+				member.Id: memberGitProviderService,
+			},
+		},
+		LoggerFactory: logs.NewLoggerFactory(t.TempDir()),
+		UserService:   userService,
+	})
+
+	adminRepository := repository
+	adminRepository.Sha = "abc"
+	gitProviderService.On("ResolveConfig", repository.Url, "github").Return(&gitprovider.GitProviderConfig{Id: "github"}, nil)
+
+	memberRepository := repository
+	memberRepository.Sha = "def"
+	// The config of another user can not be resolved with the configs of the member
+	memberGitProviderService.On("ResolveConfig", repository.Url, "github").Return((*gitprovider.GitProviderConfig)(nil), gitprovider.ErrGitProviderNotFound)
+
+	waitForBuild := func(t *testing.T, id string) *builder.Build {
+		require.Eventually(t, func() bool {
+			b, err := buildService.Find(id)
+			return err == nil && b.IsFinished()
+		}, 5*time.Second, 10*time.Millisecond)
+
+		build, err := buildService.Find(id)
+		require.NoError(t, err)
+		return build
+	}
+
+	gitProviderConfigId := "github"
+
+	t.Run("AdminUsesAllConfigs", func(t *testing.T) {
+		build, err := buildService.Create(dto.CreateBuildRequest{
+			Repository:           adminRepository,
+			DevcontainerFilePath: ".devcontainer/devcontainer.json",
+			GitProviderConfigId:  &gitProviderConfigId,
+			OwnerId:              admin.Id,
+		})
+		require.NoError(t, err)
+		require.Equal(t, admin.Id, build.OwnerId)
+
+		waitForBuild(t, build.Id)
+		gitProviderService.AssertCalled(t, "ResolveConfig", repository.Url, "github")
+	})
+
+	t.Run("MemberUsesOwnConfigs", func(t *testing.T) {
+		build, err := buildService.Create(dto.CreateBuildRequest{
+			Repository:           memberRepository,
+			DevcontainerFilePath: ".devcontainer/devcontainer.json",
+			GitProviderConfigId:  &gitProviderConfigId,
+			OwnerId:              member.Id,
+		})
+		require.NoError(t, err)
+
+		waitForBuild(t, build.Id)
+		memberGitProviderService.AssertCalled(t, "ResolveConfig", repository.Url, "github")
+
+		ownerId, err := buildService.GetBuildOwner(build.Id)
+		require.NoError(t, err)
+		require.Equal(t, member.Id, ownerId)
+	})
+}
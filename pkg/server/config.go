@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 )
 
 func GetConfig() (*Config, error) {
@@ -49,6 +50,39 @@ func GetConfig() (*Config, error) {
 	return &c, nil
 }
 
+// Redacted returns a copy of the config without the settings that hold credentials, e.g. the tailnet auth key,
+// the database connection string and the archive storage keys
+func (c Config) Redacted() *Config {
+	c.Tailnet = nil
+	c.Database = nil
+	c.Notifications = nil
+	c.ArchiveStorage = nil
+
+	return &c
+}
+
+// Environment variable holding the Postgres connection string. It is not stored in the config
+// so the database password is not written to disk next to the other settings
+const DatabaseUrlEnvVar = "DAYTONA_DATABASE_URL"
+
+// GetUrl returns the Postgres connection string from the environment or the configured file
+func (c *DatabaseConfig) GetUrl() (string, error) {
+	if url := os.Getenv(DatabaseUrlEnvVar); url != "" {
+		return url, nil
+	}
+
+	if c.UrlFile == "" {
+		return "", fmt.Errorf("database url is required when using the postgres database, set %s or the database url file", DatabaseUrlEnvVar)
+	}
+
+	content, err := os.ReadFile(c.UrlFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the database url file: %w", err)
+	}
+
+	return strings.TrimSpace(string(content)), nil
+}
+
 func configFilePath() (string, error) {
 	configDir, err := GetConfigDir()
 	if err != nil {
@@ -27,6 +27,9 @@ const defaultProjectUser = "daytona"
 const defaultLocalBuilderRegistryPort = 3988
 const defaultBuilderRegistryServer = "local"
 const defaultBuildImageNamespace = ""
+const defaultLogMaxFileSizeMB = 50
+const defaultLogMaxBackups = 5
+const defaultLogMaxAgeDays = 30
 
 var defaultProjectPostStartCommands = []string{"sudo dockerd"}
 
@@ -118,6 +121,11 @@ func getDefaultConfig() (*Config, error) {
 		LocalBuilderRegistryPort:        defaultLocalBuilderRegistryPort,
 		BuilderRegistryServer:           defaultBuilderRegistryServer,
 		BuildImageNamespace:             defaultBuildImageNamespace,
+		LogRetention: &LogRetentionConfig{
+			MaxFileSizeMB: defaultLogMaxFileSizeMB,
+			MaxBackups:    defaultLogMaxBackups,
+			MaxAgeDays:    defaultLogMaxAgeDays,
+		},
 	}
 
 	if os.Getenv("DEFAULT_REGISTRY_URL") != "" {
@@ -0,0 +1,102 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"sync"
+	"time"
+)
+
+const defaultMaxEvents = 1000
+
+type EventType string // @name EventType
+
+const (
+	// The stored project state does not match the state reported by the provider
+	EventTypeProjectStateDrift EventType = "project-state-drift"
+	// A project or one-off build failed
+	EventTypeBuildFailed EventType = "build-failed"
+	// A one-off build finished and its image can be used by new workspaces
+	EventTypePrebuildReady EventType = "prebuild-ready"
+	// Creating or starting a workspace failed
+	EventTypeWorkspaceError EventType = "workspace-error"
+	// An expired workspace was stopped automatically
+	EventTypeWorkspaceAutoStopped EventType = "workspace-auto-stopped"
+	// A workspace is about to expire and be stopped or deleted automatically
+	EventTypeWorkspaceExpiring EventType = "workspace-expiring"
+)
+
+type Event struct {
+	Type        EventType `json:"type"`
+	Time        string    `json:"time"`
+	WorkspaceId string    `json:"workspaceId,omitempty"`
+	ProjectName string    `json:"projectName,omitempty"`
+	BuildId     string    `json:"buildId,omitempty"`
+	Message     string    `json:"message"`
+} // @name Event
+
+type EventHandler func(event Event)
+
+type IEventService interface {
+	Emit(event Event)
+	List() []Event
+	Subscribe(handler EventHandler)
+}
+
+type EventServiceConfig struct {
+	// Number of most recent events that are kept. Defaults to 1000
+	MaxEvents int
+}
+
+func NewEventService(config EventServiceConfig) IEventService {
+	maxEvents := config.MaxEvents
+	if maxEvents <= 0 {
+		maxEvents = defaultMaxEvents
+	}
+
+	return &EventService{
+		maxEvents: maxEvents,
+	}
+}
+
+type EventService struct {
+	mutex     sync.RWMutex
+	maxEvents int
+	events    []Event
+	handlers  []EventHandler
+}
+
+// Emit records the event and passes it to the subscribed handlers
+func (s *EventService) Emit(event Event) {
+	if event.Time == "" {
+		event.Time = time.Now().Format(time.RFC3339)
+	}
+
+	s.mutex.Lock()
+	s.events = append(s.events, event)
+	if len(s.events) > s.maxEvents {
+		s.events = s.events[len(s.events)-s.maxEvents:]
+	}
+	handlers := append([]EventHandler{}, s.handlers...)
+	s.mutex.Unlock()
+
+	for _, handler := range handlers {
+		handler(event)
+	}
+}
+
+// List returns the recorded events, oldest first
+func (s *EventService) List() []Event {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return append([]Event{}, s.events...)
+}
+
+func (s *EventService) Subscribe(handler EventHandler) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.handlers = append(s.handlers, handler)
+}
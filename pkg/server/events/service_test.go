@@ -0,0 +1,35 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package events_test
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/server/events"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventService(t *testing.T) {
+	service := events.NewEventService(events.EventServiceConfig{MaxEvents: 2})
+
+	var handled []events.Event
+	service.Subscribe(func(event events.Event) {
+		handled = append(handled, event)
+	})
+
+	for _, message := range []string{"first", "second", "third"} {
+		service.Emit(events.Event{
+			Type:    events.EventTypeProjectStateDrift,
+			Message: message,
+		})
+	}
+
+	require.Len(t, handled, 3)
+	require.NotEmpty(t, handled[0].Time)
+
+	recorded := service.List()
+	require.Len(t, recorded, 2)
+	require.Equal(t, "second", recorded[0].Message)
+	require.Equal(t, "third", recorded[1].Message)
+}
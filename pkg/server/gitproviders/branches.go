@@ -5,10 +5,17 @@ package gitproviders
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/daytonaio/daytona/pkg/gitprovider"
+
+	log "github.com/sirupsen/logrus"
 )
 
+// Each comparison is a provider API request so only the first branches are compared
+const maxComparedBranches = 50
+const maxConcurrentComparisons = 5
+
 func (s *GitProviderService) GetRepoBranches(gitProviderId, namespaceId, repositoryId string) ([]*gitprovider.GitBranch, error) {
 	gitProvider, err := s.GetGitProvider(gitProviderId)
 	if err != nil {
@@ -17,8 +24,66 @@ func (s *GitProviderService) GetRepoBranches(gitProviderId, namespaceId, reposit
 
 	response, err := gitProvider.GetRepoBranches(repositoryId, namespaceId)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get branches: %s", err.Error())
+		return nil, fmt.Errorf("failed to get branches: %w", err)
+	}
+
+	if branchComparer, ok := gitProvider.(gitprovider.GitBranchComparer); ok {
+		compareBranches(branchComparer, repositoryId, namespaceId, response)
 	}
 
 	return response, nil
 }
+
+// Adds the ahead and behind counts versus the default branch. Branches that fail to compare are left without them
+func compareBranches(branchComparer gitprovider.GitBranchComparer, repositoryId, namespaceId string, branches []*gitprovider.GitBranch) {
+	defaultBranch, err := branchComparer.GetDefaultBranch(repositoryId, namespaceId)
+	if err != nil {
+		log.Debugf("failed to get the default branch of %s: %s", repositoryId, err)
+		return
+	}
+
+	var wg sync.WaitGroup
+	limit := make(chan struct{}, maxConcurrentComparisons)
+
+	for i, branch := range branches {
+		if branch.Name == defaultBranch {
+			zero := 0
+			branch.Ahead = &zero
+			branch.Behind = &zero
+			continue
+		}
+
+		if i >= maxComparedBranches {
+			continue
+		}
+
+		wg.Add(1)
+		go func(branch *gitprovider.GitBranch) {
+			defer wg.Done()
+
+			limit <- struct{}{}
+			defer func() { <-limit }()
+
+			comparison, err := branchComparer.CompareBranches(repositoryId, namespaceId, defaultBranch, branch.Name)
+			if err != nil {
+				log.Debugf("failed to compare branch %s with %s: %s", branch.Name, defaultBranch, err)
+				return
+			}
+
+			branch.Ahead = &comparison.Ahead
+			branch.Behind = &comparison.Behind
+
+			// Comparisons can be truncated so the commit is only used if it is the branch head
+			if comparison.HeadCommit != nil && comparison.HeadCommit.Sha == branch.Sha {
+				if branch.Author == "" {
+					branch.Author = comparison.HeadCommit.Author
+				}
+				if branch.Date == "" {
+					branch.Date = comparison.HeadCommit.Date
+				}
+			}
+		}(branch)
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,180 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitproviders
+
+import (
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	cacheTtl = 5 * time.Minute
+	// Repositories are warmed for the first namespaces only, which usually are the personal namespace and the main organizations
+	maxWarmedNamespaces = 5
+)
+
+type providerCache struct {
+	status       gitprovider.GitProviderCacheStatus
+	namespaces   *cachedNamespaces
+	repositories map[string]*cachedRepositories
+}
+
+type cachedNamespaces struct {
+	namespaces []*gitprovider.GitNamespace
+	fetchedAt  time.Time
+}
+
+type cachedRepositories struct {
+	repositories []*gitprovider.GitRepository
+	fetchedAt    time.Time
+}
+
+func (s *GitProviderService) GetCacheStatus(gitProviderId string) *gitprovider.GitProviderCacheStatus {
+	if !s.cacheWarming || !s.isConfigVisible(gitProviderId) {
+		return nil
+	}
+
+	s.cacheMutex.RLock()
+	defer s.cacheMutex.RUnlock()
+
+	cache, ok := s.caches[gitProviderId]
+	if !ok {
+		return nil
+	}
+
+	status := cache.status
+	return &status
+}
+
+// Fetches the namespaces and the repositories of the first namespaces of the provider in the background
+func (s *GitProviderService) warmCache(gitProviderId string) {
+	s.cacheMutex.Lock()
+	s.caches[gitProviderId] = &providerCache{
+		status: gitprovider.GitProviderCacheStatus{
+			State: gitprovider.GitProviderCacheStateWarming,
+		},
+		repositories: map[string]*cachedRepositories{},
+	}
+	s.cacheMutex.Unlock()
+
+	go func() {
+		err := s.fillCache(gitProviderId)
+
+		s.cacheMutex.Lock()
+		defer s.cacheMutex.Unlock()
+
+		cache, ok := s.caches[gitProviderId]
+		if !ok {
+			return
+		}
+
+		cache.status.UpdatedAt = time.Now().Format(time.RFC3339)
+		if err != nil {
+			log.Errorf("Failed to warm the cache of git provider %s: %s", gitProviderId, err)
+			cache.status.State = gitprovider.GitProviderCacheStateFailed
+			cache.status.Error = err.Error()
+			return
+		}
+
+		cache.status.State = gitprovider.GitProviderCacheStateReady
+		cache.status.Error = ""
+	}()
+}
+
+func (s *GitProviderService) fillCache(gitProviderId string) error {
+	namespaces, err := s.GetNamespaces(gitProviderId)
+	if err != nil {
+		return err
+	}
+
+	for i, namespace := range namespaces {
+		if i == maxWarmedNamespaces {
+			break
+		}
+
+		_, err := s.GetRepositories(gitProviderId, namespace.Id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *GitProviderService) removeCache(gitProviderId string) {
+	s.cacheMutex.Lock()
+	defer s.cacheMutex.Unlock()
+
+	delete(s.caches, gitProviderId)
+}
+
+func (s *GitProviderService) getCachedNamespaces(gitProviderId string) ([]*gitprovider.GitNamespace, bool) {
+	if !s.isConfigVisible(gitProviderId) {
+		return nil, false
+	}
+
+	s.cacheMutex.RLock()
+	defer s.cacheMutex.RUnlock()
+
+	cache, ok := s.caches[gitProviderId]
+	if !ok || cache.namespaces == nil || time.Since(cache.namespaces.fetchedAt) > cacheTtl {
+		return nil, false
+	}
+
+	return cache.namespaces.namespaces, true
+}
+
+func (s *GitProviderService) setCachedNamespaces(gitProviderId string, namespaces []*gitprovider.GitNamespace) {
+	s.cacheMutex.Lock()
+	defer s.cacheMutex.Unlock()
+
+	cache, ok := s.caches[gitProviderId]
+	if !ok {
+		return
+	}
+
+	cache.namespaces = &cachedNamespaces{
+		namespaces: namespaces,
+		fetchedAt:  time.Now(),
+	}
+}
+
+func (s *GitProviderService) getCachedRepositories(gitProviderId, namespaceId string) ([]*gitprovider.GitRepository, bool) {
+	if !s.isConfigVisible(gitProviderId) {
+		return nil, false
+	}
+
+	s.cacheMutex.RLock()
+	defer s.cacheMutex.RUnlock()
+
+	cache, ok := s.caches[gitProviderId]
+	if !ok {
+		return nil, false
+	}
+
+	repositories, ok := cache.repositories[namespaceId]
+	if !ok || time.Since(repositories.fetchedAt) > cacheTtl {
+		return nil, false
+	}
+
+	return repositories.repositories, true
+}
+
+func (s *GitProviderService) setCachedRepositories(gitProviderId, namespaceId string, repositories []*gitprovider.GitRepository) {
+	s.cacheMutex.Lock()
+	defer s.cacheMutex.Unlock()
+
+	cache, ok := s.caches[gitProviderId]
+	if !ok {
+		return
+	}
+
+	cache.repositories[namespaceId] = &cachedRepositories{
+		repositories: repositories,
+		fetchedAt:    time.Now(),
+	}
+}
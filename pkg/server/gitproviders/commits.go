@@ -0,0 +1,29 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitproviders
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+)
+
+func (s *GitProviderService) GetRepoCommits(gitProviderId, namespaceId, repositoryId, branch string, page, perPage int) ([]*gitprovider.GitCommit, error) {
+	gitProvider, err := s.GetGitProvider(gitProviderId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get git provider: %s", err.Error())
+	}
+
+	commitLister, ok := gitProvider.(gitprovider.GitCommitLister)
+	if !ok {
+		return nil, gitprovider.ErrCommitListingNotSupported
+	}
+
+	response, err := commitLister.GetRepoCommits(repositoryId, namespaceId, branch, page, perPage)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commits: %w", err)
+	}
+
+	return response, nil
+}
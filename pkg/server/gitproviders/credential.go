@@ -0,0 +1,138 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitproviders
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	log "github.com/sirupsen/logrus"
+)
+
+// GitLab project tokens are created with an expiry date two days ahead and replaced once less than a day is left,
+// so a new token is created at most once a day for each repository
+const (
+	gitLabProjectTokenLifetime = 48 * time.Hour
+	gitLabProjectTokenRenewal  = 24 * time.Hour
+)
+
+// Providers that fail to create a project token are retried after this delay, the personal access token is used meanwhile
+const gitLabProjectTokenRetryDelay = time.Hour
+
+type cachedGitCredential struct {
+	credential *gitprovider.GitCredential
+	renewAt    time.Time
+}
+
+// GetGitCredential returns the credential used by git operations inside projects for the repository.
+// GitHub Apps get an installation token and GitLab configs a project access token limited to the repository,
+// both expire on their own. Other providers get the personal access token of the config, which does not expire
+func (s *GitProviderService) GetGitCredential(repoUrl string, gitProviderConfigId string) (*gitprovider.GitCredential, error) {
+	providerConfig, err := s.resolveConfig(repoUrl, gitProviderConfigId)
+	if err != nil {
+		return nil, err
+	}
+
+	if providerConfig.GitHubApp != nil {
+		return s.getGitHubAppCredential(providerConfig)
+	}
+
+	switch providerConfig.GetProviderId() {
+	case "gitlab", "gitlab-self-managed":
+		return s.getGitLabCredential(providerConfig, repoUrl)
+	}
+
+	return &gitprovider.GitCredential{
+		Username: providerConfig.Username,
+		Password: providerConfig.Token,
+	}, nil
+}
+
+func (s *GitProviderService) getGitHubAppCredential(providerConfig *gitprovider.GitProviderConfig) (*gitprovider.GitCredential, error) {
+	gitProvider, err := s.newGitProvider(providerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	gitHubProvider, ok := gitProvider.(*gitprovider.GitHubGitProvider)
+	if !ok {
+		return nil, fmt.Errorf("GitHub App authentication is not supported for %s", providerConfig.Id)
+	}
+
+	token, expiresAt, err := gitHubProvider.GetTokenWithExpiry()
+	if err != nil {
+		return nil, err
+	}
+
+	return &gitprovider.GitCredential{
+		Username:  "x-access-token",
+		Password:  token,
+		ExpiresAt: expiresAt.UTC().Format(time.RFC3339),
+	}, nil
+}
+
+func (s *GitProviderService) getGitLabCredential(providerConfig *gitprovider.GitProviderConfig, repoUrl string) (*gitprovider.GitCredential, error) {
+	gitProvider, err := s.newGitProvider(providerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	gitLabProvider, ok := gitProvider.(*gitprovider.GitLabGitProvider)
+	if !ok {
+		return nil, fmt.Errorf("project tokens are not supported for %s", providerConfig.Id)
+	}
+
+	cacheKey := providerConfig.Id + "|" + strings.TrimSuffix(strings.ToLower(repoUrl), ".git")
+
+	s.credentialMutex.Lock()
+	defer s.credentialMutex.Unlock()
+
+	cached, ok := s.credentials[cacheKey]
+	if ok && time.Now().Before(cached.renewAt) {
+		return cached.credential, nil
+	}
+
+	token, expiresAt, err := gitLabProvider.CreateProjectToken(repoUrl, time.Now().Add(gitLabProjectTokenLifetime))
+	if err != nil {
+		// Creating project tokens requires the maintainer role and is not available on every GitLab tier
+		log.Debugf("failed to create a GitLab project token for %s, using the personal access token: %s", repoUrl, err)
+
+		credential := &gitprovider.GitCredential{
+			Username: providerConfig.Username,
+			Password: providerConfig.Token,
+		}
+		s.credentials[cacheKey] = &cachedGitCredential{
+			credential: credential,
+			renewAt:    time.Now().Add(gitLabProjectTokenRetryDelay),
+		}
+
+		return credential, nil
+	}
+
+	credential := &gitprovider.GitCredential{
+		Username:  providerConfig.Username,
+		Password:  token,
+		ExpiresAt: expiresAt.UTC().Format(time.RFC3339),
+	}
+	s.credentials[cacheKey] = &cachedGitCredential{
+		credential: credential,
+		renewAt:    expiresAt.Add(-gitLabProjectTokenRenewal),
+	}
+
+	return credential, nil
+}
+
+// Drops the credentials of a config so that updated tokens are used right away
+func (s *GitProviderService) removeCredentials(gitProviderId string) {
+	s.credentialMutex.Lock()
+	defer s.credentialMutex.Unlock()
+
+	for key := range s.credentials {
+		if strings.HasPrefix(key, gitProviderId+"|") {
+			delete(s.credentials, key)
+		}
+	}
+}
@@ -4,7 +4,6 @@
 package gitproviders
 
 import (
-	"errors"
 	"fmt"
 	"net/url"
 	"strings"
@@ -13,28 +12,12 @@ import (
 )
 
 func (s *GitProviderService) GetGitProviderForUrl(repoUrl string) (gitprovider.GitProvider, error) {
-	gitProviders, err := s.configStore.List()
-	if err != nil {
-		return nil, err
+	providerConfig, err := s.resolveConfig(repoUrl, "")
+	if err == nil {
+		return s.newGitProvider(providerConfig)
 	}
-
-	for _, p := range gitProviders {
-		if strings.Contains(repoUrl, fmt.Sprintf("%s.", p.Id)) {
-			return s.GetGitProvider(p.Id)
-		}
-
-		if p.BaseApiUrl == nil || *p.BaseApiUrl == "" {
-			continue
-		}
-
-		hostname, err := getHostnameFromUrl(*p.BaseApiUrl)
-		if err != nil {
-			return nil, err
-		}
-
-		if p.BaseApiUrl != nil && strings.Contains(repoUrl, hostname) {
-			return s.GetGitProvider(p.Id)
-		}
+	if !gitprovider.IsGitProviderNotFound(err) {
+		return nil, err
 	}
 
 	u, err := url.Parse(repoUrl)
@@ -54,34 +37,174 @@ func (s *GitProviderService) GetGitProviderForUrl(repoUrl string) (gitprovider.G
 }
 
 func (s *GitProviderService) GetConfigForUrl(url string) (*gitprovider.GitProviderConfig, error) {
+	return s.ResolveConfig(url, "")
+}
+
+// ResolveConfig returns the config used for the repository.
+// The config selected for the project takes precedence, followed by the config with an alias matching
+// the repository owner and the default config of the matching provider.
+func (s *GitProviderService) ResolveConfig(repoUrl string, gitProviderConfigId string) (*gitprovider.GitProviderConfig, error) {
+	providerConfig, err := s.resolveConfig(repoUrl, gitProviderConfigId)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.withGitHubAppToken(providerConfig)
+}
+
+func (s *GitProviderService) resolveConfig(repoUrl string, gitProviderConfigId string) (*gitprovider.GitProviderConfig, error) {
+	if gitProviderConfigId != "" {
+		return s.configStore.Find(gitProviderConfigId)
+	}
+
 	gitProviders, err := s.configStore.List()
 	if err != nil {
 		return nil, err
 	}
 
+	matches := []*gitprovider.GitProviderConfig{}
 	for _, p := range gitProviders {
-		if strings.Contains(url, fmt.Sprintf("%s.", p.Id)) {
+		match, err := configMatchesUrl(p, repoUrl)
+		if err != nil {
+			return nil, err
+		}
+		if match {
+			matches = append(matches, p)
+		}
+	}
+
+	if len(matches) == 0 {
+		return nil, gitprovider.ErrGitProviderNotFound
+	}
+
+	owner := getRepositoryOwner(repoUrl)
+	for _, p := range matches {
+		if p.Alias != "" && strings.EqualFold(p.Alias, owner) {
+			return p, nil
+		}
+	}
+
+	for _, p := range matches {
+		if p.IsDefault {
 			return p, nil
 		}
+	}
+
+	return matches[0], nil
+}
+
+// SetDefaultConfig makes the config the default for its provider host
+func (s *GitProviderService) SetDefaultConfig(gitProviderConfigId string) error {
+	providerConfig, err := s.configStore.Find(gitProviderConfigId)
+	if err != nil {
+		return err
+	}
+
+	gitProviders, err := s.configStore.List()
+	if err != nil {
+		return err
+	}
+
+	for _, p := range gitProviders {
+		if getConfigHost(p) != getConfigHost(providerConfig) {
+			continue
+		}
 
-		if p.BaseApiUrl == nil || *p.BaseApiUrl == "" {
+		isDefault := p.Id == providerConfig.Id
+		if p.IsDefault == isDefault {
 			continue
 		}
 
-		hostname, err := getHostnameFromUrl(*p.BaseApiUrl)
+		p.IsDefault = isDefault
+		err = s.configStore.Save(p)
 		if err != nil {
-			return nil, err
+			return err
 		}
+	}
 
-		if p.BaseApiUrl != nil && strings.Contains(url, hostname) {
-			return p, nil
+	return nil
+}
+
+func configMatchesUrl(providerConfig *gitprovider.GitProviderConfig, repoUrl string) (bool, error) {
+	if strings.Contains(repoUrl, fmt.Sprintf("%s.", providerConfig.GetProviderId())) {
+		return true, nil
+	}
+
+	if providerConfig.BaseApiUrl == nil || *providerConfig.BaseApiUrl == "" {
+		return false, nil
+	}
+
+	hostname, err := getHostnameFromUrl(*providerConfig.BaseApiUrl)
+	if err != nil {
+		return false, err
+	}
+
+	return strings.Contains(repoUrl, hostname), nil
+}
+
+// Configs with the same host compete for the same repositories
+func getConfigHost(providerConfig *gitprovider.GitProviderConfig) string {
+	if providerConfig.BaseApiUrl != nil && *providerConfig.BaseApiUrl != "" {
+		hostname, err := getHostnameFromUrl(*providerConfig.BaseApiUrl)
+		if err == nil {
+			return hostname
 		}
 	}
 
-	return nil, errors.New("git provider not found")
+	return providerConfig.GetProviderId()
+}
+
+// Returns the first path segment of the repository URL, e.g. "daytonaio" for https://github.com/daytonaio/daytona
+// or git@github.com:daytonaio/daytona.git
+func getRepositoryOwner(repoUrl string) string {
+	path := repoUrl
+	if i := strings.Index(path, "://"); i != -1 {
+		path = path[i+3:]
+	}
+
+	if i := strings.IndexAny(path, "/:"); i != -1 {
+		path = path[i+1:]
+	} else {
+		return ""
+	}
+
+	return strings.Split(strings.TrimPrefix(path, "/"), "/")[0]
+}
+
+// withGitHubAppToken returns a copy of the config with a short-lived installation token
+// that can be used for git operations if the provider authenticates as a GitHub App
+func (s *GitProviderService) withGitHubAppToken(providerConfig *gitprovider.GitProviderConfig) (*gitprovider.GitProviderConfig, error) {
+	if providerConfig.GitHubApp == nil {
+		return providerConfig, nil
+	}
+
+	gitProvider, err := s.newGitProvider(providerConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	gitHubProvider, ok := gitProvider.(*gitprovider.GitHubGitProvider)
+	if !ok {
+		return nil, fmt.Errorf("GitHub App authentication is not supported for %s", providerConfig.Id)
+	}
+
+	token, err := gitHubProvider.GetToken()
+	if err != nil {
+		return nil, err
+	}
+
+	config := *providerConfig
+	config.Username = "x-access-token"
+	config.Token = token
+
+	return &config, nil
 }
 
 func (s *GitProviderService) SetGitProviderConfig(providerConfig *gitprovider.GitProviderConfig) error {
+	if providerConfig.ProviderId == "" {
+		providerConfig.ProviderId = providerConfig.Id
+	}
+
 	gitProvider, err := s.newGitProvider(providerConfig)
 	if err != nil {
 		return err
@@ -95,7 +218,75 @@ func (s *GitProviderService) SetGitProviderConfig(providerConfig *gitprovider.Gi
 		providerConfig.Username = userData.Username
 	}
 
-	return s.configStore.Save(providerConfig)
+	if providerConfig.Id == "" {
+		providerConfig.Id, err = s.getConfigId(providerConfig)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Updating a config keeps its owner
+	existingConfig, err := s.configStore.Find(providerConfig.Id)
+	if err == nil {
+		providerConfig.OwnerId = existingConfig.OwnerId
+	}
+
+	err = s.configStore.Save(providerConfig)
+	if err != nil {
+		return err
+	}
+
+	s.removeCredentials(providerConfig.Id)
+
+	if s.cacheWarming {
+		s.warmCache(providerConfig.Id)
+	}
+
+	return nil
+}
+
+// Returns the ID of the existing config for the same account, so it is updated, or a new unique ID.
+// The first config of a provider uses the provider ID.
+func (s *GitProviderService) getConfigId(providerConfig *gitprovider.GitProviderConfig) (string, error) {
+	gitProviders, err := s.configStore.List()
+	if err != nil {
+		return "", err
+	}
+
+	for _, p := range gitProviders {
+		if p.GetProviderId() == providerConfig.ProviderId && p.Username == providerConfig.Username && getConfigHost(p) == getConfigHost(providerConfig) {
+			providerConfig.IsDefault = p.IsDefault
+			return p.Id, nil
+		}
+	}
+
+	// IDs are unique across the configs of all users
+	allGitProviders, err := s.getUnscopedConfigStore().List()
+	if err != nil {
+		return "", err
+	}
+
+	ids := map[string]bool{}
+	for _, p := range allGitProviders {
+		ids[p.Id] = true
+	}
+
+	if !ids[providerConfig.ProviderId] {
+		return providerConfig.ProviderId, nil
+	}
+
+	suffix := providerConfig.Alias
+	if suffix == "" {
+		suffix = providerConfig.Username
+	}
+	baseId := fmt.Sprintf("%s-%s", providerConfig.ProviderId, strings.ToLower(suffix))
+
+	id := baseId
+	for i := 2; ids[id]; i++ {
+		id = fmt.Sprintf("%s-%d", baseId, i)
+	}
+
+	return id, nil
 }
 
 func getHostnameFromUrl(urlToParse string) (string, error) {
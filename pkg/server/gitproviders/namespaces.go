@@ -10,6 +10,10 @@ import (
 )
 
 func (s *GitProviderService) GetNamespaces(gitProviderId string) ([]*gitprovider.GitNamespace, error) {
+	if namespaces, ok := s.getCachedNamespaces(gitProviderId); ok {
+		return namespaces, nil
+	}
+
 	gitProvider, err := s.GetGitProvider(gitProviderId)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get git provider: %s", err.Error())
@@ -17,8 +21,10 @@ func (s *GitProviderService) GetNamespaces(gitProviderId string) ([]*gitprovider
 
 	response, err := gitProvider.GetNamespaces()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get namespaces: %s", err.Error())
+		return nil, fmt.Errorf("failed to get namespaces: %w", err)
 	}
 
+	s.setCachedNamespaces(gitProviderId, response)
+
 	return response, nil
 }
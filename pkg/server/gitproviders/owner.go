@@ -0,0 +1,98 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitproviders
+
+import (
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+)
+
+// ForOwner returns a view of the service restricted to the configs owned by the user.
+// Configs of other users are never listed, resolved for a repository URL or modified through the view
+func (s *GitProviderService) ForOwner(ownerId string) IGitProviderService {
+	return &GitProviderService{
+		configStore: &ownerConfigStore{
+			store:   s.getUnscopedConfigStore(),
+			ownerId: ownerId,
+		},
+		cacheWarming: s.cacheWarming,
+		caches:       s.caches,
+		cacheMutex:   s.cacheMutex,
+
+		credentials:     s.credentials,
+		credentialMutex: s.credentialMutex,
+	}
+}
+
+func (s *GitProviderService) getUnscopedConfigStore() gitprovider.ConfigStore {
+	if store, ok := s.configStore.(*ownerConfigStore); ok {
+		return store.store
+	}
+
+	return s.configStore
+}
+
+type ownerConfigStore struct {
+	store   gitprovider.ConfigStore
+	ownerId string
+}
+
+func (s *ownerConfigStore) List() ([]*gitprovider.GitProviderConfig, error) {
+	configs, err := s.store.List()
+	if err != nil {
+		return nil, err
+	}
+
+	ownedConfigs := []*gitprovider.GitProviderConfig{}
+	for _, config := range configs {
+		if config.OwnerId == s.ownerId {
+			ownedConfigs = append(ownedConfigs, config)
+		}
+	}
+
+	return ownedConfigs, nil
+}
+
+func (s *ownerConfigStore) Find(id string) (*gitprovider.GitProviderConfig, error) {
+	config, err := s.store.Find(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Configs of other users are reported as missing so their IDs are not disclosed
+	if config.OwnerId != s.ownerId {
+		return nil, gitprovider.ErrGitProviderNotFound
+	}
+
+	return config, nil
+}
+
+func (s *ownerConfigStore) Save(config *gitprovider.GitProviderConfig) error {
+	existing, err := s.store.Find(config.Id)
+	if err == nil && existing.OwnerId != s.ownerId {
+		return gitprovider.ErrGitProviderNotFound
+	}
+
+	config.OwnerId = s.ownerId
+
+	return s.store.Save(config)
+}
+
+func (s *ownerConfigStore) Delete(config *gitprovider.GitProviderConfig) error {
+	_, err := s.Find(config.Id)
+	if err != nil {
+		return err
+	}
+
+	return s.store.Delete(config)
+}
+
+// Cached lists are shared by all views of the service so they are only returned for configs visible in the view
+func (s *GitProviderService) isConfigVisible(gitProviderId string) bool {
+	if _, ok := s.configStore.(*ownerConfigStore); !ok {
+		return true
+	}
+
+	_, err := s.configStore.Find(gitProviderId)
+	return err == nil
+}
@@ -17,7 +17,7 @@ func (s *GitProviderService) GetRepoPRs(gitProviderId, namespaceId, repositoryId
 
 	response, err := gitProvider.GetRepoPRs(repositoryId, namespaceId)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get pull requests: %s", err.Error())
+		return nil, fmt.Errorf("failed to get pull requests: %w", err)
 	}
 
 	return response, nil
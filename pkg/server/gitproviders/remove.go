@@ -9,5 +9,13 @@ func (s *GitProviderService) RemoveGitProvider(gitProviderId string) error {
 		return err
 	}
 
-	return s.configStore.Delete(gitProvider)
+	err = s.configStore.Delete(gitProvider)
+	if err != nil {
+		return err
+	}
+
+	s.removeCache(gitProviderId)
+	s.removeCredentials(gitProviderId)
+
+	return nil
 }
@@ -10,6 +10,10 @@ import (
 )
 
 func (s *GitProviderService) GetRepositories(gitProviderId, namespaceId string) ([]*gitprovider.GitRepository, error) {
+	if repositories, ok := s.getCachedRepositories(gitProviderId, namespaceId); ok {
+		return repositories, nil
+	}
+
 	gitProvider, err := s.GetGitProvider(gitProviderId)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get git provider: %s", err.Error())
@@ -17,8 +21,10 @@ func (s *GitProviderService) GetRepositories(gitProviderId, namespaceId string)
 
 	response, err := gitProvider.GetRepositories(namespaceId)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get repositories: %s", err.Error())
+		return nil, fmt.Errorf("failed to get repositories: %w", err)
 	}
 
+	s.setCachedRepositories(gitProviderId, namespaceId, response)
+
 	return response, nil
 }
@@ -0,0 +1,41 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitproviders
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+// Reads the repository config from the branch of the repository URL, or the default branch if the URL does not specify one
+func (s *GitProviderService) GetRepositoryConfig(repoUrl string) (*workspace.RepositoryConfig, error) {
+	gitProvider, err := s.GetGitProviderForUrl(repoUrl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get git provider: %s", err.Error())
+	}
+
+	fileReader, ok := gitProvider.(gitprovider.GitFileReader)
+	if !ok {
+		return nil, gitprovider.ErrFileReadingNotSupported
+	}
+
+	repo, err := gitProvider.GetRepositoryFromUrl(repoUrl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository: %w", err)
+	}
+
+	branch := ""
+	if repo.Branch != nil {
+		branch = *repo.Branch
+	}
+
+	content, err := fileReader.GetRepoFile(repo.Id, repo.Owner, branch, workspace.RepositoryConfigPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return workspace.ParseRepositoryConfig(content)
+}
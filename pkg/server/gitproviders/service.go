@@ -5,39 +5,64 @@ package gitproviders
 
 import (
 	"errors"
-	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/workspace"
 )
 
 type IGitProviderService interface {
 	GetConfig(id string) (*gitprovider.GitProviderConfig, error)
 	GetConfigForUrl(url string) (*gitprovider.GitProviderConfig, error)
+	ResolveConfig(repoUrl string, gitProviderConfigId string) (*gitprovider.GitProviderConfig, error)
+	SetDefaultConfig(gitProviderConfigId string) error
+	GetGitCredential(repoUrl string, gitProviderConfigId string) (*gitprovider.GitCredential, error)
 	GetGitProvider(id string) (gitprovider.GitProvider, error)
 	GetGitProviderForUrl(url string) (gitprovider.GitProvider, error)
 	GetGitUser(gitProviderId string) (*gitprovider.GitUser, error)
 	GetNamespaces(gitProviderId string) ([]*gitprovider.GitNamespace, error)
 	GetRepoBranches(gitProviderId string, namespaceId string, repositoryId string) ([]*gitprovider.GitBranch, error)
+	GetRepoCommits(gitProviderId string, namespaceId string, repositoryId string, branch string, page int, perPage int) ([]*gitprovider.GitCommit, error)
 	GetRepoPRs(gitProviderId string, namespaceId string, repositoryId string) ([]*gitprovider.GitPullRequest, error)
 	GetRepositories(gitProviderId string, namespaceId string) ([]*gitprovider.GitRepository, error)
+	GetRepositoryConfig(repoUrl string) (*workspace.RepositoryConfig, error)
 	ListConfigs() ([]*gitprovider.GitProviderConfig, error)
 	RemoveGitProvider(gitProviderId string) error
 	SetGitProviderConfig(providerConfig *gitprovider.GitProviderConfig) error
 	GetLastCommitSha(repo *gitprovider.GitRepository) (string, error)
+	GetCacheStatus(gitProviderId string) *gitprovider.GitProviderCacheStatus
+	ForOwner(ownerId string) IGitProviderService
 }
 
 type GitProviderServiceConfig struct {
 	ConfigStore gitprovider.ConfigStore
+	// Fetch and cache the namespace and repository lists in the background after a config is added
+	CacheWarming bool
 }
 
 type GitProviderService struct {
-	configStore gitprovider.ConfigStore
+	configStore  gitprovider.ConfigStore
+	cacheWarming bool
+
+	caches map[string]*providerCache
+	// Shared with the owner views of the service
+	cacheMutex *sync.RWMutex
+
+	// Git credentials that expire on their own, keyed by config ID and repository
+	credentials     map[string]*cachedGitCredential
+	credentialMutex *sync.Mutex
 }
 
 func NewGitProviderService(config GitProviderServiceConfig) IGitProviderService {
 	return &GitProviderService{
-		configStore: config.ConfigStore,
+		configStore:  config.ConfigStore,
+		cacheWarming: config.CacheWarming,
+		caches:       map[string]*providerCache{},
+		cacheMutex:   &sync.RWMutex{},
+
+		credentials:     map[string]*cachedGitCredential{},
+		credentialMutex: &sync.Mutex{},
 	}
 }
 
@@ -61,41 +86,19 @@ func (s *GitProviderService) GetConfig(id string) (*gitprovider.GitProviderConfi
 }
 
 func (s *GitProviderService) GetLastCommitSha(repo *gitprovider.GitRepository) (string, error) {
-	var err error
 	var provider gitprovider.GitProvider
-	providerFound := false
 
-	gitProviders, err := s.configStore.List()
-	if err != nil {
+	providerConfig, err := s.resolveConfig(repo.Url, "")
+	if err != nil && !gitprovider.IsGitProviderNotFound(err) {
 		return "", err
 	}
 
-	for _, p := range gitProviders {
-		if strings.Contains(repo.Url, fmt.Sprintf("%s.", p.Id)) {
-			provider, err = s.GetGitProvider(p.Id)
-			if err == nil {
-				return "", err
-			}
-			providerFound = true
-			break
-		}
-
-		hostname, err := getHostnameFromUrl(*p.BaseApiUrl)
+	if providerConfig != nil {
+		provider, err = s.newGitProvider(providerConfig)
 		if err != nil {
 			return "", err
 		}
-
-		if p.BaseApiUrl != nil && strings.Contains(repo.Url, hostname) {
-			provider, err = s.GetGitProvider(p.Id)
-			if err == nil {
-				return "", err
-			}
-			providerFound = true
-			break
-		}
-	}
-
-	if !providerFound {
+	} else {
 		hostname := strings.TrimPrefix(repo.Source, "www.")
 		providerId := strings.Split(hostname, ".")[0]
 
@@ -120,14 +123,21 @@ func (s *GitProviderService) GetLastCommitSha(repo *gitprovider.GitRepository) (
 		PrNumber: repo.PrNumber,
 		Source:   repo.Source,
 		Path:     repo.Path,
+		Ref:      repo.Ref,
 	})
 }
 
 func (s *GitProviderService) newGitProvider(config *gitprovider.GitProviderConfig) (gitprovider.GitProvider, error) {
-	switch config.Id {
+	switch config.GetProviderId() {
 	case "github":
+		if config.GitHubApp != nil {
+			return gitprovider.NewGitHubAppGitProvider(config.GitHubApp, nil), nil
+		}
 		return gitprovider.NewGitHubGitProvider(config.Token, nil), nil
 	case "github-enterprise-server":
+		if config.GitHubApp != nil {
+			return gitprovider.NewGitHubAppGitProvider(config.GitHubApp, config.BaseApiUrl), nil
+		}
 		return gitprovider.NewGitHubGitProvider(config.Token, config.BaseApiUrl), nil
 	case "gitlab":
 		return gitprovider.NewGitLabGitProvider(config.Token, nil), nil
@@ -143,6 +153,8 @@ func (s *GitProviderService) newGitProvider(config *gitprovider.GitProviderConfi
 		return gitprovider.NewGiteaGitProvider(config.Token, *config.BaseApiUrl), nil
 	case "gitness":
 		return gitprovider.NewGitnessGitProvider(config.Token, config.BaseApiUrl), nil
+	case "gerrit":
+		return gitprovider.NewGerritGitProvider(config.Username, config.Token, config.BaseApiUrl), nil
 	case "azure-devops":
 		return gitprovider.NewAzureDevOpsGitProvider(config.Token, *config.BaseApiUrl), nil
 	default:
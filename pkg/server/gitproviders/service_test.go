@@ -0,0 +1,273 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package gitproviders_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	t_gitproviders "github.com/daytonaio/daytona/internal/testing/server/gitproviders"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/server/gitproviders"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitProviderService(t *testing.T) {
+	configStore := t_gitproviders.NewInMemoryGitProviderConfigStore()
+
+	service := gitproviders.NewGitProviderService(gitproviders.GitProviderServiceConfig{
+		ConfigStore: configStore,
+	})
+
+	t.Run("SetGitProviderConfig", func(t *testing.T) {
+		personal := &gitprovider.GitProviderConfig{ProviderId: "github", Username: "octocat", Token: "token"}
+		err := service.SetGitProviderConfig(personal)
+		require.Nil(t, err)
+		require.Equal(t, "github", personal.Id)
+
+		work := &gitprovider.GitProviderConfig{ProviderId: "github", Username: "octocat-work", Token: "token", Alias: "daytonaio"}
+		err = service.SetGitProviderConfig(work)
+		require.Nil(t, err)
+		require.Equal(t, "github-daytonaio", work.Id)
+
+		// Registering the same account again updates the existing config
+		updated := &gitprovider.GitProviderConfig{ProviderId: "github", Username: "octocat", Token: "new-token"}
+		err = service.SetGitProviderConfig(updated)
+		require.Nil(t, err)
+		require.Equal(t, "github", updated.Id)
+
+		configs, err := service.ListConfigs()
+		require.Nil(t, err)
+		require.Len(t, configs, 2)
+	})
+
+	t.Run("ResolveConfig", func(t *testing.T) {
+		config, err := service.ResolveConfig("https://github.com/daytonaio/daytona", "")
+		require.Nil(t, err)
+		require.Equal(t, "github-daytonaio", config.Id)
+
+		config, err = service.ResolveConfig("https://github.com/daytonaio/daytona", "github")
+		require.Nil(t, err)
+		require.Equal(t, "github", config.Id)
+
+		_, err = service.ResolveConfig("https://gitlab.com/daytonaio/daytona", "")
+		require.True(t, gitprovider.IsGitProviderNotFound(err))
+	})
+
+	t.Run("GetGitCredential", func(t *testing.T) {
+		credential, err := service.GetGitCredential("https://github.com/daytonaio/daytona.git", "")
+		require.Nil(t, err)
+		require.Equal(t, "octocat-work", credential.Username)
+		require.Equal(t, "token", credential.Password)
+		// Personal access tokens do not expire on their own
+		require.Empty(t, credential.ExpiresAt)
+
+		_, err = service.GetGitCredential("https://gitlab.com/daytonaio/daytona", "")
+		require.True(t, gitprovider.IsGitProviderNotFound(err))
+	})
+
+	t.Run("SetDefaultConfig", func(t *testing.T) {
+		err := service.SetDefaultConfig("github-daytonaio")
+		require.Nil(t, err)
+
+		config, err := service.ResolveConfig("https://github.com/octocat/hello-world", "")
+		require.Nil(t, err)
+		require.Equal(t, "github-daytonaio", config.Id)
+
+		err = service.SetDefaultConfig("github")
+		require.Nil(t, err)
+
+		config, err = service.ResolveConfig("https://github.com/octocat/hello-world", "")
+		require.Nil(t, err)
+		require.Equal(t, "github", config.Id)
+
+		// The alias takes precedence over the default
+		config, err = service.ResolveConfig("git@github.com:daytonaio/daytona.git", "")
+		require.Nil(t, err)
+		require.Equal(t, "github-daytonaio", config.Id)
+	})
+}
+
+func TestGitProviderServiceForOwner(t *testing.T) {
+	service := gitproviders.NewGitProviderService(gitproviders.GitProviderServiceConfig{
+		ConfigStore: t_gitproviders.NewInMemoryGitProviderConfigStore(),
+	})
+
+	alice := service.ForOwner("alice")
+	bob := service.ForOwner("bob")
+
+	aliceConfig := &gitprovider.GitProviderConfig{ProviderId: "github", Username: "alice", Token: "alice-token"}
+	err := alice.SetGitProviderConfig(aliceConfig)
+	require.Nil(t, err)
+	require.Equal(t, "github", aliceConfig.Id)
+	require.Equal(t, "alice", aliceConfig.OwnerId)
+
+	// IDs are unique across owners
+	bobConfig := &gitprovider.GitProviderConfig{ProviderId: "github", Username: "bob", Token: "bob-token"}
+	err = bob.SetGitProviderConfig(bobConfig)
+	require.Nil(t, err)
+	require.Equal(t, "github-bob", bobConfig.Id)
+
+	configs, err := bob.ListConfigs()
+	require.Nil(t, err)
+	require.Len(t, configs, 1)
+	require.Equal(t, "github-bob", configs[0].Id)
+
+	configs, err = service.ListConfigs()
+	require.Nil(t, err)
+	require.Len(t, configs, 2)
+
+	credential, err := bob.GetGitCredential("https://github.com/daytonaio/daytona", "")
+	require.Nil(t, err)
+	require.Equal(t, "bob-token", credential.Password)
+
+	_, err = bob.GetGitCredential("https://github.com/daytonaio/daytona", "github")
+	require.True(t, gitprovider.IsGitProviderNotFound(err))
+
+	err = bob.RemoveGitProvider("github")
+	require.True(t, gitprovider.IsGitProviderNotFound(err))
+
+	_, err = alice.GetConfig("github")
+	require.Nil(t, err)
+}
+
+func TestGetGitLabCredential(t *testing.T) {
+	createdTokens := 0
+	failTokenCreation := false
+
+	gitlabServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method != http.MethodPost || r.URL.EscapedPath() != "/api/v4/projects/daytonaio%2Fdaytona/access_tokens" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if failTokenCreation {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"message": "403 Forbidden"}`))
+			return
+		}
+
+		var body map[string]interface{}
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Equal(t, []interface{}{"read_repository", "write_repository"}, body["scopes"])
+
+		createdTokens++
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(fmt.Sprintf(`{"id": %d, "token": "project-token-%d", "expires_at": "%s"}`, createdTokens, createdTokens, body["expires_at"])))
+	}))
+	defer gitlabServer.Close()
+
+	service := gitproviders.NewGitProviderService(gitproviders.GitProviderServiceConfig{
+		ConfigStore: t_gitproviders.NewInMemoryGitProviderConfigStore(),
+	})
+
+	baseApiUrl := gitlabServer.URL
+	config := &gitprovider.GitProviderConfig{ProviderId: "gitlab-self-managed", Username: "octocat", Token: "personal-token", BaseApiUrl: &baseApiUrl}
+	err := service.SetGitProviderConfig(config)
+	require.Nil(t, err)
+
+	repoUrl := gitlabServer.URL + "/daytonaio/daytona.git"
+
+	credential, err := service.GetGitCredential(repoUrl, "")
+	require.Nil(t, err)
+	require.Equal(t, "project-token-1", credential.Password)
+
+	expiresAt, err := time.Parse(time.RFC3339, credential.ExpiresAt)
+	require.Nil(t, err)
+	require.True(t, expiresAt.After(time.Now().Add(24*time.Hour)))
+	require.True(t, expiresAt.Before(time.Now().Add(48*time.Hour)))
+
+	// The project token is reused until less than a day is left
+	credential, err = service.GetGitCredential(repoUrl, "")
+	require.Nil(t, err)
+	require.Equal(t, "project-token-1", credential.Password)
+	require.Equal(t, 1, createdTokens)
+
+	// Updating the config drops its tokens. The personal access token is used if no project token can be created
+	failTokenCreation = true
+	err = service.SetGitProviderConfig(config)
+	require.Nil(t, err)
+
+	credential, err = service.GetGitCredential(repoUrl, "")
+	require.Nil(t, err)
+	require.Equal(t, "octocat", credential.Username)
+	require.Equal(t, "personal-token", credential.Password)
+	require.Empty(t, credential.ExpiresAt)
+}
+
+func TestGitProviderCacheWarming(t *testing.T) {
+	giteaServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer giteaServer.Close()
+
+	service := gitproviders.NewGitProviderService(gitproviders.GitProviderServiceConfig{
+		ConfigStore:  t_gitproviders.NewInMemoryGitProviderConfigStore(),
+		CacheWarming: true,
+	})
+
+	baseApiUrl := giteaServer.URL
+	config := &gitprovider.GitProviderConfig{ProviderId: "gitea", Username: "octocat", Token: "token", BaseApiUrl: &baseApiUrl}
+	err := service.SetGitProviderConfig(config)
+	require.Nil(t, err)
+
+	require.Eventually(t, func() bool {
+		status := service.GetCacheStatus(config.Id)
+		return status != nil && status.State == gitprovider.GitProviderCacheStateFailed
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NotEmpty(t, service.GetCacheStatus(config.Id).Error)
+
+	err = service.RemoveGitProvider(config.Id)
+	require.Nil(t, err)
+	require.Nil(t, service.GetCacheStatus(config.Id))
+}
+
+func TestGetRepoBranches(t *testing.T) {
+	gitlabServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/api/v4/projects/1/repository/branches":
+			w.Write([]byte(`[
+				{"name": "main", "commit": {"id": "a1", "author_name": "Ann", "authored_date": "2024-05-01T10:00:00Z"}},
+				{"name": "feature", "commit": {"id": "b2", "author_name": "Bob", "authored_date": "2024-05-02T10:00:00Z"}}
+			]`))
+		case "/api/v4/projects/1":
+			w.Write([]byte(`{"id": 1, "default_branch": "main"}`))
+		case "/api/v4/projects/1/repository/compare":
+			if r.URL.Query().Get("from") == "main" {
+				w.Write([]byte(`{"commits": [{"id": "b1"}, {"id": "b2"}]}`))
+			} else {
+				w.Write([]byte(`{"commits": [{"id": "a1"}]}`))
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer gitlabServer.Close()
+
+	service := gitproviders.NewGitProviderService(gitproviders.GitProviderServiceConfig{
+		ConfigStore: t_gitproviders.NewInMemoryGitProviderConfigStore(),
+	})
+
+	baseApiUrl := gitlabServer.URL
+	config := &gitprovider.GitProviderConfig{ProviderId: "gitlab-self-managed", Username: "octocat", Token: "token", BaseApiUrl: &baseApiUrl}
+	err := service.SetGitProviderConfig(config)
+	require.Nil(t, err)
+
+	branches, err := service.GetRepoBranches(config.Id, "octocat", "1")
+	require.Nil(t, err)
+
+	ahead, behind, zero := 2, 1, 0
+	require.Equal(t, []*gitprovider.GitBranch{
+		{Name: "main", Sha: "a1", Author: "Ann", Date: "2024-05-01T10:00:00Z", Ahead: &zero, Behind: &zero},
+		{Name: "feature", Sha: "b2", Author: "Bob", Date: "2024-05-02T10:00:00Z", Ahead: &ahead, Behind: &behind},
+	}, branches)
+}
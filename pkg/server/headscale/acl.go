@@ -0,0 +1,60 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package headscale
+
+import (
+	"slices"
+
+	"github.com/daytonaio/daytona/pkg/server/headscale/acl"
+	v1 "github.com/juanfont/headscale/gen/go/headscale/v1"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// SetAccessGrants restricts the access of the tagged nodes. All nodes can reach each other until the grants are set
+func (s *HeadscaleServer) SetAccessGrants(grants acl.Grants) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.aclPolicy = acl.BuildPolicy("daytona", grants)
+
+	// Nodes receive the new policy with the next network map update
+	if s.app != nil {
+		s.app.ACLPolicy = s.aclPolicy
+	}
+}
+
+// DeleteTaggedNodes removes the nodes that joined with a key of the tag from the network
+func (s *HeadscaleServer) DeleteTaggedNodes(tag string) error {
+	ctx, client, conn, cancel, err := s.getClient()
+	if err != nil {
+		return err
+	}
+	defer cancel()
+	defer conn.Close()
+
+	response, err := client.ListNodes(ctx, &v1.ListNodesRequest{
+		User: "daytona",
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, node := range response.Nodes {
+		if !slices.Contains(node.ForcedTags, tag) {
+			continue
+		}
+
+		log.Debugf("Deleting headscale node %s tagged %s", node.Name, tag)
+
+		_, err = client.DeleteNode(ctx, &v1.DeleteNodeRequest{
+			NodeId: node.Id,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package acl
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/juanfont/headscale/hscontrol/policy"
+)
+
+// Destination granting access to every node of the network
+const AllNodes = "*"
+
+// Grants maps the tag of restricted nodes to the tags of the nodes they can reach, or AllNodes.
+// Tagged nodes without grants can not reach any node. Nodes that joined without a tag are not restricted
+type Grants map[string][]string
+
+var invalidTagChars = regexp.MustCompile(`[^a-z0-9-]`)
+
+// Tag of the project nodes of the workspace
+func WorkspaceTag(workspaceId string) string {
+	return tag("workspace", workspaceId)
+}
+
+// Tag of the client nodes of a user other than an admin
+func UserTag(userId string) string {
+	return tag("user", userId)
+}
+
+// Tag of the nodes of a workspace share holder
+func ShareTag(shareName string) string {
+	return tag("share", shareName)
+}
+
+func tag(kind, name string) string {
+	return fmt.Sprintf("tag:%s-%s", kind, invalidTagChars.ReplaceAllString(strings.ToLower(name), "-"))
+}
+
+// BuildPolicy returns the headscale ACL policy enforcing the grants. The untagged nodes of the user keep
+// access to every node
+func BuildPolicy(user string, grants Grants) *policy.ACLPolicy {
+	pol := &policy.ACLPolicy{
+		TagOwners: policy.TagOwners{},
+		ACLs: []policy.ACL{
+			{
+				Action:       "accept",
+				Sources:      []string{user},
+				Destinations: []string{"*:*"},
+			},
+		},
+	}
+
+	sources := []string{}
+	for source := range grants {
+		sources = append(sources, source)
+	}
+	// Sorted so that the same grants always result in the same policy
+	sort.Strings(sources)
+
+	for _, source := range sources {
+		// Tags must have an owner to be valid even if no node uses them yet
+		pol.TagOwners[source] = []string{user}
+
+		destinations := []string{}
+		for _, destination := range grants[source] {
+			if destination == AllNodes {
+				destinations = append(destinations, "*:*")
+				continue
+			}
+
+			pol.TagOwners[destination] = []string{user}
+			destinations = append(destinations, destination+":*")
+		}
+
+		if len(destinations) == 0 {
+			continue
+		}
+
+		pol.ACLs = append(pol.ACLs, policy.ACL{
+			Action:       "accept",
+			Sources:      []string{source},
+			Destinations: destinations,
+		})
+	}
+
+	return pol
+}
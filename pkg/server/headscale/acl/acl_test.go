@@ -0,0 +1,70 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package acl_test
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/server/headscale/acl"
+	"github.com/juanfont/headscale/hscontrol/policy"
+	"github.com/juanfont/headscale/hscontrol/types"
+	"github.com/stretchr/testify/require"
+	"tailscale.com/tailcfg"
+)
+
+func newNode(id uint64, ip string, tags ...string) *types.Node {
+	return &types.Node{
+		ID:          id,
+		User:        types.User{Name: "daytona"},
+		IPAddresses: types.NodeAddresses{netip.MustParseAddr(ip)},
+		ForcedTags:  tags,
+		Hostinfo:    &tailcfg.Hostinfo{},
+	}
+}
+
+func canAccess(t *testing.T, pol *policy.ACLPolicy, nodes types.Nodes, src, dst *types.Node) bool {
+	filter, _, err := policy.GenerateFilterAndSSHRules(pol, dst, nodes)
+	require.Nil(t, err)
+
+	return src.CanAccess(filter, dst)
+}
+
+func TestBuildPolicy(t *testing.T) {
+	server := newNode(1, "100.64.0.1")
+	workspace1 := newNode(2, "100.64.0.2", acl.WorkspaceTag("workspace1"))
+	workspace2 := newNode(3, "100.64.0.3", acl.WorkspaceTag("workspace2"))
+	share := newNode(4, "100.64.0.4", acl.ShareTag("share-1"))
+	// Tagged nodes without grants must not get any access
+	unknown := newNode(5, "100.64.0.5", "tag:unknown")
+
+	nodes := types.Nodes{server, workspace1, workspace2, share, unknown}
+
+	pol := acl.BuildPolicy("daytona", acl.Grants{
+		acl.WorkspaceTag("workspace1"): {acl.AllNodes},
+		acl.WorkspaceTag("workspace2"): {acl.AllNodes},
+		acl.ShareTag("share-1"):        {acl.WorkspaceTag("workspace1")},
+		// Grants of workspaces without nodes must not invalidate the policy
+		acl.ShareTag("share-2"): {acl.WorkspaceTag("workspace3")},
+	})
+
+	require.True(t, canAccess(t, pol, nodes, server, workspace1))
+	require.True(t, canAccess(t, pol, nodes, server, share))
+	require.True(t, canAccess(t, pol, nodes, workspace1, workspace2))
+	require.True(t, canAccess(t, pol, nodes, workspace2, server))
+
+	require.True(t, canAccess(t, pol, nodes, share, workspace1))
+	require.False(t, canAccess(t, pol, nodes, share, workspace2))
+	require.False(t, canAccess(t, pol, nodes, share, server))
+
+	require.False(t, canAccess(t, pol, nodes, unknown, server))
+	require.False(t, canAccess(t, pol, nodes, unknown, workspace1))
+}
+
+func TestTags(t *testing.T) {
+	require.Equal(t, "tag:workspace-abc123", acl.WorkspaceTag("abc123"))
+	require.Equal(t, "tag:workspace-my-workspace", acl.WorkspaceTag("My_Workspace"))
+	require.Equal(t, "tag:share-share-1a2b", acl.ShareTag("share-1a2b"))
+	require.Equal(t, "tag:user-5f1c", acl.UserTag("5f1c"))
+}
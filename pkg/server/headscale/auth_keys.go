@@ -13,14 +13,29 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+// Tagged keys are used right after they are created so they only need to be valid for a short time
+const taggedAuthKeyTtl = 5 * time.Minute
+
 func (s *HeadscaleServer) CreateAuthKey() (string, error) {
 	log.Debug("Creating headscale auth key")
 
+	return s.createAuthKey(time.Now().Add(100000*time.Hour), nil)
+}
+
+// CreateTaggedAuthKey creates a single-use key for a node that only gets the access granted to the tag
+func (s *HeadscaleServer) CreateTaggedAuthKey(tag string) (string, error) {
+	log.Debugf("Creating headscale auth key tagged %s", tag)
+
+	return s.createAuthKey(time.Now().Add(taggedAuthKeyTtl), []string{tag})
+}
+
+func (s *HeadscaleServer) createAuthKey(expiresAt time.Time, tags []string) (string, error) {
 	request := &v1.CreatePreAuthKeyRequest{
 		Reusable: false,
 		User:     "daytona",
+		AclTags:  tags,
 	}
-	request.Expiration = timestamppb.New(time.Now().Add(100000 * time.Hour))
+	request.Expiration = timestamppb.New(expiresAt)
 	request.Ephemeral = true
 
 	ctx, client, conn, cancel, err := s.getClient()
@@ -4,7 +4,9 @@
 package headscale
 
 import (
+	"context"
 	"fmt"
+	"net"
 	"net/http"
 
 	"tailscale.com/tsnet"
@@ -45,3 +47,8 @@ func (s *HeadscaleServer) Connect() error {
 func (s *HeadscaleServer) HTTPClient() *http.Client {
 	return tsNetServer.HTTPClient()
 }
+
+// Dial connects to an address in the server network, e.g. a project port
+func (s *HeadscaleServer) Dial(ctx context.Context, network, address string) (net.Conn, error) {
+	return tsNetServer.Dial(ctx, network, address)
+}
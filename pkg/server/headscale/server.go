@@ -6,8 +6,10 @@ package headscale
 import (
 	"os"
 	"path/filepath"
+	"sync"
 
 	"github.com/juanfont/headscale/hscontrol"
+	"github.com/juanfont/headscale/hscontrol/policy"
 )
 
 type HeadscaleServerConfig struct {
@@ -31,6 +33,10 @@ type HeadscaleServer struct {
 	frpsDomain    string
 	frpsProtocol  string
 	headscalePort uint32
+
+	mutex     sync.Mutex
+	app       *hscontrol.Headscale
+	aclPolicy *policy.ACLPolicy
 }
 
 func (s *HeadscaleServer) Init() error {
@@ -53,6 +59,12 @@ func (s *HeadscaleServer) Start() error {
 		return err
 	}
 
+	// Access grants may be set before the server is started
+	s.mutex.Lock()
+	app.ACLPolicy = s.aclPolicy
+	s.app = app
+	s.mutex.Unlock()
+
 	return app.Serve()
 }
 
@@ -0,0 +1,73 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// LeaderLock elects the server instance that runs the background jobs on the workspaces, e.g. expiry and state
+// reconciliation, when multiple instances share the database
+type LeaderLock interface {
+	// Returns true if this instance holds the lock. The lock is kept until it is lost, e.g. on connection errors
+	TryAcquire(ctx context.Context) (bool, error)
+	Release() error
+}
+
+// leaderElection tracks whether this instance is the leader. Instances without a lock are always the leader
+type leaderElection struct {
+	lock LeaderLock
+	// Called when this instance becomes the leader
+	onElected func()
+	leader    bool
+	mutex     sync.Mutex
+}
+
+func (e *leaderElection) isLeader() bool {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	leader := true
+	if e.lock != nil {
+		var err error
+		leader, err = e.lock.TryAcquire(context.Background())
+		if err != nil {
+			log.Errorf("Failed to acquire the leader lock: %s", err)
+		}
+	}
+
+	if leader && !e.leader {
+		if e.lock != nil {
+			log.Info("Running background jobs as the leader server instance")
+		}
+		if e.onElected != nil {
+			e.onElected()
+		}
+	} else if !leader && e.leader {
+		log.Warn("Lost the leader lock. Background jobs are left to the new leader server instance")
+	}
+
+	e.leader = leader
+
+	return leader
+}
+
+func (e *leaderElection) release() {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if e.lock == nil || !e.leader {
+		return
+	}
+
+	err := e.lock.Release()
+	if err != nil {
+		log.Errorf("Failed to release the leader lock: %s", err)
+	}
+
+	e.leader = false
+}
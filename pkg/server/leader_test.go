@@ -0,0 +1,71 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testLeaderLock struct {
+	acquired bool
+	err      error
+	released int
+}
+
+func (l *testLeaderLock) TryAcquire(ctx context.Context) (bool, error) {
+	return l.acquired, l.err
+}
+
+func (l *testLeaderLock) Release() error {
+	l.released++
+	return nil
+}
+
+func TestLeaderElection(t *testing.T) {
+	t.Run("single instance", func(t *testing.T) {
+		elected := 0
+		election := &leaderElection{onElected: func() { elected++ }}
+
+		require.True(t, election.isLeader())
+		require.True(t, election.isLeader())
+		require.Equal(t, 1, elected)
+
+		election.release()
+	})
+
+	t.Run("shared database", func(t *testing.T) {
+		lock := &testLeaderLock{}
+		elected := 0
+		election := &leaderElection{lock: lock, onElected: func() { elected++ }}
+
+		require.False(t, election.isLeader())
+		require.Equal(t, 0, elected)
+
+		lock.acquired = true
+		require.True(t, election.isLeader())
+		require.True(t, election.isLeader())
+		require.Equal(t, 1, elected)
+
+		// Lost the lock
+		lock.acquired = false
+		lock.err = errors.New("connection lost")
+		require.False(t, election.isLeader())
+
+		election.release()
+		require.Equal(t, 0, lock.released)
+
+		// Elected again
+		lock.acquired = true
+		lock.err = nil
+		require.True(t, election.isLeader())
+		require.Equal(t, 2, elected)
+
+		election.release()
+		require.Equal(t, 1, lock.released)
+	})
+}
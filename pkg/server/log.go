@@ -6,7 +6,9 @@ package server
 import (
 	"io"
 	"os"
+	"time"
 
+	"github.com/daytonaio/daytona/pkg/logs"
 	frp_log "github.com/fatedier/frp/pkg/util/log"
 	log "github.com/sirupsen/logrus"
 )
@@ -73,3 +75,28 @@ func (s *Server) GetLogReader() (io.Reader, error) {
 
 	return file, nil
 }
+
+// Periodically removes the rotated workspace and build logs that exceed the retention limits
+func (s *Server) cleanupLogs() {
+	logsDir, err := GetWorkspaceLogsDir()
+	if err != nil {
+		log.Errorf("Failed to get logs dir: %s", err)
+		return
+	}
+
+	retention := logs.RetentionConfig{
+		MaxFileSizeMB: s.config.LogRetention.MaxFileSizeMB,
+		MaxBackups:    s.config.LogRetention.MaxBackups,
+		MaxAgeDays:    s.config.LogRetention.MaxAgeDays,
+	}
+
+	ticker := time.NewTicker(1 * time.Hour)
+	defer ticker.Stop()
+
+	for ; true; <-ticker.C {
+		err := logs.CleanupLogs(logsDir, retention)
+		if err != nil {
+			log.Errorf("Failed to clean up logs: %s", err)
+		}
+	}
+}
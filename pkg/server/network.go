@@ -0,0 +1,103 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server/headscale/acl"
+	"github.com/daytonaio/daytona/pkg/user"
+)
+
+const defaultTailnetControlUrl = "https://controlplane.tailscale.com"
+
+var ErrTailnetNotConfigured = errors.New("tailnet network mode requires a tailnet auth key in the server config")
+
+// Returns the network mode of the target, falling back to the server network mode
+func (s *Server) GetNetworkMode(targetName string) provider.NetworkMode {
+	target, err := s.ProviderTargetService.Find(targetName)
+	if err == nil && target.NetworkMode != nil && *target.NetworkMode != "" {
+		return *target.NetworkMode
+	}
+
+	if s.config.NetworkMode != "" {
+		return s.config.NetworkMode
+	}
+
+	return provider.NetworkModeDaytona
+}
+
+// Returns the key the project uses to join the network determined by its target network mode
+func (s *Server) GetProjectNetworkKey(workspaceId string) (*NetworkKey, error) {
+	w, err := s.WorkspaceService.GetWorkspace(workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.GetNetworkMode(w.Target) == provider.NetworkModeTailnet {
+		if s.config.Tailnet == nil || s.config.Tailnet.AuthKey == "" {
+			return nil, ErrTailnetNotConfigured
+		}
+
+		controlUrl := s.config.Tailnet.ControlUrl
+		if controlUrl == "" {
+			controlUrl = defaultTailnetControlUrl
+		}
+
+		return &NetworkKey{
+			Key:        s.config.Tailnet.AuthKey,
+			ControlUrl: controlUrl,
+		}, nil
+	}
+
+	err = s.WorkspaceService.UpdateNetworkAccess()
+	if err != nil {
+		return nil, err
+	}
+
+	authKey, err := s.TailscaleServer.CreateTaggedAuthKey(acl.WorkspaceTag(w.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	return &NetworkKey{Key: authKey}, nil
+}
+
+// Returns the key CLI clients and workspace agents use to join the network. Nodes of users other than admins and
+// of workspace agents only get the access granted to their tag. The nodes of admins and of keys without a user
+// are not restricted
+func (s *Server) GetClientNetworkKey(key *apikey.ApiKey, u *user.User) (string, error) {
+	var tag string
+
+	switch {
+	case key != nil && (key.Type == apikey.ApiKeyTypeWorkspace || key.Type == apikey.ApiKeyTypeProject):
+		// Workspace keys are named after the workspace and project keys after the workspace and the project
+		workspaceId, _, _ := strings.Cut(key.Name, "/")
+		tag = acl.WorkspaceTag(workspaceId)
+	case u != nil && !u.IsAdmin():
+		tag = acl.UserTag(u.Id)
+	default:
+		return s.TailscaleServer.CreateAuthKey()
+	}
+
+	err := s.WorkspaceService.UpdateNetworkAccess()
+	if err != nil {
+		return "", err
+	}
+
+	return s.TailscaleServer.CreateTaggedAuthKey(tag)
+}
+
+// Returns a key that only grants access to the projects of the shared workspace
+func (s *Server) GetShareNetworkKey(shareName string) (string, error) {
+	err := s.WorkspaceService.UpdateNetworkAccess()
+	if err != nil {
+		return "", err
+	}
+
+	return s.TailscaleServer.CreateTaggedAuthKey(acl.ShareTag(shareName))
+}
@@ -0,0 +1,122 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/server/events"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const requestTimeout = 10 * time.Second
+
+type NotificationSinkType string // @name NotificationSinkType
+
+const (
+	// The event is posted as JSON to the URL
+	NotificationSinkTypeWebhook NotificationSinkType = "webhook"
+	// The event is posted as a message to a Slack incoming webhook URL
+	NotificationSinkTypeSlack NotificationSinkType = "slack"
+)
+
+type NotificationSink struct {
+	Type NotificationSinkType `json:"type"`
+	Url  string               `json:"url"`
+	// Event types sent to the sink. All events are sent if empty
+	Events []events.EventType `json:"events,omitempty"`
+} // @name NotificationSink
+
+type INotificationService interface {
+	Notify(event events.Event)
+}
+
+type NotificationServiceConfig struct {
+	Sinks []NotificationSink
+	// Defaults to an HTTP client with a 10 second timeout
+	HttpClient *http.Client
+}
+
+func NewNotificationService(config NotificationServiceConfig) INotificationService {
+	httpClient := config.HttpClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: requestTimeout}
+	}
+
+	return &NotificationService{
+		sinks:      config.Sinks,
+		httpClient: httpClient,
+	}
+}
+
+// NotificationService sends events to the configured sinks. It is meant to be subscribed to the event service.
+type NotificationService struct {
+	sinks      []NotificationSink
+	httpClient *http.Client
+}
+
+// Notify sends the event to the sinks that accept it. Events are sent in the background so the emitter is not blocked.
+func (s *NotificationService) Notify(event events.Event) {
+	for _, sink := range s.sinks {
+		if !accepts(sink, event) {
+			continue
+		}
+
+		go func(sink NotificationSink) {
+			err := s.send(sink, event)
+			if err != nil {
+				log.Errorf("Failed to send %s notification to %s sink: %s", event.Type, sink.Type, err)
+			}
+		}(sink)
+	}
+}
+
+func (s *NotificationService) send(sink NotificationSink, event events.Event) error {
+	body, err := getPayload(sink, event)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, sink.Url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+
+	return nil
+}
+
+func accepts(sink NotificationSink, event events.Event) bool {
+	return len(sink.Events) == 0 || slices.Contains(sink.Events, event.Type)
+}
+
+func getPayload(sink NotificationSink, event events.Event) ([]byte, error) {
+	switch sink.Type {
+	case NotificationSinkTypeWebhook:
+		return json.Marshal(event)
+	case NotificationSinkTypeSlack:
+		return json.Marshal(map[string]string{
+			"text": fmt.Sprintf("*%s*\n%s", event.Type, event.Message),
+		})
+	default:
+		return nil, fmt.Errorf("unsupported notification sink type %s", sink.Type)
+	}
+}
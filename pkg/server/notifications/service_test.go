@@ -0,0 +1,82 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package notifications_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/server/events"
+	"github.com/daytonaio/daytona/pkg/server/notifications"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationService(t *testing.T) {
+	webhookRequests := make(chan events.Event, 10)
+	webhookServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event events.Event
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		webhookRequests <- event
+	}))
+	defer webhookServer.Close()
+
+	slackRequests := make(chan map[string]string, 10)
+	slackServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var message map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&message))
+		slackRequests <- message
+	}))
+	defer slackServer.Close()
+
+	service := notifications.NewNotificationService(notifications.NotificationServiceConfig{
+		Sinks: []notifications.NotificationSink{
+			{
+				Type: notifications.NotificationSinkTypeWebhook,
+				Url:  webhookServer.URL,
+			},
+			{
+				Type:   notifications.NotificationSinkTypeSlack,
+				Url:    slackServer.URL,
+				Events: []events.EventType{events.EventTypeBuildFailed},
+			},
+		},
+	})
+
+	service.Notify(events.Event{
+		Type:    events.EventTypeWorkspaceAutoStopped,
+		Message: "Workspace stopped",
+	})
+	service.Notify(events.Event{
+		Type:    events.EventTypeBuildFailed,
+		Message: "Build failed",
+	})
+
+	received := map[events.EventType]string{}
+	for i := 0; i < 2; i++ {
+		select {
+		case event := <-webhookRequests:
+			received[event.Type] = event.Message
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for webhook notification")
+		}
+	}
+	require.Equal(t, "Workspace stopped", received[events.EventTypeWorkspaceAutoStopped])
+	require.Equal(t, "Build failed", received[events.EventTypeBuildFailed])
+
+	select {
+	case message := <-slackRequests:
+		require.Equal(t, "*build-failed*\nBuild failed", message["text"])
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for slack notification")
+	}
+
+	select {
+	case message := <-slackRequests:
+		t.Fatalf("unexpected slack notification: %s", message["text"])
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -0,0 +1,237 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package portforwards
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/frpc"
+	"github.com/daytonaio/daytona/pkg/ports"
+	"github.com/daytonaio/daytona/pkg/workspace"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type DialFunc func(ctx context.Context, network, address string) (net.Conn, error)
+
+type ActivePortForward struct {
+	// Port on the server host the project port is available at
+	HostPort uint16
+	// Only set for public port forwards
+	PublicUrl string
+}
+
+type IPortForwardService interface {
+	Start(workspaceId, projectName string, portForward workspace.ProjectPortForward) (*ActivePortForward, error)
+	Stop(workspaceId, projectName string, port uint16)
+	StopProject(workspaceId, projectName string)
+	Get(workspaceId, projectName string, port uint16) *ActivePortForward
+}
+
+type PortForwardServiceConfig struct {
+	// Dials the projects in the server network
+	Dial         DialFunc
+	ServerId     string
+	FrpsDomain   string
+	FrpsPort     uint32
+	FrpsProtocol string
+}
+
+func NewPortForwardService(config PortForwardServiceConfig) IPortForwardService {
+	return &PortForwardService{
+		dial:         config.Dial,
+		serverId:     config.ServerId,
+		frpsDomain:   config.FrpsDomain,
+		frpsPort:     config.FrpsPort,
+		frpsProtocol: config.FrpsProtocol,
+		portForwards: make(map[string]*portForward),
+	}
+}
+
+type PortForwardService struct {
+	dial         DialFunc
+	serverId     string
+	frpsDomain   string
+	frpsPort     uint32
+	frpsProtocol string
+	portForwards map[string]*portForward
+	mutex        sync.Mutex
+}
+
+type portForward struct {
+	ActivePortForward
+	workspaceId string
+	projectName string
+	public      bool
+	listener    net.Listener
+	cancel      context.CancelFunc
+}
+
+// Start forwards the project port to the server host and, if the port forward is public, to a URL on the reverse proxy.
+// Starting an active port forward again returns the active one
+func (s *PortForwardService) Start(workspaceId, projectName string, forward workspace.ProjectPortForward) (*ActivePortForward, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key := getPortForwardKey(workspaceId, projectName, forward.Port)
+
+	if active, ok := s.portForwards[key]; ok {
+		if active.public == forward.Public {
+			activePortForward := active.ActivePortForward
+			return &activePortForward, nil
+		}
+		active.close()
+		delete(s.portForwards, key)
+	}
+
+	hostPort := forward.Port
+	if !ports.IsPortAvailable(hostPort) {
+		var err error
+		hostPort, err = ports.GetAvailableEphemeralPort()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf("localhost:%d", hostPort))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	active := &portForward{
+		ActivePortForward: ActivePortForward{
+			HostPort: hostPort,
+		},
+		workspaceId: workspaceId,
+		projectName: projectName,
+		public:      forward.Public,
+		listener:    listener,
+		cancel:      cancel,
+	}
+
+	targetAddress := fmt.Sprintf("%s:%d", workspace.GetProjectHostname(workspaceId, projectName), forward.Port)
+	go s.acceptConnections(ctx, listener, targetAddress)
+
+	if forward.Public {
+		subDomain := util.GetFrpcPortForwardSubDomain(s.serverId, workspaceId, projectName, forward.Port)
+
+		_, service, err := frpc.GetService(frpc.FrpcConnectParams{
+			ServerDomain: s.frpsDomain,
+			ServerPort:   int(s.frpsPort),
+			Name:         subDomain,
+			SubDomain:    subDomain,
+			Port:         int(hostPort),
+		})
+		if err != nil {
+			active.close()
+			return nil, err
+		}
+
+		go func() {
+			err := service.Run(ctx)
+			if err != nil {
+				log.Errorf("Public port forward %s stopped: %s", subDomain, err)
+			}
+		}()
+
+		active.PublicUrl = util.GetFrpcPortForwardUrl(s.frpsProtocol, s.serverId, s.frpsDomain, workspaceId, projectName, forward.Port)
+	}
+
+	s.portForwards[key] = active
+
+	activePortForward := active.ActivePortForward
+	return &activePortForward, nil
+}
+
+func (s *PortForwardService) Stop(workspaceId, projectName string, port uint16) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key := getPortForwardKey(workspaceId, projectName, port)
+
+	if active, ok := s.portForwards[key]; ok {
+		active.close()
+		delete(s.portForwards, key)
+	}
+}
+
+func (s *PortForwardService) StopProject(workspaceId, projectName string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for key, active := range s.portForwards {
+		if active.workspaceId == workspaceId && active.projectName == projectName {
+			active.close()
+			delete(s.portForwards, key)
+		}
+	}
+}
+
+func (s *PortForwardService) Get(workspaceId, projectName string, port uint16) *ActivePortForward {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if active, ok := s.portForwards[getPortForwardKey(workspaceId, projectName, port)]; ok {
+		activePortForward := active.ActivePortForward
+		return &activePortForward
+	}
+
+	return nil
+}
+
+func (s *PortForwardService) acceptConnections(ctx context.Context, listener net.Listener, targetAddress string) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			// The listener is closed when the port forward is stopped
+			return
+		}
+
+		go s.handleConnection(ctx, conn, targetAddress)
+	}
+}
+
+func (s *PortForwardService) handleConnection(ctx context.Context, conn net.Conn, targetAddress string) {
+	defer conn.Close()
+
+	targetConn, err := s.dial(ctx, "tcp", targetAddress)
+	if err != nil {
+		log.Debugf("Failed to dial %s: %s", targetAddress, err)
+		return
+	}
+	defer targetConn.Close()
+
+	done := make(chan struct{}, 2)
+
+	go func() {
+		_, _ = io.Copy(targetConn, conn)
+		done <- struct{}{}
+	}()
+
+	go func() {
+		_, _ = io.Copy(conn, targetConn)
+		done <- struct{}{}
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+}
+
+func (f *portForward) close() {
+	f.cancel()
+	f.listener.Close()
+}
+
+func getPortForwardKey(workspaceId, projectName string, port uint16) string {
+	return fmt.Sprintf("%s/%s/%d", workspaceId, projectName, port)
+}
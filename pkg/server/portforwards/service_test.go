@@ -0,0 +1,71 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package portforwards_test
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/server/portforwards"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPortForwardService(t *testing.T) {
+	projectListener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	defer projectListener.Close()
+
+	go func() {
+		for {
+			conn, err := projectListener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				line, _ := bufio.NewReader(conn).ReadString('\n')
+				conn.Write([]byte("echo: " + line))
+			}()
+		}
+	}()
+
+	var dialedAddress string
+	service := portforwards.NewPortForwardService(portforwards.PortForwardServiceConfig{
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			dialedAddress = address
+			return net.Dial(network, projectListener.Addr().String())
+		},
+	})
+
+	active, err := service.Start("workspace", "project", workspace.ProjectPortForward{Port: 3000})
+	require.NoError(t, err)
+	require.Empty(t, active.PublicUrl)
+	require.Equal(t, active, service.Get("workspace", "project", 3000))
+
+	conn, err := net.Dial("tcp", fmt.Sprintf("localhost:%d", active.HostPort))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = conn.Write([]byte("hello\n"))
+	require.NoError(t, err)
+
+	response, err := bufio.NewReader(conn).ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "echo: hello\n", response)
+	require.Equal(t, fmt.Sprintf("%s:3000", workspace.GetProjectHostname("workspace", "project")), dialedAddress)
+
+	restarted, err := service.Start("workspace", "project", workspace.ProjectPortForward{Port: 3000})
+	require.NoError(t, err)
+	require.Equal(t, active.HostPort, restarted.HostPort)
+
+	service.StopProject("workspace", "project")
+	require.Nil(t, service.Get("workspace", "project", 3000))
+
+	_, err = net.Dial("tcp", fmt.Sprintf("localhost:%d", active.HostPort))
+	require.Error(t, err)
+}
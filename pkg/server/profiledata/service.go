@@ -8,9 +8,9 @@ import (
 )
 
 type IProfileDataService interface {
-	Get() (*ProfileData, error)
-	Save(profileData *ProfileData) error
-	Delete() error
+	Get(userId string) (*ProfileData, error)
+	Save(userId string, profileData *ProfileData) error
+	Delete(userId string) error
 }
 
 type ProfileDataServiceConfig struct {
@@ -27,14 +27,14 @@ type ProfileDataService struct {
 	profileDataStore Store
 }
 
-func (s *ProfileDataService) Get() (*ProfileData, error) {
-	return s.profileDataStore.Get()
+func (s *ProfileDataService) Get(userId string) (*ProfileData, error) {
+	return s.profileDataStore.Get(userId)
 }
 
-func (s *ProfileDataService) Save(profileData *ProfileData) error {
-	return s.profileDataStore.Save(profileData)
+func (s *ProfileDataService) Save(userId string, profileData *ProfileData) error {
+	return s.profileDataStore.Save(userId, profileData)
 }
 
-func (s *ProfileDataService) Delete() error {
-	return s.profileDataStore.Delete()
+func (s *ProfileDataService) Delete(userId string) error {
+	return s.profileDataStore.Delete(userId)
 }
@@ -34,7 +34,7 @@ func TestApiKeyService(t *testing.T) {
 }
 
 func (s *ProfileDataServiceTestSuite) TestReturnsProfileDataNotFound() {
-	profileData, err := s.profileDataService.Get()
+	profileData, err := s.profileDataService.Get("")
 	s.Require().Nil(profileData)
 	s.Require().True(IsProfileDataNotFound(err))
 }
@@ -46,10 +46,10 @@ func (s *ProfileDataServiceTestSuite) TestSaveProfileData() {
 		},
 	}
 
-	err := s.profileDataService.Save(profileData)
+	err := s.profileDataService.Save("", profileData)
 	s.Require().Nil(err)
 
-	profileDataFromStore, err := s.profileDataStore.Get()
+	profileDataFromStore, err := s.profileDataStore.Get("")
 	s.Require().Nil(err)
 	s.Require().NotNil(profileDataFromStore)
 	s.Require().Equal(profileData, profileDataFromStore)
@@ -62,13 +62,42 @@ func (s *ProfileDataServiceTestSuite) TestDeleteProfileData() {
 		},
 	}
 
-	err := s.profileDataService.Save(profileData)
+	err := s.profileDataService.Save("", profileData)
 	s.Require().Nil(err)
 
-	err = s.profileDataService.Delete()
+	err = s.profileDataService.Delete("")
 	s.Require().Nil(err)
 
-	profileDataFromStore, err := s.profileDataStore.Get()
+	profileDataFromStore, err := s.profileDataStore.Get("")
 	s.Require().Nil(profileDataFromStore)
 	s.Require().True(IsProfileDataNotFound(err))
 }
+
+func (s *ProfileDataServiceTestSuite) TestProfileDataPerUser() {
+	profileData := &ProfileData{
+		EnvVars: map[string]string{
+			"key1": "value1",
+		},
+	}
+
+	err := s.profileDataService.Save("user1", profileData)
+	s.Require().Nil(err)
+
+	profileDataFromStore, err := s.profileDataService.Get("user1")
+	s.Require().Nil(err)
+	s.Require().Equal(profileData, profileDataFromStore)
+
+	// Other users and keys without a user do not see the profile data of the user
+	_, err = s.profileDataService.Get("user2")
+	s.Require().True(IsProfileDataNotFound(err))
+
+	_, err = s.profileDataService.Get("")
+	s.Require().True(IsProfileDataNotFound(err))
+
+	err = s.profileDataService.Delete("user2")
+	s.Require().Nil(err)
+
+	profileDataFromStore, err = s.profileDataService.Get("user1")
+	s.Require().Nil(err)
+	s.Require().Equal(profileData, profileDataFromStore)
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -21,7 +22,7 @@ func (s *Server) downloadDefaultProviders() error {
 
 	log.Info("Downloading default providers")
 	for providerName, provider := range defaultProviders {
-		_, err = s.ProviderManager.DownloadProvider(provider.DownloadUrls, providerName, false)
+		_, err = s.ProviderManager.DownloadProvider(provider.DownloadUrls, provider.Checksums, providerName, false)
 		if err != nil {
 			log.Error(err)
 		}
@@ -50,7 +51,8 @@ func (s *Server) registerProviders() error {
 	}
 
 	for _, file := range files {
-		if file.IsDir() {
+		// Hidden directories hold providers that are being installed
+		if file.IsDir() && !strings.HasPrefix(file.Name(), ".") {
 			pluginPath, err := s.getPluginPath(filepath.Join(s.config.ProvidersDir, file.Name()))
 			if err != nil {
 				log.Error(err)
@@ -13,21 +13,30 @@ import (
 	"github.com/daytonaio/daytona/pkg/frpc"
 	"github.com/daytonaio/daytona/pkg/provider/manager"
 	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	"github.com/daytonaio/daytona/pkg/server/builds"
 	"github.com/daytonaio/daytona/pkg/server/containerregistries"
+	"github.com/daytonaio/daytona/pkg/server/events"
 	"github.com/daytonaio/daytona/pkg/server/gitproviders"
 	"github.com/daytonaio/daytona/pkg/server/profiledata"
 	"github.com/daytonaio/daytona/pkg/server/providertargets"
 	"github.com/daytonaio/daytona/pkg/server/registry"
+	"github.com/daytonaio/daytona/pkg/server/sessionrecordings"
+	"github.com/daytonaio/daytona/pkg/server/targetgroups"
+	"github.com/daytonaio/daytona/pkg/server/users"
 	"github.com/daytonaio/daytona/pkg/server/workspaces"
 	"github.com/hashicorp/go-plugin"
 
 	log "github.com/sirupsen/logrus"
 )
 
+// Interval at which the stored workspace states are compared with the states reported by the providers
+const stateReconciliationInterval = 5 * time.Minute
+
 type ServerInstanceConfig struct {
 	Config                   Config
 	TailscaleServer          TailscaleServer
 	ProviderTargetService    providertargets.IProviderTargetService
+	TargetGroupService       targetgroups.ITargetGroupService
 	ContainerRegistryService containerregistries.IContainerRegistryService
 	LocalContainerRegistry   ILocalContainerRegistry
 	WorkspaceService         workspaces.IWorkspaceService
@@ -35,6 +44,13 @@ type ServerInstanceConfig struct {
 	GitProviderService       gitproviders.IGitProviderService
 	ProviderManager          manager.IProviderManager
 	ProfileDataService       profiledata.IProfileDataService
+	BuildService             builds.IBuildService
+	EventService             events.IEventService
+	SessionRecordingService  sessionrecordings.ISessionRecordingService
+	UserService              users.IUserService
+	// Runs the background jobs on a single instance if multiple instances share the database. The jobs run on
+	// every instance if not set
+	LeaderLock LeaderLock
 }
 
 var server *Server
@@ -52,6 +68,7 @@ func GetInstance(serverConfig *ServerInstanceConfig) *Server {
 			config:                   serverConfig.Config,
 			TailscaleServer:          serverConfig.TailscaleServer,
 			ProviderTargetService:    serverConfig.ProviderTargetService,
+			TargetGroupService:       serverConfig.TargetGroupService,
 			ContainerRegistryService: serverConfig.ContainerRegistryService,
 			LocalContainerRegistry:   serverConfig.LocalContainerRegistry,
 			WorkspaceService:         serverConfig.WorkspaceService,
@@ -59,6 +76,14 @@ func GetInstance(serverConfig *ServerInstanceConfig) *Server {
 			GitProviderService:       serverConfig.GitProviderService,
 			ProviderManager:          serverConfig.ProviderManager,
 			ProfileDataService:       serverConfig.ProfileDataService,
+			BuildService:             serverConfig.BuildService,
+			EventService:             serverConfig.EventService,
+			SessionRecordingService:  serverConfig.SessionRecordingService,
+			UserService:              serverConfig.UserService,
+		}
+		server.leaderElection = &leaderElection{
+			lock:      serverConfig.LeaderLock,
+			onElected: server.restorePortForwards,
 		}
 	}
 
@@ -69,6 +94,7 @@ type Server struct {
 	config                   Config
 	TailscaleServer          TailscaleServer
 	ProviderTargetService    providertargets.IProviderTargetService
+	TargetGroupService       targetgroups.ITargetGroupService
 	ContainerRegistryService containerregistries.IContainerRegistryService
 	LocalContainerRegistry   ILocalContainerRegistry
 	WorkspaceService         workspaces.IWorkspaceService
@@ -76,6 +102,11 @@ type Server struct {
 	GitProviderService       gitproviders.IGitProviderService
 	ProviderManager          manager.IProviderManager
 	ProfileDataService       profiledata.IProfileDataService
+	BuildService             builds.IBuildService
+	EventService             events.IEventService
+	SessionRecordingService  sessionrecordings.ISessionRecordingService
+	UserService              users.IUserService
+	leaderElection           *leaderElection
 }
 
 func (s *Server) Start(errCh chan error) error {
@@ -160,11 +191,18 @@ func (s *Server) Start(errCh chan error) error {
 
 		for range interruptChannel {
 			log.Info("Shutting down")
+			s.leaderElection.release()
 			plugin.CleanupClients()
 			os.Exit(0)
 		}
 	}()
 
+	// Restricts the nodes that joined before the restart
+	err = s.WorkspaceService.UpdateNetworkAccess()
+	if err != nil {
+		log.Errorf("Failed to update network access: %s", err)
+	}
+
 	go func() {
 		errChan := make(chan error)
 		go func() {
@@ -225,5 +263,57 @@ func (s *Server) Start(errCh chan error) error {
 		return err
 	}
 
+	// Port forwards are restored once this instance is elected as the leader
+	s.leaderElection.isLeader()
+
+	go func() {
+		ticker := time.NewTicker(1 * time.Minute)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			if !s.leaderElection.isLeader() {
+				continue
+			}
+
+			err := s.WorkspaceService.HandleExpiredWorkspaces()
+			if err != nil {
+				log.Errorf("Failed to handle expired workspaces: %s", err)
+			}
+
+			err = s.WorkspaceService.HandleExpiredShares()
+			if err != nil {
+				log.Errorf("Failed to handle expired workspace shares: %s", err)
+			}
+		}
+	}()
+
+	go func() {
+		ticker := time.NewTicker(stateReconciliationInterval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			if !s.leaderElection.isLeader() {
+				continue
+			}
+
+			err := s.WorkspaceService.ReconcileWorkspaceStates()
+			if err != nil {
+				log.Errorf("Failed to reconcile workspace states: %s", err)
+			}
+		}
+	}()
+
+	// Logs are stored on the host of each instance
+	if s.config.LogRetention != nil {
+		go s.cleanupLogs()
+	}
+
 	return nil
 }
+
+func (s *Server) restorePortForwards() {
+	err := s.WorkspaceService.RestorePortForwards()
+	if err != nil {
+		log.Errorf("Failed to restore port forwards: %s", err)
+	}
+}
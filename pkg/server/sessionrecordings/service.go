@@ -0,0 +1,166 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sessionrecordings
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/sessionrecording"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/docker/docker/pkg/stringid"
+)
+
+var (
+	ErrCastTooLarge = errors.New("session recording exceeds the maximum size")
+)
+
+type ISessionRecordingService interface {
+	// Lists the recordings of the workspace or all recordings if workspaceId is empty
+	List(workspaceId string) ([]*sessionrecording.SessionRecording, error)
+	Find(id string) (*sessionrecording.SessionRecording, error)
+	Save(workspaceId, projectName string, cast []byte) (*sessionrecording.SessionRecording, error)
+	GetCast(id string) ([]byte, error)
+	Delete(id string) error
+}
+
+type SessionRecordingServiceConfig struct {
+	Store          sessionrecording.Store
+	WorkspaceStore workspace.Store
+	// Directory the cast files are stored in
+	RecordingsDir string
+}
+
+type SessionRecordingService struct {
+	store          sessionrecording.Store
+	workspaceStore workspace.Store
+	recordingsDir  string
+}
+
+func NewSessionRecordingService(config SessionRecordingServiceConfig) ISessionRecordingService {
+	return &SessionRecordingService{
+		store:          config.Store,
+		workspaceStore: config.WorkspaceStore,
+		recordingsDir:  config.RecordingsDir,
+	}
+}
+
+func (s *SessionRecordingService) List(workspaceId string) ([]*sessionrecording.SessionRecording, error) {
+	recordings, err := s.store.List()
+	if err != nil {
+		return nil, err
+	}
+
+	if workspaceId == "" {
+		return recordings, nil
+	}
+
+	result := []*sessionrecording.SessionRecording{}
+	for _, recording := range recordings {
+		if recording.WorkspaceId == workspaceId {
+			result = append(result, recording)
+		}
+	}
+
+	return result, nil
+}
+
+func (s *SessionRecordingService) Find(id string) (*sessionrecording.SessionRecording, error) {
+	return s.store.Find(id)
+}
+
+func (s *SessionRecordingService) Save(workspaceId, projectName string, cast []byte) (*sessionrecording.SessionRecording, error) {
+	if len(cast) > sessionrecording.MaxCastSize {
+		return nil, ErrCastTooLarge
+	}
+
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = w.GetProject(projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	header, events, err := sessionrecording.ParseCast(bytes.NewReader(cast))
+	if err != nil {
+		return nil, err
+	}
+
+	startedAt := time.Now()
+	if header.Timestamp != 0 {
+		startedAt = time.Unix(header.Timestamp, 0)
+	}
+
+	duration := 0.0
+	if len(events) > 0 {
+		duration = events[len(events)-1].Time
+	}
+
+	recording := &sessionrecording.SessionRecording{
+		Id:            stringid.TruncateID(stringid.GenerateRandomID()),
+		WorkspaceId:   w.Id,
+		WorkspaceName: w.Name,
+		ProjectName:   projectName,
+		StartedAt:     startedAt.UTC().Format(time.RFC3339),
+		Duration:      duration,
+		Size:          int64(len(cast)),
+	}
+
+	err = os.MkdirAll(s.recordingsDir, 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	// Recordings can contain secrets typed or printed in the session
+	err = os.WriteFile(s.getCastPath(recording.Id), cast, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.store.Save(recording)
+	if err != nil {
+		return nil, errors.Join(err, os.Remove(s.getCastPath(recording.Id)))
+	}
+
+	return recording, nil
+}
+
+func (s *SessionRecordingService) GetCast(id string) ([]byte, error) {
+	recording, err := s.store.Find(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return os.ReadFile(s.getCastPath(recording.Id))
+}
+
+func (s *SessionRecordingService) Delete(id string) error {
+	recording, err := s.store.Find(id)
+	if err != nil {
+		return err
+	}
+
+	err = s.store.Delete(recording)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(s.getCastPath(recording.Id))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *SessionRecordingService) getCastPath(id string) string {
+	return filepath.Join(s.recordingsDir, fmt.Sprintf("%s.cast", id))
+}
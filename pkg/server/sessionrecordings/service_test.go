@@ -0,0 +1,91 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sessionrecordings_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	t_sessionrecordings "github.com/daytonaio/daytona/internal/testing/server/sessionrecordings"
+	t_workspaces "github.com/daytonaio/daytona/internal/testing/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/server/sessionrecordings"
+	"github.com/daytonaio/daytona/pkg/sessionrecording"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/stretchr/testify/require"
+)
+
+const cast = `{"version": 2, "width": 80, "height": 24, "timestamp": 1717243200}
+[0.25, "o", "$ make test\r\n"]
+[12.5, "o", "ok\r\n"]
+`
+
+func TestSessionRecordingService(t *testing.T) {
+	recordingsDir := filepath.Join(t.TempDir(), "session-recordings")
+
+	workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+	for _, w := range []*workspace.Workspace{
+		{Id: "workspace1", Name: "workspace-one", Projects: []*workspace.Project{{Name: "project1"}}},
+		{Id: "workspace2", Name: "workspace-two", Projects: []*workspace.Project{{Name: "project1"}}},
+	} {
+		require.Nil(t, workspaceStore.Save(w))
+	}
+
+	service := sessionrecordings.NewSessionRecordingService(sessionrecordings.SessionRecordingServiceConfig{
+		Store:          t_sessionrecordings.NewInMemorySessionRecordingStore(),
+		WorkspaceStore: workspaceStore,
+		RecordingsDir:  recordingsDir,
+	})
+
+	var recordingId string
+
+	t.Run("SaveRecording", func(t *testing.T) {
+		recording, err := service.Save("workspace1", "project1", []byte(cast))
+		require.Nil(t, err)
+
+		require.Equal(t, "2024-06-01T12:00:00Z", recording.StartedAt)
+		require.Equal(t, 12.5, recording.Duration)
+		require.Equal(t, int64(len(cast)), recording.Size)
+
+		recordingId = recording.Id
+
+		_, err = service.Save("workspace2", "project1", []byte(cast))
+		require.Nil(t, err)
+	})
+
+	t.Run("SaveInvalidRecording", func(t *testing.T) {
+		_, err := service.Save("workspace1", "project1", []byte("not a cast"))
+		require.ErrorIs(t, err, sessionrecording.ErrInvalidCast)
+
+		_, err = service.Save("workspace1", "project2", []byte(cast))
+		require.NotNil(t, err)
+	})
+
+	t.Run("ListRecordings", func(t *testing.T) {
+		recordings, err := service.List("")
+		require.Nil(t, err)
+		require.Len(t, recordings, 2)
+
+		recordings, err = service.List("workspace1")
+		require.Nil(t, err)
+		require.Len(t, recordings, 1)
+		require.Equal(t, "workspace-one", recordings[0].WorkspaceName)
+	})
+
+	t.Run("GetCast", func(t *testing.T) {
+		content, err := service.GetCast(recordingId)
+		require.Nil(t, err)
+		require.Equal(t, cast, string(content))
+	})
+
+	t.Run("DeleteRecording", func(t *testing.T) {
+		require.Nil(t, service.Delete(recordingId))
+
+		_, err := service.Find(recordingId)
+		require.True(t, sessionrecording.IsSessionRecordingNotFound(err))
+
+		_, err = os.Stat(filepath.Join(recordingsDir, recordingId+".cast"))
+		require.True(t, os.IsNotExist(err))
+	})
+}
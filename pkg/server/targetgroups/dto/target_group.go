@@ -0,0 +1,18 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package dto
+
+import "github.com/daytonaio/daytona/pkg/provider"
+
+type TargetGroupDTO struct {
+	Name            string                   `json:"name" validate:"required"`
+	PlacementPolicy provider.PlacementPolicy `json:"placementPolicy" validate:"required"`
+	Members         []TargetGroupMemberDTO   `json:"members" validate:"required"`
+} //	@name	TargetGroupDTO
+
+type TargetGroupMemberDTO struct {
+	provider.TargetGroupMember
+	// Number of workspaces on the target
+	Workspaces int `json:"workspaces" validate:"required"`
+} //	@name	TargetGroupMemberDTO
@@ -0,0 +1,256 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package targetgroups
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server/targetgroups/dto"
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+var (
+	ErrInvalidTargetGroupName   = errors.New("target group name is not a valid alphanumeric string")
+	ErrTargetGroupEmpty         = errors.New("target group has no members")
+	ErrInvalidPlacementPolicy   = errors.New("placement policy must be either 'spread' or 'ordered'")
+	ErrTargetNotInGroup         = errors.New("target is not a member of the target group")
+	ErrNoTargetAvailableInGroup = errors.New("all members of the target group are drained or removed")
+)
+
+type ITargetGroupService interface {
+	List() ([]dto.TargetGroupDTO, error)
+	Find(groupName string) (*provider.TargetGroup, error)
+	Save(group *provider.TargetGroup) error
+	Delete(groupName string) error
+	SetDrained(groupName string, targetNames []string, drained bool) (*provider.TargetGroup, error)
+	PlaceWorkspace(groupName string) (*provider.ProviderTarget, error)
+}
+
+type TargetGroupServiceConfig struct {
+	TargetGroupStore provider.TargetGroupStore
+	TargetStore      provider.TargetStore
+	WorkspaceStore   workspace.Store
+}
+
+type TargetGroupService struct {
+	targetGroupStore provider.TargetGroupStore
+	targetStore      provider.TargetStore
+	workspaceStore   workspace.Store
+}
+
+func NewTargetGroupService(config TargetGroupServiceConfig) ITargetGroupService {
+	return &TargetGroupService{
+		targetGroupStore: config.TargetGroupStore,
+		targetStore:      config.TargetStore,
+		workspaceStore:   config.WorkspaceStore,
+	}
+}
+
+func (s *TargetGroupService) List() ([]dto.TargetGroupDTO, error) {
+	groups, err := s.targetGroupStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceCounts, err := s.getWorkspaceCounts()
+	if err != nil {
+		return nil, err
+	}
+
+	result := []dto.TargetGroupDTO{}
+	for _, group := range groups {
+		groupDTO := dto.TargetGroupDTO{
+			Name:            group.Name,
+			PlacementPolicy: group.PlacementPolicy,
+			Members:         []dto.TargetGroupMemberDTO{},
+		}
+
+		for _, member := range group.Members {
+			groupDTO.Members = append(groupDTO.Members, dto.TargetGroupMemberDTO{
+				TargetGroupMember: member,
+				Workspaces:        workspaceCounts[member.Target],
+			})
+		}
+
+		result = append(result, groupDTO)
+	}
+
+	return result, nil
+}
+
+func (s *TargetGroupService) Find(groupName string) (*provider.TargetGroup, error) {
+	return s.targetGroupStore.Find(groupName)
+}
+
+// Save creates or replaces the group. The drained state of the members that are kept is preserved
+func (s *TargetGroupService) Save(group *provider.TargetGroup) error {
+	isValidName := regexp.MustCompile(`^[a-zA-Z0-9-_.]+$`).MatchString
+	if !isValidName(group.Name) {
+		return ErrInvalidTargetGroupName
+	}
+
+	if len(group.Members) == 0 {
+		return ErrTargetGroupEmpty
+	}
+
+	if group.PlacementPolicy == "" {
+		group.PlacementPolicy = provider.PlacementPolicySpread
+	}
+
+	if group.PlacementPolicy != provider.PlacementPolicySpread && group.PlacementPolicy != provider.PlacementPolicyOrdered {
+		return ErrInvalidPlacementPolicy
+	}
+
+	drained := map[string]bool{}
+	existingGroup, err := s.targetGroupStore.Find(group.Name)
+	if err == nil {
+		for _, member := range existingGroup.Members {
+			drained[member.Target] = member.Drained
+		}
+	} else if !provider.IsTargetGroupNotFound(err) {
+		return err
+	}
+
+	members := []provider.TargetGroupMember{}
+	added := map[string]bool{}
+
+	for _, member := range group.Members {
+		if added[member.Target] {
+			continue
+		}
+
+		_, err := s.targetStore.Find(member.Target)
+		if err != nil {
+			return fmt.Errorf("failed to find target %s: %w", member.Target, err)
+		}
+
+		members = append(members, provider.TargetGroupMember{
+			Target:  member.Target,
+			Drained: member.Drained || drained[member.Target],
+		})
+		added[member.Target] = true
+	}
+
+	group.Members = members
+
+	return s.targetGroupStore.Save(group)
+}
+
+func (s *TargetGroupService) Delete(groupName string) error {
+	group, err := s.targetGroupStore.Find(groupName)
+	if err != nil {
+		return err
+	}
+
+	return s.targetGroupStore.Delete(group)
+}
+
+// SetDrained drains or undrains the given members of the group. All members are updated if no targets are given
+func (s *TargetGroupService) SetDrained(groupName string, targetNames []string, drained bool) (*provider.TargetGroup, error) {
+	group, err := s.targetGroupStore.Find(groupName)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, targetName := range targetNames {
+		if !isMember(group, targetName) {
+			return nil, fmt.Errorf("%w: %s", ErrTargetNotInGroup, targetName)
+		}
+	}
+
+	for i, member := range group.Members {
+		if len(targetNames) == 0 || contains(targetNames, member.Target) {
+			group.Members[i].Drained = drained
+		}
+	}
+
+	err = s.targetGroupStore.Save(group)
+	if err != nil {
+		return nil, err
+	}
+
+	return group, nil
+}
+
+// PlaceWorkspace returns the member target a new workspace of the group is created on according to the group placement policy
+func (s *TargetGroupService) PlaceWorkspace(groupName string) (*provider.ProviderTarget, error) {
+	group, err := s.targetGroupStore.Find(groupName)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := []*provider.ProviderTarget{}
+	for _, member := range group.Members {
+		if member.Drained {
+			continue
+		}
+
+		target, err := s.targetStore.Find(member.Target)
+		if err != nil {
+			if provider.IsTargetNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		candidates = append(candidates, target)
+	}
+
+	if len(candidates) == 0 {
+		return nil, ErrNoTargetAvailableInGroup
+	}
+
+	if group.PlacementPolicy == provider.PlacementPolicyOrdered {
+		return candidates[0], nil
+	}
+
+	workspaceCounts, err := s.getWorkspaceCounts()
+	if err != nil {
+		return nil, err
+	}
+
+	placement := candidates[0]
+	for _, candidate := range candidates[1:] {
+		if workspaceCounts[candidate.Name] < workspaceCounts[placement.Name] {
+			placement = candidate
+		}
+	}
+
+	return placement, nil
+}
+
+func (s *TargetGroupService) getWorkspaceCounts() (map[string]int, error) {
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[string]int{}
+	for _, w := range workspaces {
+		counts[w.Target]++
+	}
+
+	return counts, nil
+}
+
+func isMember(group *provider.TargetGroup, targetName string) bool {
+	for _, member := range group.Members {
+		if member.Target == targetName {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,135 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package targetgroups_test
+
+import (
+	"testing"
+
+	t_targets "github.com/daytonaio/daytona/internal/testing/provider/targets"
+	t_workspaces "github.com/daytonaio/daytona/internal/testing/server/workspaces"
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server/targetgroups"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTargetGroupService(t *testing.T) {
+	targetStore := t_targets.NewInMemoryTargetStore()
+	workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+
+	for _, name := range []string{"staging-1", "staging-2", "staging-3"} {
+		require.Nil(t, targetStore.Save(&provider.ProviderTarget{Name: name}))
+	}
+
+	require.Nil(t, workspaceStore.Save(&workspace.Workspace{Id: "1", Name: "w1", Target: "staging-1"}))
+	require.Nil(t, workspaceStore.Save(&workspace.Workspace{Id: "2", Name: "w2", Target: "staging-1"}))
+	require.Nil(t, workspaceStore.Save(&workspace.Workspace{Id: "3", Name: "w3", Target: "staging-2"}))
+
+	service := targetgroups.NewTargetGroupService(targetgroups.TargetGroupServiceConfig{
+		TargetGroupStore: t_targets.NewInMemoryTargetGroupStore(),
+		TargetStore:      targetStore,
+		WorkspaceStore:   workspaceStore,
+	})
+
+	t.Run("SaveTargetGroup", func(t *testing.T) {
+		err := service.Save(&provider.TargetGroup{
+			Name: "staging",
+			Members: []provider.TargetGroupMember{
+				{Target: "staging-1"}, {Target: "staging-2"}, {Target: "staging-3"}, {Target: "staging-1"},
+			},
+		})
+		require.Nil(t, err)
+
+		group, err := service.Find("staging")
+		require.Nil(t, err)
+		require.Equal(t, provider.PlacementPolicySpread, group.PlacementPolicy)
+		require.Len(t, group.Members, 3)
+	})
+
+	t.Run("SaveInvalidTargetGroup", func(t *testing.T) {
+		err := service.Save(&provider.TargetGroup{Name: "empty"})
+		require.ErrorIs(t, err, targetgroups.ErrTargetGroupEmpty)
+
+		err = service.Save(&provider.TargetGroup{
+			Name:            "invalid",
+			Members:         []provider.TargetGroupMember{{Target: "staging-1"}},
+			PlacementPolicy: "random",
+		})
+		require.ErrorIs(t, err, targetgroups.ErrInvalidPlacementPolicy)
+
+		err = service.Save(&provider.TargetGroup{
+			Name:    "unknown",
+			Members: []provider.TargetGroupMember{{Target: "production-1"}},
+		})
+		require.ErrorIs(t, err, provider.ErrTargetNotFound)
+	})
+
+	t.Run("ListTargetGroups", func(t *testing.T) {
+		groups, err := service.List()
+		require.Nil(t, err)
+		require.Len(t, groups, 1)
+
+		workspaces := []int{}
+		for _, member := range groups[0].Members {
+			workspaces = append(workspaces, member.Workspaces)
+		}
+		require.Equal(t, []int{2, 1, 0}, workspaces)
+	})
+
+	t.Run("PlaceWorkspaceSpread", func(t *testing.T) {
+		target, err := service.PlaceWorkspace("staging")
+		require.Nil(t, err)
+		require.Equal(t, "staging-3", target.Name)
+	})
+
+	t.Run("DrainTargetGroupMember", func(t *testing.T) {
+		_, err := service.SetDrained("staging", []string{"staging-3"}, true)
+		require.Nil(t, err)
+
+		target, err := service.PlaceWorkspace("staging")
+		require.Nil(t, err)
+		require.Equal(t, "staging-2", target.Name)
+
+		_, err = service.SetDrained("staging", []string{"production-1"}, true)
+		require.ErrorIs(t, err, targetgroups.ErrTargetNotInGroup)
+	})
+
+	t.Run("PlaceWorkspaceOrdered", func(t *testing.T) {
+		group, err := service.Find("staging")
+		require.Nil(t, err)
+
+		group.PlacementPolicy = provider.PlacementPolicyOrdered
+		require.Nil(t, service.Save(group))
+
+		group, err = service.Find("staging")
+		require.Nil(t, err)
+		// Drained members are kept drained when the group is saved
+		require.True(t, group.Members[2].Drained)
+
+		target, err := service.PlaceWorkspace("staging")
+		require.Nil(t, err)
+		require.Equal(t, "staging-1", target.Name)
+	})
+
+	t.Run("DrainTargetGroup", func(t *testing.T) {
+		_, err := service.SetDrained("staging", nil, true)
+		require.Nil(t, err)
+
+		_, err = service.PlaceWorkspace("staging")
+		require.ErrorIs(t, err, targetgroups.ErrNoTargetAvailableInGroup)
+
+		group, err := service.SetDrained("staging", nil, false)
+		require.Nil(t, err)
+		for _, member := range group.Members {
+			require.False(t, member.Drained)
+		}
+	})
+
+	t.Run("DeleteTargetGroup", func(t *testing.T) {
+		require.Nil(t, service.Delete("staging"))
+
+		_, err := service.Find("staging")
+		require.True(t, provider.IsTargetGroupNotFound(err))
+	})
+}
@@ -0,0 +1,112 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package users
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	"github.com/daytonaio/daytona/pkg/user"
+	"github.com/docker/docker/pkg/stringid"
+)
+
+var (
+	ErrInvalidUserName   = errors.New("user name is not a valid alphanumeric string")
+	ErrInvalidUserRole   = errors.New("user role must be either 'admin' or 'member'")
+	ErrUserAlreadyExists = errors.New("user already exists")
+)
+
+type IUserService interface {
+	// Creates the user and generates its first API key. Returns the user and the key
+	Create(name string, role user.Role) (*user.User, string, error)
+	List() ([]*user.User, error)
+	Find(userId string) (*user.User, error)
+	// Disabled users keep their resources but their API keys are rejected
+	SetDisabled(userName string, disabled bool) (*user.User, error)
+}
+
+type UserServiceConfig struct {
+	UserStore     user.Store
+	ApiKeyService apikeys.IApiKeyService
+}
+
+type UserService struct {
+	userStore     user.Store
+	apiKeyService apikeys.IApiKeyService
+}
+
+func NewUserService(config UserServiceConfig) IUserService {
+	return &UserService{
+		userStore:     config.UserStore,
+		apiKeyService: config.ApiKeyService,
+	}
+}
+
+func (s *UserService) Create(name string, role user.Role) (*user.User, string, error) {
+	isValidUserName := regexp.MustCompile(`^[a-zA-Z0-9-_.]+$`).MatchString
+	if !isValidUserName(name) {
+		return nil, "", ErrInvalidUserName
+	}
+
+	if role == "" {
+		role = user.RoleMember
+	}
+	if role != user.RoleAdmin && role != user.RoleMember {
+		return nil, "", ErrInvalidUserRole
+	}
+
+	_, err := s.userStore.FindByName(name)
+	if err == nil {
+		return nil, "", ErrUserAlreadyExists
+	}
+	if !user.IsUserNotFound(err) {
+		return nil, "", err
+	}
+
+	u := &user.User{
+		Id:        stringid.TruncateID(stringid.GenerateRandomID()),
+		Name:      name,
+		Role:      role,
+		CreatedAt: time.Now().Format(time.RFC3339),
+	}
+
+	err = s.userStore.Save(u)
+	if err != nil {
+		return nil, "", err
+	}
+
+	key, err := s.apiKeyService.GenerateUserKey(u.Id, fmt.Sprintf("%s-default", u.Name))
+	if err != nil {
+		return nil, "", err
+	}
+
+	return u, key, nil
+}
+
+func (s *UserService) List() ([]*user.User, error) {
+	return s.userStore.List()
+}
+
+func (s *UserService) Find(userId string) (*user.User, error) {
+	return s.userStore.Find(userId)
+}
+
+func (s *UserService) SetDisabled(userName string, disabled bool) (*user.User, error) {
+	u, err := s.userStore.FindByName(userName)
+	if err != nil {
+		return nil, err
+	}
+
+	u.Disabled = disabled
+
+	err = s.userStore.Save(u)
+	if err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package users_test
+
+import (
+	"testing"
+
+	t_apikeys "github.com/daytonaio/daytona/internal/testing/server/apikeys"
+	t_users "github.com/daytonaio/daytona/internal/testing/server/users"
+	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	"github.com/daytonaio/daytona/pkg/server/users"
+	"github.com/daytonaio/daytona/pkg/user"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserService(t *testing.T) {
+	apiKeyService := apikeys.NewApiKeyService(apikeys.ApiKeyServiceConfig{
+		ApiKeyStore: t_apikeys.NewInMemoryApiKeyStore(),
+	})
+
+	service := users.NewUserService(users.UserServiceConfig{
+		UserStore:     t_users.NewInMemoryUserStore(),
+		ApiKeyService: apiKeyService,
+	})
+
+	t.Run("CreateUser", func(t *testing.T) {
+		u, key, err := service.Create("alice", "")
+		require.Nil(t, err)
+		require.Equal(t, user.RoleMember, u.Role)
+
+		apiKey, err := apiKeyService.GetApiKey(key)
+		require.Nil(t, err)
+		require.Equal(t, u.Id, apiKey.UserId)
+		require.True(t, apiKeyService.IsValidApiKey(key))
+
+		found, err := service.Find(u.Id)
+		require.Nil(t, err)
+		require.Equal(t, "alice", found.Name)
+	})
+
+	t.Run("CreateInvalidUser", func(t *testing.T) {
+		_, _, err := service.Create("alice", user.RoleAdmin)
+		require.ErrorIs(t, err, users.ErrUserAlreadyExists)
+
+		_, _, err = service.Create("bob smith", user.RoleMember)
+		require.ErrorIs(t, err, users.ErrInvalidUserName)
+
+		_, _, err = service.Create("bob", "owner")
+		require.ErrorIs(t, err, users.ErrInvalidUserRole)
+	})
+
+	t.Run("DisableUser", func(t *testing.T) {
+		u, err := service.SetDisabled("alice", true)
+		require.Nil(t, err)
+		require.True(t, u.Disabled)
+
+		_, err = service.SetDisabled("unknown", true)
+		require.True(t, user.IsUserNotFound(err))
+	})
+
+	t.Run("ListUsers", func(t *testing.T) {
+		_, _, err := service.Create("bob", user.RoleAdmin)
+		require.Nil(t, err)
+
+		userList, err := service.List()
+		require.Nil(t, err)
+		require.Len(t, userList, 2)
+	})
+}
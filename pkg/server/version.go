@@ -0,0 +1,65 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import "github.com/daytonaio/daytona/internal"
+
+// Capability is a server feature newer clients check for before using it
+type Capability string
+
+const (
+	CapabilityWorkspaceTtl        Capability = "workspace-ttl"
+	CapabilityWorkspaceRetry      Capability = "workspace-retry"
+	CapabilityWorkspaceShare      Capability = "workspace-share"
+	CapabilityWorkspaceArchive    Capability = "workspace-archive"
+	CapabilityPortForwards        Capability = "port-forwards"
+	CapabilityProjectStats        Capability = "project-stats"
+	CapabilityRepoCommits         Capability = "repo-commits"
+	CapabilityImageExport         Capability = "image-export"
+	CapabilityWorkspaceCost       Capability = "workspace-cost"
+	CapabilitySshCertificates     Capability = "ssh-certificates"
+	CapabilityProviderFileInstall Capability = "provider-file-install"
+	CapabilityProjectDependencies Capability = "project-dependencies"
+	CapabilityLogFilters          Capability = "log-filters"
+	CapabilityTargetGroups        Capability = "target-groups"
+	CapabilitySessionRecording    Capability = "session-recording"
+	CapabilityWorktrees           Capability = "worktrees"
+	CapabilityRepositoryConfig    Capability = "repository-config"
+	CapabilityUsers               Capability = "users"
+)
+
+// Capabilities lists the features supported by this server version
+var Capabilities = []Capability{
+	CapabilityWorkspaceTtl,
+	CapabilityWorkspaceRetry,
+	CapabilityWorkspaceShare,
+	CapabilityWorkspaceArchive,
+	CapabilityPortForwards,
+	CapabilityProjectStats,
+	CapabilityRepoCommits,
+	CapabilityImageExport,
+	CapabilityWorkspaceCost,
+	CapabilitySshCertificates,
+	CapabilityProviderFileInstall,
+	CapabilityProjectDependencies,
+	CapabilityLogFilters,
+	CapabilityTargetGroups,
+	CapabilitySessionRecording,
+	CapabilityWorktrees,
+	CapabilityRepositoryConfig,
+	CapabilityUsers,
+}
+
+type VersionInfo struct {
+	Version string `json:"version" validate:"required"`
+	// Unknown capabilities must be ignored by clients
+	Capabilities []Capability `json:"capabilities" validate:"required" swaggertype:"array,string"`
+} // @name ServerVersion
+
+func GetVersionInfo() VersionInfo {
+	return VersionInfo{
+		Version:      internal.Version,
+		Capabilities: Capabilities,
+	}
+}
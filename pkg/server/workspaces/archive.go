@@ -0,0 +1,139 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/containerregistry"
+	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/workspace"
+
+	"github.com/daytonaio/daytona/internal/util"
+)
+
+// ArchiveWorkspace exports the workspace projects to the archive storage and removes them from the target
+func (s *WorkspaceService) ArchiveWorkspace(workspaceId string) error {
+	if s.archiveStorage == nil {
+		return ErrArchiveNotConfigured
+	}
+
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return ErrWorkspaceNotFound
+	}
+
+	if w.IsArchived() {
+		return ErrWorkspaceArchived
+	}
+
+	target, err := s.targetStore.Find(w.Target)
+	if err != nil {
+		return err
+	}
+
+	workspaceLogger := s.loggerFactory.CreateWorkspaceLogger(w.Id, logs.LogSourceServer)
+	defer workspaceLogger.Close()
+
+	wsLogWriter := io.MultiWriter(&util.InfoLogWriter{}, workspaceLogger)
+
+	defer s.markBusy(w.Id)()
+
+	wsLogWriter.Write([]byte(fmt.Sprintf("Archiving workspace %s\n", w.Name)))
+
+	for _, project := range w.Projects {
+		s.stopPortForwards(project)
+
+		err := s.provisioner.ArchiveProject(project, target, s.archiveStorage)
+		if err != nil {
+			// Projects archived so far are persisted so that they can be restored
+			saveErr := s.workspaceStore.Save(w)
+			if saveErr != nil {
+				return saveErr
+			}
+			return fmt.Errorf("failed to archive project %s: %w", project.Name, err)
+		}
+
+		if project.State != nil {
+			project.State.Uptime = 0
+			project.State.Resources = nil
+			project.State.UpdatedAt = time.Now().Format(time.RFC1123)
+		}
+		project.Status = workspace.ProjectStatusArchived
+		project.StatusError = ""
+	}
+
+	wsLogWriter.Write([]byte(fmt.Sprintf("Workspace %s archived\n", w.Name)))
+
+	accumulateCost(w)
+
+	return s.workspaceStore.Save(w)
+}
+
+// UnarchiveWorkspace restores the archived workspace projects to the target. The projects are stopped after being restored
+func (s *WorkspaceService) UnarchiveWorkspace(workspaceId string) error {
+	if s.archiveStorage == nil {
+		return ErrArchiveNotConfigured
+	}
+
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return ErrWorkspaceNotFound
+	}
+
+	if !w.IsArchived() {
+		return ErrWorkspaceNotArchived
+	}
+
+	target, err := s.targetStore.Find(w.Target)
+	if err != nil {
+		return err
+	}
+
+	workspaceLogger := s.loggerFactory.CreateWorkspaceLogger(w.Id, logs.LogSourceServer)
+	defer workspaceLogger.Close()
+
+	wsLogWriter := io.MultiWriter(&util.InfoLogWriter{}, workspaceLogger)
+
+	defer s.markBusy(w.Id)()
+
+	wsLogWriter.Write([]byte(fmt.Sprintf("Restoring workspace %s\n", w.Name)))
+
+	for _, project := range w.Projects {
+		if project.Status != workspace.ProjectStatusArchived {
+			continue
+		}
+
+		cr, err := s.containerRegistryService.FindByImageName(project.Image)
+		if err != nil && !containerregistry.IsContainerRegistryNotFound(err) {
+			return err
+		}
+
+		gc, err := s.getGitProviderService(w.OwnerId).ResolveConfig(project.Repository.Url, project.GitProviderConfigId)
+		if err != nil && !gitprovider.IsGitProviderNotFound(err) {
+			return err
+		}
+
+		err = s.provisioner.UnarchiveProject(project, target, cr, gc, s.archiveStorage)
+		if err != nil {
+			saveErr := s.workspaceStore.Save(w)
+			if saveErr != nil {
+				return saveErr
+			}
+			return fmt.Errorf("failed to restore project %s: %w", project.Name, err)
+		}
+
+		project.Status = workspace.ProjectStatusStopped
+		project.StatusError = ""
+	}
+
+	wsLogWriter.Write([]byte(fmt.Sprintf("Workspace %s restored\n", w.Name)))
+
+	accumulateCost(w)
+
+	return s.workspaceStore.Save(w)
+}
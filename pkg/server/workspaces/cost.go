@@ -0,0 +1,53 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"errors"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+// Stores the cost estimate reported by the provider. Workspaces of providers without cost estimates have no cost
+func (s *WorkspaceService) setCostEstimate(ws *workspace.Workspace, target *provider.ProviderTarget) error {
+	estimate, err := s.provisioner.GetWorkspaceCostEstimate(ws, target)
+	if err != nil {
+		if errors.Is(err, provider.ErrCostEstimateNotSupported) {
+			return nil
+		}
+		return err
+	}
+
+	if estimate == nil || len(estimate.Items) == 0 {
+		return nil
+	}
+
+	w, err := s.workspaceStore.Find(ws.Id)
+	if err != nil {
+		return err
+	}
+
+	w.Cost = workspace.NewWorkspaceCost(*estimate, w.IsRunning(), time.Now())
+	ws.Cost = w.Cost
+
+	return s.workspaceStore.Save(w)
+}
+
+// Accumulates the cost incurred since the last update and continues at the hourly cost of the current workspace state.
+// Must be called whenever project statuses change
+func accumulateCost(w *workspace.Workspace) {
+	if w.Cost == nil {
+		return
+	}
+
+	// Archived projects are removed from the target
+	var hourlyCost float64
+	if !w.IsArchived() {
+		hourlyCost = w.Cost.Estimate.GetHourlyCost(w.IsRunning())
+	}
+
+	w.Cost.Accumulate(time.Now(), hourlyCost)
+}
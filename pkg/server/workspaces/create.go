@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"io"
 	"regexp"
+	"strings"
+	"time"
 
 	"github.com/daytonaio/daytona/pkg/apikey"
 	"github.com/daytonaio/daytona/pkg/builder"
@@ -14,6 +16,7 @@ import (
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server/events"
 	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
 	"github.com/daytonaio/daytona/pkg/workspace"
 )
@@ -30,9 +33,43 @@ func (s *WorkspaceService) CreateWorkspace(req dto.CreateWorkspaceRequest) (*wor
 	}
 
 	w := &workspace.Workspace{
-		Id:     req.Id,
-		Name:   req.Name,
-		Target: req.Target,
+		Id:      req.Id,
+		Name:    req.Name,
+		Target:  req.Target,
+		OwnerId: req.OwnerId,
+	}
+
+	gitProviderService := s.getGitProviderService(w.OwnerId)
+
+	if req.TargetGroup != nil && *req.TargetGroup != "" {
+		if s.targetGroupService == nil {
+			return nil, ErrTargetGroupsDisabled
+		}
+
+		target, err := s.targetGroupService.PlaceWorkspace(*req.TargetGroup)
+		if err != nil {
+			return nil, fmt.Errorf("failed to place workspace in target group %s: %w", *req.TargetGroup, err)
+		}
+
+		w.Target = target.Name
+		w.TargetGroup = *req.TargetGroup
+	}
+
+	if req.Ttl != nil {
+		ttl, err := time.ParseDuration(*req.Ttl)
+		if err != nil || ttl <= 0 {
+			return nil, ErrInvalidTtl
+		}
+
+		w.ExpiryAction = workspace.ExpiryActionDelete
+		if req.ExpiryAction != nil {
+			if *req.ExpiryAction != workspace.ExpiryActionDelete && *req.ExpiryAction != workspace.ExpiryActionStop {
+				return nil, ErrInvalidExpiryAction
+			}
+			w.ExpiryAction = *req.ExpiryAction
+		}
+
+		w.ExpiresAt = time.Now().Add(ttl).Format(time.RFC3339)
 	}
 
 	apiKey, err := s.apiKeyService.Generate(apikey.ApiKeyTypeWorkspace, w.Id)
@@ -42,6 +79,9 @@ func (s *WorkspaceService) CreateWorkspace(req dto.CreateWorkspaceRequest) (*wor
 	w.ApiKey = apiKey
 
 	w.Projects = []*workspace.Project{}
+	w.Creation = &workspace.WorkspaceCreation{
+		CompletedSteps: []string{},
+	}
 
 	for _, project := range req.Projects {
 		isValidProjectName := regexp.MustCompile(`^[a-zA-Z0-9-_.]+$`).MatchString
@@ -49,8 +89,32 @@ func (s *WorkspaceService) CreateWorkspace(req dto.CreateWorkspaceRequest) (*wor
 			return nil, ErrInvalidProjectName
 		}
 
+		if project.Source.Repository != nil && project.Source.Repository.Pinned && project.Source.Repository.Sha == "" {
+			return nil, ErrPinnedShaRequired
+		}
+
+		worktreeMode := project.WorktreeMode != nil && *project.WorktreeMode
+		if worktreeMode && project.Source.Repository != nil && (project.Source.Repository.Pinned || (project.Source.Repository.Ref != nil && *project.Source.Repository.Ref != "")) {
+			return nil, ErrWorktreeModeUnsupported
+		}
+
+		for _, portForward := range project.PortForwards {
+			if portForward.Port == 0 {
+				return nil, ErrInvalidPort
+			}
+		}
+
+		gitProviderConfigId := ""
+		if project.GitProviderConfigId != nil && *project.GitProviderConfigId != "" {
+			_, err := gitProviderService.GetConfig(*project.GitProviderConfigId)
+			if err != nil {
+				return nil, fmt.Errorf("git provider config %s not found: %w", *project.GitProviderConfigId, err)
+			}
+			gitProviderConfigId = *project.GitProviderConfigId
+		}
+
 		if project.Source.Repository != nil && project.Source.Repository.Sha == "" {
-			sha, err := s.gitProviderService.GetLastCommitSha(project.Source.Repository)
+			sha, err := gitProviderService.GetLastCommitSha(project.Source.Repository)
 			if err != nil {
 				return nil, err
 			}
@@ -78,42 +142,61 @@ func (s *WorkspaceService) CreateWorkspace(req dto.CreateWorkspaceRequest) (*wor
 		}
 
 		p := &workspace.Project{
-			Name:              project.Name,
-			Image:             projectImage,
-			User:              projectUser,
-			Build:             project.Build,
-			PostStartCommands: postStartCommands,
-			Repository:        project.Source.Repository,
-			WorkspaceId:       w.Id,
-			ApiKey:            apiKey,
-			Target:            w.Target,
-			EnvVars:           project.EnvVars,
+			Name:                project.Name,
+			Image:               projectImage,
+			User:                projectUser,
+			Build:               project.Build,
+			PostStartCommands:   postStartCommands,
+			PostCreateCommands:  project.PostCreateCommands,
+			PortForwards:        project.PortForwards,
+			Repository:          project.Source.Repository,
+			WorkspaceId:         w.Id,
+			ApiKey:              apiKey,
+			Target:              w.Target,
+			EnvVars:             project.EnvVars,
+			GitProviderConfigId: gitProviderConfigId,
+			DependsOn:           project.DependsOn,
+			RecordSessions:      req.RecordSessions != nil && *req.RecordSessions,
+			WorktreeMode:        worktreeMode,
 		}
 		w.Projects = append(w.Projects, p)
 	}
 
+	err = workspace.ValidateProjectDependencies(w.Projects)
+	if err != nil {
+		return nil, err
+	}
+
 	err = s.workspaceStore.Save(w)
 	if err != nil {
 		return nil, err
 	}
 
+	defer s.markBusy(w.Id)()
+
 	return s.createWorkspace(w)
 }
 
+// Uses the prebuilt image of the project config or builds it. The project is created with the default image if the build fails.
+// Projects keep their configured image if builds are disabled
 func (s *WorkspaceService) createBuild(project *workspace.Project, gc *gitprovider.GitProviderConfig, logWriter io.Writer) (*workspace.Project, error) {
-	// FIXME: skip build completely for now
-	return project, nil
+	if !s.buildProjects {
+		return project, nil
+	}
 
-	if project.Build != nil { // nolint:govet
+	if project.Build != nil {
 		lastBuildResult, err := s.builderFactory.CheckExistingBuild(*project)
 		if err != nil {
 			return nil, err
 		}
 		if lastBuildResult != nil {
+			logWriter.Write([]byte(fmt.Sprintf("Using prebuilt image %s%s\n", lastBuildResult.ImageName, getBuildDescription(lastBuildResult))))
+			project.Prebuild = newProjectPrebuild(true, lastBuildResult)
 			project.Image = lastBuildResult.ImageName
 			project.User = lastBuildResult.User
 			project.PostStartCommands = lastBuildResult.PostStartCommands
 			project.PostCreateCommands = lastBuildResult.PostCreateCommands
+			project.ExportedImage = lastBuildResult.ExportedImage
 			return project, nil
 		}
 
@@ -126,13 +209,16 @@ func (s *WorkspaceService) createBuild(project *workspace.Project, gc *gitprovid
 			return project, nil
 		}
 
+		logWriter.Write([]byte("No prebuilt image found for the project config. Building the project image\n"))
+		project.Prebuild = &workspace.ProjectPrebuild{Hit: false}
+
 		buildResult, err := builder.Build()
 		if err != nil {
 			s.handleBuildError(project, builder, logWriter, err)
 			return project, nil
 		}
 
-		err = builder.Publish()
+		err = builder.Publish(buildResult)
 		if err != nil {
 			s.handleBuildError(project, builder, logWriter, err)
 			return project, nil
@@ -149,10 +235,13 @@ func (s *WorkspaceService) createBuild(project *workspace.Project, gc *gitprovid
 			logWriter.Write([]byte(fmt.Sprintf("Error cleaning up build: %s\n", err.Error())))
 		}
 
+		logWriter.Write([]byte(fmt.Sprintf("Built image %s%s\n", buildResult.ImageName, getBuildDescription(buildResult))))
+		project.Prebuild = newProjectPrebuild(false, buildResult)
 		project.Image = buildResult.ImageName
 		project.User = buildResult.User
 		project.PostStartCommands = buildResult.PostStartCommands
 		project.PostCreateCommands = buildResult.PostCreateCommands
+		project.ExportedImage = buildResult.ExportedImage
 
 		return project, nil
 	}
@@ -160,25 +249,51 @@ func (s *WorkspaceService) createBuild(project *workspace.Project, gc *gitprovid
 	return project, nil
 }
 
-func (s *WorkspaceService) createProject(project *workspace.Project, target *provider.ProviderTarget, logWriter io.Writer) error {
-	logWriter.Write([]byte(fmt.Sprintf("Creating project %s\n", project.Name)))
+func newProjectPrebuild(hit bool, result *builder.BuildResult) *workspace.ProjectPrebuild {
+	return &workspace.ProjectPrebuild{
+		Hit:     hit,
+		BuildId: result.BuildId,
+		Image:   result.ImageName,
+		Sha:     result.Sha,
+	}
+}
+
+// Results saved before builds recorded their ID and commit are described only by the image
+func getBuildDescription(result *builder.BuildResult) string {
+	details := []string{}
+	if result.BuildId != "" {
+		details = append(details, fmt.Sprintf("build %s", result.BuildId))
+	}
+	if result.Sha != "" {
+		details = append(details, fmt.Sprintf("commit %s", result.Sha))
+	}
+
+	if len(details) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(" (%s)", strings.Join(details, ", "))
+}
+
+func (s *WorkspaceService) createProject(project *workspace.Project, target *provider.ProviderTarget, ownerId string, logger logs.Logger) error {
+	logger.Write([]byte(fmt.Sprintf("Creating project %s\n", project.Name)))
 
 	cr, err := s.containerRegistryService.FindByImageName(project.Image)
 	if err != nil && !containerregistry.IsContainerRegistryNotFound(err) {
 		return err
 	}
 
-	gc, err := s.gitProviderService.GetConfigForUrl(project.Repository.Url)
+	gc, err := s.getGitProviderService(ownerId).ResolveConfig(project.Repository.Url, project.GitProviderConfigId)
 	if err != nil && !gitprovider.IsGitProviderNotFound(err) {
 		return err
 	}
 
-	err = s.provisioner.CreateProject(project, target, cr, gc)
+	err = s.provisioner.CreateProject(project, target, cr, gc, newLoggerProgressReporter(logger))
 	if err != nil {
 		return err
 	}
 
-	logWriter.Write([]byte(fmt.Sprintf("Project %s created\n", project.Name)))
+	logger.Write([]byte(fmt.Sprintf("Project %s created\n", project.Name)))
 
 	return nil
 }
@@ -194,7 +309,9 @@ func (s *WorkspaceService) createWorkspace(ws *workspace.Workspace) (*workspace.
 
 	wsLogger.Write([]byte(fmt.Sprintf("Creating workspace %s (%s)\n", ws.Name, ws.Id)))
 
-	err = s.provisioner.CreateWorkspace(ws, target)
+	err = s.runCreationStep(ws, creationStepWorkspace, wsLogger, func() error {
+		return s.provisioner.CreateWorkspace(ws, target, newLoggerProgressReporter(wsLogger))
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -203,37 +320,54 @@ func (s *WorkspaceService) createWorkspace(ws *workspace.Workspace) (*workspace.
 		projectLogger := s.loggerFactory.CreateProjectLogger(ws.Id, project.Name, logs.LogSourceServer)
 		defer projectLogger.Close()
 
-		gc, _ := s.gitProviderService.GetConfigForUrl(project.Repository.Url)
+		err = s.runCreationStep(ws, getProjectCreationStep(project.Name), projectLogger, func() error {
+			gc, _ := s.getGitProviderService(ws.OwnerId).ResolveConfig(project.Repository.Url, project.GitProviderConfigId)
 
-		projectWithEnv := *project
-		projectWithEnv.EnvVars = workspace.GetProjectEnvVars(project, s.serverApiUrl, s.serverUrl)
+			projectWithEnv := *project
+			projectWithEnv.EnvVars = s.getProjectEnvVars(project)
 
-		for k, v := range project.EnvVars {
-			projectWithEnv.EnvVars[k] = v
-		}
+			for k, v := range project.EnvVars {
+				projectWithEnv.EnvVars[k] = v
+			}
 
-		var err error
+			builtProject, err := s.createBuild(&projectWithEnv, gc, projectLogger)
+			if err != nil {
+				return err
+			}
 
-		project, err = s.createBuild(&projectWithEnv, gc, projectLogger)
-		if err != nil {
-			return nil, err
-		}
+			ws.Projects[i] = builtProject
+			err = s.workspaceStore.Save(ws)
+			if err != nil {
+				return err
+			}
 
-		ws.Projects[i] = project
-		err = s.workspaceStore.Save(ws)
+			return s.createProject(builtProject, target, ws.OwnerId, projectLogger)
+		})
 		if err != nil {
 			return nil, err
 		}
+	}
 
-		err = s.createProject(project, target, projectLogger)
-		if err != nil {
-			return nil, err
-		}
+	if ws.ExpiresAt != "" {
+		wsLogger.Write([]byte(fmt.Sprintf("Workspace will be %s automatically at %s\n", expiryActionVerb(ws.ExpiryAction), ws.ExpiresAt)))
 	}
 
 	wsLogger.Write([]byte("Workspace creation complete. Pending start...\n"))
 
-	err = s.startWorkspace(ws, target, wsLogger)
+	err = s.runCreationStep(ws, creationStepStart, wsLogger, func() error {
+		return s.startWorkspace(ws, target, wsLogger)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.setCostEstimate(ws, target)
+	if err != nil {
+		wsLogger.Write([]byte(fmt.Sprintf("Failed to get the workspace cost estimate: %s\n", err)))
+	}
+
+	ws.Creation = nil
+	err = s.saveCreation(ws)
 	if err != nil {
 		return nil, err
 	}
@@ -246,6 +380,13 @@ func (s *WorkspaceService) handleBuildError(project *workspace.Project, builder
 	logWriter.Write([]byte(fmt.Sprintf("#### BUILD FAILED FOR PROJECT %s: %s\n", project.Name, err.Error())))
 	logWriter.Write([]byte("################################################\n"))
 
+	s.emitEvent(events.Event{
+		Type:        events.EventTypeBuildFailed,
+		WorkspaceId: project.WorkspaceId,
+		ProjectName: project.Name,
+		Message:     fmt.Sprintf("Build of project %s failed: %s", project.Name, err.Error()),
+	})
+
 	cleanupErr := builder.CleanUp()
 	if cleanupErr != nil {
 		logWriter.Write([]byte(fmt.Sprintf("Error cleaning up build: %s\n", cleanupErr.Error())))
@@ -5,6 +5,7 @@ package dto
 
 import (
 	"github.com/daytonaio/daytona/pkg/gitprovider"
+	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/workspace"
 )
 
@@ -18,6 +19,58 @@ type ProjectDTO struct {
 	Info *workspace.ProjectInfo
 } //	@name	ProjectDTO
 
+type WorkspaceShare struct {
+	Name        string `json:"name" validate:"required"`
+	WorkspaceId string `json:"workspaceId" validate:"required"`
+	ExpiresAt   string `json:"expiresAt" validate:"required"`
+	// Link passed to 'daytona connect'. Only returned when the share is created
+	Url string `json:"url,omitempty"`
+} //	@name	WorkspaceShare
+
+// SharedWorkspace is the workspace returned to share key holders
+type SharedWorkspace struct {
+	workspace.Workspace
+	Info *workspace.WorkspaceInfo
+	// Network mode used to connect to the workspace projects
+	NetworkMode provider.NetworkMode `json:"networkMode,omitempty"`
+} //	@name	SharedWorkspace
+
+type PortForward struct {
+	WorkspaceId string `json:"workspaceId" validate:"required"`
+	ProjectName string `json:"projectName" validate:"required"`
+	Port        uint16 `json:"port" validate:"required"`
+	Public      bool   `json:"public" validate:"required"`
+	// Set while the project is running and the port is forwarded by the server
+	Active bool `json:"active" validate:"required"`
+	// Port on the server host the project port is available at
+	HostPort  uint16 `json:"hostPort,omitempty"`
+	PublicUrl string `json:"publicUrl,omitempty"`
+} //	@name	PortForward
+
+type ProjectStats struct {
+	WorkspaceId   string                      `json:"workspaceId" validate:"required"`
+	WorkspaceName string                      `json:"workspaceName" validate:"required"`
+	ProjectName   string                      `json:"projectName" validate:"required"`
+	Target        string                      `json:"target" validate:"required"`
+	Uptime        uint64                      `json:"uptime" validate:"required"`
+	Resources     *workspace.ProjectResources `json:"resources,omitempty"`
+} //	@name	ProjectStats
+
+type PrebuildStats struct {
+	Hits   int `json:"hits" validate:"required"`
+	Misses int `json:"misses" validate:"required"`
+	// Share of project builds that used a prebuilt image, between 0 and 1
+	HitRate      float64                   `json:"hitRate" validate:"required"`
+	Repositories []RepositoryPrebuildStats `json:"repositories" validate:"required"`
+} //	@name	PrebuildStats
+
+type RepositoryPrebuildStats struct {
+	RepositoryUrl string  `json:"repositoryUrl" validate:"required"`
+	Hits          int     `json:"hits" validate:"required"`
+	Misses        int     `json:"misses" validate:"required"`
+	HitRate       float64 `json:"hitRate" validate:"required"`
+} //	@name	RepositoryPrebuildStats
+
 type CreateWorkspaceRequestProjectSource struct {
 	Repository *gitprovider.GitRepository `json:"repository"`
 } // @name CreateWorkspaceRequestProjectSource
@@ -30,11 +83,37 @@ type CreateWorkspaceRequestProject struct {
 	Source            CreateWorkspaceRequestProjectSource `json:"source"`
 	EnvVars           map[string]string                   `json:"envVars"`
 	PostStartCommands *[]string                           `json:"postStartCommands,omitempty"`
+	// Commands run once in the project directory after the project is created
+	PostCreateCommands []string `json:"postCreateCommands,omitempty"`
+	// Ports forwarded by the server while the project is running
+	PortForwards []workspace.ProjectPortForward `json:"portForwards,omitempty"`
+	// Git provider config used for the repository. Resolved from the repository URL if not set
+	GitProviderConfigId *string `json:"gitProviderConfigId,omitempty"`
+	// Names of the workspace projects started before the project
+	DependsOn []string `json:"dependsOn,omitempty"`
+	// Clone a bare repository and check out branches as worktrees on demand
+	WorktreeMode *bool `json:"worktreeMode,omitempty"`
 } // @name CreateWorkspaceRequestProject
 
 type CreateWorkspaceRequest struct {
-	Id       string                          `json:"id"`
-	Name     string                          `json:"name"`
-	Target   string                          `json:"target"`
-	Projects []CreateWorkspaceRequestProject `json:"projects" validate:"required,gt=0,dive"`
+	Id     string `json:"id"`
+	Name   string `json:"name"`
+	Target string `json:"target"`
+	// Target group the workspace target is picked from according to the group placement policy. Overrides the target
+	TargetGroup *string                         `json:"targetGroup,omitempty"`
+	Projects    []CreateWorkspaceRequestProject `json:"projects" validate:"required,gt=0,dive"`
+	// Duration (e.g. 72h) after which the expiry action is performed on the workspace
+	Ttl          *string                 `json:"ttl,omitempty"`
+	ExpiryAction *workspace.ExpiryAction `json:"expiryAction,omitempty"`
+	// Record the terminal sessions of SSH connections to the workspace projects
+	RecordSessions *bool `json:"recordSessions,omitempty"`
+	// Set by the server to the user creating the workspace
+	OwnerId string `json:"-" swaggerignore:"true"`
 } //	@name	CreateWorkspaceRequest
+
+type SshCertificate struct {
+	// Certificate in the OpenSSH authorized_keys format
+	Certificate string `json:"certificate" validate:"required"`
+	// RFC3339 timestamp after which the certificate is rejected
+	ExpiresAt string `json:"expiresAt" validate:"required"`
+} //	@name	SshCertificate
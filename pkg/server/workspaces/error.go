@@ -8,11 +8,29 @@ import (
 )
 
 var (
-	ErrWorkspaceAlreadyExists = errors.New("workspace already exists")
-	ErrInvalidWorkspaceName   = errors.New("name is not a valid alphanumeric string")
-	ErrWorkspaceNotFound      = errors.New("workspace not found")
-	ErrProjectNotFound        = errors.New("project not found")
-	ErrInvalidProjectName     = errors.New("project name is not valid. Only [a-zA-Z0-9-_.] are allowed")
+	ErrWorkspaceAlreadyExists  = errors.New("workspace already exists")
+	ErrInvalidWorkspaceName    = errors.New("name is not a valid alphanumeric string")
+	ErrWorkspaceNotFound       = errors.New("workspace not found")
+	ErrProjectNotFound         = errors.New("project not found")
+	ErrInvalidProjectName      = errors.New("project name is not valid. Only [a-zA-Z0-9-_.] are allowed")
+	ErrInvalidTtl              = errors.New("ttl must be a positive duration (e.g. 72h)")
+	ErrInvalidExpiryAction     = errors.New("expiry action must be either 'delete' or 'stop'")
+	ErrWorkspaceHasNoExpiry    = errors.New("workspace has no expiry set")
+	ErrPinnedShaRequired       = errors.New("a commit SHA is required when pinning the repository")
+	ErrInvalidShareDuration    = errors.New("share duration must be a positive duration (e.g. 2h)")
+	ErrWorkspaceShareNotFound  = errors.New("workspace share not found")
+	ErrWorkspaceAlreadyCreated = errors.New("workspace creation already completed")
+	ErrWorkspaceBusy           = errors.New("workspace has an operation in progress")
+	ErrInvalidPort             = errors.New("port must be between 1 and 65535")
+	ErrPortForwardNotFound     = errors.New("port forward not found")
+	ErrWorkspaceArchived       = errors.New("workspace is archived. Run 'daytona unarchive' to restore it")
+	ErrWorkspaceNotArchived    = errors.New("workspace is not archived")
+	ErrArchiveNotConfigured    = errors.New("archive storage is not configured on the server")
+	ErrSshCertificatesDisabled = errors.New("SSH certificate authentication is not enabled on the server")
+	ErrProjectNotHealthy       = errors.New("project did not become healthy")
+	ErrTargetGroupsDisabled    = errors.New("target groups are not enabled on the server")
+	ErrWorktreeModeUnsupported = errors.New("pinned commits and refs can not be checked out in worktree mode")
+	ErrSshCertificatesRequired = errors.New("workspaces can only be shared if SSH certificate authentication is enabled on the server")
 )
 
 func IsWorkspaceAlreadyExists(err error) bool {
@@ -23,6 +41,42 @@ func IsWorkspaceNotFound(err error) bool {
 	return err.Error() == ErrWorkspaceNotFound.Error()
 }
 
+func IsWorkspaceShareNotFound(err error) bool {
+	return err.Error() == ErrWorkspaceShareNotFound.Error()
+}
+
+func IsWorkspaceAlreadyCreated(err error) bool {
+	return err.Error() == ErrWorkspaceAlreadyCreated.Error()
+}
+
+func IsWorkspaceBusy(err error) bool {
+	return err.Error() == ErrWorkspaceBusy.Error()
+}
+
+func IsInvalidPort(err error) bool {
+	return err.Error() == ErrInvalidPort.Error()
+}
+
+func IsPortForwardNotFound(err error) bool {
+	return err.Error() == ErrPortForwardNotFound.Error()
+}
+
+func IsWorkspaceArchived(err error) bool {
+	return err.Error() == ErrWorkspaceArchived.Error()
+}
+
+func IsWorkspaceNotArchived(err error) bool {
+	return err.Error() == ErrWorkspaceNotArchived.Error()
+}
+
+func IsArchiveNotConfigured(err error) bool {
+	return err.Error() == ErrArchiveNotConfigured.Error()
+}
+
+func IsSshCertificatesDisabled(err error) bool {
+	return err.Error() == ErrSshCertificatesDisabled.Error()
+}
+
 func IsProjectNotFound(err error) bool {
 	return err.Error() == ErrProjectNotFound.Error()
 }
@@ -30,3 +84,7 @@ func IsProjectNotFound(err error) bool {
 func IsInvalidWorkspaceName(err error) bool {
 	return err.Error() == ErrInvalidWorkspaceName.Error()
 }
+
+func IsSshCertificatesRequired(err error) bool {
+	return err.Error() == ErrSshCertificatesRequired.Error()
+}
@@ -0,0 +1,157 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/server/events"
+	"github.com/daytonaio/daytona/pkg/workspace"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func (s *WorkspaceService) ExtendWorkspace(workspaceId string, duration time.Duration) (*workspace.Workspace, error) {
+	if duration <= 0 {
+		return nil, ErrInvalidTtl
+	}
+
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	if w.ExpiresAt == "" {
+		return nil, ErrWorkspaceHasNoExpiry
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, w.ExpiresAt)
+	if err != nil || expiresAt.Before(time.Now()) {
+		expiresAt = time.Now()
+	}
+
+	w.ExpiresAt = expiresAt.Add(duration).Format(time.RFC3339)
+
+	err = s.workspaceStore.Save(w)
+	if err != nil {
+		return nil, err
+	}
+
+	wsLogger := s.loggerFactory.CreateWorkspaceLogger(w.Id, logs.LogSourceServer)
+	defer wsLogger.Close()
+
+	wsLogger.Write([]byte(fmt.Sprintf("Workspace expiry extended by %s. Workspace will be %s automatically at %s\n", duration, expiryActionVerb(w.ExpiryAction), w.ExpiresAt)))
+
+	return w, nil
+}
+
+func (s *WorkspaceService) HandleExpiredWorkspaces() error {
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return err
+	}
+
+	for _, w := range workspaces {
+		if !w.IsExpired() {
+			if s.shouldWarnAboutExpiry(w) {
+				s.warnAboutExpiry(w)
+			}
+			continue
+		}
+
+		s.expiryWarningsMutex.Lock()
+		delete(s.expiryWarnings, w.Id)
+		s.expiryWarningsMutex.Unlock()
+
+		err := s.handleExpiredWorkspace(w)
+		if err != nil {
+			log.Errorf("Failed to handle expired workspace %s: %s", w.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *WorkspaceService) shouldWarnAboutExpiry(w *workspace.Workspace) bool {
+	if s.expiryWarning <= 0 || w.ExpiresAt == "" {
+		return false
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, w.ExpiresAt)
+	if err != nil || time.Until(expiresAt) > s.expiryWarning {
+		return false
+	}
+
+	s.expiryWarningsMutex.Lock()
+	defer s.expiryWarningsMutex.Unlock()
+
+	return s.expiryWarnings[w.Id] != w.ExpiresAt
+}
+
+func (s *WorkspaceService) warnAboutExpiry(w *workspace.Workspace) {
+	s.expiryWarningsMutex.Lock()
+	s.expiryWarnings[w.Id] = w.ExpiresAt
+	s.expiryWarningsMutex.Unlock()
+
+	message := fmt.Sprintf("Workspace %s will be %s automatically at %s. Run 'daytona extend %s <duration>' to keep it longer", w.Name, expiryActionVerb(w.ExpiryAction), w.ExpiresAt, w.Name)
+
+	log.Warn(message)
+
+	wsLogger := s.loggerFactory.CreateWorkspaceLogger(w.Id, logs.LogSourceServer)
+	defer wsLogger.Close()
+
+	wsLogger.Write([]byte(message + "\n"))
+
+	s.emitEvent(events.Event{
+		Type:        events.EventTypeWorkspaceExpiring,
+		WorkspaceId: w.Id,
+		Message:     message,
+	})
+}
+
+func (s *WorkspaceService) handleExpiredWorkspace(w *workspace.Workspace) error {
+	wsLogger := s.loggerFactory.CreateWorkspaceLogger(w.Id, logs.LogSourceServer)
+	defer wsLogger.Close()
+
+	wsLogger.Write([]byte(fmt.Sprintf("Workspace %s expired at %s\n", w.Name, w.ExpiresAt)))
+
+	if w.ExpiryAction == workspace.ExpiryActionStop {
+		wsLogger.Write([]byte("Stopping workspace...\n"))
+
+		// Clear the expiry so the workspace is not stopped again after being restarted
+		expiresAt := w.ExpiresAt
+		w.ExpiresAt = ""
+		err := s.workspaceStore.Save(w)
+		if err != nil {
+			return err
+		}
+
+		err = s.StopWorkspace(w.Id)
+		if err != nil {
+			return err
+		}
+
+		s.emitEvent(events.Event{
+			Type:        events.EventTypeWorkspaceAutoStopped,
+			WorkspaceId: w.Id,
+			Message:     fmt.Sprintf("Workspace %s was stopped after expiring at %s", w.Name, expiresAt),
+		})
+
+		return nil
+	}
+
+	wsLogger.Write([]byte("Removing workspace...\n"))
+
+	return s.RemoveWorkspace(w.Id)
+}
+
+func expiryActionVerb(action workspace.ExpiryAction) string {
+	if action == workspace.ExpiryActionStop {
+		return "stopped"
+	}
+
+	return "deleted"
+}
@@ -0,0 +1,185 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/daytonaio/daytona/pkg/workspace"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// AddPortForward persists the port forward on the project and starts it if the project is running.
+// Adding an existing port again updates its public setting
+func (s *WorkspaceService) AddPortForward(workspaceId, projectName string, portForward workspace.ProjectPortForward) (*dto.PortForward, error) {
+	if portForward.Port == 0 {
+		return nil, ErrInvalidPort
+	}
+
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	project, err := w.GetProject(projectName)
+	if err != nil {
+		return nil, ErrProjectNotFound
+	}
+
+	found := false
+	for i, existing := range project.PortForwards {
+		if existing.Port == portForward.Port {
+			project.PortForwards[i] = portForward
+			found = true
+		}
+	}
+	if !found {
+		project.PortForwards = append(project.PortForwards, portForward)
+	}
+
+	err = s.workspaceStore.Save(w)
+	if err != nil {
+		return nil, err
+	}
+
+	if project.Status == workspace.ProjectStatusRunning && s.portForwardService != nil {
+		_, err = s.portForwardService.Start(w.Id, project.Name, portForward)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := s.toPortForward(w.Id, project.Name, portForward)
+	return &result, nil
+}
+
+func (s *WorkspaceService) ListPortForwards(workspaceId string) ([]dto.PortForward, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	portForwards := []dto.PortForward{}
+	for _, project := range w.Projects {
+		for _, portForward := range project.PortForwards {
+			portForwards = append(portForwards, s.toPortForward(w.Id, project.Name, portForward))
+		}
+	}
+
+	return portForwards, nil
+}
+
+func (s *WorkspaceService) RemovePortForward(workspaceId, projectName string, port uint16) error {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return ErrWorkspaceNotFound
+	}
+
+	project, err := w.GetProject(projectName)
+	if err != nil {
+		return ErrProjectNotFound
+	}
+
+	portForwards := []workspace.ProjectPortForward{}
+	for _, portForward := range project.PortForwards {
+		if portForward.Port != port {
+			portForwards = append(portForwards, portForward)
+		}
+	}
+
+	if len(portForwards) == len(project.PortForwards) {
+		return ErrPortForwardNotFound
+	}
+
+	project.PortForwards = portForwards
+
+	err = s.workspaceStore.Save(w)
+	if err != nil {
+		return err
+	}
+
+	if s.portForwardService != nil {
+		s.portForwardService.Stop(w.Id, project.Name, port)
+	}
+
+	return nil
+}
+
+// RestorePortForwards starts the port forwards of the running projects, e.g. after a server restart
+func (s *WorkspaceService) RestorePortForwards() error {
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return err
+	}
+
+	for _, w := range workspaces {
+		for _, project := range w.Projects {
+			if project.Status != workspace.ProjectStatusRunning {
+				continue
+			}
+
+			projectLogger := s.loggerFactory.CreateProjectLogger(w.Id, project.Name, logs.LogSourceServer)
+			s.startPortForwards(project, projectLogger)
+			projectLogger.Close()
+		}
+	}
+
+	return nil
+}
+
+// Port forwards that fail to start are logged and do not fail the project start
+func (s *WorkspaceService) startPortForwards(project *workspace.Project, logWriter io.Writer) {
+	if s.portForwardService == nil {
+		return
+	}
+
+	for _, portForward := range project.PortForwards {
+		active, err := s.portForwardService.Start(project.WorkspaceId, project.Name, portForward)
+		if err != nil {
+			log.Errorf("Failed to forward port %d of project %s: %s", portForward.Port, project.Name, err)
+			logWriter.Write([]byte(fmt.Sprintf("Failed to forward port %d: %s\n", portForward.Port, err)))
+			continue
+		}
+
+		message := fmt.Sprintf("Port %d forwarded to port %d on the server host", portForward.Port, active.HostPort)
+		if active.PublicUrl != "" {
+			message = fmt.Sprintf("%s and available at %s", message, active.PublicUrl)
+		}
+		logWriter.Write([]byte(message + "\n"))
+	}
+}
+
+func (s *WorkspaceService) stopPortForwards(project *workspace.Project) {
+	if s.portForwardService == nil {
+		return
+	}
+
+	s.portForwardService.StopProject(project.WorkspaceId, project.Name)
+}
+
+func (s *WorkspaceService) toPortForward(workspaceId, projectName string, portForward workspace.ProjectPortForward) dto.PortForward {
+	result := dto.PortForward{
+		WorkspaceId: workspaceId,
+		ProjectName: projectName,
+		Port:        portForward.Port,
+		Public:      portForward.Public,
+	}
+
+	if s.portForwardService == nil {
+		return result
+	}
+
+	active := s.portForwardService.Get(workspaceId, projectName, portForward.Port)
+	if active != nil {
+		result.Active = true
+		result.HostPort = active.HostPort
+		result.PublicUrl = active.PublicUrl
+	}
+
+	return result
+}
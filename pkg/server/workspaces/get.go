@@ -30,3 +30,13 @@ func (s *WorkspaceService) GetWorkspace(workspaceId string) (*dto.WorkspaceDTO,
 
 	return &response, nil
 }
+
+// Returns the ID of the user who owns the workspace without querying the provider
+func (s *WorkspaceService) GetWorkspaceOwner(workspaceId string) (string, error) {
+	workspace, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return "", ErrWorkspaceNotFound
+	}
+
+	return workspace.OwnerId, nil
+}
@@ -5,6 +5,7 @@ package workspaces
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
 	"github.com/daytonaio/daytona/pkg/workspace"
@@ -42,3 +43,90 @@ func (s *WorkspaceService) ListWorkspaces(verbose bool) ([]dto.WorkspaceDTO, err
 
 	return response, nil
 }
+
+func (s *WorkspaceService) ListProjectStats() ([]dto.ProjectStats, error) {
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	response := []dto.ProjectStats{}
+
+	for _, w := range workspaces {
+		for _, project := range w.Projects {
+			if project.State == nil || project.State.Uptime == 0 {
+				continue
+			}
+
+			response = append(response, dto.ProjectStats{
+				WorkspaceId:   w.Id,
+				WorkspaceName: w.Name,
+				ProjectName:   project.Name,
+				Target:        w.Target,
+				Uptime:        project.State.Uptime,
+				Resources:     project.State.Resources,
+			})
+		}
+	}
+
+	return response, nil
+}
+
+// GetPrebuildStats aggregates the prebuild hits and misses of the projects in existing workspaces.
+// Only the workspaces of the owners canAccess returns true for are included. All workspaces are included if it is nil
+func (s *WorkspaceService) GetPrebuildStats(canAccess func(ownerId string) bool) (*dto.PrebuildStats, error) {
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &dto.PrebuildStats{Repositories: []dto.RepositoryPrebuildStats{}}
+	repositoryStats := map[string]*dto.RepositoryPrebuildStats{}
+
+	for _, w := range workspaces {
+		if canAccess != nil && !canAccess(w.OwnerId) {
+			continue
+		}
+
+		for _, project := range w.Projects {
+			if project.Prebuild == nil || project.Repository == nil {
+				continue
+			}
+
+			repoStats, ok := repositoryStats[project.Repository.Url]
+			if !ok {
+				repoStats = &dto.RepositoryPrebuildStats{RepositoryUrl: project.Repository.Url}
+				repositoryStats[project.Repository.Url] = repoStats
+			}
+
+			if project.Prebuild.Hit {
+				stats.Hits++
+				repoStats.Hits++
+			} else {
+				stats.Misses++
+				repoStats.Misses++
+			}
+		}
+	}
+
+	stats.HitRate = getHitRate(stats.Hits, stats.Misses)
+
+	for _, repoStats := range repositoryStats {
+		repoStats.HitRate = getHitRate(repoStats.Hits, repoStats.Misses)
+		stats.Repositories = append(stats.Repositories, *repoStats)
+	}
+
+	sort.Slice(stats.Repositories, func(i, j int) bool {
+		return stats.Repositories[i].RepositoryUrl < stats.Repositories[j].RepositoryUrl
+	})
+
+	return stats, nil
+}
+
+func getHitRate(hits, misses int) float64 {
+	if hits+misses == 0 {
+		return 0
+	}
+
+	return float64(hits) / float64(hits+misses)
+}
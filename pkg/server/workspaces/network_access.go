@@ -0,0 +1,109 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/server/headscale/acl"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type networkAccess interface {
+	SetAccessGrants(grants acl.Grants)
+	DeleteTaggedNodes(tag string) error
+}
+
+// UpdateNetworkAccess grants the share holders access to the projects of the shared workspace only. The nodes of
+// users other than admins and of their workspaces can only reach the workspaces of the user, the other workspace
+// nodes can reach every node. It must be called before tagged network keys are created
+func (s *WorkspaceService) UpdateNetworkAccess() error {
+	if s.networkAccess == nil {
+		return nil
+	}
+
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return err
+	}
+
+	grants := acl.Grants{}
+
+	memberWorkspaceTags := map[string][]string{}
+	for _, w := range workspaces {
+		if s.isOwnedByMember(w.OwnerId) {
+			memberWorkspaceTags[w.OwnerId] = append(memberWorkspaceTags[w.OwnerId], acl.WorkspaceTag(w.Id))
+		}
+	}
+
+	for ownerId, tags := range memberWorkspaceTags {
+		grants[acl.UserTag(ownerId)] = tags
+	}
+
+	for _, w := range workspaces {
+		if tags, ok := memberWorkspaceTags[w.OwnerId]; ok {
+			grants[acl.WorkspaceTag(w.Id)] = tags
+		} else {
+			grants[acl.WorkspaceTag(w.Id)] = []string{acl.AllNodes}
+		}
+
+		shareKeys, err := s.apiKeyService.ListShareKeys(w.Id)
+		if err != nil {
+			return err
+		}
+
+		for _, shareKey := range shareKeys {
+			if shareKey.IsExpired() {
+				continue
+			}
+			grants[acl.ShareTag(shareKey.Name)] = []string{acl.WorkspaceTag(w.Id)}
+		}
+	}
+
+	s.networkAccess.SetAccessGrants(grants)
+
+	return nil
+}
+
+// HandleExpiredShares revokes the expired workspace shares and removes the nodes of their holders from the network
+func (s *WorkspaceService) HandleExpiredShares() error {
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return err
+	}
+
+	for _, w := range workspaces {
+		shareKeys, err := s.apiKeyService.ListShareKeys(w.Id)
+		if err != nil {
+			return err
+		}
+
+		for _, shareKey := range shareKeys {
+			if !shareKey.IsExpired() {
+				continue
+			}
+
+			err := s.revokeShare(shareKey)
+			if err != nil {
+				log.Errorf("Failed to revoke expired share %s: %s", shareKey.Name, err)
+			}
+		}
+	}
+
+	return s.UpdateNetworkAccess()
+}
+
+// The key is revoked first so that the holder can not create new network keys for the share
+func (s *WorkspaceService) revokeShare(shareKey *apikey.ApiKey) error {
+	err := s.apiKeyService.Revoke(shareKey.Name)
+	if err != nil {
+		return err
+	}
+
+	if s.networkAccess == nil {
+		return nil
+	}
+
+	return s.networkAccess.DeleteTaggedNodes(acl.ShareTag(shareKey.Name))
+}
@@ -0,0 +1,34 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"github.com/daytonaio/daytona/pkg/server/gitproviders"
+	log "github.com/sirupsen/logrus"
+)
+
+// Returns the git provider configs the workspaces of the owner can use.
+// Workspaces without an owner and workspaces of admins can use all configs
+func (s *WorkspaceService) getGitProviderService(ownerId string) gitproviders.IGitProviderService {
+	if !s.isOwnedByMember(ownerId) {
+		return s.gitProviderService
+	}
+
+	return s.gitProviderService.ForOwner(ownerId)
+}
+
+// Returns true if the owner is a user other than an admin. Owners that can not be found are treated as members
+func (s *WorkspaceService) isOwnedByMember(ownerId string) bool {
+	if ownerId == "" || s.userService == nil {
+		return false
+	}
+
+	owner, err := s.userService.Find(ownerId)
+	if err != nil {
+		log.Errorf("failed to find the owner of the workspace: %s", err)
+		return true
+	}
+
+	return !owner.IsAdmin()
+}
@@ -0,0 +1,57 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/provider"
+	log "github.com/sirupsen/logrus"
+)
+
+// Writes the progress events of provider operations to the workspace or project logs
+func newLoggerProgressReporter(logger logs.Logger) provider.ProgressReporter {
+	var mutex sync.Mutex
+	var lastEvent *provider.ProgressEvent
+
+	return provider.ProgressReporterFunc(func(event provider.ProgressEvent) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		// Providers may report the same percentage many times, e.g. for every pulled layer chunk
+		if lastEvent != nil && *lastEvent == event {
+			return
+		}
+		lastEvent = &event
+
+		err := logger.WriteProgress(logs.LogProgress{
+			Phase:   event.Phase,
+			Percent: event.Percent,
+		}, formatProgressEvent(event)+"\n")
+		if err != nil {
+			log.Error(err)
+		}
+	})
+}
+
+// Used for operations that have no log to stream to, e.g. workspace removal
+func newServerProgressReporter(resource string) provider.ProgressReporter {
+	return provider.ProgressReporterFunc(func(event provider.ProgressEvent) {
+		log.Debugf("%s: %s", resource, formatProgressEvent(event))
+	})
+}
+
+func formatProgressEvent(event provider.ProgressEvent) string {
+	line := event.Phase
+	if event.Percent >= 0 {
+		line = fmt.Sprintf("%s: %d%%", line, event.Percent)
+	}
+	if event.Message != "" {
+		line = fmt.Sprintf("%s (%s)", line, event.Message)
+	}
+
+	return line
+}
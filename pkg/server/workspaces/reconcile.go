@@ -0,0 +1,178 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/server/events"
+	"github.com/daytonaio/daytona/pkg/workspace"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var errProjectNotFoundOnTarget = errors.New("project not found on the target")
+
+// ReconcileWorkspaceStates compares the stored project statuses with the states reported by the providers
+// and updates the projects that were stopped, removed or failed outside of Daytona
+func (s *WorkspaceService) ReconcileWorkspaceStates() error {
+	workspaces, err := s.workspaceStore.List()
+	if err != nil {
+		return err
+	}
+
+	for _, w := range workspaces {
+		// Archived workspaces have no projects on the target
+		if s.isBusy(w.Id) || w.IsArchived() {
+			continue
+		}
+
+		err := s.reconcileWorkspaceState(w)
+		if err != nil {
+			log.Errorf("Failed to reconcile the state of workspace %s: %s", w.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *WorkspaceService) reconcileWorkspaceState(w *workspace.Workspace) error {
+	target, err := s.targetStore.Find(w.Target)
+	if err != nil {
+		return err
+	}
+
+	workspaceInfo, infoErr := s.provisioner.GetWorkspaceInfo(w, target)
+
+	// An operation might have started while the provider was queried
+	if s.isBusy(w.Id) {
+		return nil
+	}
+
+	// Reloaded so that project states reported by the agent in the meantime are not overwritten
+	w, err = s.workspaceStore.Find(w.Id)
+	if err != nil {
+		return err
+	}
+
+	verifiedAt := time.Now().Format(time.RFC3339)
+
+	for _, project := range w.Projects {
+		status, statusErr := getProviderProjectStatus(project.Name, workspaceInfo, infoErr)
+
+		statusError := ""
+		if statusErr != nil {
+			statusError = statusErr.Error()
+		}
+
+		if project.Status != "" && project.Status != status {
+			s.reportStateDrift(project, status, statusError)
+		}
+
+		project.Status = status
+		project.StatusError = statusError
+		project.StateLastVerifiedAt = verifiedAt
+	}
+
+	accumulateCost(w)
+
+	return s.workspaceStore.Save(w)
+}
+
+func (s *WorkspaceService) reportStateDrift(project *workspace.Project, status workspace.ProjectStatus, statusError string) {
+	message := fmt.Sprintf("Project %s is %s on the target but was expected to be %s", project.Name, status, project.Status)
+	if statusError != "" {
+		message = fmt.Sprintf("%s: %s", message, statusError)
+	}
+
+	log.Warnf("Workspace %s: %s", project.WorkspaceId, message)
+
+	projectLogger := s.loggerFactory.CreateProjectLogger(project.WorkspaceId, project.Name, logs.LogSourceServer)
+	defer projectLogger.Close()
+
+	projectLogger.Write([]byte(message + "\n"))
+
+	s.emitEvent(events.Event{
+		Type:        events.EventTypeProjectStateDrift,
+		WorkspaceId: project.WorkspaceId,
+		ProjectName: project.Name,
+		Message:     message,
+	})
+}
+
+// Persists the project status on a freshly loaded workspace so that concurrent state updates from the agent are not overwritten
+func (s *WorkspaceService) setProjectStatus(workspaceId, projectName string, status workspace.ProjectStatus, statusError string) error {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return err
+	}
+
+	project, err := w.GetProject(projectName)
+	if err != nil {
+		return err
+	}
+
+	project.Status = status
+	project.StatusError = statusError
+
+	accumulateCost(w)
+
+	return s.workspaceStore.Save(w)
+}
+
+// Marks the workspace as having an operation in progress until the returned function is called.
+// Transitional states of busy workspaces are not reported as drift.
+func (s *WorkspaceService) markBusy(workspaceId string) func() {
+	s.busyMutex.Lock()
+	defer s.busyMutex.Unlock()
+
+	s.busyWorkspaces[workspaceId]++
+
+	return func() {
+		s.busyMutex.Lock()
+		defer s.busyMutex.Unlock()
+
+		s.busyWorkspaces[workspaceId]--
+		if s.busyWorkspaces[workspaceId] <= 0 {
+			delete(s.busyWorkspaces, workspaceId)
+		}
+	}
+}
+
+func (s *WorkspaceService) isBusy(workspaceId string) bool {
+	s.busyMutex.Lock()
+	defer s.busyMutex.Unlock()
+
+	return s.busyWorkspaces[workspaceId] > 0
+}
+
+func (s *WorkspaceService) emitEvent(event events.Event) {
+	if s.eventService != nil {
+		s.eventService.Emit(event)
+	}
+}
+
+func getProviderProjectStatus(projectName string, workspaceInfo *workspace.WorkspaceInfo, infoErr error) (workspace.ProjectStatus, error) {
+	if infoErr != nil {
+		return workspace.ProjectStatusError, infoErr
+	}
+
+	if workspaceInfo != nil {
+		for _, projectInfo := range workspaceInfo.Projects {
+			if projectInfo == nil || projectInfo.Name != projectName {
+				continue
+			}
+
+			if projectInfo.IsRunning {
+				return workspace.ProjectStatusRunning, nil
+			}
+			return workspace.ProjectStatusStopped, nil
+		}
+	}
+
+	return workspace.ProjectStatusError, errProjectNotFoundOnTarget
+}
@@ -18,20 +18,24 @@ func (s *WorkspaceService) RemoveWorkspace(workspaceId string) error {
 
 	log.Infof("Destroying workspace %s", workspace.Id)
 
+	defer s.markBusy(workspace.Id)()
+
 	target, err := s.targetStore.Find(workspace.Target)
 	if err != nil {
 		return err
 	}
 
 	for _, project := range workspace.Projects {
+		s.stopPortForwards(project)
+
 		//	todo: go routines
-		err := s.provisioner.DestroyProject(project, target)
+		err := s.provisioner.DestroyProject(project, target, newServerProgressReporter(fmt.Sprintf("Project %s", project.Name)))
 		if err != nil {
 			return err
 		}
 	}
 
-	err = s.provisioner.DestroyWorkspace(workspace, target)
+	err = s.provisioner.DestroyWorkspace(workspace, target, newServerProgressReporter(fmt.Sprintf("Workspace %s", workspace.Id)))
 	if err != nil {
 		return err
 	}
@@ -42,6 +46,12 @@ func (s *WorkspaceService) RemoveWorkspace(workspaceId string) error {
 		log.Error(err)
 	}
 
+	// Should not fail the whole operation if the share keys cannot be revoked
+	err = s.RevokeWorkspaceShare(workspace.Id, "")
+	if err != nil {
+		log.Error(err)
+	}
+
 	for _, project := range workspace.Projects {
 		err := s.apiKeyService.Revoke(fmt.Sprintf("%s/%s", workspace.Id, project.Name))
 		if err != nil {
@@ -81,17 +91,21 @@ func (s *WorkspaceService) ForceRemoveWorkspace(workspaceId string) error {
 
 	log.Infof("Destroying workspace %s", workspace.Id)
 
+	defer s.markBusy(workspace.Id)()
+
 	target, _ := s.targetStore.Find(workspace.Target)
 
 	for _, project := range workspace.Projects {
+		s.stopPortForwards(project)
+
 		//	todo: go routines
-		err := s.provisioner.DestroyProject(project, target)
+		err := s.provisioner.DestroyProject(project, target, newServerProgressReporter(fmt.Sprintf("Project %s", project.Name)))
 		if err != nil {
 			log.Error(err)
 		}
 	}
 
-	err = s.provisioner.DestroyWorkspace(workspace, target)
+	err = s.provisioner.DestroyWorkspace(workspace, target, newServerProgressReporter(fmt.Sprintf("Workspace %s", workspace.Id)))
 	if err != nil {
 		log.Error(err)
 	}
@@ -101,6 +115,11 @@ func (s *WorkspaceService) ForceRemoveWorkspace(workspaceId string) error {
 		log.Error(err)
 	}
 
+	err = s.RevokeWorkspaceShare(workspace.Id, "")
+	if err != nil {
+		log.Error(err)
+	}
+
 	for _, project := range workspace.Projects {
 		err := s.apiKeyService.Revoke(fmt.Sprintf("%s/%s", workspace.Id, project.Name))
 		if err != nil {
@@ -0,0 +1,91 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/logs"
+)
+
+// ResetProject re-runs the clone and build steps of a single project without affecting the rest of the workspace.
+// A hard reset destroys the project first so its data is wiped and the repository is cloned again.
+func (s *WorkspaceService) ResetProject(workspaceId, projectName string, hard bool) error {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return ErrWorkspaceNotFound
+	}
+
+	project, err := w.GetProject(projectName)
+	if err != nil {
+		return ErrProjectNotFound
+	}
+
+	target, err := s.targetStore.Find(project.Target)
+	if err != nil {
+		return err
+	}
+
+	projectLogger := s.loggerFactory.CreateProjectLogger(w.Id, project.Name, logs.LogSourceServer)
+	defer projectLogger.Close()
+
+	defer s.markBusy(w.Id)()
+
+	projectLogger.Write([]byte(fmt.Sprintf("Resetting project %s\n", project.Name)))
+
+	err = s.provisioner.StopProject(project, target)
+	if err != nil {
+		return err
+	}
+
+	if hard {
+		projectLogger.Write([]byte("Removing project data\n"))
+
+		err = s.provisioner.DestroyProject(project, target, newLoggerProgressReporter(projectLogger))
+		if err != nil {
+			return err
+		}
+	}
+
+	gc, _ := s.getGitProviderService(w.OwnerId).ResolveConfig(project.Repository.Url, project.GitProviderConfigId)
+
+	projectWithEnv := *project
+	projectWithEnv.EnvVars = s.getProjectEnvVars(project)
+
+	for k, v := range project.EnvVars {
+		projectWithEnv.EnvVars[k] = v
+	}
+
+	resetProject, err := s.createBuild(&projectWithEnv, gc, projectLogger)
+	if err != nil {
+		return err
+	}
+
+	resetProject.State = nil
+
+	for i, p := range w.Projects {
+		if p.Name == project.Name {
+			w.Projects[i] = resetProject
+		}
+	}
+
+	err = s.workspaceStore.Save(w)
+	if err != nil {
+		return err
+	}
+
+	err = s.createProject(resetProject, target, w.OwnerId, projectLogger)
+	if err != nil {
+		return err
+	}
+
+	err = s.startProject(resetProject, target, projectLogger)
+	if err != nil {
+		return err
+	}
+
+	projectLogger.Write([]byte(fmt.Sprintf("Project %s reset\n", project.Name)))
+
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/daytonaio/daytona/pkg/server/events"
+	"github.com/daytonaio/daytona/pkg/workspace"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	creationStepWorkspace = "create-workspace"
+	creationStepStart     = "start"
+)
+
+func getProjectCreationStep(projectName string) string {
+	return fmt.Sprintf("create-project/%s", projectName)
+}
+
+// RetryWorkspaceCreation resumes the creation of a workspace from the step that failed.
+// The steps that completed during the previous attempts are skipped.
+func (s *WorkspaceService) RetryWorkspaceCreation(workspaceId string) (*workspace.Workspace, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	if w.Creation == nil {
+		return nil, ErrWorkspaceAlreadyCreated
+	}
+
+	if s.isBusy(w.Id) {
+		return nil, ErrWorkspaceBusy
+	}
+
+	defer s.markBusy(w.Id)()
+
+	return s.createWorkspace(w)
+}
+
+// Runs the creation step unless it completed during a previous attempt and checkpoints the result
+func (s *WorkspaceService) runCreationStep(ws *workspace.Workspace, step string, logWriter io.Writer, run func() error) error {
+	if ws.Creation == nil {
+		return run()
+	}
+
+	if ws.Creation.IsStepCompleted(step) {
+		logWriter.Write([]byte(fmt.Sprintf("Skipping step %s completed during a previous attempt\n", step)))
+		return nil
+	}
+
+	err := run()
+	if err != nil {
+		ws.Creation.FailedStep = step
+		ws.Creation.Error = err.Error()
+
+		saveErr := s.saveCreation(ws)
+		if saveErr != nil {
+			log.Errorf("Failed to save the creation progress of workspace %s: %s", ws.Name, saveErr)
+		}
+
+		logWriter.Write([]byte(fmt.Sprintf("Step %s failed. Run 'daytona create --retry %s' to resume the creation\n", step, ws.Name)))
+
+		s.emitEvent(events.Event{
+			Type:        events.EventTypeWorkspaceError,
+			WorkspaceId: ws.Id,
+			Message:     fmt.Sprintf("Creating workspace %s failed at step %s: %s", ws.Name, step, err.Error()),
+		})
+
+		return err
+	}
+
+	ws.Creation.CompletedSteps = append(ws.Creation.CompletedSteps, step)
+	ws.Creation.FailedStep = ""
+	ws.Creation.Error = ""
+
+	return s.saveCreation(ws)
+}
+
+// Persists the creation progress on a freshly loaded workspace so that project statuses saved by the steps are not overwritten
+func (s *WorkspaceService) saveCreation(ws *workspace.Workspace) error {
+	w, err := s.workspaceStore.Find(ws.Id)
+	if err != nil {
+		return err
+	}
+
+	w.Creation = ws.Creation
+
+	return s.workspaceStore.Save(w)
+}
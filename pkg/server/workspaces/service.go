@@ -6,31 +6,61 @@ package workspaces
 import (
 	"errors"
 	"io"
+	"sync"
+	"time"
 
 	"github.com/daytonaio/daytona/pkg/builder"
 	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/objectstorage"
 	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/provisioner"
 	"github.com/daytonaio/daytona/pkg/server/apikeys"
 	"github.com/daytonaio/daytona/pkg/server/containerregistries"
+	"github.com/daytonaio/daytona/pkg/server/events"
 	"github.com/daytonaio/daytona/pkg/server/gitproviders"
+	"github.com/daytonaio/daytona/pkg/server/portforwards"
+	"github.com/daytonaio/daytona/pkg/server/targetgroups"
+	"github.com/daytonaio/daytona/pkg/server/users"
 	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/daytonaio/daytona/pkg/sshca"
 	"github.com/daytonaio/daytona/pkg/workspace"
 )
 
 type IWorkspaceService interface {
 	CreateWorkspace(req dto.CreateWorkspaceRequest) (*workspace.Workspace, error)
+	RetryWorkspaceCreation(workspaceId string) (*workspace.Workspace, error)
 	GetWorkspace(workspaceId string) (*dto.WorkspaceDTO, error)
+	GetWorkspaceOwner(workspaceId string) (string, error)
 	GetWorkspaceLogReader(workspaceId string) (io.Reader, error)
 	GetProjectLogReader(workspaceId, projectName string) (io.Reader, error)
 	ListWorkspaces(verbose bool) ([]dto.WorkspaceDTO, error)
+	ListProjectStats() ([]dto.ProjectStats, error)
+	GetPrebuildStats(canAccess func(ownerId string) bool) (*dto.PrebuildStats, error)
 	RemoveWorkspace(workspaceId string) error
 	ForceRemoveWorkspace(workspaceId string) error
 	SetProjectState(workspaceId string, projectName string, state *workspace.ProjectState) (*workspace.Workspace, error)
 	StartProject(workspaceId string, projectName string) error
 	StartWorkspace(workspaceId string) error
 	StopProject(workspaceId string, projectName string) error
+	ResetProject(workspaceId string, projectName string, hard bool) error
 	StopWorkspace(workspaceId string) error
+	ExtendWorkspace(workspaceId string, duration time.Duration) (*workspace.Workspace, error)
+	ShareWorkspace(workspaceId string, duration time.Duration) (*dto.WorkspaceShare, error)
+	ListWorkspaceShares(workspaceId string) ([]dto.WorkspaceShare, error)
+	RevokeWorkspaceShare(workspaceId string, shareName string) error
+	GetSharedWorkspace(workspaceId string) (*dto.SharedWorkspace, error)
+	AddPortForward(workspaceId, projectName string, portForward workspace.ProjectPortForward) (*dto.PortForward, error)
+	ListPortForwards(workspaceId string) ([]dto.PortForward, error)
+	RemovePortForward(workspaceId, projectName string, port uint16) error
+	RestorePortForwards() error
+	HandleExpiredWorkspaces() error
+	ReconcileWorkspaceStates() error
+	ArchiveWorkspace(workspaceId string) error
+	UnarchiveWorkspace(workspaceId string) error
+	IssueSshCertificate(workspaceId, projectName, publicKey string) (*dto.SshCertificate, error)
+	IssueSharedSshCertificate(workspaceId, shareName, projectName, publicKey string) (*dto.SshCertificate, error)
+	UpdateNetworkAccess() error
+	HandleExpiredShares() error
 }
 
 type targetStore interface {
@@ -51,6 +81,28 @@ type WorkspaceServiceConfig struct {
 	LoggerFactory                   logs.LoggerFactory
 	GitProviderService              gitproviders.IGitProviderService
 	BuilderFactory                  builder.IBuilderFactory
+	EventService                    events.IEventService
+	// Runs the persisted port forwards of the projects. Port forwards are only stored if not set
+	PortForwardService portforwards.IPortForwardService
+	// S3-compatible storage archived projects are exported to. Archiving is disabled if not set
+	ArchiveStorage *objectstorage.S3Config
+	// Issues the SSH certificates trusted by the project agents. Projects accept any SSH connection if not set
+	SshCertificateAuthority *sshca.CertificateAuthority
+	SshCertificateTtl       time.Duration
+	// Time to wait for a project to become healthy before the projects that depend on it are started.
+	// Projects are started in dependency order without waiting if not set
+	ProjectDependencyTimeout time.Duration
+	// Places workspaces created in a target group. Target groups can not be used if not set
+	TargetGroupService targetgroups.ITargetGroupService
+	// Workspaces of users other than admins only use the git provider configs of their owner. All configs are used if not set
+	UserService users.IUserService
+	// Restricts the network access of the project nodes and the share holders. All nodes can reach each other if not set
+	NetworkAccess networkAccess
+	// Time before a workspace expires at which a warning is written to its logs and emitted as an event.
+	// No warning is given if not set
+	ExpiryWarning time.Duration
+	// Builds the images of projects with a build config on creation. Projects are created with their configured image if not set
+	BuildProjects bool
 }
 
 func NewWorkspaceService(config WorkspaceServiceConfig) IWorkspaceService {
@@ -68,6 +120,19 @@ func NewWorkspaceService(config WorkspaceServiceConfig) IWorkspaceService {
 		apiKeyService:                   config.ApiKeyService,
 		gitProviderService:              config.GitProviderService,
 		builderFactory:                  config.BuilderFactory,
+		eventService:                    config.EventService,
+		portForwardService:              config.PortForwardService,
+		archiveStorage:                  config.ArchiveStorage,
+		sshCertificateAuthority:         config.SshCertificateAuthority,
+		sshCertificateTtl:               config.SshCertificateTtl,
+		projectDependencyTimeout:        config.ProjectDependencyTimeout,
+		targetGroupService:              config.TargetGroupService,
+		userService:                     config.UserService,
+		networkAccess:                   config.NetworkAccess,
+		expiryWarning:                   config.ExpiryWarning,
+		buildProjects:                   config.BuildProjects,
+		busyWorkspaces:                  make(map[string]int),
+		expiryWarnings:                  make(map[string]string),
 	}
 }
 
@@ -85,6 +150,23 @@ type WorkspaceService struct {
 	loggerFactory                   logs.LoggerFactory
 	gitProviderService              gitproviders.IGitProviderService
 	builderFactory                  builder.IBuilderFactory
+	eventService                    events.IEventService
+	portForwardService              portforwards.IPortForwardService
+	archiveStorage                  *objectstorage.S3Config
+	sshCertificateAuthority         *sshca.CertificateAuthority
+	sshCertificateTtl               time.Duration
+	projectDependencyTimeout        time.Duration
+	targetGroupService              targetgroups.ITargetGroupService
+	userService                     users.IUserService
+	networkAccess                   networkAccess
+	expiryWarning                   time.Duration
+	buildProjects                   bool
+	// Workspaces with operations in progress, skipped by the state reconciliation
+	busyWorkspaces map[string]int
+	busyMutex      sync.Mutex
+	// Expiry times the workspaces were last warned about, so extended workspaces are warned again
+	expiryWarnings      map[string]string
+	expiryWarningsMutex sync.Mutex
 }
 
 func (s *WorkspaceService) SetProjectState(workspaceId, projectName string, state *workspace.ProjectState) (*workspace.Workspace, error) {
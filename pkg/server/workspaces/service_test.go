@@ -4,23 +4,40 @@
 package workspaces_test
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
 	"fmt"
+	"path/filepath"
+	"reflect"
+	"sort"
 	"testing"
 	"time"
 
 	t_targets "github.com/daytonaio/daytona/internal/testing/provider/targets"
+	t_apikeys "github.com/daytonaio/daytona/internal/testing/server/apikeys"
+	t_users "github.com/daytonaio/daytona/internal/testing/server/users"
 	t_workspaces "github.com/daytonaio/daytona/internal/testing/server/workspaces"
 	"github.com/daytonaio/daytona/internal/testing/server/workspaces/mocks"
 	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/builder"
 	"github.com/daytonaio/daytona/pkg/containerregistry"
 	"github.com/daytonaio/daytona/pkg/gitprovider"
 	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/objectstorage"
 	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server/apikeys"
+	"github.com/daytonaio/daytona/pkg/server/events"
+	"github.com/daytonaio/daytona/pkg/server/headscale/acl"
+	"github.com/daytonaio/daytona/pkg/server/users"
 	"github.com/daytonaio/daytona/pkg/server/workspaces"
 	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/daytonaio/daytona/pkg/sshca"
+	"github.com/daytonaio/daytona/pkg/user"
 	"github.com/daytonaio/daytona/pkg/workspace"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
 )
 
 const serverApiUrl = "http://localhost:3986"
@@ -57,6 +74,14 @@ var createWorkspaceRequest = dto.CreateWorkspaceRequest{
 	},
 }
 
+var costEstimate = workspace.CostEstimate{
+	Currency: "USD",
+	Items: []workspace.CostItem{
+		{Name: "t3.large", HourlyCost: 0.08},
+		{Name: "50 GB disk", HourlyCost: 0.02, ChargedWhenStopped: true},
+	},
+}
+
 var workspaceInfo = workspace.WorkspaceInfo{
 	Name:             createWorkspaceRequest.Name,
 	ProviderMetadata: "provider-metadata-test",
@@ -111,6 +136,7 @@ func TestWorkspaceService(t *testing.T) {
 
 		provisioner.On("CreateWorkspace", mock.Anything, &target).Return(nil)
 		provisioner.On("StartWorkspace", mock.Anything, &target).Return(nil)
+		provisioner.On("GetWorkspaceCostEstimate", mock.Anything, &target).Return(&costEstimate, nil)
 
 		apiKeyService.On("Generate", apikey.ApiKeyTypeWorkspace, createWorkspaceRequest.Id).Return(createWorkspaceRequest.Id, nil)
 		gitProviderService.On("GetLastCommitSha", createWorkspaceRequest.Projects[0].Source.Repository).Return("123", nil)
@@ -129,7 +155,7 @@ func TestWorkspaceService(t *testing.T) {
 		provisioner.On("CreateProject", mock.Anything, &target, containerRegistry, &gitProviderConfig).Return(nil)
 		provisioner.On("StartProject", mock.Anything, &target).Return(nil)
 
-		gitProviderService.On("GetConfigForUrl", "https://github.com/daytonaio/daytona").Return(&gitProviderConfig, nil)
+		gitProviderService.On("ResolveConfig", "https://github.com/daytonaio/daytona", "").Return(&gitProviderConfig, nil)
 
 		workspace, err := service.CreateWorkspace(createWorkspaceRequest)
 
@@ -137,6 +163,10 @@ func TestWorkspaceService(t *testing.T) {
 		require.NotNil(t, workspace)
 
 		workspaceEquals(t, createWorkspaceRequest, workspace, defaultProjectImage)
+
+		require.NotNil(t, workspace.Cost)
+		require.Equal(t, costEstimate, workspace.Cost.Estimate)
+		require.InDelta(t, 0.1, workspace.Cost.HourlyCost, 1e-9)
 	})
 
 	t.Run("CreateWorkspace fails when workspace already exists", func(t *testing.T) {
@@ -154,6 +184,62 @@ func TestWorkspaceService(t *testing.T) {
 		require.Equal(t, workspaces.ErrInvalidWorkspaceName, err)
 	})
 
+	t.Run("CreateWorkspace fails when target groups are disabled", func(t *testing.T) {
+		targetGroupRequest := createWorkspaceRequest
+		targetGroupRequest.Name = "target-group"
+		targetGroup := "staging"
+		targetGroupRequest.TargetGroup = &targetGroup
+
+		_, err := service.CreateWorkspace(targetGroupRequest)
+		require.Equal(t, workspaces.ErrTargetGroupsDisabled, err)
+	})
+
+	t.Run("CreateWorkspace fails for pinned commits in worktree mode", func(t *testing.T) {
+		worktreeModeRequest := createWorkspaceRequest
+		worktreeModeRequest.Name = "worktrees"
+		worktreeMode := true
+		worktreeModeRequest.Projects = []dto.CreateWorkspaceRequestProject{
+			{
+				Name: "project1",
+				Source: dto.CreateWorkspaceRequestProjectSource{
+					Repository: &gitprovider.GitRepository{
+						Id:     "123",
+						Url:    "https://github.com/daytonaio/daytona",
+						Name:   "daytona",
+						Sha:    "1234567",
+						Pinned: true,
+					},
+				},
+				WorktreeMode: &worktreeMode,
+			},
+		}
+
+		_, err := service.CreateWorkspace(worktreeModeRequest)
+		require.Equal(t, workspaces.ErrWorktreeModeUnsupported, err)
+	})
+
+	t.Run("CreateWorkspace fails for invalid port forwards", func(t *testing.T) {
+		portForwardRequest := createWorkspaceRequest
+		portForwardRequest.Name = "port-forwards"
+		portForwardRequest.Projects = []dto.CreateWorkspaceRequestProject{
+			{
+				Name: "project1",
+				Source: dto.CreateWorkspaceRequestProjectSource{
+					Repository: &gitprovider.GitRepository{
+						Id:   "123",
+						Url:  "https://github.com/daytonaio/daytona",
+						Name: "daytona",
+						Sha:  "1234567",
+					},
+				},
+				PortForwards: []workspace.ProjectPortForward{{Port: 0}},
+			},
+		}
+
+		_, err := service.CreateWorkspace(portForwardRequest)
+		require.Equal(t, workspaces.ErrInvalidPort, err)
+	})
+
 	t.Run("GetWorkspace", func(t *testing.T) {
 		provisioner.On("GetWorkspaceInfo", mock.Anything, &target).Return(&workspaceInfo, nil)
 
@@ -224,6 +310,11 @@ func TestWorkspaceService(t *testing.T) {
 		err := service.StopWorkspace(createWorkspaceRequest.Id)
 
 		require.Nil(t, err)
+
+		// Only the disk is charged while the workspace is stopped
+		stopped, err := workspaceStore.Find(createWorkspaceRequest.Id)
+		require.Nil(t, err)
+		require.InDelta(t, 0.02, stopped.Cost.HourlyCost, 1e-9)
 	})
 
 	t.Run("StopProject", func(t *testing.T) {
@@ -235,6 +326,80 @@ func TestWorkspaceService(t *testing.T) {
 		require.Nil(t, err)
 	})
 
+	t.Run("ResetProject", func(t *testing.T) {
+		provisioner.On("StopProject", mock.Anything, &target).Return(nil)
+		provisioner.On("DestroyProject", mock.Anything, &target).Return(nil)
+		provisioner.On("CreateProject", mock.Anything, &target, mock.Anything, mock.Anything).Return(nil)
+		provisioner.On("StartProject", mock.Anything, &target).Return(nil)
+
+		err := service.ResetProject(createWorkspaceRequest.Id, createWorkspaceRequest.Projects[0].Name, true)
+
+		require.Nil(t, err)
+	})
+
+	t.Run("ShareWorkspace", func(t *testing.T) {
+		shareKey := &apikey.ApiKey{
+			Name:        "share-1",
+			Type:        apikey.ApiKeyTypeShare,
+			WorkspaceId: createWorkspaceRequest.Id,
+			ExpiresAt:   time.Now().Add(2 * time.Hour).Format(time.RFC3339),
+		}
+		apiKeyService.On("ListShareKeys", createWorkspaceRequest.Id).Return([]*apikey.ApiKey{shareKey}, nil)
+
+		// Sharing requires SSH certificates, see TestWorkspaceShares
+		_, err := service.ShareWorkspace(createWorkspaceRequest.Id, 2*time.Hour)
+		require.Equal(t, workspaces.ErrSshCertificatesRequired, err)
+
+		shares, err := service.ListWorkspaceShares(createWorkspaceRequest.Id)
+		require.Nil(t, err)
+		require.Len(t, shares, 1)
+		require.Empty(t, shares[0].Url)
+
+		_, err = service.ShareWorkspace(createWorkspaceRequest.Id, 0)
+		require.Equal(t, workspaces.ErrInvalidShareDuration, err)
+	})
+
+	t.Run("RevokeWorkspaceShare", func(t *testing.T) {
+		apiKeyService.On("Revoke", "share-1").Return(nil)
+
+		err := service.RevokeWorkspaceShare(createWorkspaceRequest.Id, "share-1")
+		require.Nil(t, err)
+
+		err = service.RevokeWorkspaceShare(createWorkspaceRequest.Id, "share-2")
+		require.Equal(t, workspaces.ErrWorkspaceShareNotFound, err)
+	})
+
+	t.Run("PortForwards", func(t *testing.T) {
+		projectName := createWorkspaceRequest.Projects[0].Name
+
+		_, err := service.AddPortForward(createWorkspaceRequest.Id, projectName, workspace.ProjectPortForward{Port: 3000})
+		require.Nil(t, err)
+
+		portForward, err := service.AddPortForward(createWorkspaceRequest.Id, projectName, workspace.ProjectPortForward{Port: 3000, Public: true})
+		require.Nil(t, err)
+		require.True(t, portForward.Public)
+		require.False(t, portForward.Active)
+
+		portForwards, err := service.ListPortForwards(createWorkspaceRequest.Id)
+		require.Nil(t, err)
+		require.Len(t, portForwards, 1)
+		require.Equal(t, projectName, portForwards[0].ProjectName)
+
+		_, err = service.AddPortForward(createWorkspaceRequest.Id, projectName, workspace.ProjectPortForward{Port: 0})
+		require.Equal(t, workspaces.ErrInvalidPort, err)
+
+		err = service.RemovePortForward(createWorkspaceRequest.Id, projectName, 3000)
+		require.Nil(t, err)
+
+		err = service.RemovePortForward(createWorkspaceRequest.Id, projectName, 3000)
+		require.Equal(t, workspaces.ErrPortForwardNotFound, err)
+	})
+
+	t.Run("IssueSshCertificate fails when certificates are disabled", func(t *testing.T) {
+		_, err := service.IssueSshCertificate(createWorkspaceRequest.Id, createWorkspaceRequest.Projects[0].Name, "")
+		require.Equal(t, workspaces.ErrSshCertificatesDisabled, err)
+	})
+
 	t.Run("RemoveWorkspace", func(t *testing.T) {
 		provisioner.On("DestroyWorkspace", mock.Anything, &target).Return(nil)
 		provisioner.On("DestroyProject", mock.Anything, &target).Return(nil)
@@ -253,6 +418,7 @@ func TestWorkspaceService(t *testing.T) {
 
 		provisioner.On("CreateWorkspace", mock.Anything, &target).Return(nil)
 		provisioner.On("StartWorkspace", mock.Anything, &target).Return(nil)
+		provisioner.On("GetWorkspaceCostEstimate", mock.Anything, &target).Return(&costEstimate, nil)
 
 		apiKeyService.On("Generate", apikey.ApiKeyTypeWorkspace, createWorkspaceRequest.Id).Return(createWorkspaceRequest.Id, nil)
 		gitProviderService.On("GetLastCommitSha", createWorkspaceRequest.Projects[0].Source.Repository).Return("123", nil)
@@ -264,7 +430,7 @@ func TestWorkspaceService(t *testing.T) {
 			Token:      "test-token",
 			BaseApiUrl: &baseApiUrl,
 		}
-		gitProviderService.On("GetConfigForUrl", "https://github.com/daytonaio/daytona").Return(&gitProviderConfig, nil)
+		gitProviderService.On("ResolveConfig", "https://github.com/daytonaio/daytona", "").Return(&gitProviderConfig, nil)
 
 		for _, project := range createWorkspaceRequest.Projects {
 			apiKeyService.On("Generate", apikey.ApiKeyTypeProject, fmt.Sprintf("%s/%s", createWorkspaceRequest.Id, project.Name)).Return(project.Name, nil)
@@ -306,6 +472,11 @@ func TestWorkspaceService(t *testing.T) {
 		require.Equal(t, "main", project.State.GitStatus.CurrentBranch)
 	})
 
+	t.Run("ExtendWorkspace fails when workspace has no expiry", func(t *testing.T) {
+		_, err := service.ExtendWorkspace(createWorkspaceRequest.Id, 24*time.Hour)
+		require.Equal(t, workspaces.ErrWorkspaceHasNoExpiry, err)
+	})
+
 	t.Cleanup(func() {
 		apiKeyService.AssertExpectations(t)
 		provisioner.AssertExpectations(t)
@@ -361,3 +532,837 @@ func workspaceDtoEquals(t *testing.T, req dto.CreateWorkspaceRequest, workspace
 		}
 	}
 }
+
+func TestReconcileWorkspaceStates(t *testing.T) {
+	workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+
+	targetStore := t_targets.NewInMemoryTargetStore()
+	err := targetStore.Save(&target)
+	require.Nil(t, err)
+
+	provisioner := mocks.NewMockProvisioner()
+	eventService := events.NewEventService(events.EventServiceConfig{})
+
+	service := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
+		WorkspaceStore: workspaceStore,
+		TargetStore:    targetStore,
+		Provisioner:    provisioner,
+		LoggerFactory:  logs.NewLoggerFactory(t.TempDir()),
+		EventService:   eventService,
+	})
+
+	ws := &workspace.Workspace{
+		Id:     createWorkspaceRequest.Id,
+		Name:   createWorkspaceRequest.Name,
+		Target: target.Name,
+		Projects: []*workspace.Project{
+			{
+				Name:        createWorkspaceRequest.Projects[0].Name,
+				WorkspaceId: createWorkspaceRequest.Id,
+				Target:      target.Name,
+				Status:      workspace.ProjectStatusRunning,
+			},
+			{
+				Name:        "project2",
+				WorkspaceId: createWorkspaceRequest.Id,
+				Target:      target.Name,
+				Status:      workspace.ProjectStatusRunning,
+			},
+		},
+	}
+	err = workspaceStore.Save(ws)
+	require.Nil(t, err)
+
+	stoppedWorkspaceInfo := workspace.WorkspaceInfo{
+		Name: ws.Name,
+		Projects: []*workspace.ProjectInfo{
+			{
+				Name:        ws.Projects[0].Name,
+				IsRunning:   false,
+				WorkspaceId: ws.Id,
+			},
+		},
+	}
+	provisioner.On("GetWorkspaceInfo", mock.Anything, &target).Return(&stoppedWorkspaceInfo, nil)
+
+	err = service.ReconcileWorkspaceStates()
+	require.Nil(t, err)
+
+	reconciled, err := workspaceStore.Find(ws.Id)
+	require.Nil(t, err)
+
+	require.Equal(t, workspace.ProjectStatusStopped, reconciled.Projects[0].Status)
+	require.Empty(t, reconciled.Projects[0].StatusError)
+	require.NotEmpty(t, reconciled.Projects[0].StateLastVerifiedAt)
+
+	require.Equal(t, workspace.ProjectStatusError, reconciled.Projects[1].Status)
+	require.NotEmpty(t, reconciled.Projects[1].StatusError)
+
+	driftEvents := eventService.List()
+	require.Len(t, driftEvents, 2)
+	require.Equal(t, events.EventTypeProjectStateDrift, driftEvents[0].Type)
+	require.Equal(t, ws.Id, driftEvents[0].WorkspaceId)
+	require.Equal(t, ws.Projects[0].Name, driftEvents[0].ProjectName)
+
+	// Unchanged states are not reported again
+	err = service.ReconcileWorkspaceStates()
+	require.Nil(t, err)
+	require.Len(t, eventService.List(), 2)
+}
+
+func TestWorkspaceExpiry(t *testing.T) {
+	workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+
+	targetStore := t_targets.NewInMemoryTargetStore()
+	err := targetStore.Save(&target)
+	require.Nil(t, err)
+
+	containerRegistryService := mocks.NewMockContainerRegistryService()
+	apiKeyService := mocks.NewMockApiKeyService()
+	gitProviderService := mocks.NewMockGitProviderService()
+	provisioner := mocks.NewMockProvisioner()
+	eventService := events.NewEventService(events.EventServiceConfig{})
+
+	service := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
+		WorkspaceStore:           workspaceStore,
+		TargetStore:              targetStore,
+		ContainerRegistryService: containerRegistryService,
+		DefaultProjectImage:      defaultProjectImage,
+		DefaultProjectUser:       defaultProjectUser,
+		ApiKeyService:            apiKeyService,
+		Provisioner:              provisioner,
+		LoggerFactory:            logs.NewLoggerFactory(t.TempDir()),
+		GitProviderService:       gitProviderService,
+		BuilderFactory:           &mocks.MockBuilderFactory{},
+		EventService:             eventService,
+		ExpiryWarning:            time.Hour,
+	})
+
+	var containerRegistry *containerregistry.ContainerRegistry
+	gitProviderConfig := gitprovider.GitProviderConfig{Id: "github"}
+
+	containerRegistryService.On("FindByImageName", defaultProjectImage).Return(containerRegistry, containerregistry.ErrContainerRegistryNotFound)
+	gitProviderService.On("GetLastCommitSha", createWorkspaceRequest.Projects[0].Source.Repository).Return("123", nil)
+	gitProviderService.On("ResolveConfig", "https://github.com/daytonaio/daytona", "").Return(&gitProviderConfig, nil)
+	apiKeyService.On("Generate", mock.Anything, mock.Anything).Return("api-key", nil)
+	provisioner.On("CreateWorkspace", mock.Anything, &target).Return(nil)
+	provisioner.On("StartWorkspace", mock.Anything, &target).Return(nil)
+	provisioner.On("GetWorkspaceCostEstimate", mock.Anything, &target).Return(&costEstimate, nil)
+	provisioner.On("CreateProject", mock.Anything, &target, containerRegistry, &gitProviderConfig).Return(nil)
+	provisioner.On("StartProject", mock.Anything, &target).Return(nil)
+
+	expiringWarnings := func() int {
+		count := 0
+		for _, event := range eventService.List() {
+			if event.Type == events.EventTypeWorkspaceExpiring && event.WorkspaceId == createWorkspaceRequest.Id {
+				count++
+			}
+		}
+		return count
+	}
+
+	t.Run("CreateWorkspace with TTL", func(t *testing.T) {
+		invalidTtlRequest := createWorkspaceRequest
+		invalidTtl := "0s"
+		invalidTtlRequest.Ttl = &invalidTtl
+
+		_, err := service.CreateWorkspace(invalidTtlRequest)
+		require.Equal(t, workspaces.ErrInvalidTtl, err)
+
+		ttlRequest := createWorkspaceRequest
+		ttl := "30m"
+		ttlRequest.Ttl = &ttl
+
+		ws, err := service.CreateWorkspace(ttlRequest)
+		require.Nil(t, err)
+		require.Equal(t, workspace.ExpiryActionDelete, ws.ExpiryAction)
+
+		expiresAt, err := time.Parse(time.RFC3339, ws.ExpiresAt)
+		require.Nil(t, err)
+		require.WithinDuration(t, time.Now().Add(30*time.Minute), expiresAt, time.Minute)
+	})
+
+	t.Run("HandleExpiredWorkspaces warns before the expiry", func(t *testing.T) {
+		err := service.HandleExpiredWorkspaces()
+		require.Nil(t, err)
+		require.Equal(t, 1, expiringWarnings())
+
+		// The workspace is only warned about once
+		err = service.HandleExpiredWorkspaces()
+		require.Nil(t, err)
+		require.Equal(t, 1, expiringWarnings())
+
+		_, err = workspaceStore.Find(createWorkspaceRequest.Id)
+		require.Nil(t, err)
+	})
+
+	t.Run("ExtendWorkspace", func(t *testing.T) {
+		ws, err := workspaceStore.Find(createWorkspaceRequest.Id)
+		require.Nil(t, err)
+		previousExpiresAt, err := time.Parse(time.RFC3339, ws.ExpiresAt)
+		require.Nil(t, err)
+
+		_, err = service.ExtendWorkspace(createWorkspaceRequest.Id, 0)
+		require.Equal(t, workspaces.ErrInvalidTtl, err)
+
+		ws, err = service.ExtendWorkspace(createWorkspaceRequest.Id, 2*time.Hour)
+		require.Nil(t, err)
+
+		expiresAt, err := time.Parse(time.RFC3339, ws.ExpiresAt)
+		require.Nil(t, err)
+		require.Equal(t, previousExpiresAt.Add(2*time.Hour), expiresAt)
+
+		// The extended workspace no longer expires within the warning time
+		err = service.HandleExpiredWorkspaces()
+		require.Nil(t, err)
+		require.Equal(t, 1, expiringWarnings())
+	})
+
+	t.Run("HandleExpiredWorkspaces deletes only expired workspaces", func(t *testing.T) {
+		expired := &workspace.Workspace{
+			Id:           "expired",
+			Name:         "expired",
+			Target:       target.Name,
+			ExpiresAt:    time.Now().Add(-time.Minute).Format(time.RFC3339),
+			ExpiryAction: workspace.ExpiryActionDelete,
+			Projects: []*workspace.Project{
+				{Name: "project1", WorkspaceId: "expired", Target: target.Name},
+			},
+		}
+		withoutExpiry := &workspace.Workspace{Id: "without-expiry", Name: "without-expiry", Target: target.Name}
+
+		require.Nil(t, workspaceStore.Save(expired))
+		require.Nil(t, workspaceStore.Save(withoutExpiry))
+
+		provisioner.On("DestroyProject", mock.Anything, &target).Return(nil)
+		provisioner.On("DestroyWorkspace", mock.Anything, &target).Return(nil)
+		apiKeyService.On("Revoke", mock.Anything).Return(nil)
+		apiKeyService.On("ListShareKeys", mock.Anything).Return([]*apikey.ApiKey{}, nil)
+
+		err := service.HandleExpiredWorkspaces()
+		require.Nil(t, err)
+
+		_, err = workspaceStore.Find(expired.Id)
+		require.NotNil(t, err)
+
+		_, err = workspaceStore.Find(withoutExpiry.Id)
+		require.Nil(t, err)
+		_, err = workspaceStore.Find(createWorkspaceRequest.Id)
+		require.Nil(t, err)
+
+		provisioner.AssertNumberOfCalls(t, "DestroyWorkspace", 1)
+	})
+}
+
+func TestRetryWorkspaceCreation(t *testing.T) {
+	workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+
+	targetStore := t_targets.NewInMemoryTargetStore()
+	err := targetStore.Save(&target)
+	require.Nil(t, err)
+
+	containerRegistryService := mocks.NewMockContainerRegistryService()
+	apiKeyService := mocks.NewMockApiKeyService()
+	gitProviderService := mocks.NewMockGitProviderService()
+	provisioner := mocks.NewMockProvisioner()
+
+	service := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
+		WorkspaceStore:           workspaceStore,
+		TargetStore:              targetStore,
+		ContainerRegistryService: containerRegistryService,
+		DefaultProjectImage:      defaultProjectImage,
+		DefaultProjectUser:       defaultProjectUser,
+		ApiKeyService:            apiKeyService,
+		Provisioner:              provisioner,
+		LoggerFactory:            logs.NewLoggerFactory(t.TempDir()),
+		GitProviderService:       gitProviderService,
+		BuilderFactory:           &mocks.MockBuilderFactory{},
+	})
+
+	var containerRegistry *containerregistry.ContainerRegistry
+	gitProviderConfig := gitprovider.GitProviderConfig{Id: "github"}
+
+	containerRegistryService.On("FindByImageName", defaultProjectImage).Return(containerRegistry, containerregistry.ErrContainerRegistryNotFound)
+	apiKeyService.On("Generate", apikey.ApiKeyTypeWorkspace, createWorkspaceRequest.Id).Return(createWorkspaceRequest.Id, nil)
+	apiKeyService.On("Generate", apikey.ApiKeyTypeProject, fmt.Sprintf("%s/%s", createWorkspaceRequest.Id, createWorkspaceRequest.Projects[0].Name)).Return("project1", nil)
+	gitProviderService.On("GetLastCommitSha", mock.Anything).Return("123", nil)
+	gitProviderService.On("ResolveConfig", "https://github.com/daytonaio/daytona", "").Return(&gitProviderConfig, nil)
+
+	provisioner.On("CreateWorkspace", mock.Anything, &target).Return(nil)
+	provisioner.On("CreateProject", mock.Anything, &target, containerRegistry, &gitProviderConfig).Return(errors.New("image pull timeout")).Once()
+	provisioner.On("CreateProject", mock.Anything, &target, containerRegistry, &gitProviderConfig).Return(nil)
+	provisioner.On("StartWorkspace", mock.Anything, &target).Return(nil)
+	provisioner.On("StartProject", mock.Anything, &target).Return(nil)
+	provisioner.On("GetWorkspaceCostEstimate", mock.Anything, &target).Return(&costEstimate, nil)
+
+	_, err = service.CreateWorkspace(createWorkspaceRequest)
+	require.NotNil(t, err)
+
+	failed, err := workspaceStore.Find(createWorkspaceRequest.Id)
+	require.Nil(t, err)
+	require.NotNil(t, failed.Creation)
+	require.Equal(t, []string{"create-workspace"}, failed.Creation.CompletedSteps)
+	require.Equal(t, "create-project/project1", failed.Creation.FailedStep)
+	require.Equal(t, "image pull timeout", failed.Creation.Error)
+
+	created, err := service.RetryWorkspaceCreation(createWorkspaceRequest.Name)
+	require.Nil(t, err)
+	require.Nil(t, created.Creation)
+
+	// The workspace is not provisioned again
+	provisioner.AssertNumberOfCalls(t, "CreateWorkspace", 1)
+	provisioner.AssertNumberOfCalls(t, "CreateProject", 2)
+
+	stored, err := workspaceStore.Find(createWorkspaceRequest.Id)
+	require.Nil(t, err)
+	require.Nil(t, stored.Creation)
+	require.Equal(t, workspace.ProjectStatusRunning, stored.Projects[0].Status)
+
+	_, err = service.RetryWorkspaceCreation(createWorkspaceRequest.Name)
+	require.Equal(t, workspaces.ErrWorkspaceAlreadyCreated, err)
+}
+
+func TestCreateWorkspaceWithBuild(t *testing.T) {
+	targetStore := t_targets.NewInMemoryTargetStore()
+	err := targetStore.Save(&target)
+	require.Nil(t, err)
+
+	prebuiltResult := &builder.BuildResult{
+		BuildId:   "build1",
+		Sha:       "sha1",
+		ImageName: "registry/prebuilt:latest",
+		User:      "daytona",
+	}
+
+	for _, tc := range []struct {
+		name           string
+		buildsDisabled bool
+		existingResult *builder.BuildResult
+		expectedImage  string
+		expectedSaved  int
+		expected       *workspace.ProjectPrebuild
+	}{
+		{
+			name:          "cold build",
+			expectedImage: mocks.MockBuildResults.ImageName,
+			expectedSaved: 1,
+			expected:      &workspace.ProjectPrebuild{Hit: false, Image: mocks.MockBuildResults.ImageName},
+		},
+		{
+			name:           "prebuilt image",
+			existingResult: prebuiltResult,
+			expectedImage:  prebuiltResult.ImageName,
+			expected:       &workspace.ProjectPrebuild{Hit: true, BuildId: "build1", Image: prebuiltResult.ImageName, Sha: "sha1"},
+		},
+		{
+			name:           "builds disabled",
+			buildsDisabled: true,
+			existingResult: prebuiltResult,
+			expectedImage:  defaultProjectImage,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+			containerRegistryService := mocks.NewMockContainerRegistryService()
+			apiKeyService := mocks.NewMockApiKeyService()
+			gitProviderService := mocks.NewMockGitProviderService()
+			provisioner := mocks.NewMockProvisioner()
+			builderFactory := &mocks.MockBuilderFactory{ExistingBuildResult: tc.existingResult}
+
+			service := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
+				WorkspaceStore:           workspaceStore,
+				TargetStore:              targetStore,
+				ContainerRegistryService: containerRegistryService,
+				DefaultProjectImage:      defaultProjectImage,
+				DefaultProjectUser:       defaultProjectUser,
+				ApiKeyService:            apiKeyService,
+				Provisioner:              provisioner,
+				LoggerFactory:            logs.NewLoggerFactory(t.TempDir()),
+				GitProviderService:       gitProviderService,
+				BuilderFactory:           builderFactory,
+				BuildProjects:            !tc.buildsDisabled,
+			})
+
+			var containerRegistry *containerregistry.ContainerRegistry
+
+			containerRegistryService.On("FindByImageName", mock.Anything).Return(containerRegistry, containerregistry.ErrContainerRegistryNotFound)
+			apiKeyService.On("Generate", mock.Anything, mock.Anything).Return("key", nil)
+			gitProviderService.On("GetLastCommitSha", mock.Anything).Return("123", nil)
+			gitProviderService.On("ResolveConfig", mock.Anything, "").Return(&gitprovider.GitProviderConfig{Id: "github"}, nil)
+			provisioner.On("CreateWorkspace", mock.Anything, &target).Return(nil)
+			provisioner.On("CreateProject", mock.Anything, &target, containerRegistry, mock.Anything).Return(nil)
+			provisioner.On("StartWorkspace", mock.Anything, &target).Return(nil)
+			provisioner.On("StartProject", mock.Anything, &target).Return(nil)
+			provisioner.On("GetWorkspaceCostEstimate", mock.Anything, &target).Return(&costEstimate, nil)
+
+			req := createWorkspaceRequest
+			req.Projects = []dto.CreateWorkspaceRequestProject{createWorkspaceRequest.Projects[0]}
+			req.Projects[0].Build = &workspace.ProjectBuild{
+				Devcontainer: &workspace.ProjectBuildDevcontainer{DevContainerFilePath: ".devcontainer/devcontainer.json"},
+			}
+
+			w, err := service.CreateWorkspace(req)
+			require.Nil(t, err)
+
+			require.Equal(t, tc.expectedImage, w.Projects[0].Image)
+			require.Equal(t, tc.expected, w.Projects[0].Prebuild)
+			require.Len(t, builderFactory.SavedBuildResults, tc.expectedSaved)
+
+			stored, err := workspaceStore.Find(req.Id)
+			require.Nil(t, err)
+			require.Equal(t, tc.expected, stored.Projects[0].Prebuild)
+		})
+	}
+}
+
+func TestArchiveWorkspace(t *testing.T) {
+	workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+
+	targetStore := t_targets.NewInMemoryTargetStore()
+	err := targetStore.Save(&target)
+	require.Nil(t, err)
+
+	containerRegistryService := mocks.NewMockContainerRegistryService()
+	gitProviderService := mocks.NewMockGitProviderService()
+	provisioner := mocks.NewMockProvisioner()
+
+	archiveStorage := &objectstorage.S3Config{
+		Endpoint:        "http://localhost:9000",
+		Region:          "us-east-1",
+		Bucket:          "archives",
+		AccessKeyId:     "access-key",
+		SecretAccessKey: "secret-key",
+	}
+
+	service := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
+		WorkspaceStore:           workspaceStore,
+		TargetStore:              targetStore,
+		ContainerRegistryService: containerRegistryService,
+		GitProviderService:       gitProviderService,
+		Provisioner:              provisioner,
+		LoggerFactory:            logs.NewLoggerFactory(t.TempDir()),
+		ArchiveStorage:           archiveStorage,
+	})
+
+	ws := &workspace.Workspace{
+		Id:     createWorkspaceRequest.Id,
+		Name:   createWorkspaceRequest.Name,
+		Target: target.Name,
+		Projects: []*workspace.Project{
+			{
+				Name:        createWorkspaceRequest.Projects[0].Name,
+				Image:       defaultProjectImage,
+				WorkspaceId: createWorkspaceRequest.Id,
+				Target:      target.Name,
+				Repository:  createWorkspaceRequest.Projects[0].Source.Repository,
+				Status:      workspace.ProjectStatusRunning,
+			},
+		},
+	}
+	err = workspaceStore.Save(ws)
+	require.Nil(t, err)
+
+	var containerRegistry *containerregistry.ContainerRegistry
+	gitProviderConfig := gitprovider.GitProviderConfig{Id: "github"}
+
+	containerRegistryService.On("FindByImageName", defaultProjectImage).Return(containerRegistry, containerregistry.ErrContainerRegistryNotFound)
+	gitProviderService.On("ResolveConfig", "https://github.com/daytonaio/daytona", "").Return(&gitProviderConfig, nil)
+
+	provisioner.On("ArchiveProject", mock.Anything, &target, archiveStorage).Return(nil)
+	provisioner.On("UnarchiveProject", mock.Anything, &target, containerRegistry, &gitProviderConfig, archiveStorage).Return(nil)
+
+	err = service.ArchiveWorkspace(ws.Id)
+	require.Nil(t, err)
+
+	archived, err := workspaceStore.Find(ws.Id)
+	require.Nil(t, err)
+	require.Equal(t, workspace.ProjectStatusArchived, archived.Projects[0].Status)
+
+	err = service.ArchiveWorkspace(ws.Id)
+	require.Equal(t, workspaces.ErrWorkspaceArchived, err)
+
+	err = service.StartWorkspace(ws.Id)
+	require.Equal(t, workspaces.ErrWorkspaceArchived, err)
+
+	err = service.UnarchiveWorkspace(ws.Id)
+	require.Nil(t, err)
+
+	restored, err := workspaceStore.Find(ws.Id)
+	require.Nil(t, err)
+	require.Equal(t, workspace.ProjectStatusStopped, restored.Projects[0].Status)
+
+	err = service.UnarchiveWorkspace(ws.Id)
+	require.Equal(t, workspaces.ErrWorkspaceNotArchived, err)
+
+	provisioner.AssertNumberOfCalls(t, "ArchiveProject", 1)
+	provisioner.AssertNumberOfCalls(t, "UnarchiveProject", 1)
+}
+
+func TestIssueSshCertificate(t *testing.T) {
+	workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+
+	ca, err := sshca.LoadOrCreate(filepath.Join(t.TempDir(), "ssh_ca"))
+	require.Nil(t, err)
+
+	service := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
+		WorkspaceStore:          workspaceStore,
+		LoggerFactory:           logs.NewLoggerFactory(t.TempDir()),
+		SshCertificateAuthority: ca,
+		SshCertificateTtl:       time.Hour,
+	})
+
+	projectName := createWorkspaceRequest.Projects[0].Name
+
+	err = workspaceStore.Save(&workspace.Workspace{
+		Id:       createWorkspaceRequest.Id,
+		Name:     createWorkspaceRequest.Name,
+		Target:   target.Name,
+		Projects: []*workspace.Project{{Name: projectName, WorkspaceId: createWorkspaceRequest.Id}},
+	})
+	require.Nil(t, err)
+
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.Nil(t, err)
+	signer, err := ssh.NewSignerFromKey(privateKey)
+	require.Nil(t, err)
+	publicKey := string(ssh.MarshalAuthorizedKey(signer.PublicKey()))
+
+	cert, err := service.IssueSshCertificate(createWorkspaceRequest.Id, projectName, publicKey)
+	require.Nil(t, err)
+
+	expiresAt, err := time.Parse(time.RFC3339, cert.ExpiresAt)
+	require.Nil(t, err)
+	require.WithinDuration(t, time.Now().Add(time.Hour), expiresAt, time.Minute)
+
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(cert.Certificate))
+	require.Nil(t, err)
+
+	checker, err := sshca.NewCertificateChecker(ca.PublicKey())
+	require.Nil(t, err)
+
+	require.Nil(t, checker.Check(workspace.GetProjectHostname(createWorkspaceRequest.Id, projectName), key))
+	require.ErrorIs(t, checker.Check(workspace.GetProjectHostname(createWorkspaceRequest.Id, "other"), key), sshca.ErrInvalidCertificate)
+
+	_, err = service.IssueSshCertificate(createWorkspaceRequest.Id, "other", publicKey)
+	require.Equal(t, workspaces.ErrProjectNotFound, err)
+
+	_, err = service.IssueSshCertificate(createWorkspaceRequest.Id, projectName, "invalid")
+	require.ErrorIs(t, err, sshca.ErrInvalidPublicKey)
+}
+
+func TestWorkspaceShares(t *testing.T) {
+	workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+	apiKeyService := mocks.NewMockApiKeyService()
+	networkAccess := mocks.NewMockNetworkAccess()
+
+	ca, err := sshca.LoadOrCreate(filepath.Join(t.TempDir(), "ssh_ca"))
+	require.Nil(t, err)
+
+	service := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
+		WorkspaceStore:          workspaceStore,
+		ServerApiUrl:            serverApiUrl,
+		ApiKeyService:           apiKeyService,
+		LoggerFactory:           logs.NewLoggerFactory(t.TempDir()),
+		SshCertificateAuthority: ca,
+		SshCertificateTtl:       time.Hour,
+		NetworkAccess:           networkAccess,
+	})
+
+	projectName := createWorkspaceRequest.Projects[0].Name
+
+	err = workspaceStore.Save(&workspace.Workspace{
+		Id:       createWorkspaceRequest.Id,
+		Name:     createWorkspaceRequest.Name,
+		Target:   target.Name,
+		Projects: []*workspace.Project{{Name: projectName, WorkspaceId: createWorkspaceRequest.Id}},
+	})
+	require.Nil(t, err)
+
+	activeShareKey := &apikey.ApiKey{
+		Name:        "share-1",
+		Type:        apikey.ApiKeyTypeShare,
+		WorkspaceId: createWorkspaceRequest.Id,
+		ExpiresAt:   time.Now().Add(30 * time.Minute).Format(time.RFC3339),
+	}
+	expiredShareKey := &apikey.ApiKey{
+		Name:        "share-2",
+		Type:        apikey.ApiKeyTypeShare,
+		WorkspaceId: createWorkspaceRequest.Id,
+		ExpiresAt:   time.Now().Add(-time.Minute).Format(time.RFC3339),
+	}
+
+	apiKeyService.On("GenerateShareKey", createWorkspaceRequest.Id, mock.Anything).Return(activeShareKey, "share-key", nil)
+	apiKeyService.On("ListShareKeys", createWorkspaceRequest.Id).Return([]*apikey.ApiKey{activeShareKey, expiredShareKey}, nil)
+
+	// Share holders only get access to the projects of the shared workspace
+	expectedGrants := acl.Grants{
+		acl.WorkspaceTag(createWorkspaceRequest.Id): {acl.AllNodes},
+		acl.ShareTag(activeShareKey.Name):           {acl.WorkspaceTag(createWorkspaceRequest.Id)},
+	}
+
+	t.Run("ShareWorkspace", func(t *testing.T) {
+		share, err := service.ShareWorkspace(createWorkspaceRequest.Id, 30*time.Minute)
+		require.Nil(t, err)
+		require.Equal(t, activeShareKey.Name, share.Name)
+		require.Equal(t, serverApiUrl+"/share?token=share-key", share.Url)
+	})
+
+	t.Run("UpdateNetworkAccess", func(t *testing.T) {
+		networkAccess.On("SetAccessGrants", expectedGrants).Return().Once()
+
+		err := service.UpdateNetworkAccess()
+		require.Nil(t, err)
+
+		networkAccess.AssertExpectations(t)
+	})
+
+	t.Run("IssueSharedSshCertificate expires with the share", func(t *testing.T) {
+		_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		require.Nil(t, err)
+		signer, err := ssh.NewSignerFromKey(privateKey)
+		require.Nil(t, err)
+		publicKey := string(ssh.MarshalAuthorizedKey(signer.PublicKey()))
+
+		cert, err := service.IssueSharedSshCertificate(createWorkspaceRequest.Id, activeShareKey.Name, projectName, publicKey)
+		require.Nil(t, err)
+
+		expiresAt, err := time.Parse(time.RFC3339, cert.ExpiresAt)
+		require.Nil(t, err)
+		require.WithinDuration(t, time.Now().Add(30*time.Minute), expiresAt, time.Minute)
+
+		_, err = service.IssueSharedSshCertificate(createWorkspaceRequest.Id, expiredShareKey.Name, projectName, publicKey)
+		require.Equal(t, workspaces.ErrWorkspaceShareNotFound, err)
+	})
+
+	t.Run("HandleExpiredShares", func(t *testing.T) {
+		apiKeyService.On("Revoke", expiredShareKey.Name).Return(nil).Once()
+		networkAccess.On("DeleteTaggedNodes", acl.ShareTag(expiredShareKey.Name)).Return(nil).Once()
+		networkAccess.On("SetAccessGrants", expectedGrants).Return().Once()
+
+		err := service.HandleExpiredShares()
+		require.Nil(t, err)
+
+		apiKeyService.AssertCalled(t, "Revoke", expiredShareKey.Name)
+		apiKeyService.AssertNotCalled(t, "Revoke", activeShareKey.Name)
+		networkAccess.AssertExpectations(t)
+	})
+
+	t.Run("RevokeWorkspaceShare", func(t *testing.T) {
+		apiKeyService.On("Revoke", activeShareKey.Name).Return(nil).Once()
+		networkAccess.On("DeleteTaggedNodes", acl.ShareTag(activeShareKey.Name)).Return(nil).Once()
+		networkAccess.On("SetAccessGrants", mock.Anything).Return().Once()
+
+		err := service.RevokeWorkspaceShare(createWorkspaceRequest.Id, activeShareKey.Name)
+		require.Nil(t, err)
+
+		networkAccess.AssertExpectations(t)
+	})
+}
+
+func TestUpdateNetworkAccess(t *testing.T) {
+	workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+	networkAccess := mocks.NewMockNetworkAccess()
+
+	apiKeyService := apikeys.NewApiKeyService(apikeys.ApiKeyServiceConfig{
+		ApiKeyStore: t_apikeys.NewInMemoryApiKeyStore(),
+	})
+	userService := users.NewUserService(users.UserServiceConfig{
+		UserStore:     t_users.NewInMemoryUserStore(),
+		ApiKeyService: apiKeyService,
+	})
+
+	service := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
+		WorkspaceStore: workspaceStore,
+		ApiKeyService:  apiKeyService,
+		LoggerFactory:  logs.NewLoggerFactory(t.TempDir()),
+		UserService:    userService,
+		NetworkAccess:  networkAccess,
+	})
+
+	admin, _, err := userService.Create("admin", user.RoleAdmin)
+	require.Nil(t, err)
+	member, _, err := userService.Create("member", user.RoleMember)
+	require.Nil(t, err)
+
+	for _, w := range []*workspace.Workspace{
+		{Id: "legacy"},
+		{Id: "admin1", OwnerId: admin.Id},
+		{Id: "member1", OwnerId: member.Id},
+		{Id: "member2", OwnerId: member.Id},
+	} {
+		err = workspaceStore.Save(w)
+		require.Nil(t, err)
+	}
+
+	memberWorkspaceTags := []string{acl.WorkspaceTag("member1"), acl.WorkspaceTag("member2")}
+
+	// Members and their workspaces can only reach the workspaces of the member
+	networkAccess.On("SetAccessGrants", mock.MatchedBy(func(grants acl.Grants) bool {
+		for _, tags := range grants {
+			sort.Strings(tags)
+		}
+
+		return reflect.DeepEqual(acl.Grants{
+			acl.WorkspaceTag("legacy"):  {acl.AllNodes},
+			acl.WorkspaceTag("admin1"):  {acl.AllNodes},
+			acl.WorkspaceTag("member1"): memberWorkspaceTags,
+			acl.WorkspaceTag("member2"): memberWorkspaceTags,
+			acl.UserTag(member.Id):      memberWorkspaceTags,
+		}, grants)
+	})).Return().Once()
+
+	err = service.UpdateNetworkAccess()
+	require.Nil(t, err)
+
+	networkAccess.AssertExpectations(t)
+}
+
+func TestGetPrebuildStats(t *testing.T) {
+	workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+
+	service := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
+		WorkspaceStore: workspaceStore,
+		LoggerFactory:  logs.NewLoggerFactory(t.TempDir()),
+	})
+
+	newProject := func(name, repositoryUrl string, prebuild *workspace.ProjectPrebuild) *workspace.Project {
+		return &workspace.Project{
+			Name:       name,
+			Repository: &gitprovider.GitRepository{Url: repositoryUrl},
+			Prebuild:   prebuild,
+		}
+	}
+
+	err := workspaceStore.Save(&workspace.Workspace{
+		Id: "ws1",
+		Projects: []*workspace.Project{
+			newProject("p1", "https://github.com/daytonaio/daytona", &workspace.ProjectPrebuild{Hit: true, BuildId: "b1", Sha: "sha1"}),
+			newProject("p2", "https://github.com/daytonaio/docs", &workspace.ProjectPrebuild{Hit: false}),
+			newProject("p3", "https://github.com/daytonaio/docs", nil),
+		},
+	})
+	require.Nil(t, err)
+
+	err = workspaceStore.Save(&workspace.Workspace{
+		Id:      "ws2",
+		OwnerId: "user1",
+		Projects: []*workspace.Project{
+			newProject("p1", "https://github.com/daytonaio/daytona", &workspace.ProjectPrebuild{Hit: true, BuildId: "b1", Sha: "sha1"}),
+		},
+	})
+	require.Nil(t, err)
+
+	stats, err := service.GetPrebuildStats(nil)
+	require.Nil(t, err)
+
+	require.Equal(t, 2, stats.Hits)
+	require.Equal(t, 1, stats.Misses)
+	require.InDelta(t, 2.0/3.0, stats.HitRate, 1e-9)
+	require.Equal(t, []dto.RepositoryPrebuildStats{
+		{RepositoryUrl: "https://github.com/daytonaio/daytona", Hits: 2, HitRate: 1},
+		{RepositoryUrl: "https://github.com/daytonaio/docs", Misses: 1, HitRate: 0},
+	}, stats.Repositories)
+
+	t.Run("only includes the workspaces of the owner", func(t *testing.T) {
+		stats, err := service.GetPrebuildStats(func(ownerId string) bool {
+			return ownerId == "user1"
+		})
+		require.Nil(t, err)
+
+		require.Equal(t, 1, stats.Hits)
+		require.Equal(t, 0, stats.Misses)
+		require.Equal(t, []dto.RepositoryPrebuildStats{
+			{RepositoryUrl: "https://github.com/daytonaio/daytona", Hits: 1, HitRate: 1},
+		}, stats.Repositories)
+	})
+}
+
+func TestProjectDependencies(t *testing.T) {
+	workspaceStore := t_workspaces.NewInMemoryWorkspaceStore()
+
+	targetStore := t_targets.NewInMemoryTargetStore()
+	err := targetStore.Save(&target)
+	require.Nil(t, err)
+
+	apiKeyService := mocks.NewMockApiKeyService()
+	provisioner := mocks.NewMockProvisioner()
+
+	service := workspaces.NewWorkspaceService(workspaces.WorkspaceServiceConfig{
+		WorkspaceStore:           workspaceStore,
+		TargetStore:              targetStore,
+		ApiKeyService:            apiKeyService,
+		Provisioner:              provisioner,
+		LoggerFactory:            logs.NewLoggerFactory(t.TempDir()),
+		GitProviderService:       mocks.NewMockGitProviderService(),
+		ProjectDependencyTimeout: time.Minute,
+	})
+
+	t.Run("CreateWorkspace fails when project dependencies contain a cycle", func(t *testing.T) {
+		apiKeyService.On("Generate", mock.Anything, mock.Anything).Return("api-key", nil)
+
+		_, err := service.CreateWorkspace(dto.CreateWorkspaceRequest{
+			Id:     "cycle",
+			Name:   "cycle",
+			Target: target.Name,
+			Projects: []dto.CreateWorkspaceRequestProject{
+				{Name: "api", DependsOn: []string{"db"}},
+				{Name: "db", DependsOn: []string{"api"}},
+			},
+		})
+		require.ErrorIs(t, err, workspace.ErrProjectDependencyCycle)
+
+		_, err = workspaceStore.Find("cycle")
+		require.NotNil(t, err)
+	})
+
+	err = workspaceStore.Save(&workspace.Workspace{
+		Id:     "ws",
+		Name:   "ws",
+		Target: target.Name,
+		Projects: []*workspace.Project{
+			{Name: "api", WorkspaceId: "ws", Target: target.Name, Repository: &gitprovider.GitRepository{}, DependsOn: []string{"db"}},
+			{Name: "db", WorkspaceId: "ws", Target: target.Name, Repository: &gitprovider.GitRepository{}},
+		},
+	})
+	require.Nil(t, err)
+
+	startedProjects := []string{}
+
+	provisioner.On("StartWorkspace", mock.Anything, &target).Return(nil)
+	provisioner.On("StartProject", mock.Anything, &target).Run(func(args mock.Arguments) {
+		project := args.Get(0).(*workspace.Project)
+		startedProjects = append(startedProjects, project.Name)
+
+		// The agent reports the project state once the project is started
+		_, err := service.SetProjectState("ws", project.Name, &workspace.ProjectState{UpdatedAt: time.Now().Format(time.RFC1123)})
+		require.Nil(t, err)
+	}).Return(nil)
+
+	t.Run("StartWorkspace starts dependencies first", func(t *testing.T) {
+		err := service.StartWorkspace("ws")
+		require.Nil(t, err)
+		require.Equal(t, []string{"db", "api"}, startedProjects)
+	})
+
+	t.Run("StartProject starts stopped dependencies", func(t *testing.T) {
+		w, err := workspaceStore.Find("ws")
+		require.Nil(t, err)
+		for _, project := range w.Projects {
+			project.Status = workspace.ProjectStatusStopped
+		}
+		require.Nil(t, workspaceStore.Save(w))
+
+		startedProjects = []string{}
+
+		err = service.StartProject("ws", "api")
+		require.Nil(t, err)
+		require.Equal(t, []string{"db", "api"}, startedProjects)
+
+		// Running dependencies are not started again
+		startedProjects = []string{}
+
+		err = service.StartProject("ws", "api")
+		require.Nil(t, err)
+		require.Equal(t, []string{"api"}, startedProjects)
+	})
+}
@@ -0,0 +1,174 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apikey"
+	"github.com/daytonaio/daytona/pkg/logs"
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/daytonaio/daytona/pkg/workspace"
+)
+
+// Path of the sharing link relative to the server API URL
+const ShareLinkPath = "/share"
+
+func (s *WorkspaceService) ShareWorkspace(workspaceId string, duration time.Duration) (*dto.WorkspaceShare, error) {
+	if duration <= 0 {
+		return nil, ErrInvalidShareDuration
+	}
+
+	// Share holders join the network, so the projects must only accept the SSH certificates issued for the share
+	if s.sshCertificateAuthority == nil {
+		return nil, ErrSshCertificatesRequired
+	}
+
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	shareKey, key, err := s.apiKeyService.GenerateShareKey(w.Id, time.Now().Add(duration))
+	if err != nil {
+		return nil, err
+	}
+
+	shareUrl, err := url.JoinPath(s.serverApiUrl, ShareLinkPath)
+	if err != nil {
+		return nil, err
+	}
+
+	wsLogger := s.loggerFactory.CreateWorkspaceLogger(w.Id, logs.LogSourceServer)
+	defer wsLogger.Close()
+
+	wsLogger.Write([]byte(fmt.Sprintf("Workspace shared as %s until %s\n", shareKey.Name, shareKey.ExpiresAt)))
+
+	share := toWorkspaceShare(shareKey)
+	share.Url = fmt.Sprintf("%s?token=%s", shareUrl, url.QueryEscape(key))
+
+	return &share, nil
+}
+
+func (s *WorkspaceService) ListWorkspaceShares(workspaceId string) ([]dto.WorkspaceShare, error) {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	shareKeys, err := s.apiKeyService.ListShareKeys(w.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	shares := []dto.WorkspaceShare{}
+	for _, shareKey := range shareKeys {
+		if shareKey.IsExpired() {
+			continue
+		}
+		shares = append(shares, toWorkspaceShare(shareKey))
+	}
+
+	return shares, nil
+}
+
+// Revokes the workspace share with the given name. All shares of the workspace are revoked if shareName is empty
+func (s *WorkspaceService) RevokeWorkspaceShare(workspaceId string, shareName string) error {
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return ErrWorkspaceNotFound
+	}
+
+	shareKeys, err := s.apiKeyService.ListShareKeys(w.Id)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for _, shareKey := range shareKeys {
+		if shareName != "" && shareKey.Name != shareName {
+			continue
+		}
+
+		err = s.revokeShare(shareKey)
+		if err != nil {
+			return err
+		}
+		found = true
+	}
+
+	if shareName != "" && !found {
+		return ErrWorkspaceShareNotFound
+	}
+
+	return s.UpdateNetworkAccess()
+}
+
+// IssueSharedSshCertificate signs the public key of a share holder with a certificate for the project that expires
+// with the share at the latest
+func (s *WorkspaceService) IssueSharedSshCertificate(workspaceId, shareName, projectName, publicKey string) (*dto.SshCertificate, error) {
+	shareKeys, err := s.apiKeyService.ListShareKeys(workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, shareKey := range shareKeys {
+		if shareKey.Name != shareName || shareKey.IsExpired() {
+			continue
+		}
+
+		ttl := s.sshCertificateTtl
+
+		expiresAt, err := time.Parse(time.RFC3339, shareKey.ExpiresAt)
+		if err == nil && time.Until(expiresAt) < ttl {
+			ttl = time.Until(expiresAt)
+		}
+
+		return s.issueSshCertificate(workspaceId, projectName, publicKey, ttl)
+	}
+
+	return nil, ErrWorkspaceShareNotFound
+}
+
+// Returns the workspace for a share key holder. Project environment variables are omitted since they contain the project API keys
+func (s *WorkspaceService) GetSharedWorkspace(workspaceId string) (*dto.SharedWorkspace, error) {
+	w, err := s.GetWorkspace(workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	projects := []*workspace.Project{}
+	for _, project := range w.Projects {
+		p := *project
+		p.EnvVars = nil
+		projects = append(projects, &p)
+	}
+	w.Projects = projects
+
+	sharedWorkspace := &dto.SharedWorkspace{
+		Workspace: w.Workspace,
+		Info:      w.Info,
+	}
+
+	target, err := s.targetStore.Find(w.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	if target.NetworkMode != nil {
+		sharedWorkspace.NetworkMode = *target.NetworkMode
+	}
+
+	return sharedWorkspace, nil
+}
+
+func toWorkspaceShare(shareKey *apikey.ApiKey) dto.WorkspaceShare {
+	return dto.WorkspaceShare{
+		Name:        shareKey.Name,
+		WorkspaceId: shareKey.WorkspaceId,
+		ExpiresAt:   shareKey.ExpiresAt,
+	}
+}
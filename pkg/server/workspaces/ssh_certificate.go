@@ -0,0 +1,59 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspaces
+
+import (
+	"strings"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/server/workspaces/dto"
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"golang.org/x/crypto/ssh"
+)
+
+const SSH_CA_PUBLIC_KEY_ENV_VAR = "DAYTONA_SSH_CA_PUBLIC_KEY"
+
+// IssueSshCertificate signs the public key with a certificate accepted only by the agent of the project
+func (s *WorkspaceService) IssueSshCertificate(workspaceId, projectName, publicKey string) (*dto.SshCertificate, error) {
+	return s.issueSshCertificate(workspaceId, projectName, publicKey, s.sshCertificateTtl)
+}
+
+func (s *WorkspaceService) issueSshCertificate(workspaceId, projectName, publicKey string, ttl time.Duration) (*dto.SshCertificate, error) {
+	if s.sshCertificateAuthority == nil {
+		return nil, ErrSshCertificatesDisabled
+	}
+
+	w, err := s.workspaceStore.Find(workspaceId)
+	if err != nil {
+		return nil, ErrWorkspaceNotFound
+	}
+
+	project, err := w.GetProject(projectName)
+	if err != nil {
+		return nil, ErrProjectNotFound
+	}
+
+	// Agents only trust certificates issued for their own hostname
+	principal := workspace.GetProjectHostname(w.Id, project.Name)
+
+	cert, err := s.sshCertificateAuthority.IssueUserCertificate(publicKey, principal, []string{principal}, ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.SshCertificate{
+		Certificate: strings.TrimSpace(string(ssh.MarshalAuthorizedKey(cert))),
+		ExpiresAt:   time.Unix(int64(cert.ValidBefore), 0).Format(time.RFC3339),
+	}, nil
+}
+
+func (s *WorkspaceService) getProjectEnvVars(project *workspace.Project) map[string]string {
+	envVars := workspace.GetProjectEnvVars(project, s.serverApiUrl, s.serverUrl)
+
+	if s.sshCertificateAuthority != nil {
+		envVars[SSH_CA_PUBLIC_KEY_ENV_VAR] = s.sshCertificateAuthority.PublicKey()
+	}
+
+	return envVars
+}
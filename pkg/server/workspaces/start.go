@@ -6,20 +6,31 @@ package workspaces
 import (
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/daytonaio/daytona/pkg/logs"
 	"github.com/daytonaio/daytona/pkg/provider"
+	"github.com/daytonaio/daytona/pkg/server/events"
 	"github.com/daytonaio/daytona/pkg/workspace"
 
 	"github.com/daytonaio/daytona/internal/util"
+
+	log "github.com/sirupsen/logrus"
 )
 
+// Agents report the project state every 2 seconds
+const projectHealthCheckInterval = 2 * time.Second
+
 func (s *WorkspaceService) StartWorkspace(workspaceId string) error {
 	w, err := s.workspaceStore.Find(workspaceId)
 	if err != nil {
 		return ErrWorkspaceNotFound
 	}
 
+	if w.IsArchived() {
+		return ErrWorkspaceArchived
+	}
+
 	target, err := s.targetStore.Find(w.Target)
 	if err != nil {
 		return err
@@ -30,7 +41,18 @@ func (s *WorkspaceService) StartWorkspace(workspaceId string) error {
 
 	wsLogWriter := io.MultiWriter(&util.InfoLogWriter{}, workspaceLogger)
 
-	return s.startWorkspace(w, target, wsLogWriter)
+	defer s.markBusy(w.Id)()
+
+	err = s.startWorkspace(w, target, wsLogWriter)
+	if err != nil {
+		s.emitEvent(events.Event{
+			Type:        events.EventTypeWorkspaceError,
+			WorkspaceId: w.Id,
+			Message:     fmt.Sprintf("Starting workspace %s failed: %s", w.Name, err.Error()),
+		})
+	}
+
+	return err
 }
 
 func (s *WorkspaceService) StartProject(workspaceId, projectName string) error {
@@ -44,6 +66,10 @@ func (s *WorkspaceService) StartProject(workspaceId, projectName string) error {
 		return ErrProjectNotFound
 	}
 
+	if project.Status == workspace.ProjectStatusArchived {
+		return ErrWorkspaceArchived
+	}
+
 	target, err := s.targetStore.Find(project.Target)
 	if err != nil {
 		return err
@@ -52,44 +78,159 @@ func (s *WorkspaceService) StartProject(workspaceId, projectName string) error {
 	projectLogger := s.loggerFactory.CreateProjectLogger(w.Id, project.Name, logs.LogSourceServer)
 	defer projectLogger.Close()
 
-	return s.startProject(project, target, projectLogger)
+	defer s.markBusy(w.Id)()
+
+	err = s.startProjectDependencies(w, project.Name)
+	if err == nil {
+		err = s.startProject(project, target, projectLogger)
+	}
+	if err != nil {
+		s.emitEvent(events.Event{
+			Type:        events.EventTypeWorkspaceError,
+			WorkspaceId: w.Id,
+			ProjectName: project.Name,
+			Message:     fmt.Sprintf("Starting project %s of workspace %s failed: %s", project.Name, w.Name, err.Error()),
+		})
+	}
+
+	return err
 }
 
-func (s *WorkspaceService) startWorkspace(workspace *workspace.Workspace, target *provider.ProviderTarget, wsLogWriter io.Writer) error {
+func (s *WorkspaceService) startWorkspace(w *workspace.Workspace, target *provider.ProviderTarget, wsLogWriter io.Writer) error {
 	wsLogWriter.Write([]byte("Starting workspace\n"))
 
-	err := s.provisioner.StartWorkspace(workspace, target)
+	err := s.provisioner.StartWorkspace(w, target)
 	if err != nil {
 		return err
 	}
 
-	for _, project := range workspace.Projects {
-		projectLogger := s.loggerFactory.CreateProjectLogger(workspace.Id, project.Name, logs.LogSourceServer)
-		defer projectLogger.Close()
+	projects, err := workspace.GetProjectStartOrder(w.Projects)
+	if err != nil {
+		return err
+	}
 
-		err = s.startProject(project, target, projectLogger)
+	for _, project := range projects {
+		err = s.startProjectInOrder(w, project, target)
 		if err != nil {
 			return err
 		}
 	}
 
-	wsLogWriter.Write([]byte(fmt.Sprintf("Workspace %s started\n", workspace.Name)))
+	wsLogWriter.Write([]byte(fmt.Sprintf("Workspace %s started\n", w.Name)))
+
+	return nil
+}
+
+// Starts the dependencies of the project that are not running so that the project can rely on them
+func (s *WorkspaceService) startProjectDependencies(w *workspace.Workspace, projectName string) error {
+	stoppedDependencies := []*workspace.Project{}
+	for _, dependency := range w.GetProjectDependencies(projectName) {
+		if dependency.Status != workspace.ProjectStatusRunning {
+			stoppedDependencies = append(stoppedDependencies, dependency)
+		}
+	}
+
+	dependencies, err := workspace.GetProjectStartOrder(stoppedDependencies)
+	if err != nil {
+		return err
+	}
+
+	for _, dependency := range dependencies {
+		target, err := s.targetStore.Find(dependency.Target)
+		if err != nil {
+			return err
+		}
+
+		err = s.startProjectInOrder(w, dependency, target)
+		if err != nil {
+			return fmt.Errorf("failed to start dependency %s: %w", dependency.Name, err)
+		}
+	}
 
 	return nil
 }
 
+// Starts the project and waits until it is healthy if other projects depend on it
+func (s *WorkspaceService) startProjectInOrder(w *workspace.Workspace, project *workspace.Project, target *provider.ProviderTarget) error {
+	projectLogger := s.loggerFactory.CreateProjectLogger(w.Id, project.Name, logs.LogSourceServer)
+	defer projectLogger.Close()
+
+	startedAt := time.Now()
+
+	err := s.startProject(project, target, projectLogger)
+	if err != nil {
+		return err
+	}
+
+	if !w.HasDependents(project.Name) {
+		return nil
+	}
+
+	return s.waitForProjectHealthy(project, startedAt, projectLogger)
+}
+
+// A project is healthy once its agent reports the project state after the project was started
+func (s *WorkspaceService) waitForProjectHealthy(project *workspace.Project, startedAt time.Time, logWriter io.Writer) error {
+	if s.projectDependencyTimeout == 0 {
+		return nil
+	}
+
+	logWriter.Write([]byte(fmt.Sprintf("Waiting for project %s to become healthy before starting the projects that depend on it\n", project.Name)))
+
+	// The state update time is reported with a precision of seconds
+	startedAt = startedAt.Truncate(time.Second)
+	timeout := time.After(s.projectDependencyTimeout)
+
+	for {
+		w, err := s.workspaceStore.Find(project.WorkspaceId)
+		if err != nil {
+			return err
+		}
+
+		p, err := w.GetProject(project.Name)
+		if err != nil {
+			return err
+		}
+
+		if p.State != nil {
+			updatedAt, err := time.Parse(time.RFC1123, p.State.UpdatedAt)
+			if err == nil && !updatedAt.Before(startedAt) {
+				logWriter.Write([]byte(fmt.Sprintf("Project %s is healthy\n", project.Name)))
+				return nil
+			}
+		}
+
+		select {
+		case <-timeout:
+			return fmt.Errorf("%w: %s did not report its state within %s", ErrProjectNotHealthy, project.Name, s.projectDependencyTimeout)
+		case <-time.After(projectHealthCheckInterval):
+		}
+	}
+}
+
 func (s *WorkspaceService) startProject(project *workspace.Project, target *provider.ProviderTarget, logWriter io.Writer) error {
 	logWriter.Write([]byte(fmt.Sprintf("Starting project %s\n", project.Name)))
 
 	projectToStart := *project
-	projectToStart.EnvVars = workspace.GetProjectEnvVars(project, s.serverApiUrl, s.serverUrl)
+	projectToStart.EnvVars = s.getProjectEnvVars(project)
 
 	err := s.provisioner.StartProject(project, target)
+	if err != nil {
+		statusErr := s.setProjectStatus(project.WorkspaceId, project.Name, workspace.ProjectStatusError, err.Error())
+		if statusErr != nil {
+			log.Errorf("Failed to set the status of project %s: %s", project.Name, statusErr)
+		}
+		return err
+	}
+
+	err = s.setProjectStatus(project.WorkspaceId, project.Name, workspace.ProjectStatusRunning, "")
 	if err != nil {
 		return err
 	}
 
 	logWriter.Write([]byte(fmt.Sprintf("Project %s started\n", project.Name)))
 
+	s.startPortForwards(project, logWriter)
+
 	return nil
 }
@@ -5,37 +5,52 @@ package workspaces
 
 import (
 	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
 )
 
 func (s *WorkspaceService) StopWorkspace(workspaceId string) error {
-	workspace, err := s.workspaceStore.Find(workspaceId)
+	w, err := s.workspaceStore.Find(workspaceId)
 	if err != nil {
 		return ErrWorkspaceNotFound
 	}
 
-	target, err := s.targetStore.Find(workspace.Target)
+	if w.IsArchived() {
+		return ErrWorkspaceArchived
+	}
+
+	target, err := s.targetStore.Find(w.Target)
 	if err != nil {
 		return err
 	}
 
-	for _, project := range workspace.Projects {
+	defer s.markBusy(w.Id)()
+
+	for _, project := range w.Projects {
 		//	todo: go routines
+		s.stopPortForwards(project)
+
 		err := s.provisioner.StopProject(project, target)
 		if err != nil {
 			return err
 		}
 		if project.State != nil {
 			project.State.Uptime = 0
+			project.State.Resources = nil
 			project.State.UpdatedAt = time.Now().Format(time.RFC1123)
 		}
+		project.Status = workspace.ProjectStatusStopped
+		project.StatusError = ""
 	}
 
-	err = s.provisioner.StopWorkspace(workspace, target)
+	err = s.provisioner.StopWorkspace(w, target)
 	if err != nil {
 		return err
 	}
 
-	return s.workspaceStore.Save(workspace)
+	accumulateCost(w)
+
+	return s.workspaceStore.Save(w)
 }
 
 func (s *WorkspaceService) StopProject(workspaceId, projectName string) error {
@@ -49,11 +64,19 @@ func (s *WorkspaceService) StopProject(workspaceId, projectName string) error {
 		return ErrProjectNotFound
 	}
 
+	if project.Status == workspace.ProjectStatusArchived {
+		return ErrWorkspaceArchived
+	}
+
 	target, err := s.targetStore.Find(w.Target)
 	if err != nil {
 		return err
 	}
 
+	defer s.markBusy(w.Id)()
+
+	s.stopPortForwards(project)
+
 	err = s.provisioner.StopProject(project, target)
 	if err != nil {
 		return err
@@ -61,8 +84,13 @@ func (s *WorkspaceService) StopProject(workspaceId, projectName string) error {
 
 	if project.State != nil {
 		project.State.Uptime = 0
+		project.State.Resources = nil
 		project.State.UpdatedAt = time.Now().Format(time.RFC1123)
 	}
+	project.Status = workspace.ProjectStatusStopped
+	project.StatusError = ""
+
+	accumulateCost(w)
 
 	return s.workspaceStore.Save(w)
 }
@@ -0,0 +1,243 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sessionrecording
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// Recordings are stored in the asciicast v2 format (https://docs.asciinema.org/manual/asciicast/v2/)
+// so that they can also be replayed with asciinema
+const CastVersion = 2
+
+// Output is not recorded anymore once the recording reaches this size
+const MaxCastSize = 32 << 20
+
+const (
+	CastEventOutput = "o"
+	CastEventResize = "r"
+)
+
+var ErrInvalidCast = errors.New("invalid asciicast v2 recording")
+
+type CastHeader struct {
+	Version int `json:"version"`
+	Width   int `json:"width"`
+	Height  int `json:"height"`
+	// Unix timestamp of the session start
+	Timestamp int64             `json:"timestamp,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+type CastEvent struct {
+	// Seconds since the session start
+	Time float64
+	Type string
+	Data string
+}
+
+// Recorder records the terminal output written to it
+type Recorder struct {
+	mu        sync.Mutex
+	buf       bytes.Buffer
+	startedAt time.Time
+	// Trailing bytes of an incomplete UTF-8 sequence, written with the next output
+	pending   []byte
+	truncated bool
+}
+
+func NewRecorder(width, height int, env map[string]string) *Recorder {
+	r := &Recorder{
+		startedAt: time.Now(),
+	}
+
+	header, _ := json.Marshal(CastHeader{
+		Version:   CastVersion,
+		Width:     width,
+		Height:    height,
+		Timestamp: r.startedAt.Unix(),
+		Env:       env,
+	})
+	r.buf.Write(header)
+	r.buf.WriteByte('\n')
+
+	return r
+}
+
+func (r *Recorder) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data := append(r.pending, p...)
+	complete := len(data) - incompleteRuneSuffixLen(data)
+
+	r.writeEvent(CastEventOutput, string(data[:complete]))
+	r.pending = append([]byte{}, data[complete:]...)
+
+	return len(p), nil
+}
+
+func (r *Recorder) Resize(width, height int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.writeEvent(CastEventResize, fmt.Sprintf("%dx%d", width, height))
+}
+
+func (r *Recorder) StartedAt() time.Time {
+	return r.startedAt
+}
+
+// Cast returns the recording in the asciicast v2 format
+func (r *Recorder) Cast() []byte {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.pending) > 0 {
+		r.writeEvent(CastEventOutput, string(r.pending))
+		r.pending = nil
+	}
+
+	return append([]byte{}, r.buf.Bytes()...)
+}
+
+func (r *Recorder) writeEvent(eventType, data string) {
+	if r.truncated || data == "" {
+		return
+	}
+
+	event, _ := json.Marshal([]interface{}{time.Since(r.startedAt).Seconds(), eventType, data})
+	if r.buf.Len()+len(event)+1 > MaxCastSize {
+		r.truncated = true
+		return
+	}
+
+	r.buf.Write(event)
+	r.buf.WriteByte('\n')
+}
+
+// ParseCast reads an asciicast v2 recording
+func ParseCast(cast io.Reader) (*CastHeader, []CastEvent, error) {
+	scanner := bufio.NewScanner(cast)
+	scanner.Buffer(make([]byte, 64*1024), MaxCastSize)
+
+	if !scanner.Scan() {
+		return nil, nil, fmt.Errorf("%w: missing header", ErrInvalidCast)
+	}
+
+	var header CastHeader
+	err := json.Unmarshal(scanner.Bytes(), &header)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %s", ErrInvalidCast, err)
+	}
+
+	if header.Version != CastVersion {
+		return nil, nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidCast, header.Version)
+	}
+
+	events := []CastEvent{}
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		var fields []interface{}
+		err := json.Unmarshal(scanner.Bytes(), &fields)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: %s", ErrInvalidCast, err)
+		}
+
+		event, ok := toCastEvent(fields)
+		if !ok {
+			return nil, nil, fmt.Errorf("%w: malformed event %s", ErrInvalidCast, scanner.Text())
+		}
+
+		events = append(events, event)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("%w: %s", ErrInvalidCast, err)
+	}
+
+	return &header, events, nil
+}
+
+// Play writes the recorded output to w with the recorded timing divided by speed.
+// Pauses longer than maxIdle are shortened to maxIdle if it is set
+func Play(w io.Writer, events []CastEvent, speed float64, maxIdle time.Duration) error {
+	if speed <= 0 {
+		speed = 1
+	}
+
+	previous := 0.0
+	for _, event := range events {
+		delay := time.Duration((event.Time - previous) / speed * float64(time.Second))
+		if maxIdle > 0 && delay > maxIdle {
+			delay = maxIdle
+		}
+		previous = event.Time
+
+		if delay > 0 {
+			time.Sleep(delay)
+		}
+
+		if event.Type != CastEventOutput {
+			continue
+		}
+
+		_, err := io.WriteString(w, event.Data)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func toCastEvent(fields []interface{}) (CastEvent, bool) {
+	if len(fields) != 3 {
+		return CastEvent{}, false
+	}
+
+	eventTime, ok := fields[0].(float64)
+	if !ok {
+		return CastEvent{}, false
+	}
+
+	eventType, ok := fields[1].(string)
+	if !ok {
+		return CastEvent{}, false
+	}
+
+	data, ok := fields[2].(string)
+	if !ok {
+		return CastEvent{}, false
+	}
+
+	return CastEvent{Time: eventTime, Type: eventType, Data: data}, true
+}
+
+// Returns the length of an incomplete UTF-8 sequence at the end of data
+func incompleteRuneSuffixLen(data []byte) int {
+	for i := 1; i <= utf8.UTFMax && i <= len(data); i++ {
+		if !utf8.RuneStart(data[len(data)-i]) {
+			continue
+		}
+
+		if utf8.FullRune(data[len(data)-i:]) {
+			return 0
+		}
+		return i
+	}
+
+	return 0
+}
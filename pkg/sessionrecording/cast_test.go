@@ -0,0 +1,80 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sessionrecording_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/sessionrecording"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder(t *testing.T) {
+	recorder := sessionrecording.NewRecorder(80, 24, map[string]string{"TERM": "xterm-256color"})
+
+	_, err := recorder.Write([]byte("$ echo "))
+	require.NoError(t, err)
+	recorder.Resize(120, 40)
+
+	// "héllo" split in the middle of the two byte é
+	hello := []byte("héllo\r\n")
+	_, err = recorder.Write(hello[:2])
+	require.NoError(t, err)
+	_, err = recorder.Write(hello[2:])
+	require.NoError(t, err)
+
+	header, events, err := sessionrecording.ParseCast(bytes.NewReader(recorder.Cast()))
+	require.NoError(t, err)
+
+	require.Equal(t, sessionrecording.CastVersion, header.Version)
+	require.Equal(t, 80, header.Width)
+	require.Equal(t, 24, header.Height)
+	require.Equal(t, recorder.StartedAt().Unix(), header.Timestamp)
+	require.Equal(t, "xterm-256color", header.Env["TERM"])
+
+	require.Len(t, events, 4)
+	require.Equal(t, sessionrecording.CastEventResize, events[1].Type)
+	require.Equal(t, "120x40", events[1].Data)
+
+	output := ""
+	for _, event := range events {
+		if event.Type == sessionrecording.CastEventOutput {
+			output += event.Data
+		}
+		require.GreaterOrEqual(t, event.Time, 0.0)
+	}
+	require.Equal(t, "$ echo héllo\r\n", output)
+}
+
+func TestParseInvalidCast(t *testing.T) {
+	_, _, err := sessionrecording.ParseCast(strings.NewReader(""))
+	require.ErrorIs(t, err, sessionrecording.ErrInvalidCast)
+
+	_, _, err = sessionrecording.ParseCast(strings.NewReader(`{"version": 1, "width": 80, "height": 24}`))
+	require.ErrorIs(t, err, sessionrecording.ErrInvalidCast)
+
+	_, _, err = sessionrecording.ParseCast(strings.NewReader("{\"version\": 2, \"width\": 80, \"height\": 24}\n[0.5, \"o\"]\n"))
+	require.ErrorIs(t, err, sessionrecording.ErrInvalidCast)
+}
+
+func TestPlay(t *testing.T) {
+	events := []sessionrecording.CastEvent{
+		{Time: 0, Type: sessionrecording.CastEventOutput, Data: "$ ls\r\n"},
+		{Time: 0.01, Type: sessionrecording.CastEventResize, Data: "100x30"},
+		// Idle for an hour
+		{Time: 3600, Type: sessionrecording.CastEventOutput, Data: "README.md\r\n"},
+	}
+
+	var output bytes.Buffer
+	startedAt := time.Now()
+
+	err := sessionrecording.Play(&output, events, 1, 10*time.Millisecond)
+	require.NoError(t, err)
+
+	require.Equal(t, "$ ls\r\nREADME.md\r\n", output.String())
+	require.Less(t, time.Since(startedAt), time.Second)
+}
@@ -0,0 +1,19 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sessionrecording
+
+// SessionRecording is a terminal session of a project SSH connection recorded by the project agent
+type SessionRecording struct {
+	Id          string `json:"id" validate:"required"`
+	WorkspaceId string `json:"workspaceId" validate:"required"`
+	// Kept to identify the recording after the workspace is removed
+	WorkspaceName string `json:"workspaceName" validate:"required"`
+	ProjectName   string `json:"projectName" validate:"required"`
+	// RFC3339 timestamp of the session start
+	StartedAt string `json:"startedAt" validate:"required"`
+	// Session duration in seconds
+	Duration float64 `json:"duration" validate:"required"`
+	// Size of the cast file in bytes
+	Size int64 `json:"size" validate:"required"`
+} // @name SessionRecording
@@ -0,0 +1,21 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sessionrecording
+
+import "errors"
+
+type Store interface {
+	List() ([]*SessionRecording, error)
+	Find(id string) (*SessionRecording, error)
+	Save(recording *SessionRecording) error
+	Delete(recording *SessionRecording) error
+}
+
+var (
+	ErrSessionRecordingNotFound = errors.New("session recording not found")
+)
+
+func IsSessionRecordingNotFound(err error) bool {
+	return err.Error() == ErrSessionRecordingNotFound.Error()
+}
@@ -0,0 +1,166 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sshca
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// Certificates are valid slightly before they are issued to tolerate clock skew between the server and the projects
+const clockSkew = 5 * time.Minute
+
+var (
+	ErrInvalidCertificate = errors.New("invalid SSH certificate")
+	ErrInvalidPublicKey   = errors.New("invalid public key")
+)
+
+// CertificateAuthority issues short-lived SSH user certificates trusted by the project agents
+type CertificateAuthority struct {
+	signer ssh.Signer
+}
+
+// LoadOrCreate loads the CA private key from keyPath. A new Ed25519 key is generated there if the file does not exist
+func LoadOrCreate(keyPath string) (*CertificateAuthority, error) {
+	content, err := os.ReadFile(keyPath)
+	if err == nil {
+		signer, err := ssh.ParsePrivateKey(content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the SSH CA key: %w", err)
+		}
+		return &CertificateAuthority{signer: signer}, nil
+	}
+
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	pemBlock, err := ssh.MarshalPrivateKey(privateKey, "daytona-ssh-ca")
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(filepath.Dir(keyPath), 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.WriteFile(keyPath, pem.EncodeToMemory(pemBlock), 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := ssh.NewSignerFromKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CertificateAuthority{signer: signer}, nil
+}
+
+// PublicKey returns the CA public key in the authorized_keys format
+func (ca *CertificateAuthority) PublicKey() string {
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(ca.signer.PublicKey())))
+}
+
+// IssueUserCertificate signs the authorized_keys formatted public key. The certificate is only accepted by
+// agents that expect one of the principals
+func (ca *CertificateAuthority) IssueUserCertificate(publicKey string, keyId string, principals []string, ttl time.Duration) (*ssh.Certificate, error) {
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidPublicKey, err)
+	}
+
+	if _, ok := key.(*ssh.Certificate); ok {
+		return nil, fmt.Errorf("%w: certificates can not be signed", ErrInvalidPublicKey)
+	}
+
+	serial := make([]byte, 8)
+	_, err = rand.Read(serial)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	cert := &ssh.Certificate{
+		Key:             key,
+		Serial:          binary.BigEndian.Uint64(serial),
+		CertType:        ssh.UserCert,
+		KeyId:           keyId,
+		ValidPrincipals: principals,
+		ValidAfter:      uint64(now.Add(-clockSkew).Unix()),
+		ValidBefore:     uint64(now.Add(ttl).Unix()),
+		Permissions: ssh.Permissions{
+			Extensions: map[string]string{
+				"permit-agent-forwarding": "",
+				"permit-port-forwarding":  "",
+				"permit-pty":              "",
+			},
+		},
+	}
+
+	err = cert.SignCert(rand.Reader, ca.signer)
+	if err != nil {
+		return nil, err
+	}
+
+	return cert, nil
+}
+
+// CertificateChecker verifies user certificates issued by the CA with the given public key
+type CertificateChecker struct {
+	caPublicKey ssh.PublicKey
+	checker     *ssh.CertChecker
+}
+
+func NewCertificateChecker(caPublicKey string) (*CertificateChecker, error) {
+	key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(caPublicKey))
+	if err != nil {
+		return nil, fmt.Errorf("invalid SSH CA public key: %w", err)
+	}
+
+	c := &CertificateChecker{caPublicKey: key}
+	c.checker = &ssh.CertChecker{
+		IsUserAuthority: func(auth ssh.PublicKey) bool {
+			return string(auth.Marshal()) == string(c.caPublicKey.Marshal())
+		},
+	}
+
+	return c, nil
+}
+
+// Check returns nil if the key is a valid, unexpired certificate signed by the CA for the principal
+func (c *CertificateChecker) Check(principal string, key ssh.PublicKey) error {
+	cert, ok := key.(*ssh.Certificate)
+	if !ok || cert.CertType != ssh.UserCert {
+		return ErrInvalidCertificate
+	}
+
+	if !c.checker.IsUserAuthority(cert.SignatureKey) {
+		return fmt.Errorf("%w: not signed by the Daytona SSH CA", ErrInvalidCertificate)
+	}
+
+	err := c.checker.CheckCert(principal, cert)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidCertificate, err)
+	}
+
+	return nil
+}
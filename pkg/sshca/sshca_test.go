@@ -0,0 +1,65 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sshca_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/sshca"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func generatePublicKey(t *testing.T) string {
+	publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.Nil(t, err)
+
+	sshPublicKey, err := ssh.NewPublicKey(publicKey)
+	require.Nil(t, err)
+
+	return string(ssh.MarshalAuthorizedKey(sshPublicKey))
+}
+
+func TestCertificateAuthority(t *testing.T) {
+	keyPath := filepath.Join(t.TempDir(), "ssh_ca")
+
+	ca, err := sshca.LoadOrCreate(keyPath)
+	require.Nil(t, err)
+
+	// The generated key is reused
+	loaded, err := sshca.LoadOrCreate(keyPath)
+	require.Nil(t, err)
+	require.Equal(t, ca.PublicKey(), loaded.PublicKey())
+
+	cert, err := ca.IssueUserCertificate(generatePublicKey(t), "default", []string{"ws1-project1"}, time.Hour)
+	require.Nil(t, err)
+	require.Equal(t, []string{"ws1-project1"}, cert.ValidPrincipals)
+
+	checker, err := sshca.NewCertificateChecker(ca.PublicKey())
+	require.Nil(t, err)
+
+	require.Nil(t, checker.Check("ws1-project1", cert))
+
+	err = checker.Check("ws1-project2", cert)
+	require.True(t, errors.Is(err, sshca.ErrInvalidCertificate))
+
+	// Plain public keys are rejected
+	require.True(t, errors.Is(checker.Check("ws1-project1", cert.Key), sshca.ErrInvalidCertificate))
+
+	expired, err := ca.IssueUserCertificate(generatePublicKey(t), "default", []string{"ws1-project1"}, -time.Hour)
+	require.Nil(t, err)
+	require.True(t, errors.Is(checker.Check("ws1-project1", expired), sshca.ErrInvalidCertificate))
+
+	otherCa, err := sshca.LoadOrCreate(filepath.Join(t.TempDir(), "ssh_ca"))
+	require.Nil(t, err)
+
+	otherCert, err := otherCa.IssueUserCertificate(generatePublicKey(t), "default", []string{"ws1-project1"}, time.Hour)
+	require.Nil(t, err)
+	require.True(t, errors.Is(checker.Check("ws1-project1", otherCert), sshca.ErrInvalidCertificate))
+}
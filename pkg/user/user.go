@@ -0,0 +1,26 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package user
+
+type Role string // @name UserRole
+
+const (
+	// Manages the server and users and can access the resources of all users
+	RoleAdmin Role = "admin"
+	// Can only access the workspaces, git providers and targets they own
+	RoleMember Role = "member"
+)
+
+type User struct {
+	Id   string `json:"id" validate:"required"`
+	Name string `json:"name" validate:"required"`
+	Role Role   `json:"role" validate:"required"`
+	// Disabled users can no longer authenticate with their API keys. Their resources are kept
+	Disabled  bool   `json:"disabled" validate:"required"`
+	CreatedAt string `json:"createdAt" validate:"required"`
+} // @name User
+
+func (u *User) IsAdmin() bool {
+	return u.Role == RoleAdmin
+}
@@ -0,0 +1,85 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package devcontainer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daytonaio/daytona/pkg/builder/devcontainer"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+const propertyNameWidth = 20
+
+var propertyNameStyle = lipgloss.NewStyle().
+	Foreground(views.LightGray)
+
+var propertyValueStyle = lipgloss.NewStyle().
+	Foreground(views.Light).
+	Bold(true)
+
+var severityStyles = map[devcontainer.ValidationSeverity]lipgloss.Style{
+	devcontainer.ValidationSeverityError:   lipgloss.NewStyle().Foreground(views.Orange).Bold(true),
+	devcontainer.ValidationSeverityWarning: lipgloss.NewStyle().Foreground(views.Yellow),
+	devcontainer.ValidationSeverityInfo:    lipgloss.NewStyle().Foreground(views.LightGray),
+}
+
+func RenderValidationResult(result *devcontainer.ValidationResult) {
+	output := views.GetStyledMainTitle(fmt.Sprintf("Devcontainer configuration %s", result.ConfigFilePath)) + "\n\n"
+
+	switch result.Source {
+	case devcontainer.BuildSourceImage:
+		output += getInfoLine("Image", result.Image)
+	case devcontainer.BuildSourceDockerfile:
+		output += getInfoLine("Dockerfile", result.Dockerfile)
+		output += getInfoLine("Build context", result.BuildContext)
+	case devcontainer.BuildSourceCompose:
+		output += getInfoLine("Compose file", result.ComposeFile)
+		output += getInfoLine("Service", result.Service)
+		if result.Dockerfile != "" {
+			output += getInfoLine("Dockerfile", result.Dockerfile)
+			output += getInfoLine("Build context", result.BuildContext)
+		} else {
+			output += getInfoLine("Image", result.Image)
+		}
+	}
+
+	output += getInfoLine("Features", strings.Join(result.Features, ", "))
+	output += getInfoLine("Remote user", result.RemoteUser)
+	output += getInfoLine("Workspace folder", result.WorkspaceFolder)
+	output += getInfoLine("Post create", strings.Join(result.PostCreateCommands, "; "))
+	output += getInfoLine("Post start", strings.Join(result.PostStartCommands, "; "))
+
+	if len(result.Issues) > 0 {
+		output += "\n"
+	}
+
+	for _, issue := range result.Issues {
+		line := severityStyles[issue.Severity].Render(string(issue.Severity))
+		if issue.Property != "" {
+			line += " " + propertyValueStyle.Render(issue.Property) + ":"
+		}
+		output += fmt.Sprintf("%s %s\n", line, issue.Message)
+	}
+
+	// Not rendered in a container layout so that the output is also readable when the command runs in CI
+	fmt.Println(views.BasicLayout.Render(output))
+
+	if result.HasErrors() {
+		views.RenderInfoMessageBold("The devcontainer configuration is invalid")
+		return
+	}
+
+	views.RenderInfoMessageBold("The devcontainer configuration is valid")
+}
+
+func getInfoLine(key, value string) string {
+	if value == "" {
+		return ""
+	}
+
+	return propertyNameStyle.Render(fmt.Sprintf("%-*s", propertyNameWidth, key)) + propertyValueStyle.Render(value) + "\n"
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"slices"
+	"strings"
 
 	"github.com/charmbracelet/huh"
 	"github.com/charmbracelet/lipgloss"
@@ -17,11 +18,15 @@ import (
 )
 
 type GitProviderView struct {
-	Id         string
-	Name       string
-	Username   string
-	BaseApiUrl string
-	Token      string
+	Id          string
+	ProviderId  string
+	Name        string
+	Username    string
+	BaseApiUrl  string
+	Token       string
+	Alias       string
+	IsDefault   bool
+	CacheStatus string
 }
 
 var commonGitProviderIds = []string{"github", "gitlab", "bitbucket"}
@@ -29,13 +34,19 @@ var commonGitProviderIds = []string{"github", "gitlab", "bitbucket"}
 func GitProviderSelectionView(gitProviderAddView *apiclient.GitProvider, userGitProviders []apiclient.GitProvider, isDeleting bool) {
 	supportedProviders := config.GetSupportedGitProviders()
 
+	// The config is selected when deleting and the provider type when adding
+	selectedId := gitProviderAddView.ProviderId
+	if isDeleting {
+		selectedId = gitProviderAddView.Id
+	}
+
 	var gitProviderOptions []huh.Option[string]
 	var otherGitProviderOptions []huh.Option[string]
 	for _, supportedProvider := range supportedProviders {
 		if isDeleting {
 			for _, userProvider := range userGitProviders {
-				if *userProvider.Id == supportedProvider.Id {
-					gitProviderOptions = append(gitProviderOptions, huh.Option[string]{Key: supportedProvider.Name, Value: supportedProvider.Id})
+				if GetProviderId(userProvider) == supportedProvider.Id {
+					gitProviderOptions = append(gitProviderOptions, huh.Option[string]{Key: GetConfigLabel(userProvider), Value: *userProvider.Id})
 				}
 			}
 		} else {
@@ -58,15 +69,15 @@ func GitProviderSelectionView(gitProviderAddView *apiclient.GitProvider, userGit
 				Options(
 					gitProviderOptions...,
 				).
-				Value(gitProviderAddView.Id)),
+				Value(selectedId)),
 		huh.NewGroup(
 			huh.NewSelect[string]().
 				Title("Choose a Git provider").
 				Options(
 					otherGitProviderOptions...,
 				).
-				Value(gitProviderAddView.Id)).WithHideFunc(func() bool {
-			return *gitProviderAddView.Id != "other"
+				Value(selectedId)).WithHideFunc(func() bool {
+			return *selectedId != "other"
 		}),
 	).WithTheme(views.GetCustomTheme())
 
@@ -87,13 +98,13 @@ func GitProviderSelectionView(gitProviderAddView *apiclient.GitProvider, userGit
 					return nil
 				}),
 		).WithHideFunc(func() bool {
-			return isDeleting || !providerRequiresUsername(*gitProviderAddView.Id)
+			return isDeleting || !providerRequiresUsername(*gitProviderAddView.ProviderId)
 		}),
 		huh.NewGroup(
 			huh.NewInput().
 				Title("Self-managed API URL").
 				Value(gitProviderAddView.BaseApiUrl).
-				Description(getApiUrlDescription(*gitProviderAddView.Id)).
+				Description(getApiUrlDescription(*gitProviderAddView.ProviderId)).
 				Validate(func(str string) error {
 					if str == "" {
 						return errors.New("URL can not be blank")
@@ -101,7 +112,7 @@ func GitProviderSelectionView(gitProviderAddView *apiclient.GitProvider, userGit
 					return nil
 				}),
 		).WithHideFunc(func() bool {
-			return isDeleting || !providerRequiresApiUrl(*gitProviderAddView.Id)
+			return isDeleting || !providerRequiresApiUrl(*gitProviderAddView.ProviderId)
 		}),
 		huh.NewGroup(
 			huh.NewInput().
@@ -115,10 +126,16 @@ func GitProviderSelectionView(gitProviderAddView *apiclient.GitProvider, userGit
 					return nil
 				}),
 		).WithHide(isDeleting),
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Alias").
+				Description("Optional. Selects this config for repositories owned by the user or organization\nwith the same name when several configs are registered for the provider").
+				Value(gitProviderAddView.Alias),
+		).WithHide(isDeleting),
 	).WithTheme(views.GetCustomTheme())
 
 	if !isDeleting {
-		views.RenderInfoMessage(getGitProviderHelpMessage(*gitProviderAddView.Id))
+		views.RenderInfoMessage(getGitProviderHelpMessage(*gitProviderAddView.ProviderId))
 	}
 
 	err = userDataForm.Run()
@@ -128,11 +145,11 @@ func GitProviderSelectionView(gitProviderAddView *apiclient.GitProvider, userGit
 }
 
 func providerRequiresUsername(gitProviderId string) bool {
-	return gitProviderId == "bitbucket" || gitProviderId == "bitbucket-server"
+	return gitProviderId == "bitbucket" || gitProviderId == "bitbucket-server" || gitProviderId == "gerrit"
 }
 
 func providerRequiresApiUrl(gitProviderId string) bool {
-	return gitProviderId == "gitness" || gitProviderId == "github-enterprise-server" || gitProviderId == "gitlab-self-managed" || gitProviderId == "gitea" || gitProviderId == "bitbucket-server" || gitProviderId == "azure-devops"
+	return gitProviderId == "gitness" || gitProviderId == "github-enterprise-server" || gitProviderId == "gitlab-self-managed" || gitProviderId == "gitea" || gitProviderId == "bitbucket-server" || gitProviderId == "azure-devops" || gitProviderId == "gerrit"
 }
 
 func getApiUrlDescription(gitProviderId string) string {
@@ -148,6 +165,8 @@ func getApiUrlDescription(gitProviderId string) string {
 		return "For example: https://dev.azure.com/organization"
 	} else if gitProviderId == "bitbucket-server" {
 		return "For example: https://bitbucket.host.com/rest"
+	} else if gitProviderId == "gerrit" {
+		return "For example: https://gerrit-host"
 	}
 	return ""
 }
@@ -159,3 +178,56 @@ func getGitProviderHelpMessage(gitProviderId string) string {
 		lipgloss.NewStyle().Foreground(views.Green).Bold(true).Render("Required scopes: "),
 		config.GetScopesFromGitProvider(gitProviderId))
 }
+
+// Returns the git provider type of the config. Configs registered before multiple configs per provider
+// were supported use the provider type as the ID
+func GetProviderId(gitProvider apiclient.GitProvider) string {
+	if gitProvider.GetProviderId() != "" {
+		return gitProvider.GetProviderId()
+	}
+
+	return gitProvider.GetId()
+}
+
+// Returns the provider name followed by the account and alias of the config
+func GetConfigLabel(gitProvider apiclient.GitProvider) string {
+	name := GetProviderId(gitProvider)
+	for _, supportedProvider := range config.GetSupportedGitProviders() {
+		if supportedProvider.Id == name {
+			name = supportedProvider.Name
+		}
+	}
+
+	details := []string{}
+	if gitProvider.GetUsername() != "" {
+		details = append(details, gitProvider.GetUsername())
+	}
+	if gitProvider.GetAlias() != "" {
+		details = append(details, fmt.Sprintf("alias: %s", gitProvider.GetAlias()))
+	}
+	if gitProvider.GetIsDefault() {
+		details = append(details, "default")
+	}
+
+	if len(details) == 0 {
+		return name
+	}
+
+	return fmt.Sprintf("%s (%s)", name, strings.Join(details, ", "))
+}
+
+// Returns a description of the repository listing cache or an empty string if the cache is not warmed
+func GetCacheStatusLabel(gitProvider apiclient.GitProvider) string {
+	if gitProvider.CacheStatus == nil {
+		return ""
+	}
+
+	switch gitProvider.CacheStatus.State {
+	case apiclient.GitProviderCacheStateReady:
+		return fmt.Sprintf("cache ready, updated at %s", gitProvider.CacheStatus.GetUpdatedAt())
+	case apiclient.GitProviderCacheStateFailed:
+		return fmt.Sprintf("cache failed: %s", gitProvider.CacheStatus.GetError())
+	default:
+		return "cache warming"
+	}
+}
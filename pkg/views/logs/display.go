@@ -6,6 +6,7 @@ package logs
 import (
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/daytonaio/daytona/pkg/logs"
@@ -20,6 +21,12 @@ var maxPrefixLength = 20
 var prefixDelimiter = " | "
 var prefixPadding = " "
 
+var progressBarWidth = 30
+
+// Progress bars are redrawn in place until another line is printed
+var progressMutex sync.Mutex
+var progressLineOpen bool
+
 func DisplayLogs(logEntriesChan <-chan logs.LogEntry, index int) {
 	for logEntry := range logEntriesChan {
 		DisplayLogEntry(logEntry, index)
@@ -38,6 +45,19 @@ func DisplayLogEntry(logEntry logs.LogEntry, index int) {
 
 	prefix := lipgloss.NewStyle().Foreground(prefixColor).Bold(true).Render(formatPrefixText(prefixText))
 
+	progressMutex.Lock()
+	defer progressMutex.Unlock()
+
+	if logEntry.Progress != nil {
+		displayProgress(*logEntry.Progress, logEntry.Msg, prefix)
+		return
+	}
+
+	if progressLineOpen {
+		fmt.Println()
+		progressLineOpen = false
+	}
+
 	if index == WORKSPACE_INDEX {
 		line = fmt.Sprintf("%s%s%s \033[1m%s\033[0m", prefixPadding, prefix, views.CheckmarkSymbol, line)
 		fmt.Print(line)
@@ -70,6 +90,37 @@ func DisplayLogEntry(logEntry logs.LogEntry, index int) {
 	fmt.Print(result)
 }
 
+func displayProgress(progress logs.LogProgress, msg string, prefix string) {
+	// The message holds the plain text version of the progress, e.g. "Pulling image: 45% (ubuntu:22.04)"
+	text := strings.TrimSpace(msg)
+	if progress.Percent >= 0 {
+		text = fmt.Sprintf("%s %s", formatProgressBar(progress.Percent), text)
+	}
+
+	// Clear the rest of the line in case the previous progress was longer
+	fmt.Printf("\r%s%s%s\033[K", prefixPadding, prefix, text)
+	progressLineOpen = true
+
+	if progress.Percent >= 100 {
+		fmt.Println()
+		progressLineOpen = false
+	}
+}
+
+func formatProgressBar(percent int) string {
+	if percent > 100 {
+		percent = 100
+	}
+
+	filled := progressBarWidth * percent / 100
+	bar := strings.Repeat("=", filled)
+	if filled < progressBarWidth {
+		bar += ">" + strings.Repeat(" ", progressBarWidth-filled-1)
+	}
+
+	return fmt.Sprintf("[%s]", bar)
+}
+
 func CalculateLongestPrefixLength(projectNames []string) {
 	for _, projectName := range projectNames {
 		if len(projectName) > longestPrefixLength {
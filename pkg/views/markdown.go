@@ -0,0 +1,28 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package views
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/glamour"
+)
+
+// RenderMarkdown renders markdown for the terminal wrapped at the given width
+func RenderMarkdown(content string, width int) (string, error) {
+	renderer, err := glamour.NewTermRenderer(
+		glamour.WithStandardStyle("dark"),
+		glamour.WithWordWrap(width),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	output, err := renderer.Render(content)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Trim(output, "\n"), nil
+}
@@ -0,0 +1,116 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"golang.org/x/term"
+)
+
+type catalogRowData struct {
+	Name             string
+	InstalledVersion string
+	LatestVersion    string
+	Status           string
+}
+
+func getCatalogRowData(entry *apiclient.ProviderCatalogEntry) catalogRowData {
+	rowData := catalogRowData{
+		Name:             entry.Name,
+		InstalledVersion: "-",
+		LatestVersion:    entry.LatestVersion,
+		Status:           "Not installed",
+	}
+
+	if entry.InstalledVersion != nil {
+		rowData.InstalledVersion = *entry.InstalledVersion
+		rowData.Status = "Up to date"
+		if entry.Outdated {
+			rowData.Status = "Outdated"
+		}
+	}
+
+	return rowData
+}
+
+func getCatalogRow(rowData catalogRowData) []string {
+	statusStyle := views.DefaultRowDataStyle
+	switch rowData.Status {
+	case "Outdated":
+		statusStyle = views.InactiveStyle
+	case "Up to date":
+		statusStyle = views.ActiveStyle
+	}
+
+	return []string{
+		views.NameStyle.Render(rowData.Name),
+		views.DefaultRowDataStyle.Render(rowData.InstalledVersion),
+		views.DefaultRowDataStyle.Render(rowData.LatestVersion),
+		statusStyle.Render(rowData.Status),
+	}
+}
+
+func ListCatalog(catalog []apiclient.ProviderCatalogEntry) {
+	re := lipgloss.NewRenderer(os.Stdout)
+
+	headers := []string{"Name", "Installed", "Latest", "Status"}
+
+	data := [][]string{}
+	for _, entry := range catalog {
+		data = append(data, getCatalogRow(getCatalogRowData(&entry)))
+	}
+
+	terminalWidth, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		fmt.Println(data)
+		return
+	}
+
+	breakpointWidth := views.GetContainerBreakpointWidth(terminalWidth)
+
+	if breakpointWidth == 0 || terminalWidth < views.TUITableMinimumWidth {
+		renderUnstyledCatalog(catalog)
+		return
+	}
+
+	t := table.New().
+		Headers(headers...).
+		Rows(data...).
+		BorderStyle(re.NewStyle().Foreground(views.LightGray)).
+		BorderRow(false).BorderColumn(false).BorderLeft(false).BorderRight(false).BorderTop(false).BorderBottom(false).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == 0 {
+				return views.TableHeaderStyle
+			}
+			return views.BaseCellStyle
+		}).Width(breakpointWidth - 2*views.BaseTableStyleHorizontalPadding)
+
+	fmt.Println(views.BaseTableStyle.Render(t.String()))
+}
+
+func renderUnstyledCatalog(catalog []apiclient.ProviderCatalogEntry) {
+	output := "\n"
+
+	for i, entry := range catalog {
+		rowData := getCatalogRowData(&entry)
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Provider Name: "), rowData.Name) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Installed Version: "), rowData.InstalledVersion) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Latest Version: "), rowData.LatestVersion) + "\n\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Status: "), rowData.Status) + "\n"
+
+		if i < len(catalog)-1 {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
@@ -18,31 +18,42 @@ import (
 type RowData struct {
 	Name    string
 	Version string
+	Source  string
 }
 
 func getRowFromRowData(rowData RowData) []string {
 	row := []string{
 		views.NameStyle.Render(rowData.Name),
 		views.DefaultRowDataStyle.Render(rowData.Version),
+		views.DefaultRowDataStyle.Render(rowData.Source),
 	}
 
 	return row
 }
 
 func getRowData(provider *apiclient.Provider) *RowData {
-	rowData := RowData{"", ""}
+	rowData := RowData{"", "", ""}
 
 	rowData.Name = *provider.Name
 	rowData.Version = *provider.Version
+	rowData.Source = getSource(provider)
 
 	return &rowData
 }
 
+func getSource(provider *apiclient.Provider) string {
+	if provider.InstalledFromFile != nil && *provider.InstalledFromFile {
+		return "file"
+	}
+
+	return "registry"
+}
+
 func List(providerList []apiclient.Provider) {
 
 	re := lipgloss.NewRenderer(os.Stdout)
 
-	headers := []string{"Name", "Version"}
+	headers := []string{"Name", "Version", "Source"}
 
 	data := [][]string{}
 
@@ -92,7 +103,9 @@ func renderUnstyledList(providerList []apiclient.Provider) {
 	for _, provider := range providerList {
 		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Provider Name: "), *provider.Name) + "\n\n"
 
-		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Provider Version: "), *provider.Version) + "\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Provider Version: "), *provider.Version) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Source: "), getSource(&provider)) + "\n"
 
 		if provider.Name != providerList[len(providerList)-1].Name {
 			output += views.SeparatorString + "\n\n"
@@ -8,6 +8,7 @@ import (
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/provider"
 	"github.com/daytonaio/daytona/pkg/server"
 	"github.com/daytonaio/daytona/pkg/views"
 )
@@ -23,6 +24,10 @@ func RenderConfig(config *server.Config) {
 
 	output += fmt.Sprintf("%s %d", views.GetPropertyKey("API Port: "), config.ApiPort) + "\n\n"
 
+	if config.RateLimit != nil && config.RateLimit.RequestsPerSecond > 0 {
+		output += fmt.Sprintf("%s %g requests/s per API key (burst %d)", views.GetPropertyKey("API Rate Limit: "), config.RateLimit.RequestsPerSecond, config.RateLimit.Burst) + "\n\n"
+	}
+
 	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Default Project Image: "), config.DefaultProjectImage) + "\n\n"
 
 	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Default Project User: "), config.DefaultProjectUser) + "\n\n"
@@ -33,10 +38,30 @@ func RenderConfig(config *server.Config) {
 
 	output += fmt.Sprintf("%s %d", views.GetPropertyKey("Headscale Port: "), config.HeadscalePort) + "\n\n"
 
+	networkMode := config.NetworkMode
+	if networkMode == "" {
+		networkMode = provider.NetworkModeDaytona
+	}
+	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Network Mode: "), networkMode) + "\n\n"
+
+	if networkMode == provider.NetworkModeTailnet && config.Tailnet != nil {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Tailnet Control URL: "), config.Tailnet.ControlUrl) + "\n\n"
+	}
+
 	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Binaries Path: "), config.BinariesPath) + "\n\n"
 
+	databaseType := server.DatabaseTypeSqlite
+	if config.Database != nil && config.Database.Type != "" {
+		databaseType = config.Database.Type
+	}
+	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Database: "), databaseType) + "\n\n"
+
 	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Logs Path: "), config.LogFilePath) + "\n\n"
 
+	if config.LogRetention != nil && config.LogRetention.MaxFileSizeMB > 0 {
+		output += fmt.Sprintf("%s rotate at %d MB, keep %d files for %d days", views.GetPropertyKey("Log Retention: "), config.LogRetention.MaxFileSizeMB, config.LogRetention.MaxBackups, config.LogRetention.MaxAgeDays) + "\n\n"
+	}
+
 	output += fmt.Sprintf("%s %s", views.GetPropertyKey("Builder Image: "), config.BuilderImage) + "\n\n"
 
 	if config.BuilderRegistryServer == "local" {
@@ -0,0 +1,98 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package user
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"golang.org/x/term"
+)
+
+func ListUsers(userList []apiclient.User) {
+	re := lipgloss.NewRenderer(os.Stdout)
+
+	headers := []string{"Name", "Role", "Status", "Created"}
+
+	data := [][]string{}
+
+	for _, user := range userList {
+		data = append(data, []string{
+			views.NameStyle.Render(user.Name),
+			views.DefaultRowDataStyle.Render(string(user.Role)),
+			views.DefaultRowDataStyle.Render(getUserStatus(user)),
+			views.DefaultRowDataStyle.Render(getCreatedAt(user)),
+		})
+	}
+
+	terminalWidth, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		fmt.Println(data)
+		return
+	}
+
+	breakpointWidth := views.GetContainerBreakpointWidth(terminalWidth)
+
+	if breakpointWidth == 0 || terminalWidth < views.TUITableMinimumWidth {
+		renderUnstyledList(userList)
+		return
+	}
+
+	t := table.New().
+		Headers(headers...).
+		Rows(data...).
+		BorderStyle(re.NewStyle().Foreground(views.LightGray)).
+		BorderRow(false).BorderColumn(false).BorderLeft(false).BorderRight(false).BorderTop(false).BorderBottom(false).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == 0 {
+				return views.TableHeaderStyle
+			}
+			return views.BaseCellStyle
+		}).Width(breakpointWidth - 2*views.BaseTableStyleHorizontalPadding)
+
+	fmt.Println(views.BaseTableStyle.Render(t.String()))
+}
+
+func getUserStatus(user apiclient.User) string {
+	if user.Disabled {
+		return "Disabled"
+	}
+	return "Active"
+}
+
+func getCreatedAt(user apiclient.User) string {
+	t, err := time.Parse(time.RFC3339, user.CreatedAt)
+	if err != nil {
+		return user.CreatedAt
+	}
+
+	return util.FormatCreatedTime(t.UTC().Format(time.RFC3339Nano))
+}
+
+func renderUnstyledList(userList []apiclient.User) {
+	output := "\n"
+
+	for i, user := range userList {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Name: "), user.Name) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Role: "), user.Role) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Status: "), getUserStatus(user)) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Created: "), getCreatedAt(user)) + "\n\n"
+
+		if i < len(userList)-1 {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
@@ -0,0 +1,95 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package group
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"golang.org/x/term"
+)
+
+func ListTargetGroups(groupList []apiclient.TargetGroupDTO) {
+	re := lipgloss.NewRenderer(os.Stdout)
+
+	headers := []string{"Group", "Placement Policy", "Target", "Workspaces", "Status"}
+
+	data := [][]string{}
+
+	for _, group := range groupList {
+		for i, member := range group.Members {
+			groupName, placementPolicy := "", ""
+			if i == 0 {
+				groupName, placementPolicy = group.Name, string(group.PlacementPolicy)
+			}
+
+			data = append(data, []string{
+				views.NameStyle.Render(groupName),
+				views.DefaultRowDataStyle.Render(placementPolicy),
+				views.DefaultRowDataStyle.Render(member.Target),
+				views.DefaultRowDataStyle.Render(fmt.Sprint(member.Workspaces)),
+				views.DefaultRowDataStyle.Render(getMemberStatus(member)),
+			})
+		}
+	}
+
+	terminalWidth, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		fmt.Println(data)
+		return
+	}
+
+	breakpointWidth := views.GetContainerBreakpointWidth(terminalWidth)
+
+	if breakpointWidth == 0 || terminalWidth < views.TUITableMinimumWidth {
+		renderUnstyledList(groupList)
+		return
+	}
+
+	t := table.New().
+		Headers(headers...).
+		Rows(data...).
+		BorderStyle(re.NewStyle().Foreground(views.LightGray)).
+		BorderRow(false).BorderColumn(false).BorderLeft(false).BorderRight(false).BorderTop(false).BorderBottom(false).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == 0 {
+				return views.TableHeaderStyle
+			}
+			return views.BaseCellStyle
+		}).Width(breakpointWidth - 2*views.BaseTableStyleHorizontalPadding)
+
+	fmt.Println(views.BaseTableStyle.Render(t.String()))
+}
+
+func getMemberStatus(member apiclient.TargetGroupMemberDTO) string {
+	if member.Drained {
+		return "Drained"
+	}
+	return "Active"
+}
+
+func renderUnstyledList(groupList []apiclient.TargetGroupDTO) {
+	output := "\n"
+
+	for i, group := range groupList {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Group Name: "), group.Name) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Placement Policy: "), group.PlacementPolicy) + "\n\n"
+
+		for _, member := range group.Members {
+			output += fmt.Sprintf("%s %s (%d workspaces, %s)", views.GetPropertyKey("Target: "), member.Target, member.Workspaces, getMemberStatus(member)) + "\n\n"
+		}
+
+		if i < len(groupList)-1 {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"strings"
 
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/views"
@@ -17,9 +18,11 @@ import (
 )
 
 type RowData struct {
-	Target   string
-	Provider string
-	Options  string
+	Target        string
+	Provider      string
+	Options       string
+	NetworkPolicy string
+	NetworkMode   string
 }
 
 func getRowFromRowData(rowData RowData) []string {
@@ -27,17 +30,21 @@ func getRowFromRowData(rowData RowData) []string {
 		views.NameStyle.Render(rowData.Target),
 		views.DefaultRowDataStyle.Render(rowData.Provider),
 		views.DefaultRowDataStyle.Render(rowData.Options),
+		views.DefaultRowDataStyle.Render(rowData.NetworkPolicy),
+		views.DefaultRowDataStyle.Render(rowData.NetworkMode),
 	}
 
 	return row
 }
 
 func getRowData(target *apiclient.ProviderTarget) *RowData {
-	rowData := RowData{"", "", ""}
+	rowData := RowData{"", "", "", "", ""}
 
 	rowData.Target = *target.Name
 	rowData.Provider = *target.ProviderInfo.Name
 	rowData.Options = *target.Options
+	rowData.NetworkPolicy = getNetworkPolicyString(target.NetworkPolicy)
+	rowData.NetworkMode = getNetworkModeString(target.NetworkMode)
 
 	return &rowData
 }
@@ -48,7 +55,7 @@ func ListTargets(targetList []apiclient.ProviderTarget) {
 
 	re := lipgloss.NewRenderer(os.Stdout)
 
-	headers := []string{"Target", "Provider", "Options"}
+	headers := []string{"Target", "Provider", "Options", "Network Policy", "Network Mode"}
 
 	data := [][]string{}
 
@@ -108,7 +115,11 @@ func renderUnstyledList(targetList []apiclient.ProviderTarget) {
 
 		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Target Provider: "), *target.ProviderInfo.Name) + "\n\n"
 
-		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Target Options: "), *target.Options) + "\n"
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Target Options: "), *target.Options) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Target Network Policy: "), getNetworkPolicyString(target.NetworkPolicy)) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Target Network Mode: "), getNetworkModeString(target.NetworkMode)) + "\n"
 
 		if target.Name != targetList[len(targetList)-1].Name {
 			output += views.SeparatorString + "\n\n"
@@ -117,3 +128,38 @@ func renderUnstyledList(targetList []apiclient.ProviderTarget) {
 
 	fmt.Println(output)
 }
+
+func getNetworkPolicyString(networkPolicy *apiclient.NetworkPolicy) string {
+	if networkPolicy == nil {
+		return "-"
+	}
+
+	inboundPorts := []string{}
+	for _, portRange := range networkPolicy.AllowedInboundPorts {
+		if portRange.End <= portRange.Start {
+			inboundPorts = append(inboundPorts, fmt.Sprintf("%d", portRange.Start))
+		} else {
+			inboundPorts = append(inboundPorts, fmt.Sprintf("%d-%d", portRange.Start, portRange.End))
+		}
+	}
+
+	inbound := "none"
+	if len(inboundPorts) > 0 {
+		inbound = strings.Join(inboundPorts, ", ")
+	}
+
+	egress := "allowed"
+	if networkPolicy.DenyAllEgress != nil && *networkPolicy.DenyAllEgress {
+		egress = "denied"
+	}
+
+	return fmt.Sprintf("Inbound ports: %s; Egress: %s", inbound, egress)
+}
+
+func getNetworkModeString(networkMode *apiclient.NetworkMode) string {
+	if networkMode == nil || *networkMode == "" {
+		return "server default"
+	}
+
+	return string(*networkMode)
+}
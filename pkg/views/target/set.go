@@ -42,7 +42,8 @@ func NewTargetNameInput(targetName *string, existingTargetNames []string) error
 	return nil
 }
 
-func SetTargetForm(target *apiclient.ProviderTarget, targetManifest map[string]apiclient.ProviderProviderTargetProperty) error {
+// SetTargetForm prompts for the target options. Validation errors of a previous attempt are shown with the related options
+func SetTargetForm(target *apiclient.ProviderTarget, targetManifest map[string]apiclient.ProviderProviderTargetProperty, validationErrors []apiclient.TargetOptionValidationError) error {
 	fields := make([]huh.Field, 0, len(targetManifest))
 	groups := []*huh.Group{}
 	options := make(map[string]interface{})
@@ -66,6 +67,9 @@ func SetTargetForm(target *apiclient.ProviderTarget, targetManifest map[string]a
 			}
 		}
 
+		description := getDescription(name, property, validationErrors)
+		property.Description = &description
+
 		switch *property.Type {
 		case apiclient.ProviderTargetPropertyTypeFloat, apiclient.ProviderTargetPropertyTypeInt:
 			var initialValue *string
@@ -155,6 +159,22 @@ func SetTargetForm(target *apiclient.ProviderTarget, targetManifest map[string]a
 	return nil
 }
 
+// Appends the validation errors of the option to its description
+func getDescription(name string, property apiclient.ProviderProviderTargetProperty, validationErrors []apiclient.TargetOptionValidationError) string {
+	description := ""
+	if property.Description != nil {
+		description = *property.Description
+	}
+
+	for _, validationError := range validationErrors {
+		if validationError.GetOption() == name {
+			description += "\n" + views.InactiveStyle.Render(validationError.GetMessage())
+		}
+	}
+
+	return strings.TrimPrefix(description, "\n")
+}
+
 func getInput(name string, property apiclient.ProviderProviderTargetProperty, initialValue *string) (*huh.Input, *string) {
 	value := property.DefaultValue
 	if initialValue != nil {
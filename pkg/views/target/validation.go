@@ -0,0 +1,25 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package target
+
+import (
+	"fmt"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+func RenderValidationErrors(validationErrors []apiclient.TargetOptionValidationError) {
+	output := views.GetStyledMainTitle("The provider detected problems with the target options") + "\n\n"
+
+	for _, validationError := range validationErrors {
+		if validationError.GetOption() == "" {
+			output += views.InactiveStyle.Render(fmt.Sprintf("- %s", validationError.GetMessage())) + "\n"
+			continue
+		}
+		output += views.InactiveStyle.Render(fmt.Sprintf("- %s: %s", validationError.GetOption(), validationError.GetMessage())) + "\n"
+	}
+
+	views.RenderContainerLayout(output)
+}
@@ -26,7 +26,7 @@ func (c *BuildChoice) Set(v string) error {
 		*c = BuildChoice(v)
 		return nil
 	default:
-		return fmt.Errorf("Build type must be one of %s/%s/%s", AUTOMATIC, DEVCONTAINER, NONE)
+		return fmt.Errorf("Build type must be one of %s/%s/%s/%s", AUTOMATIC, DEVCONTAINER, CUSTOMIMAGE, NONE)
 	}
 }
 
@@ -0,0 +1,107 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package forward
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"golang.org/x/term"
+)
+
+func ListPortForwards(portForwardList []apiclient.PortForward) {
+	re := lipgloss.NewRenderer(os.Stdout)
+
+	headers := []string{"Project", "Port", "Status", "Server Port", "Public URL"}
+
+	data := [][]string{}
+
+	for _, portForward := range portForwardList {
+		data = append(data, []string{
+			views.NameStyle.Render(portForward.ProjectName),
+			views.DefaultRowDataStyle.Render(fmt.Sprint(portForward.Port)),
+			getStatus(portForward),
+			views.DefaultRowDataStyle.Render(getHostPort(portForward)),
+			views.DefaultRowDataStyle.Render(getPublicUrl(portForward)),
+		})
+	}
+
+	terminalWidth, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		fmt.Println(data)
+		return
+	}
+
+	breakpointWidth := views.GetContainerBreakpointWidth(terminalWidth)
+
+	if breakpointWidth == 0 || terminalWidth < views.TUITableMinimumWidth {
+		renderUnstyledList(portForwardList)
+		return
+	}
+
+	t := table.New().
+		Headers(headers...).
+		Rows(data...).
+		BorderStyle(re.NewStyle().Foreground(views.LightGray)).
+		BorderRow(false).BorderColumn(false).BorderLeft(false).BorderRight(false).BorderTop(false).BorderBottom(false).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == 0 {
+				return views.TableHeaderStyle
+			}
+			return views.BaseCellStyle
+		}).Width(breakpointWidth - 2*views.BaseTableStyleHorizontalPadding)
+
+	fmt.Println(views.BaseTableStyle.Render(t.String()))
+}
+
+func renderUnstyledList(portForwardList []apiclient.PortForward) {
+	output := "\n"
+
+	for i, portForward := range portForwardList {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Project: "), portForward.ProjectName) + "\n\n"
+
+		output += fmt.Sprintf("%s %d", views.GetPropertyKey("Port: "), portForward.Port) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Status: "), getStatus(portForward)) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Server Port: "), getHostPort(portForward)) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Public URL: "), getPublicUrl(portForward)) + "\n\n"
+
+		if i < len(portForwardList)-1 {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
+
+func getStatus(portForward apiclient.PortForward) string {
+	if portForward.Active {
+		return views.ActiveStyle.Render("Active")
+	}
+	return views.InactiveStyle.Render("Inactive")
+}
+
+func getHostPort(portForward apiclient.PortForward) string {
+	if portForward.HostPort == nil {
+		return "-"
+	}
+	return fmt.Sprint(*portForward.HostPort)
+}
+
+func getPublicUrl(portForward apiclient.PortForward) string {
+	if portForward.PublicUrl != nil && *portForward.PublicUrl != "" {
+		return *portForward.PublicUrl
+	}
+	if portForward.Public {
+		return "Available when active"
+	}
+	return "-"
+}
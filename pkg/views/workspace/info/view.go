@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/daytonaio/daytona/pkg/apiclient"
@@ -41,6 +42,22 @@ func Render(workspace *apiclient.WorkspaceDTO, ide string, forceUnstyled bool) {
 
 	output += getInfoLine("ID", *workspace.Id) + "\n"
 
+	if workspace.ExpiresAt != nil && *workspace.ExpiresAt != "" {
+		output += getInfoLine("Expires", *workspace.ExpiresAt) + "\n"
+	}
+
+	if workspace.TargetGroup != nil && *workspace.TargetGroup != "" {
+		output += getInfoLine("Target Group", fmt.Sprintf("%s (placed on %s)", *workspace.TargetGroup, workspace.GetTarget())) + "\n"
+	}
+
+	if len(workspace.Projects) > 0 && workspace.Projects[0].GetRecordSessions() {
+		output += getInfoLine("SSH Sessions", "Recorded (see 'daytona sessions')") + "\n"
+	}
+
+	if workspace.Cost != nil && !isCreationView {
+		output += getInfoLine("Cost", GetCostDescription(*workspace.Cost)) + "\n"
+	}
+
 	if isCreationView {
 		output += getInfoLine("Editor", ide) + "\n"
 	}
@@ -54,10 +71,12 @@ func Render(workspace *apiclient.WorkspaceDTO, ide string, forceUnstyled bool) {
 	terminalWidth, _, err := term.GetSize(int(os.Stdout.Fd()))
 	if err != nil {
 		fmt.Println(output)
+		renderWelcome(workspace.Projects, 0)
 		return
 	}
 	if terminalWidth < views.TUITableMinimumWidth || forceUnstyled {
 		renderUnstyledInfo(output)
+		renderWelcome(workspace.Projects, 0)
 		return
 	}
 
@@ -66,6 +85,34 @@ func Render(workspace *apiclient.WorkspaceDTO, ide string, forceUnstyled bool) {
 	}
 
 	renderTUIView(output, views.GetContainerBreakpointWidth(terminalWidth), isCreationView)
+	renderWelcome(workspace.Projects, views.GetContainerBreakpointWidth(terminalWidth))
+}
+
+// Shows the welcome files reported by the project agents.
+// The markdown is printed as is if width is 0
+func renderWelcome(projects []apiclient.Project, width int) {
+	for _, project := range projects {
+		if project.State == nil || strings.TrimSpace(project.State.GetWelcome()) == "" {
+			continue
+		}
+
+		welcome := project.State.GetWelcome()
+		if width == 0 {
+			fmt.Printf("%s:\n\n%s\n\n", *project.Name, welcome)
+			continue
+		}
+
+		output, err := views.RenderMarkdown(welcome, width)
+		if err != nil {
+			output = welcome
+		}
+
+		if len(projects) > 1 {
+			output = propertyNameStyle.Render(*project.Name) + "\n" + output
+		}
+
+		fmt.Println(output + "\n")
+	}
 }
 
 func renderUnstyledInfo(output string) {
@@ -96,13 +143,25 @@ func getSingleProjectOutput(project *apiclient.Project, isCreationView bool) str
 		repositoryUrl = strings.TrimPrefix(repositoryUrl, "http://")
 	}
 
-	if project.State != nil {
-		output += getInfoLineState("State", project.State) + "\n"
+	if project.State != nil || project.GetStatus() == apiclient.ProjectStatusError {
+		output += getInfoLineState("State", project) + "\n"
 		if project.State.GitStatus != nil {
 			output += getInfoLineGitStatus("Branch", project.State.GitStatus) + "\n"
 		}
 	}
 
+	if project.Repository != nil && project.Repository.GetPinned() {
+		output += getInfoLinePinnedCommit("Commit", project) + "\n"
+	}
+
+	if project.GetWorktreeMode() {
+		output += getInfoLine("Worktrees", "Enabled (see 'daytona worktree')") + "\n"
+	}
+
+	if project.GetExportedImage() != "" {
+		output += getInfoLine("Exported Image", project.GetExportedImage()) + "\n"
+	}
+
 	if project.Target != nil && !isCreationView {
 		output += getInfoLine("Target", *project.Target) + "\n"
 	}
@@ -120,13 +179,25 @@ func getProjectsOutputs(projects []apiclient.Project, isCreationView bool) strin
 	var output string
 	for i, project := range projects {
 		output += getInfoLine(fmt.Sprintf("Project #%d", i+1), *project.Name)
-		output += getInfoLineState("State", project.State)
+		output += getInfoLineState("State", &project)
 		if project.State != nil && project.State.GitStatus != nil {
 			output += getInfoLineGitStatus("Branch", project.State.GitStatus)
 		}
+		if project.Repository != nil && project.Repository.GetPinned() {
+			output += getInfoLinePinnedCommit("Commit", &project)
+		}
+		if project.GetWorktreeMode() {
+			output += getInfoLine("Worktrees", "Enabled (see 'daytona worktree')")
+		}
+		if project.GetExportedImage() != "" {
+			output += getInfoLine("Exported Image", project.GetExportedImage())
+		}
 		if project.Target != nil && !isCreationView {
 			output += getInfoLine("Target", *project.Target)
 		}
+		if len(project.DependsOn) > 0 {
+			output += getInfoLine("Depends On", strings.Join(project.DependsOn, ", "))
+		}
 		if project.Repository != nil {
 			output += getInfoLine("Repository", *project.Repository.Url)
 		}
@@ -137,21 +208,42 @@ func getProjectsOutputs(projects []apiclient.Project, isCreationView bool) strin
 	return output
 }
 
+// GetCostDescription returns the accumulated cost, including the cost incurred since the server last updated it, and the current hourly cost
+func GetCostDescription(cost apiclient.WorkspaceCost) string {
+	accumulatedCost := float64(cost.AccumulatedCost)
+
+	accumulatedAt, err := time.Parse(time.RFC3339, cost.AccumulatedAt)
+	if err == nil && time.Now().After(accumulatedAt) {
+		accumulatedCost += float64(cost.HourlyCost) * time.Since(accumulatedAt).Hours()
+	}
+
+	return fmt.Sprintf("%.2f %s (%.2f %s/h)", accumulatedCost, cost.Estimate.Currency, cost.HourlyCost, cost.Estimate.Currency)
+}
+
 func getInfoLine(key, value string) string {
 	return propertyNameStyle.Render(fmt.Sprintf("%-*s", propertyNameWidth, key)) + propertyValueStyle.Render(value) + "\n"
 }
 
-func getInfoLineState(key string, state *apiclient.ProjectState) string {
+func getInfoLineState(key string, project *apiclient.Project) string {
 	var uptime int
 	var stateProperty string
 
+	state := project.State
 	if state == nil || state.Uptime == nil {
 		uptime = 0
 	} else {
 		uptime = int(*state.Uptime)
 	}
 
-	if uptime == 0 {
+	// Set by the server when the provider reports the project container or VM as failed or missing
+	if project.GetStatus() == apiclient.ProjectStatusError {
+		stateProperty = propertyValueStyle.Foreground(views.Orange).Render("ERROR")
+		if project.GetStatusError() != "" {
+			stateProperty += propertyValueStyle.Foreground(views.Gray).Render(fmt.Sprintf(" (%s)", project.GetStatusError()))
+		}
+	} else if project.GetStatus() == apiclient.ProjectStatusArchived {
+		stateProperty = propertyValueStyle.Foreground(views.Gray).Render("ARCHIVED")
+	} else if uptime == 0 {
 		stateProperty = propertyValueStyle.Foreground(views.Gray).Render("STOPPED")
 	} else {
 		stateProperty = propertyValueStyle.Foreground(views.Green).Render("RUNNING")
@@ -160,6 +252,33 @@ func getInfoLineState(key string, state *apiclient.ProjectState) string {
 	return propertyNameStyle.Render(fmt.Sprintf("%-*s", propertyNameWidth, key)) + stateProperty + propertyValueStyle.Foreground(views.Light).Render("\n")
 }
 
+// Shows the pinned commit and whether the project HEAD has moved away from it
+func getInfoLinePinnedCommit(key string, project *apiclient.Project) string {
+	output := propertyNameStyle.Render(fmt.Sprintf("%-*s", propertyNameWidth, key))
+
+	pinnedSha := project.Repository.GetSha()
+	output += propertyValueStyle.Render(shortSha(pinnedSha) + " (pinned)")
+
+	if project.State == nil || project.State.GitStatus == nil || project.State.GitStatus.GetHeadSha() == "" {
+		return output + "\n"
+	}
+
+	headSha := project.State.GitStatus.GetHeadSha()
+	// Pinned commits may have been set with uppercase hex digits through the API
+	if strings.HasPrefix(strings.ToLower(headSha), strings.ToLower(pinnedSha)) {
+		return output + "\n"
+	}
+
+	return output + propertyValueStyle.Foreground(views.Orange).Render(fmt.Sprintf(" drifted, HEAD at %s", shortSha(headSha))) + "\n"
+}
+
+func shortSha(sha string) string {
+	if len(sha) > 8 {
+		return sha[:8]
+	}
+	return sha
+}
+
 func getInfoLineGitStatus(key string, status *apiclient.GitStatus) string {
 	output := propertyNameStyle.Render(fmt.Sprintf("%-*s", propertyNameWidth, key))
 	if status.CurrentBranch == nil {
@@ -0,0 +1,38 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package info
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/stretchr/testify/require"
+)
+
+func newPinnedProject(pinnedSha, headSha string) *apiclient.Project {
+	pinned := true
+
+	return &apiclient.Project{
+		Repository: &apiclient.GitRepository{
+			Sha:    &pinnedSha,
+			Pinned: &pinned,
+		},
+		State: &apiclient.ProjectState{
+			GitStatus: &apiclient.GitStatus{
+				HeadSha: &headSha,
+			},
+		},
+	}
+}
+
+func TestGetInfoLinePinnedCommit(t *testing.T) {
+	headSha := "1a2b3c4d5e6f7a8b9c0d1a2b3c4d5e6f7a8b9c0d"
+
+	require.NotContains(t, getInfoLinePinnedCommit("Commit", newPinnedProject("1a2b3c4", headSha)), "drifted")
+
+	// SHAs set through the API are not normalized and may use uppercase hex digits
+	require.NotContains(t, getInfoLinePinnedCommit("Commit", newPinnedProject("1A2B3C4D", headSha)), "drifted")
+
+	require.Contains(t, getInfoLinePinnedCommit("Commit", newPinnedProject("9f8e7d6", headSha)), "drifted, HEAD at 1a2b3c4d")
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package list
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+type BulkAction string
+
+const (
+	BulkActionStop   BulkAction = "stop"
+	BulkActionDelete BulkAction = "delete"
+	BulkActionCancel BulkAction = "cancel"
+)
+
+type BulkActionResult struct {
+	WorkspaceName string
+	Err           error
+}
+
+func GetBulkActionFromPrompt(workspaceNames []string) (BulkAction, error) {
+	action := BulkActionCancel
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[BulkAction]().
+				Title(fmt.Sprintf("Choose an action for workspace(s): [%s]", strings.Join(workspaceNames, ", "))).
+				Options(
+					huh.NewOption("Stop", BulkActionStop),
+					huh.NewOption("Delete", BulkActionDelete),
+					huh.NewOption("Cancel", BulkActionCancel),
+				).
+				Value(&action),
+		),
+	).WithTheme(views.GetCustomTheme())
+
+	err := form.Run()
+	if err != nil {
+		return BulkActionCancel, err
+	}
+
+	return action, nil
+}
+
+// RenderBulkActionResults prints the outcome of the action for each workspace
+func RenderBulkActionResults(action BulkAction, results []BulkActionResult) {
+	pastTense := "stopped"
+	if action == BulkActionDelete {
+		pastTense = "deleted"
+	}
+
+	failed := 0
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+			views.RenderLine(views.InactiveStyle.Render(fmt.Sprintf("- Failed to %s workspace %s: %s", action, result.WorkspaceName, result.Err)))
+			continue
+		}
+		views.RenderLine(fmt.Sprintf("- Workspace %s successfully %s", result.WorkspaceName, pastTense))
+	}
+
+	if failed > 0 {
+		views.RenderInfoMessage(fmt.Sprintf("%d of %d workspace(s) could not be %s", failed, len(results), pastTense))
+		return
+	}
+
+	views.RenderInfoMessage(fmt.Sprintf("%d workspace(s) %s", len(results), pastTense))
+}
@@ -27,7 +27,7 @@ type RowData struct {
 	Branch     string
 }
 
-func ListWorkspaces(workspaceList []apiclient.WorkspaceDTO, specifyGitProviders bool, verbose bool) {
+func ListWorkspaces(workspaceList []apiclient.WorkspaceDTO, specifyGitProviders bool, verbose bool, showCost bool) {
 	sortWorkspaces(&workspaceList, verbose)
 
 	re := lipgloss.NewRenderer(os.Stdout)
@@ -35,18 +35,27 @@ func ListWorkspaces(workspaceList []apiclient.WorkspaceDTO, specifyGitProviders
 	headers := []string{"Workspace", "Repository", "Target", "Status", "Created", "Branch"}
 
 	data := [][]string{}
+	// Costs are tracked per workspace and shown on the first row of each workspace
+	costs := []string{}
 
 	for _, workspace := range workspaceList {
 		var rowData *RowData
 		var row []string
 
+		cost := "-"
+		if workspace.Cost != nil {
+			cost = info_view.GetCostDescription(*workspace.Cost)
+		}
+
 		if len(workspace.Projects) == 1 {
 			rowData = getWorkspaceTableRowData(workspace, specifyGitProviders)
 			row = getRowFromRowData(*rowData, false)
 			data = append(data, row)
+			costs = append(costs, cost)
 		} else {
 			row = getRowFromRowData(RowData{Name: *workspace.Name}, true)
 			data = append(data, row)
+			costs = append(costs, cost)
 			for _, project := range workspace.Projects {
 				rowData = getProjectTableRowData(workspace, project, specifyGitProviders)
 				if rowData == nil {
@@ -54,6 +63,7 @@ func ListWorkspaces(workspaceList []apiclient.WorkspaceDTO, specifyGitProviders
 				}
 				row = getRowFromRowData(*rowData, false)
 				data = append(data, row)
+				costs = append(costs, "")
 			}
 		}
 	}
@@ -71,6 +81,13 @@ func ListWorkspaces(workspaceList []apiclient.WorkspaceDTO, specifyGitProviders
 		}
 	}
 
+	if showCost {
+		headers = append(headers, "Cost")
+		for value := range data {
+			data[value] = append(data[value], views.DefaultRowDataStyle.Render(costs[value]))
+		}
+	}
+
 	terminalWidth, _, err := term.GetSize(int(os.Stdout.Fd()))
 	if err != nil {
 		fmt.Println(data)
@@ -4,31 +4,32 @@
 package selection
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/daytonaio/daytona/pkg/apiclient"
 	"github.com/daytonaio/daytona/pkg/views"
+	"github.com/go-git/go-git/v5/plumbing"
 
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
 )
 
-func selectBranchPrompt(branches []apiclient.GitBranch, additionalProjectOrder int, choiceChan chan<- string) {
+func selectBranchPrompt(branches []apiclient.GitBranch, title string, additionalProjectOrder int, choiceChan chan<- string) {
 	items := []list.Item{}
 
 	// Populate items with titles and descriptions from workspaces.
 	for _, branch := range branches {
 		newItem := item[string]{id: *branch.Name, title: *branch.Name, choiceProperty: *branch.Name}
-		if *branch.Sha != "" {
-			newItem.desc = fmt.Sprintf("SHA: %s", *branch.Sha)
-		}
+		newItem.desc = getBranchDescription(branch)
 		items = append(items, newItem)
 	}
 
 	l := views.GetStyledSelectList(items)
 
-	title := "Choose a Branch"
 	if additionalProjectOrder > 0 {
 		title += fmt.Sprintf(" (Project #%d)", additionalProjectOrder)
 	}
@@ -49,10 +50,75 @@ func selectBranchPrompt(branches []apiclient.GitBranch, additionalProjectOrder i
 	}
 }
 
+// Shows the last commit and the ahead and behind counts versus the default branch where the git provider returns them
+func getBranchDescription(branch apiclient.GitBranch) string {
+	columns := []string{}
+
+	if branch.GetSha() != "" {
+		columns = append(columns, fmt.Sprintf("SHA: %s", shortSha(branch.GetSha())))
+	}
+	if branch.GetAuthor() != "" {
+		columns = append(columns, branch.GetAuthor())
+	}
+	if branch.GetDate() != "" {
+		columns = append(columns, formatCommitDate(branch.GetDate()))
+	}
+	if branch.Ahead != nil && branch.Behind != nil {
+		columns = append(columns, fmt.Sprintf("%d ahead, %d behind", *branch.Ahead, *branch.Behind))
+	}
+
+	return strings.Join(columns, "  ")
+}
+
 func GetBranchFromPrompt(branches []apiclient.GitBranch, additionalProjectOrder int) *apiclient.GitBranch {
+	return getBranchFromPrompt(branches, "Choose a Branch", additionalProjectOrder)
+}
+
+func GetBaseBranchFromPrompt(branches []apiclient.GitBranch, additionalProjectOrder int) *apiclient.GitBranch {
+	return getBranchFromPrompt(branches, "Choose a Base Branch", additionalProjectOrder)
+}
+
+func GetNewBranchNameFromPrompt(branches []apiclient.GitBranch, additionalProjectOrder int) (string, error) {
+	var branchName string
+
+	title := "New branch name"
+	if additionalProjectOrder > 0 {
+		title += fmt.Sprintf(" (Project #%d)", additionalProjectOrder)
+	}
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewInput().
+				Title(title).
+				Value(&branchName).
+				Validate(func(str string) error {
+					if str == "" {
+						return errors.New("branch name can not be blank")
+					}
+
+					for _, b := range branches {
+						if *b.Name == str {
+							return fmt.Errorf("branch '%s' already exists", str)
+						}
+					}
+
+					return plumbing.NewBranchReferenceName(str).Validate()
+				}),
+		),
+	).WithTheme(views.GetCustomTheme())
+
+	err := form.Run()
+	if err != nil {
+		return "", err
+	}
+
+	return branchName, nil
+}
+
+func getBranchFromPrompt(branches []apiclient.GitBranch, title string, additionalProjectOrder int) *apiclient.GitBranch {
 	choiceChan := make(chan string)
 
-	go selectBranchPrompt(branches, additionalProjectOrder, choiceChan)
+	go selectBranchPrompt(branches, title, additionalProjectOrder, choiceChan)
 
 	branchName := <-choiceChan
 
@@ -19,9 +19,11 @@ type CheckoutOption struct {
 }
 
 var (
-	CheckoutDefault = CheckoutOption{Title: "Clone the default branch", Id: "default"}
-	CheckoutBranch  = CheckoutOption{Title: "Branches", Id: "branch"}
-	CheckoutPR      = CheckoutOption{Title: "Pull/Merge requests", Id: "pullrequest"}
+	CheckoutDefault   = CheckoutOption{Title: "Clone the default branch", Id: "default"}
+	CheckoutBranch    = CheckoutOption{Title: "Branches", Id: "branch"}
+	CheckoutNewBranch = CheckoutOption{Title: "Create a new branch", Id: "newbranch"}
+	CheckoutPR        = CheckoutOption{Title: "Pull/Merge/Change requests", Id: "pullrequest"}
+	CheckoutCommit    = CheckoutOption{Title: "Recent commits", Id: "commit"}
 )
 
 func selectCheckoutPrompt(checkoutOptions []CheckoutOption, additionalProjectOrder int, choiceChan chan<- string) {
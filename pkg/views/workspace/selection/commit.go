@@ -0,0 +1,114 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package selection
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const loadMoreCommitsIdentifier = "<LOAD_MORE_COMMITS>"
+
+// CommitPageLoader returns the commits on the page and whether there are more pages
+type CommitPageLoader func(page int) ([]apiclient.GitCommit, bool, error)
+
+func selectCommitPrompt(commits []apiclient.GitCommit, hasMore bool, selectedIndex int, branchName string, additionalProjectOrder int, choiceChan chan<- string) {
+	items := []list.Item{}
+
+	for _, commit := range commits {
+		newItem := item[string]{id: commit.Sha, title: commit.Message, choiceProperty: commit.Sha}
+		newItem.desc = fmt.Sprintf("%s  %s  %s", shortSha(commit.Sha), commit.Author, formatCommitDate(commit.Date))
+		items = append(items, newItem)
+	}
+
+	if hasMore {
+		items = append(items, item[string]{id: loadMoreCommitsIdentifier, title: "Load more commits", choiceProperty: loadMoreCommitsIdentifier})
+	}
+
+	l := views.GetStyledSelectList(items)
+	l.Select(selectedIndex)
+
+	title := fmt.Sprintf("Choose a Commit from %s", branchName)
+	if additionalProjectOrder > 0 {
+		title += fmt.Sprintf(" (Project #%d)", additionalProjectOrder)
+	}
+	l.Title = views.GetStyledMainTitle(title)
+	l.Styles.Title = titleStyle
+	m := model[string]{list: l}
+
+	p, err := tea.NewProgram(m, tea.WithAltScreen()).Run()
+	if err != nil {
+		fmt.Println("Error running program:", err)
+		os.Exit(1)
+	}
+
+	if m, ok := p.(model[string]); ok && m.choice != nil {
+		choiceChan <- *m.choice
+	} else {
+		choiceChan <- ""
+	}
+}
+
+// GetCommitFromPrompt lists the commits of the branch, newest first. The next page is loaded
+// when the user selects the last item of the list
+func GetCommitFromPrompt(loadPage CommitPageLoader, branchName string, additionalProjectOrder int) (*apiclient.GitCommit, error) {
+	commits, hasMore, err := loadPage(1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := 1
+	selectedIndex := 0
+
+	for {
+		choiceChan := make(chan string)
+
+		go selectCommitPrompt(commits, hasMore, selectedIndex, branchName, additionalProjectOrder, choiceChan)
+
+		sha := <-choiceChan
+
+		if sha != loadMoreCommitsIdentifier {
+			for _, c := range commits {
+				if c.Sha == sha {
+					return &c, nil
+				}
+			}
+			return nil, nil
+		}
+
+		page++
+		nextCommits, nextHasMore, err := loadPage(page)
+		if err != nil {
+			return nil, err
+		}
+
+		selectedIndex = len(commits)
+		commits = append(commits, nextCommits...)
+		hasMore = nextHasMore
+	}
+}
+
+func shortSha(sha string) string {
+	if len(sha) > 8 {
+		return sha[:8]
+	}
+	return sha
+}
+
+func formatCommitDate(date string) string {
+	t, err := time.Parse(time.RFC3339, date)
+	if err != nil {
+		return date
+	}
+
+	return util.FormatCreatedTime(t.UTC().Format(time.RFC3339Nano))
+}
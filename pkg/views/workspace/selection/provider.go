@@ -20,6 +20,10 @@ var titleStyle = lipgloss.NewStyle()
 func selectProviderPrompt(gitProviders []gitprovider_view.GitProviderView, additionalProjectOrder int, choiceChan chan<- string) {
 	items := []list.Item{}
 
+	if len(gitProviders) > 1 {
+		items = append(items, item[string]{id: AllProvidersIdentifier, title: "All providers", desc: "Browse the namespaces of all providers", choiceProperty: AllProvidersIdentifier})
+	}
+
 	// Populate items with titles and descriptions from workspaces.
 	for _, provider := range gitProviders {
 		newItem := item[string]{id: provider.Id, title: provider.Name, choiceProperty: provider.Id}
@@ -59,3 +63,43 @@ func GetProviderIdFromPrompt(gitProviders []gitprovider_view.GitProviderView, ad
 
 	return <-choiceChan
 }
+
+func selectGitProviderConfigPrompt(gitProviders []gitprovider_view.GitProviderView, additionalProjectOrder int, choiceChan chan<- string) {
+	items := []list.Item{}
+
+	for _, provider := range gitProviders {
+		newItem := item[string]{id: provider.Id, title: provider.Name, desc: provider.Id, choiceProperty: provider.Id}
+		items = append(items, newItem)
+	}
+
+	l := views.GetStyledSelectList(items)
+
+	title := "Choose a Git Provider Config"
+	if additionalProjectOrder > 0 {
+		title += fmt.Sprintf(" (Project #%d)", additionalProjectOrder)
+	}
+	l.Title = views.GetStyledMainTitle(title)
+	l.Styles.Title = titleStyle
+	m := model[string]{list: l}
+
+	p, err := tea.NewProgram(m, tea.WithAltScreen()).Run()
+	if err != nil {
+		fmt.Println("Error running program:", err)
+		os.Exit(1)
+	}
+
+	if m, ok := p.(model[string]); ok && m.choice != nil {
+		choiceChan <- *m.choice
+	} else {
+		choiceChan <- ""
+	}
+}
+
+// GetGitProviderConfigIdFromPrompt lets the user choose between several configs matching the same repository
+func GetGitProviderConfigIdFromPrompt(gitProviders []gitprovider_view.GitProviderView, additionalProjectOrder int) string {
+	choiceChan := make(chan string)
+
+	go selectGitProviderConfigPrompt(gitProviders, additionalProjectOrder, choiceChan)
+
+	return <-choiceChan
+}
@@ -28,7 +28,7 @@ func selectPullRequestPrompt(pullRequests []apiclient.GitPullRequest, additional
 
 	l := views.GetStyledSelectList(items)
 
-	title := "Choose a Pull/Merge Request"
+	title := "Choose a Pull/Merge/Change Request"
 	if additionalProjectOrder > 0 {
 		title += fmt.Sprintf(" (Project #%d)", additionalProjectOrder)
 	}
@@ -23,6 +23,10 @@ func selectRepositoryPrompt(repositories []apiclient.GitRepository, index int, c
 		items = append(items, newItem)
 	}
 
+	runRepositoryPrompt(items, index, choiceChan)
+}
+
+func runRepositoryPrompt(items []list.Item, index int, choiceChan chan<- string) {
 	l := views.GetStyledSelectList(items)
 
 	title := "Choose a Repository"
@@ -61,3 +65,40 @@ func GetRepositoryFromPrompt(repositories []apiclient.GitRepository, index int)
 
 	return nil
 }
+
+// ProviderRepository is a repository of one of several git providers listed together
+type ProviderRepository struct {
+	GitProviderId   string
+	GitProviderName string
+	NamespaceId     string
+	Repository      apiclient.GitRepository
+}
+
+func selectProviderRepositoryPrompt(repositories []ProviderRepository, index int, choiceChan chan<- string) {
+	items := []list.Item{}
+
+	// Repositories of different providers can have the same URL, e.g. with multiple configs of a provider
+	for i, repository := range repositories {
+		id := fmt.Sprint(i)
+		newItem := item[string]{id: id, title: *repository.Repository.Name, choiceProperty: id, desc: fmt.Sprintf("%s, %s", repository.GitProviderName, *repository.Repository.Url)}
+		items = append(items, newItem)
+	}
+
+	runRepositoryPrompt(items, index, choiceChan)
+}
+
+func GetProviderRepositoryFromPrompt(repositories []ProviderRepository, index int) *ProviderRepository {
+	choiceChan := make(chan string)
+
+	go selectProviderRepositoryPrompt(repositories, index, choiceChan)
+
+	choice := <-choiceChan
+
+	for i := range repositories {
+		if fmt.Sprint(i) == choice {
+			return &repositories[i]
+		}
+	}
+
+	return nil
+}
@@ -21,6 +21,8 @@ import (
 
 var CustomRepoIdentifier = "<CUSTOM_REPO>"
 
+var AllProvidersIdentifier = "<ALL_PROVIDERS>"
+
 var selectedStyles = lipgloss.NewStyle().
 	Border(lipgloss.NormalBorder(), false, false, false, true).
 	BorderForeground(views.Green).
@@ -38,7 +40,7 @@ var statusMessageDangerStyle = lipgloss.NewStyle().Bold(true).
 type item[T any] struct {
 	id, title, desc, createdTime, uptime, target string
 	choiceProperty                               T
-	markForDeletion                              bool
+	marked                                       bool
 }
 
 func (i item[T]) Title() string       { return i.title }
@@ -83,7 +85,7 @@ func (m model[T]) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			workspaceList := m.list.Items()
 			var choices []*T
 			for _, workspace := range workspaceList {
-				if workspace.(item[T]).markForDeletion {
+				if workspace.(item[T]).marked {
 					workspaceItem, ok := workspace.(item[T])
 					if !ok {
 						continue
@@ -130,6 +132,8 @@ func (m model[T]) View() string {
 }
 
 type ItemDelegate[T any] struct {
+	// Items are marked with space instead of being marked for deletion with 'x'
+	multiSelect bool
 }
 
 func (d ItemDelegate[T]) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
@@ -197,17 +201,38 @@ func (d ItemDelegate[T]) Update(msg tea.Msg, m *list.Model) tea.Cmd {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch keypress := msg.String(); keypress {
+		case " ":
+			if !d.multiSelect {
+				return nil
+			}
+
+			if i.marked {
+				i.title = strings.TrimPrefix(i.title, statusMessageGreenStyle("Selected: "))
+				i.marked = false
+				m.SetItem(m.Index(), i)
+				return m.NewStatusMessage(statusMessageGreenStyle("Unselected workspace: ") + i.title)
+			}
+
+			title = i.title
+			i.title = statusMessageGreenStyle("Selected: ") + i.title
+			i.marked = true
+			m.SetItem(m.Index(), i)
+			return m.NewStatusMessage(statusMessageGreenStyle("Selected workspace: ") + title)
 		case "x":
-			if i.markForDeletion {
+			if d.multiSelect {
+				return nil
+			}
+
+			if i.marked {
 				i.title = strings.TrimPrefix(i.title, statusMessageDangerStyle("Delete: "))
-				i.markForDeletion = false
+				i.marked = false
 				m.SetItem(m.Index(), i)
 				return m.NewStatusMessage(statusMessageGreenStyle("Removed workspace from deletion list: ") + statusMessageGreenStyle(i.title))
 			}
 
 			title = i.title
 			i.title = statusMessageDangerStyle("Delete: ") + statusMessageGreenStyle(i.title)
-			i.markForDeletion = true
+			i.marked = true
 			m.SetItem(m.Index(), i)
 			return m.NewStatusMessage(statusMessageDangerStyle("Added workspace to deletion list: ") + statusMessageGreenStyle(title))
 		}
@@ -69,11 +69,11 @@ func generateWorkspaceList(workspaces []apiclient.WorkspaceDTO) []list.Item {
 	return items
 }
 
-func getWorkspaceProgramEssentials(modelTitle string, actionVerb string, workspaces []apiclient.WorkspaceDTO, footerText string) tea.Model {
+func getWorkspaceProgramEssentials(modelTitle string, actionVerb string, workspaces []apiclient.WorkspaceDTO, footerText string, multiSelect bool) tea.Model {
 
 	items := generateWorkspaceList(workspaces)
 
-	d := ItemDelegate[apiclient.WorkspaceDTO]{}
+	d := ItemDelegate[apiclient.WorkspaceDTO]{multiSelect: multiSelect}
 
 	l := list.New(items, d, 0, 0)
 
@@ -101,7 +101,7 @@ func getWorkspaceProgramEssentials(modelTitle string, actionVerb string, workspa
 
 func selectWorkspacePrompt(workspaces []apiclient.WorkspaceDTO, actionVerb string, choiceChan chan<- *apiclient.WorkspaceDTO) {
 
-	p := getWorkspaceProgramEssentials("Select a Workspace To ", actionVerb, workspaces, "")
+	p := getWorkspaceProgramEssentials("Select a Workspace To ", actionVerb, workspaces, "", false)
 	if m, ok := p.(model[apiclient.WorkspaceDTO]); ok && m.choice != nil {
 		choiceChan <- m.choice
 	} else {
@@ -120,7 +120,7 @@ func GetWorkspaceFromPrompt(workspaces []apiclient.WorkspaceDTO, actionVerb stri
 func selectWorkspacesFromPrompt(workspaces []apiclient.WorkspaceDTO, actionVerb string, choiceChan chan<- []*apiclient.WorkspaceDTO) {
 
 	footerText := lipgloss.NewStyle().Bold(true).PaddingLeft(2).Render("\n\nPress 'x' to mark workspace for deletion.\nPress 'enter' to delete the current/marked workspaces.")
-	p := getWorkspaceProgramEssentials("Select Workspaces To ", actionVerb, workspaces, footerText)
+	p := getWorkspaceProgramEssentials("Select Workspaces To ", actionVerb, workspaces, footerText, false)
 
 	m, ok := p.(model[apiclient.WorkspaceDTO])
 	if ok && m.choices != nil {
@@ -139,3 +139,27 @@ func GetWorkspacesFromPrompt(workspaces []apiclient.WorkspaceDTO, actionVerb str
 
 	return <-choiceChan
 }
+
+func selectMarkedWorkspacesFromPrompt(workspaces []apiclient.WorkspaceDTO, choiceChan chan<- []*apiclient.WorkspaceDTO) {
+
+	footerText := lipgloss.NewStyle().Bold(true).PaddingLeft(2).Render("\n\nPress 'space' to select or unselect a workspace.\nPress 'enter' to choose an action for the current/selected workspaces.")
+	p := getWorkspaceProgramEssentials("Select Workspaces", "", workspaces, footerText, true)
+
+	m, ok := p.(model[apiclient.WorkspaceDTO])
+	if ok && m.choices != nil {
+		choiceChan <- m.choices
+	} else if ok && m.choice != nil {
+		choiceChan <- []*apiclient.WorkspaceDTO{m.choice}
+	} else {
+		choiceChan <- nil
+	}
+}
+
+// GetMarkedWorkspacesFromPrompt returns the workspaces selected with space, or the current workspace if none were selected
+func GetMarkedWorkspacesFromPrompt(workspaces []apiclient.WorkspaceDTO) []*apiclient.WorkspaceDTO {
+	choiceChan := make(chan []*apiclient.WorkspaceDTO)
+
+	go selectMarkedWorkspacesFromPrompt(workspaces, choiceChan)
+
+	return <-choiceChan
+}
@@ -0,0 +1,89 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package sessions
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"golang.org/x/term"
+)
+
+func ListSessionRecordings(recordingList []apiclient.SessionRecording) {
+	re := lipgloss.NewRenderer(os.Stdout)
+
+	headers := []string{"ID", "Workspace", "Project", "Started At", "Duration"}
+
+	data := [][]string{}
+
+	for _, recording := range recordingList {
+		data = append(data, []string{
+			views.NameStyle.Render(recording.Id),
+			views.DefaultRowDataStyle.Render(recording.WorkspaceName),
+			views.DefaultRowDataStyle.Render(recording.ProjectName),
+			views.DefaultRowDataStyle.Render(recording.StartedAt),
+			views.DefaultRowDataStyle.Render(getDurationString(recording.Duration)),
+		})
+	}
+
+	terminalWidth, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		fmt.Println(data)
+		return
+	}
+
+	breakpointWidth := views.GetContainerBreakpointWidth(terminalWidth)
+
+	if breakpointWidth == 0 || terminalWidth < views.TUITableMinimumWidth {
+		renderUnstyledList(recordingList)
+		return
+	}
+
+	t := table.New().
+		Headers(headers...).
+		Rows(data...).
+		BorderStyle(re.NewStyle().Foreground(views.LightGray)).
+		BorderRow(false).BorderColumn(false).BorderLeft(false).BorderRight(false).BorderTop(false).BorderBottom(false).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == 0 {
+				return views.TableHeaderStyle
+			}
+			return views.BaseCellStyle
+		}).Width(breakpointWidth - 2*views.BaseTableStyleHorizontalPadding)
+
+	fmt.Println(views.BaseTableStyle.Render(t.String()))
+}
+
+// Formats a duration in seconds, rounded to seconds
+func getDurationString(seconds float32) string {
+	return time.Duration(float64(seconds) * float64(time.Second)).Round(time.Second).String()
+}
+
+func renderUnstyledList(recordingList []apiclient.SessionRecording) {
+	output := "\n"
+
+	for i, recording := range recordingList {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Recording ID: "), recording.Id) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Workspace: "), recording.WorkspaceName) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Project: "), recording.ProjectName) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Started At: "), recording.StartedAt) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Duration: "), getDurationString(recording.Duration)) + "\n\n"
+
+		if i < len(recordingList)-1 {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
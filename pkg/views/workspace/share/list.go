@@ -0,0 +1,74 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package share
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"golang.org/x/term"
+)
+
+func ListShares(shareList []apiclient.WorkspaceShare) {
+	re := lipgloss.NewRenderer(os.Stdout)
+
+	headers := []string{"Name", "Expires At"}
+
+	data := [][]string{}
+
+	for _, share := range shareList {
+		data = append(data, []string{
+			views.NameStyle.Render(share.Name),
+			views.DefaultRowDataStyle.Render(share.ExpiresAt),
+		})
+	}
+
+	terminalWidth, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		fmt.Println(data)
+		return
+	}
+
+	breakpointWidth := views.GetContainerBreakpointWidth(terminalWidth)
+
+	if breakpointWidth == 0 || terminalWidth < views.TUITableMinimumWidth {
+		renderUnstyledList(shareList)
+		return
+	}
+
+	t := table.New().
+		Headers(headers...).
+		Rows(data...).
+		BorderStyle(re.NewStyle().Foreground(views.LightGray)).
+		BorderRow(false).BorderColumn(false).BorderLeft(false).BorderRight(false).BorderTop(false).BorderBottom(false).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == 0 {
+				return views.TableHeaderStyle
+			}
+			return views.BaseCellStyle
+		}).Width(breakpointWidth - 2*views.BaseTableStyleHorizontalPadding)
+
+	fmt.Println(views.BaseTableStyle.Render(t.String()))
+}
+
+func renderUnstyledList(shareList []apiclient.WorkspaceShare) {
+	output := "\n"
+
+	for i, share := range shareList {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Share Name: "), share.Name) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Expires At: "), share.ExpiresAt) + "\n\n"
+
+		if i < len(shareList)-1 {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
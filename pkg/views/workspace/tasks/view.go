@@ -0,0 +1,101 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package tasks
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/daytonaio/daytona/pkg/agent/tasks"
+	"github.com/daytonaio/daytona/pkg/views"
+
+	"github.com/charmbracelet/huh"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"golang.org/x/term"
+)
+
+func ListTasks(taskList []tasks.Task) {
+	re := lipgloss.NewRenderer(os.Stdout)
+
+	headers := []string{"Task", "Source", "Command"}
+
+	data := [][]string{}
+
+	for _, task := range taskList {
+		data = append(data, []string{
+			views.NameStyle.Render(task.Name),
+			views.DefaultRowDataStyle.Render(string(task.Source)),
+			views.DefaultRowDataStyle.Render(task.Command),
+		})
+	}
+
+	terminalWidth, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		fmt.Println(data)
+		return
+	}
+
+	breakpointWidth := views.GetContainerBreakpointWidth(terminalWidth)
+
+	if breakpointWidth == 0 || terminalWidth < views.TUITableMinimumWidth {
+		renderUnstyledList(taskList)
+		return
+	}
+
+	t := table.New().
+		Headers(headers...).
+		Rows(data...).
+		BorderStyle(re.NewStyle().Foreground(views.LightGray)).
+		BorderRow(false).BorderColumn(false).BorderLeft(false).BorderRight(false).BorderTop(false).BorderBottom(false).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == 0 {
+				return views.TableHeaderStyle
+			}
+			return views.BaseCellStyle
+		}).Width(breakpointWidth - 2*views.BaseTableStyleHorizontalPadding)
+
+	fmt.Println(views.BaseTableStyle.Render(t.String()))
+}
+
+func GetTaskFromPrompt(taskList []tasks.Task) (*tasks.Task, error) {
+	var selectedName string
+
+	options := []huh.Option[string]{}
+	for _, task := range taskList {
+		options = append(options, huh.NewOption(fmt.Sprintf("%s (%s)", task.Name, task.Command), task.Name))
+	}
+
+	err := huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title("Choose a task to run").
+				Options(options...).
+				Value(&selectedName),
+		),
+	).WithTheme(views.GetCustomTheme()).Run()
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks.Find(taskList, selectedName)
+}
+
+func renderUnstyledList(taskList []tasks.Task) {
+	output := "\n"
+
+	for i, task := range taskList {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Task: "), task.Name) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Source: "), task.Source) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Command: "), task.Command) + "\n\n"
+
+		if i < len(taskList)-1 {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
@@ -0,0 +1,273 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package top
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daytonaio/daytona/internal/util"
+	"github.com/daytonaio/daytona/pkg/apiclient"
+	"github.com/daytonaio/daytona/pkg/views"
+)
+
+const refreshInterval = 2 * time.Second
+
+type SortKey string
+
+const (
+	SortByName   SortKey = "name"
+	SortByCpu    SortKey = "cpu"
+	SortByMemory SortKey = "memory"
+	SortByDisk   SortKey = "disk"
+)
+
+type FetchStatsFunc func() ([]apiclient.ProjectStats, error)
+type StopWorkspaceFunc func(workspaceId string) error
+
+type tickMsg time.Time
+
+type statsMsg struct {
+	stats []apiclient.ProjectStats
+	err   error
+}
+
+type stopMsg struct {
+	workspaceName string
+	err           error
+}
+
+type model struct {
+	table         table.Model
+	stats         []apiclient.ProjectStats
+	sortKey       SortKey
+	fetchStats    FetchStatsFunc
+	stopWorkspace StopWorkspaceFunc
+	status        string
+	err           error
+	selected      *apiclient.ProjectStats
+}
+
+var columns = []table.Column{
+	{Title: "Workspace", Width: 20},
+	{Title: "Project", Width: 20},
+	{Title: "Target", Width: 15},
+	{Title: "CPU", Width: 8},
+	{Title: "Memory", Width: 18},
+	{Title: "Disk", Width: 18},
+	{Title: "Uptime", Width: 15},
+}
+
+var helpStyle = lipgloss.NewStyle().Foreground(views.Gray)
+
+// Render runs the live resource view until the user quits.
+// Returns the project selected for SSH, or nil if the user quit the view.
+func Render(fetchStats FetchStatsFunc, stopWorkspace StopWorkspaceFunc) *apiclient.ProjectStats {
+	t := table.New(
+		table.WithColumns(columns),
+		table.WithFocused(true),
+		table.WithHeight(15),
+	)
+
+	style := table.DefaultStyles()
+	style.Header = style.Header.
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(views.LightGray).
+		BorderBottom(true).
+		Bold(false)
+	style.Selected = style.Selected.
+		Foreground(views.Dark).
+		Background(views.Green).
+		Bold(false)
+	t.SetStyles(style)
+
+	m := model{
+		table:         t,
+		sortKey:       SortByCpu,
+		fetchStats:    fetchStats,
+		stopWorkspace: stopWorkspace,
+	}
+
+	p, err := tea.NewProgram(m, tea.WithAltScreen()).Run()
+	if err != nil {
+		fmt.Println("Error running program:", err)
+		os.Exit(1)
+	}
+
+	if m, ok := p.(model); ok {
+		return m.selected
+	}
+
+	return nil
+}
+
+func (m model) Init() tea.Cmd {
+	return m.fetch()
+}
+
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "ctrl+c", "q", "esc":
+			return m, tea.Quit
+		case "enter", "x":
+			if selected := m.getSelected(); selected != nil {
+				m.selected = selected
+				return m, tea.Quit
+			}
+			return m, nil
+		case "s":
+			if selected := m.getSelected(); selected != nil {
+				m.status = fmt.Sprintf("Stopping workspace '%s'...", selected.WorkspaceName)
+				return m, m.stop(*selected)
+			}
+			return m, nil
+		case "c":
+			m.setSortKey(SortByCpu)
+			return m, nil
+		case "m":
+			m.setSortKey(SortByMemory)
+			return m, nil
+		case "d":
+			m.setSortKey(SortByDisk)
+			return m, nil
+		case "n":
+			m.setSortKey(SortByName)
+			return m, nil
+		}
+	case tea.WindowSizeMsg:
+		m.table.SetHeight(max(msg.Height-8, 5))
+		return m, nil
+	case tickMsg:
+		return m, m.fetch()
+	case statsMsg:
+		m.err = msg.err
+		if msg.err == nil {
+			m.stats = msg.stats
+			m.refreshRows()
+		}
+		return m, tick()
+	case stopMsg:
+		if msg.err != nil {
+			m.status = fmt.Sprintf("Failed to stop workspace '%s': %s", msg.workspaceName, msg.err.Error())
+		} else {
+			m.status = fmt.Sprintf("Workspace '%s' stopped", msg.workspaceName)
+		}
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.table, cmd = m.table.Update(msg)
+	return m, cmd
+}
+
+func (m model) View() string {
+	output := views.GetStyledMainTitle("Daytona Top") + "\n\n"
+	output += m.table.View() + "\n\n"
+
+	if m.err != nil {
+		output += views.InactiveStyle.Render("Failed to fetch stats: "+m.err.Error()) + "\n"
+	} else if m.status != "" {
+		output += m.status + "\n"
+	} else if len(m.stats) == 0 {
+		output += "No running projects\n"
+	}
+
+	output += helpStyle.Render(fmt.Sprintf("sort: c cpu • m memory • d disk • n name (%s) • s stop • enter/x ssh • q quit", m.sortKey))
+
+	return output
+}
+
+func (m model) fetch() tea.Cmd {
+	return func() tea.Msg {
+		stats, err := m.fetchStats()
+		return statsMsg{stats: stats, err: err}
+	}
+}
+
+func (m model) stop(stats apiclient.ProjectStats) tea.Cmd {
+	return func() tea.Msg {
+		err := m.stopWorkspace(stats.WorkspaceId)
+		return stopMsg{workspaceName: stats.WorkspaceName, err: err}
+	}
+}
+
+func tick() tea.Cmd {
+	return tea.Tick(refreshInterval, func(t time.Time) tea.Msg {
+		return tickMsg(t)
+	})
+}
+
+func (m *model) setSortKey(sortKey SortKey) {
+	m.sortKey = sortKey
+	m.refreshRows()
+}
+
+func (m *model) getSelected() *apiclient.ProjectStats {
+	cursor := m.table.Cursor()
+	if cursor < 0 || cursor >= len(m.stats) {
+		return nil
+	}
+
+	selected := m.stats[cursor]
+	return &selected
+}
+
+func (m *model) refreshRows() {
+	sortStats(m.stats, m.sortKey)
+
+	rows := []table.Row{}
+	for _, stats := range m.stats {
+		rows = append(rows, getRow(stats))
+	}
+
+	m.table.SetRows(rows)
+	if m.table.Cursor() >= len(rows) {
+		m.table.SetCursor(max(len(rows)-1, 0))
+	}
+}
+
+func sortStats(stats []apiclient.ProjectStats, sortKey SortKey) {
+	sort.SliceStable(stats, func(i, j int) bool {
+		switch sortKey {
+		case SortByCpu:
+			return stats[i].Resources.GetCpuUsage() > stats[j].Resources.GetCpuUsage()
+		case SortByMemory:
+			return stats[i].Resources.GetMemoryUsageMB() > stats[j].Resources.GetMemoryUsageMB()
+		case SortByDisk:
+			return stats[i].Resources.GetDiskUsageMB() > stats[j].Resources.GetDiskUsageMB()
+		}
+
+		if stats[i].WorkspaceName != stats[j].WorkspaceName {
+			return stats[i].WorkspaceName < stats[j].WorkspaceName
+		}
+		return stats[i].ProjectName < stats[j].ProjectName
+	})
+}
+
+func getRow(stats apiclient.ProjectStats) table.Row {
+	cpu, memory, disk := "-", "-", "-"
+
+	if stats.Resources != nil {
+		cpu = fmt.Sprintf("%.1f%%", stats.Resources.GetCpuUsage())
+		memory = fmt.Sprintf("%d / %d MB", stats.Resources.GetMemoryUsageMB(), stats.Resources.GetMemoryLimitMB())
+		disk = fmt.Sprintf("%d / %d MB", stats.Resources.GetDiskUsageMB(), stats.Resources.GetDiskTotalMB())
+	}
+
+	return table.Row{
+		stats.WorkspaceName,
+		stats.ProjectName,
+		stats.Target,
+		cpu,
+		memory,
+		disk,
+		util.FormatUptime(stats.Uptime),
+	}
+}
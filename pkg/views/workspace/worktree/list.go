@@ -0,0 +1,88 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package worktree
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/daytonaio/daytona/pkg/git"
+	"github.com/daytonaio/daytona/pkg/views"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"golang.org/x/term"
+)
+
+func ListWorktrees(worktreeList []git.Worktree) {
+	re := lipgloss.NewRenderer(os.Stdout)
+
+	headers := []string{"Branch", "Path", "Commit"}
+
+	data := [][]string{}
+
+	for _, worktree := range worktreeList {
+		data = append(data, []string{
+			views.NameStyle.Render(getBranchString(worktree)),
+			views.DefaultRowDataStyle.Render(worktree.Path),
+			views.DefaultRowDataStyle.Render(getShortSha(worktree.Sha)),
+		})
+	}
+
+	terminalWidth, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		fmt.Println(data)
+		return
+	}
+
+	breakpointWidth := views.GetContainerBreakpointWidth(terminalWidth)
+
+	if breakpointWidth == 0 || terminalWidth < views.TUITableMinimumWidth {
+		renderUnstyledList(worktreeList)
+		return
+	}
+
+	t := table.New().
+		Headers(headers...).
+		Rows(data...).
+		BorderStyle(re.NewStyle().Foreground(views.LightGray)).
+		BorderRow(false).BorderColumn(false).BorderLeft(false).BorderRight(false).BorderTop(false).BorderBottom(false).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == 0 {
+				return views.TableHeaderStyle
+			}
+			return views.BaseCellStyle
+		}).Width(breakpointWidth - 2*views.BaseTableStyleHorizontalPadding)
+
+	fmt.Println(views.BaseTableStyle.Render(t.String()))
+}
+
+func getBranchString(worktree git.Worktree) string {
+	if worktree.Branch == "" {
+		return "(detached)"
+	}
+	return worktree.Branch
+}
+
+func getShortSha(sha string) string {
+	return sha[:min(len(sha), 8)]
+}
+
+func renderUnstyledList(worktreeList []git.Worktree) {
+	output := "\n"
+
+	for i, worktree := range worktreeList {
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Branch: "), getBranchString(worktree)) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Path: "), worktree.Path) + "\n\n"
+
+		output += fmt.Sprintf("%s %s", views.GetPropertyKey("Commit: "), getShortSha(worktree.Sha)) + "\n\n"
+
+		if i < len(worktreeList)-1 {
+			output += views.SeparatorString + "\n\n"
+		}
+	}
+
+	fmt.Println(output)
+}
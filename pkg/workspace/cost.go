@@ -0,0 +1,60 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import "time"
+
+// CostItem is a resource provisioned for the workspace, e.g. an instance type or a disk
+type CostItem struct {
+	Name       string  `json:"name" validate:"required"`
+	HourlyCost float64 `json:"hourlyCost" validate:"required"`
+	// Set for resources that are also charged while the workspace is stopped, e.g. disks
+	ChargedWhenStopped bool `json:"chargedWhenStopped" validate:"required"`
+} // @name CostItem
+
+// CostEstimate is reported by the provider after the workspace is provisioned
+type CostEstimate struct {
+	Currency string     `json:"currency" validate:"required"`
+	Items    []CostItem `json:"items" validate:"required"`
+} // @name CostEstimate
+
+func (e *CostEstimate) GetHourlyCost(running bool) float64 {
+	var hourlyCost float64
+	for _, item := range e.Items {
+		if running || item.ChargedWhenStopped {
+			hourlyCost += item.HourlyCost
+		}
+	}
+	return hourlyCost
+}
+
+// WorkspaceCost tracks the estimated cost of the workspace since it was created
+type WorkspaceCost struct {
+	Estimate CostEstimate `json:"estimate" validate:"required"`
+	// Hourly cost in the current workspace state
+	HourlyCost      float64 `json:"hourlyCost" validate:"required"`
+	AccumulatedCost float64 `json:"accumulatedCost" validate:"required"`
+	// RFC3339 timestamp up to which the cost is accumulated
+	AccumulatedAt string `json:"accumulatedAt" validate:"required"`
+} // @name WorkspaceCost
+
+func NewWorkspaceCost(estimate CostEstimate, running bool, now time.Time) *WorkspaceCost {
+	return &WorkspaceCost{
+		Estimate:      estimate,
+		HourlyCost:    estimate.GetHourlyCost(running),
+		AccumulatedAt: now.Format(time.RFC3339),
+	}
+}
+
+// Accumulate adds the cost incurred at the previous hourly cost since the last accumulation
+// and continues with the given hourly cost
+func (c *WorkspaceCost) Accumulate(now time.Time, hourlyCost float64) {
+	accumulatedAt, err := time.Parse(time.RFC3339, c.AccumulatedAt)
+	if err == nil && now.After(accumulatedAt) {
+		c.AccumulatedCost += c.HourlyCost * now.Sub(accumulatedAt).Hours()
+	}
+
+	c.HourlyCost = hourlyCost
+	c.AccumulatedAt = now.Format(time.RFC3339)
+}
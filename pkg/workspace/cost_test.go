@@ -0,0 +1,37 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkspaceCost(t *testing.T) {
+	estimate := workspace.CostEstimate{
+		Currency: "USD",
+		Items: []workspace.CostItem{
+			{Name: "t3.large", HourlyCost: 0.08},
+			{Name: "50 GB gp3 disk", HourlyCost: 0.02, ChargedWhenStopped: true},
+		},
+	}
+
+	require.InDelta(t, 0.1, estimate.GetHourlyCost(true), 1e-9)
+	require.InDelta(t, 0.02, estimate.GetHourlyCost(false), 1e-9)
+
+	createdAt := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	cost := workspace.NewWorkspaceCost(estimate, true, createdAt)
+	require.InDelta(t, 0.1, cost.HourlyCost, 1e-9)
+
+	// Running for 10 hours, then stopped for 5 hours
+	cost.Accumulate(createdAt.Add(10*time.Hour), estimate.GetHourlyCost(false))
+	require.InDelta(t, 1.0, cost.AccumulatedCost, 1e-9)
+
+	cost.Accumulate(createdAt.Add(15*time.Hour), estimate.GetHourlyCost(false))
+	require.InDelta(t, 1.1, cost.AccumulatedCost, 1e-9)
+	require.Equal(t, createdAt.Add(15*time.Hour).Format(time.RFC3339), cost.AccumulatedAt)
+}
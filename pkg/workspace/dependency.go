@@ -0,0 +1,130 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrUnknownProjectDependency = errors.New("project depends on a project that is not part of the workspace")
+	ErrProjectDependencyCycle   = errors.New("project dependencies contain a cycle")
+)
+
+// ValidateProjectDependencies returns an error if a project depends on itself, on an unknown project
+// or if the dependencies can not be ordered
+func ValidateProjectDependencies(projects []*Project) error {
+	names := map[string]bool{}
+	for _, project := range projects {
+		names[project.Name] = true
+	}
+
+	for _, project := range projects {
+		for _, dependency := range project.DependsOn {
+			if !names[dependency] {
+				return fmt.Errorf("%w: %s depends on %s", ErrUnknownProjectDependency, project.Name, dependency)
+			}
+		}
+	}
+
+	_, err := GetProjectStartOrder(projects)
+	return err
+}
+
+// GetProjectStartOrder returns the projects ordered so that each project comes after the projects it depends on.
+// Projects without dependencies between them keep their order. Dependencies outside of the given projects are ignored
+func GetProjectStartOrder(projects []*Project) ([]*Project, error) {
+	included := map[string]bool{}
+	for _, project := range projects {
+		included[project.Name] = true
+	}
+
+	ordered := []*Project{}
+	added := map[string]bool{}
+
+	for len(ordered) < len(projects) {
+		progress := false
+
+		for _, project := range projects {
+			if added[project.Name] || !dependenciesAdded(project, included, added) {
+				continue
+			}
+
+			ordered = append(ordered, project)
+			added[project.Name] = true
+			progress = true
+		}
+
+		if !progress {
+			return nil, fmt.Errorf("%w: %s", ErrProjectDependencyCycle, strings.Join(getUnorderedProjectNames(projects, added), ", "))
+		}
+	}
+
+	return ordered, nil
+}
+
+// GetProjectDependencies returns the projects the project depends on directly or through other projects
+func (w *Workspace) GetProjectDependencies(projectName string) []*Project {
+	dependencies := []*Project{}
+	visited := map[string]bool{projectName: true}
+	queue := []string{projectName}
+
+	for len(queue) > 0 {
+		project, err := w.GetProject(queue[0])
+		queue = queue[1:]
+		if err != nil {
+			continue
+		}
+
+		for _, dependencyName := range project.DependsOn {
+			if visited[dependencyName] {
+				continue
+			}
+			visited[dependencyName] = true
+
+			dependency, err := w.GetProject(dependencyName)
+			if err != nil {
+				continue
+			}
+
+			dependencies = append(dependencies, dependency)
+			queue = append(queue, dependencyName)
+		}
+	}
+
+	return dependencies
+}
+
+// HasDependents returns true if another workspace project depends on the project
+func (w *Workspace) HasDependents(projectName string) bool {
+	for _, project := range w.Projects {
+		for _, dependency := range project.DependsOn {
+			if dependency == projectName {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func dependenciesAdded(project *Project, included, added map[string]bool) bool {
+	for _, dependency := range project.DependsOn {
+		if included[dependency] && !added[dependency] {
+			return false
+		}
+	}
+	return true
+}
+
+func getUnorderedProjectNames(projects []*Project, added map[string]bool) []string {
+	names := []string{}
+	for _, project := range projects {
+		if !added[project.Name] {
+			names = append(names, project.Name)
+		}
+	}
+	return names
+}
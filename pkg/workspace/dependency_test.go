@@ -0,0 +1,67 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace_test
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectStartOrder(t *testing.T) {
+	projects := []*workspace.Project{
+		{Name: "web", DependsOn: []string{"api"}},
+		{Name: "api", DependsOn: []string{"db", "cache"}},
+		{Name: "docs"},
+		{Name: "db"},
+		{Name: "cache"},
+	}
+
+	require.NoError(t, workspace.ValidateProjectDependencies(projects))
+
+	ordered, err := workspace.GetProjectStartOrder(projects)
+	require.NoError(t, err)
+	require.Equal(t, []string{"docs", "db", "cache", "api", "web"}, getProjectNames(ordered))
+
+	// Dependencies that are not being started are ignored
+	ordered, err = workspace.GetProjectStartOrder([]*workspace.Project{projects[0], projects[1]})
+	require.NoError(t, err)
+	require.Equal(t, []string{"api", "web"}, getProjectNames(ordered))
+
+	w := &workspace.Workspace{Projects: projects}
+	require.Equal(t, []string{"api", "db", "cache"}, getProjectNames(w.GetProjectDependencies("web")))
+	require.Empty(t, w.GetProjectDependencies("docs"))
+	require.True(t, w.HasDependents("db"))
+	require.False(t, w.HasDependents("web"))
+}
+
+func TestProjectDependenciesValidation(t *testing.T) {
+	err := workspace.ValidateProjectDependencies([]*workspace.Project{
+		{Name: "api", DependsOn: []string{"db"}},
+	})
+	require.ErrorIs(t, err, workspace.ErrUnknownProjectDependency)
+
+	err = workspace.ValidateProjectDependencies([]*workspace.Project{
+		{Name: "api", DependsOn: []string{"api"}},
+	})
+	require.ErrorIs(t, err, workspace.ErrProjectDependencyCycle)
+
+	err = workspace.ValidateProjectDependencies([]*workspace.Project{
+		{Name: "web", DependsOn: []string{"api"}},
+		{Name: "api", DependsOn: []string{"db"}},
+		{Name: "db", DependsOn: []string{"web"}},
+		{Name: "docs"},
+	})
+	require.ErrorIs(t, err, workspace.ErrProjectDependencyCycle)
+	require.EqualError(t, err, "project dependencies contain a cycle: web, api, db")
+}
+
+func getProjectNames(projects []*workspace.Project) []string {
+	names := []string{}
+	for _, project := range projects {
+		names = append(names, project.Name)
+	}
+	return names
+}
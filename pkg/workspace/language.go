@@ -0,0 +1,105 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Detection stops after this many files so large repositories do not delay the project start
+const maxLanguageDetectionFiles = 20000
+
+var languageExtensions = map[string]string{
+	".go":    "go",
+	".ts":    "typescript",
+	".tsx":   "typescript",
+	".js":    "javascript",
+	".jsx":   "javascript",
+	".mjs":   "javascript",
+	".py":    "python",
+	".java":  "java",
+	".kt":    "kotlin",
+	".kts":   "kotlin",
+	".rs":    "rust",
+	".c":     "c",
+	".h":     "c",
+	".cc":    "cpp",
+	".cpp":   "cpp",
+	".hpp":   "cpp",
+	".cs":    "csharp",
+	".php":   "php",
+	".rb":    "ruby",
+	".swift": "swift",
+	".scala": "scala",
+	".dart":  "dart",
+}
+
+// Dependency and build output directories are not part of the project sources
+var ignoredLanguageDetectionDirs = map[string]bool{
+	".git":         true,
+	"node_modules": true,
+	"vendor":       true,
+	"dist":         true,
+	"build":        true,
+	"target":       true,
+	".venv":        true,
+	"venv":         true,
+	"__pycache__":  true,
+}
+
+// DetectLanguage returns the language with the most source code in the project directory by file extension.
+// Returns an empty string if no known source files are found
+func DetectLanguage(projectDir string) (string, error) {
+	sizes := map[string]int64{}
+	files := 0
+
+	err := filepath.WalkDir(projectDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsPermission(err) {
+				return nil
+			}
+			return err
+		}
+
+		if d.IsDir() {
+			if path != projectDir && ignoredLanguageDetectionDirs[d.Name()] {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		files++
+		if files > maxLanguageDetectionFiles {
+			return filepath.SkipAll
+		}
+
+		language, ok := languageExtensions[strings.ToLower(filepath.Ext(d.Name()))]
+		if !ok {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+
+		sizes[language] += info.Size()
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	primaryLanguage := ""
+	for language, size := range sizes {
+		if size > sizes[primaryLanguage] || (size == sizes[primaryLanguage] && language < primaryLanguage) {
+			primaryLanguage = language
+		}
+	}
+
+	return primaryLanguage, nil
+}
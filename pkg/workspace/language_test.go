@@ -0,0 +1,46 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectLanguage(t *testing.T) {
+	projectDir := t.TempDir()
+
+	files := map[string]string{
+		"main.go":                   "package main\n\nfunc main() {}\n",
+		"pkg/util/util.go":          "package util\n",
+		"web/index.ts":              "export {}\n",
+		"node_modules/lib/index.js": "module.exports = {}; // a large dependency that must be ignored\n",
+	}
+
+	for path, content := range files {
+		err := os.MkdirAll(filepath.Dir(filepath.Join(projectDir, path)), 0755)
+		require.NoError(t, err)
+		err = os.WriteFile(filepath.Join(projectDir, path), []byte(content), 0644)
+		require.NoError(t, err)
+	}
+
+	language, err := workspace.DetectLanguage(projectDir)
+	require.NoError(t, err)
+	require.Equal(t, "go", language)
+}
+
+func TestDetectLanguage_NoSources(t *testing.T) {
+	projectDir := t.TempDir()
+
+	err := os.WriteFile(filepath.Join(projectDir, "README.md"), []byte("# Project\n"), 0644)
+	require.NoError(t, err)
+
+	language, err := workspace.DetectLanguage(projectDir)
+	require.NoError(t, err)
+	require.Equal(t, "", language)
+}
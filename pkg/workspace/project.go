@@ -25,8 +25,16 @@ type ProjectBuildDockerfile struct {
 } // @name ProjectBuildDockerfile
 */
 
+// ProjectBuildExport pushes the built image to an external container registry
+// so it can be reused by other Daytona servers or CI systems
+type ProjectBuildExport struct {
+	// Image name the build is pushed as, e.g. registry.example.com/team/project:latest
+	Image string `json:"image" validate:"required"`
+} // @name ProjectBuildExport
+
 type ProjectBuild struct {
 	Devcontainer *ProjectBuildDevcontainer `json:"devcontainer"`
+	Export       *ProjectBuildExport       `json:"export,omitempty"`
 	/*
 		Dockerfile   *ProjectBuildDockerfile   `json:"dockerfile"`
 	*/
@@ -45,8 +53,55 @@ type Project struct {
 	State              *ProjectState              `json:"state,omitempty"`
 	PostCreateCommands []string                   `json:"postCreateCommands,omitempty"`
 	PostStartCommands  []string                   `json:"postStartCommands,omitempty"`
+	// Digest reference (name@sha256:...) of the build image pushed to the export registry
+	ExportedImage string `json:"exportedImage,omitempty"`
+	// Git provider config used for the repository. Resolved from the repository URL if empty
+	GitProviderConfigId string `json:"gitProviderConfigId,omitempty"`
+	// Last known state of the project container or VM. Updated by the server and verified against the provider periodically
+	Status ProjectStatus `json:"status,omitempty"`
+	// Reason the project is in the error status
+	StatusError string `json:"statusError,omitempty"`
+	// Time (RFC3339) the status was last verified with the provider
+	StateLastVerifiedAt string `json:"stateLastVerifiedAt,omitempty"`
+	// Ports forwarded by the server while the project is running
+	PortForwards []ProjectPortForward `json:"portForwards,omitempty"`
+	// Set if the project image is built from its build config during workspace creation
+	Prebuild *ProjectPrebuild `json:"prebuild,omitempty"`
+	// Names of the workspace projects that are started and healthy before the project is started
+	DependsOn []string `json:"dependsOn,omitempty"`
+	// Terminal sessions of SSH connections to the project are recorded by the agent and uploaded to the server
+	RecordSessions bool `json:"recordSessions,omitempty"`
+	// The repository is cloned as a bare repository and branches are checked out as worktrees in the project directory
+	WorktreeMode bool `json:"worktreeMode,omitempty"`
 } // @name Project
 
+type ProjectPortForward struct {
+	Port uint16 `json:"port" validate:"required"`
+	// Exposes the port through a public URL on the server's reverse proxy
+	Public bool `json:"public" validate:"required"`
+} // @name ProjectPortForward
+
+// ProjectPrebuild reports whether the project image was taken from a previous build of the same project config
+type ProjectPrebuild struct {
+	// False if the image was built during workspace creation
+	Hit bool `json:"hit" validate:"required"`
+	// ID of the build that produced the image
+	BuildId string `json:"buildId,omitempty"`
+	Image   string `json:"image,omitempty"`
+	// Commit the image was built from
+	Sha string `json:"sha,omitempty"`
+} // @name ProjectPrebuild
+
+type ProjectStatus string // @name ProjectStatus
+
+const (
+	ProjectStatusRunning ProjectStatus = "running"
+	ProjectStatusStopped ProjectStatus = "stopped"
+	ProjectStatusError   ProjectStatus = "error"
+	// The project data is stored in the server archive storage and the project is removed from the target
+	ProjectStatusArchived ProjectStatus = "archived"
+)
+
 type ProjectInfo struct {
 	Name             string `json:"name"`
 	Created          string `json:"created"`
@@ -56,13 +111,29 @@ type ProjectInfo struct {
 } // @name ProjectInfo
 
 type ProjectState struct {
-	UpdatedAt string     `json:"updatedAt"`
-	Uptime    uint64     `json:"uptime"`
-	GitStatus *GitStatus `json:"gitStatus"`
+	UpdatedAt string            `json:"updatedAt"`
+	Uptime    uint64            `json:"uptime"`
+	GitStatus *GitStatus        `json:"gitStatus"`
+	Resources *ProjectResources `json:"resources,omitempty"`
+	// Markdown content of the project welcome file (.daytona/welcome.md)
+	Welcome string `json:"welcome,omitempty"`
+	// Primary language of the project sources detected by the agent after cloning (e.g. go, typescript)
+	Language string `json:"language,omitempty"`
 } // @name ProjectState
 
+// ProjectResources is the resource usage of a project as reported by the agent
+type ProjectResources struct {
+	// CPU usage in percent of a single core
+	CpuUsage      float64 `json:"cpuUsage"`
+	MemoryUsageMB uint64  `json:"memoryUsageMB"`
+	MemoryLimitMB uint64  `json:"memoryLimitMB"`
+	DiskUsageMB   uint64  `json:"diskUsageMB"`
+	DiskTotalMB   uint64  `json:"diskTotalMB"`
+} // @name ProjectResources
+
 type GitStatus struct {
 	CurrentBranch string        `json:"currentBranch"`
+	HeadSha       string        `json:"headSha,omitempty"`
 	Files         []*FileStatus `json:"fileStatus"`
 } // @name GitStatus
 
@@ -100,6 +171,10 @@ func GetProjectEnvVars(project *Project, apiUrl, serverUrl string) map[string]st
 		"DAYTONA_AGENT_LOG_FILE_PATH": "(HOME)/.daytona-agent.log",
 	}
 
+	if project.RecordSessions {
+		envVars["DAYTONA_RECORD_SSH_SESSIONS"] = "true"
+	}
+
 	return envVars
 }
 
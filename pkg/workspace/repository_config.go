@@ -0,0 +1,97 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"gopkg.in/yaml.v2"
+)
+
+// Path of the repository config relative to the repository root
+const RepositoryConfigPath = ".daytona/config.yaml"
+
+type RepositoryConfigBuilder string
+
+const (
+	RepositoryConfigBuilderAuto         RepositoryConfigBuilder = "auto"
+	RepositoryConfigBuilderDevcontainer RepositoryConfigBuilder = "devcontainer"
+	RepositoryConfigBuilderCustomImage  RepositoryConfigBuilder = "custom-image"
+	RepositoryConfigBuilderNone         RepositoryConfigBuilder = "none"
+)
+
+var ErrInvalidRepositoryConfig = errors.New("invalid repository config")
+
+// RepositoryConfig declares the default workspace settings of a repository. Settings passed to daytona create take precedence
+type RepositoryConfig struct {
+	// Branch cloned if the repository URL does not specify one
+	Branch  string                  `json:"branch,omitempty" yaml:"branch"`
+	Builder RepositoryConfigBuilder `json:"builder,omitempty" yaml:"builder"`
+	// Path of the devcontainer config used by the devcontainer builder
+	DevcontainerPath string `json:"devcontainerPath,omitempty" yaml:"devcontainerPath"`
+	// Image and user of the custom-image builder
+	Image     string            `json:"image,omitempty" yaml:"image"`
+	ImageUser string            `json:"imageUser,omitempty" yaml:"imageUser"`
+	EnvVars   map[string]string `json:"envVars,omitempty" yaml:"envVars"`
+	// Ports forwarded by the server while the project is running
+	Ports []ProjectPortForward `json:"ports,omitempty" yaml:"ports"`
+	// Commands run in the project directory once the project is created
+	PostCreateCommands []string `json:"postCreateCommands,omitempty" yaml:"postCreateCommands"`
+} // @name RepositoryConfig
+
+// ParseRepositoryConfig parses and validates the repository config. Unknown keys are rejected so that typos are not silently ignored
+func ParseRepositoryConfig(content []byte) (*RepositoryConfig, error) {
+	config := &RepositoryConfig{}
+
+	err := yaml.UnmarshalStrict(content, config)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidRepositoryConfig, err)
+	}
+
+	if config.Branch != "" && plumbing.NewBranchReferenceName(config.Branch).Validate() != nil {
+		return nil, fmt.Errorf("%w: invalid branch name %s", ErrInvalidRepositoryConfig, config.Branch)
+	}
+
+	// The builder of the devcontainer path and the image is implied
+	if config.Builder == "" && config.DevcontainerPath != "" {
+		config.Builder = RepositoryConfigBuilderDevcontainer
+	}
+	if config.Builder == "" && config.Image != "" {
+		config.Builder = RepositoryConfigBuilderCustomImage
+	}
+
+	switch config.Builder {
+	case "", RepositoryConfigBuilderAuto, RepositoryConfigBuilderDevcontainer, RepositoryConfigBuilderCustomImage, RepositoryConfigBuilderNone:
+	default:
+		return nil, fmt.Errorf("%w: builder must be one of %s/%s/%s/%s", ErrInvalidRepositoryConfig, RepositoryConfigBuilderAuto, RepositoryConfigBuilderDevcontainer, RepositoryConfigBuilderCustomImage, RepositoryConfigBuilderNone)
+	}
+
+	if config.DevcontainerPath != "" && config.Builder != RepositoryConfigBuilderDevcontainer {
+		return nil, fmt.Errorf("%w: devcontainerPath requires the %s builder", ErrInvalidRepositoryConfig, RepositoryConfigBuilderDevcontainer)
+	}
+
+	if config.Builder == RepositoryConfigBuilderCustomImage && config.Image == "" {
+		return nil, fmt.Errorf("%w: the %s builder requires an image", ErrInvalidRepositoryConfig, RepositoryConfigBuilderCustomImage)
+	}
+
+	if (config.Image != "" || config.ImageUser != "") && config.Builder != RepositoryConfigBuilderCustomImage {
+		return nil, fmt.Errorf("%w: image and imageUser require the %s builder", ErrInvalidRepositoryConfig, RepositoryConfigBuilderCustomImage)
+	}
+
+	for _, port := range config.Ports {
+		if port.Port == 0 {
+			return nil, fmt.Errorf("%w: ports must be between 1 and 65535", ErrInvalidRepositoryConfig)
+		}
+	}
+
+	for name := range config.EnvVars {
+		if name == "" {
+			return nil, fmt.Errorf("%w: environment variable names must not be empty", ErrInvalidRepositoryConfig)
+		}
+	}
+
+	return config, nil
+}
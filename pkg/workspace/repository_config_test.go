@@ -0,0 +1,61 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace_test
+
+import (
+	"testing"
+
+	"github.com/daytonaio/daytona/pkg/workspace"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRepositoryConfig(t *testing.T) {
+	config, err := workspace.ParseRepositoryConfig([]byte(`
+branch: develop
+devcontainerPath: .devcontainer/dev/devcontainer.json
+envVars:
+  LOG_LEVEL: debug
+ports:
+  - port: 3000
+  - port: 8080
+    public: true
+postCreateCommands:
+  - make setup
+`))
+	require.NoError(t, err)
+	require.Equal(t, &workspace.RepositoryConfig{
+		Branch:             "develop",
+		Builder:            workspace.RepositoryConfigBuilderDevcontainer,
+		DevcontainerPath:   ".devcontainer/dev/devcontainer.json",
+		EnvVars:            map[string]string{"LOG_LEVEL": "debug"},
+		Ports:              []workspace.ProjectPortForward{{Port: 3000}, {Port: 8080, Public: true}},
+		PostCreateCommands: []string{"make setup"},
+	}, config)
+
+	config, err = workspace.ParseRepositoryConfig([]byte("image: node:20\nimageUser: node\n"))
+	require.NoError(t, err)
+	require.Equal(t, workspace.RepositoryConfigBuilderCustomImage, config.Builder)
+
+	config, err = workspace.ParseRepositoryConfig([]byte(""))
+	require.NoError(t, err)
+	require.Equal(t, &workspace.RepositoryConfig{}, config)
+}
+
+func TestParseRepositoryConfigValidation(t *testing.T) {
+	invalidConfigs := []string{
+		"brnach: develop",
+		"branch: 'bad..branch'",
+		"builder: docker",
+		"builder: custom-image",
+		"builder: none\ndevcontainerPath: .devcontainer/devcontainer.json",
+		"builder: devcontainer\nimage: node:20",
+		"ports:\n  - port: 0",
+		"ports:\n  - port: 70000",
+	}
+
+	for _, content := range invalidConfigs {
+		_, err := workspace.ParseRepositoryConfig([]byte(content))
+		require.ErrorIs(t, err, workspace.ErrInvalidRepositoryConfig, content)
+	}
+}
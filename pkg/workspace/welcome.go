@@ -0,0 +1,36 @@
+// Copyright 2024 Daytona Platforms Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package workspace
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// Path of the welcome file relative to the project directory
+const WelcomeFilePath = ".daytona/welcome.md"
+
+// The welcome file is reported with the project state so larger files are truncated
+const maxWelcomeFileSize = 16 * 1024
+
+// ReadWelcomeFile returns the content of the project welcome file or an empty string if the project has none
+func ReadWelcomeFile(projectDir string) (string, error) {
+	file, err := os.Open(filepath.Join(projectDir, WelcomeFilePath))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", nil
+		}
+		return "", err
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(io.LimitReader(file, maxWelcomeFileSize))
+	if err != nil {
+		return "", err
+	}
+
+	return string(content), nil
+}
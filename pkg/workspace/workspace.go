@@ -5,6 +5,14 @@ package workspace
 
 import (
 	"errors"
+	"time"
+)
+
+type ExpiryAction string
+
+const (
+	ExpiryActionDelete ExpiryAction = "delete"
+	ExpiryActionStop   ExpiryAction = "stop"
 )
 
 type Workspace struct {
@@ -12,9 +20,38 @@ type Workspace struct {
 	Name     string     `json:"name"`
 	Projects []*Project `json:"projects"`
 	Target   string     `json:"target"`
-	ApiKey   string     `json:"-"`
+	// Set if the target was picked from a target group
+	TargetGroup string `json:"targetGroup,omitempty"`
+	ApiKey      string `json:"-"`
+	// RFC3339 timestamp after which the expiry action is performed
+	ExpiresAt    string       `json:"expiresAt,omitempty"`
+	ExpiryAction ExpiryAction `json:"expiryAction,omitempty"`
+	// Set until the workspace is created successfully
+	Creation *WorkspaceCreation `json:"creation,omitempty"`
+	// Set if the provider reports cost estimates
+	Cost *WorkspaceCost `json:"cost,omitempty"`
+	// ID of the user who created the workspace. Empty for workspaces created before users were introduced
+	OwnerId string `json:"ownerId,omitempty"`
 } // @name Workspace
 
+// WorkspaceCreation checkpoints the workspace creation so that an interrupted creation
+// can be retried from the step that failed
+type WorkspaceCreation struct {
+	CompletedSteps []string `json:"completedSteps"`
+	// Step that failed during the last attempt
+	FailedStep string `json:"failedStep,omitempty"`
+	Error      string `json:"error,omitempty"`
+} // @name WorkspaceCreation
+
+func (c *WorkspaceCreation) IsStepCompleted(step string) bool {
+	for _, s := range c.CompletedSteps {
+		if s == step {
+			return true
+		}
+	}
+	return false
+}
+
 type WorkspaceInfo struct {
 	Name             string         `json:"name"`
 	Projects         []*ProjectInfo `json:"projects"`
@@ -30,6 +67,39 @@ func (w *Workspace) GetProject(projectName string) (*Project, error) {
 	return nil, errors.New("project not found")
 }
 
+// IsArchived returns true if any of the workspace projects is archived. Projects are archived and restored together
+func (w *Workspace) IsArchived() bool {
+	for _, project := range w.Projects {
+		if project.Status == ProjectStatusArchived {
+			return true
+		}
+	}
+	return false
+}
+
+// IsRunning returns true if any of the workspace projects is running
+func (w *Workspace) IsRunning() bool {
+	for _, project := range w.Projects {
+		if project.Status == ProjectStatusRunning {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *Workspace) IsExpired() bool {
+	if w.ExpiresAt == "" {
+		return false
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, w.ExpiresAt)
+	if err != nil {
+		return false
+	}
+
+	return time.Now().After(expiresAt)
+}
+
 type WorkspaceEnvVarParams struct {
 	ApiUrl        string
 	ApiKey        string